@@ -98,6 +98,17 @@ CREATE FUNCTION public.notify_on_change() RETURNS trigger
 
 SET default_tablespace = '';
 
+--
+-- Name: draining_storages; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.draining_storages (
+    virtual_storage text NOT NULL,
+    storage text NOT NULL,
+    drained_at timestamp without time zone DEFAULT timezone('UTC'::text, now()) NOT NULL
+);
+
+
 --
 -- Name: node_status; Type: TABLE; Schema: public; Owner: -
 --
@@ -120,7 +131,9 @@ CREATE VIEW public.healthy_storages AS
  SELECT ns.shard_name AS virtual_storage,
     ns.node_name AS storage
    FROM public.node_status ns
-  WHERE (ns.last_seen_active_at >= (now() - '00:00:10'::interval))
+  WHERE ((ns.last_seen_active_at >= (now() - '00:00:10'::interval)) AND (NOT (EXISTS ( SELECT
+           FROM public.draining_storages
+          WHERE ((draining_storages.virtual_storage = (ns.shard_name)::text) AND (draining_storages.storage = (ns.node_name)::text))))))
   GROUP BY ns.shard_name, ns.node_name
  HAVING ((count(ns.praefect_name))::numeric >= ( SELECT ceil(((count(DISTINCT node_status.praefect_name))::numeric / 2.0)) AS quorum_count
            FROM public.node_status
@@ -128,6 +141,44 @@ CREATE VIEW public.healthy_storages AS
   ORDER BY ns.shard_name, ns.node_name;
 
 
+--
+-- Name: consistency_audit; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.consistency_audit (
+    id bigint NOT NULL,
+    repository_id bigint NOT NULL,
+    virtual_storage text NOT NULL,
+    relative_path text NOT NULL,
+    storage text NOT NULL,
+    generation bigint NOT NULL,
+    checksum text NOT NULL,
+    majority_checksum text,
+    source_storage text,
+    decision text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: consistency_audit_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.consistency_audit_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: consistency_audit_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.consistency_audit_id_seq OWNED BY public.consistency_audit.id;
+
+
 --
 -- Name: hello_world; Type: TABLE; Schema: public; Owner: -
 --
@@ -156,6 +207,30 @@ CREATE SEQUENCE public.node_status_id_seq
 ALTER SEQUENCE public.node_status_id_seq OWNED BY public.node_status.id;
 
 
+--
+-- Name: rebalancing_moves; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rebalancing_moves (
+    repository_id bigint NOT NULL,
+    source_storage text NOT NULL,
+    target_storage text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: rebalancing_plans; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rebalancing_plans (
+    virtual_storage text NOT NULL,
+    strategy text NOT NULL,
+    drained_storages text[] DEFAULT '{}'::text[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: replication_queue; Type: TABLE; Schema: public; Owner: -
 --
@@ -168,7 +243,8 @@ CREATE TABLE public.replication_queue (
     attempt integer DEFAULT 3 NOT NULL,
     lock_id text,
     job jsonb,
-    meta jsonb
+    meta jsonb,
+    priority smallint DEFAULT 3 NOT NULL
 );
 
 
@@ -378,6 +454,13 @@ CREATE TABLE public.virtual_storages (
 );
 
 
+--
+-- Name: consistency_audit id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.consistency_audit ALTER COLUMN id SET DEFAULT nextval('public.consistency_audit_id_seq'::regclass);
+
+
 --
 -- Name: node_status id; Type: DEFAULT; Schema: public; Owner: -
 --
@@ -406,6 +489,22 @@ ALTER TABLE ONLY public.repositories ALTER COLUMN repository_id SET DEFAULT next
 ALTER TABLE ONLY public.shard_primaries ALTER COLUMN id SET DEFAULT nextval('public.shard_primaries_id_seq'::regclass);
 
 
+--
+-- Name: consistency_audit consistency_audit_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.consistency_audit
+    ADD CONSTRAINT consistency_audit_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: draining_storages draining_storages_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.draining_storages
+    ADD CONSTRAINT draining_storages_pkey PRIMARY KEY (virtual_storage, storage);
+
+
 --
 -- Name: node_status node_status_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -414,6 +513,22 @@ ALTER TABLE ONLY public.node_status
     ADD CONSTRAINT node_status_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: rebalancing_moves rebalancing_moves_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rebalancing_moves
+    ADD CONSTRAINT rebalancing_moves_pkey PRIMARY KEY (repository_id, source_storage);
+
+
+--
+-- Name: rebalancing_plans rebalancing_plans_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rebalancing_plans
+    ADD CONSTRAINT rebalancing_plans_pkey PRIMARY KEY (virtual_storage);
+
+
 --
 -- Name: replication_queue_job_lock replication_queue_job_lock_pk; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -494,6 +609,13 @@ ALTER TABLE ONLY public.virtual_storages
     ADD CONSTRAINT virtual_storages_pkey PRIMARY KEY (virtual_storage);
 
 
+--
+-- Name: consistency_audit_repository_index; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX consistency_audit_repository_index ON public.consistency_audit USING btree (virtual_storage, relative_path);
+
+
 --
 -- Name: delete_replica_unique_index; Type: INDEX; Schema: public; Owner: -
 --
@@ -606,6 +728,14 @@ CREATE TRIGGER notify_on_insert AFTER INSERT ON public.storage_repositories REFE
 CREATE TRIGGER notify_on_update AFTER UPDATE ON public.storage_repositories REFERENCING OLD TABLE AS old NEW TABLE AS new FOR EACH STATEMENT EXECUTE PROCEDURE public.notify_on_change('storage_repositories_updates');
 
 
+--
+-- Name: rebalancing_moves rebalancing_moves_repository_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rebalancing_moves
+    ADD CONSTRAINT rebalancing_moves_repository_id_fkey FOREIGN KEY (repository_id) REFERENCES public.repositories(repository_id) ON DELETE CASCADE;
+
+
 --
 -- Name: replication_queue_job_lock replication_queue_job_lock_job_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
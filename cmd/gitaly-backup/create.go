@@ -24,23 +24,27 @@ type serverRepository struct {
 }
 
 type createSubcommand struct {
-	backupPath      string
-	parallel        int
-	parallelStorage int
-	layout          string
-	incremental     bool
-	backupID        string
-	serverSide      bool
+	backupPath        string
+	parallel          int
+	parallelStorage   int
+	layout            string
+	incremental       bool
+	backupID          string
+	serverSide        bool
+	encryptionKeyring string
+	allowUnencrypted  bool
 }
 
 func (cmd *createSubcommand) Flags(fs *flag.FlagSet) {
 	fs.StringVar(&cmd.backupPath, "path", "", "repository backup path")
 	fs.IntVar(&cmd.parallel, "parallel", runtime.NumCPU(), "maximum number of parallel backups")
 	fs.IntVar(&cmd.parallelStorage, "parallel-storage", 2, "maximum number of parallel backups per storage. Note: actual parallelism when combined with `-parallel` depends on the order the repositories are received.")
-	fs.StringVar(&cmd.layout, "layout", "pointer", "how backup files are located. Either pointer or legacy.")
+	fs.StringVar(&cmd.layout, "layout", "pointer", "how backup files are located. Either pointer, dedup, or legacy.")
 	fs.BoolVar(&cmd.incremental, "incremental", false, "creates an incremental backup if possible.")
 	fs.StringVar(&cmd.backupID, "id", time.Now().UTC().Format("20060102150405"), "the backup ID used when creating a full backup.")
 	fs.BoolVar(&cmd.serverSide, "server-side", false, "use server-side backups. Note: The feature is not ready for production use.")
+	fs.StringVar(&cmd.encryptionKeyring, "encryption-keyring", "", "path to the keyring file used to encrypt and decrypt the backup files. Backup files are not encrypted if not set.")
+	fs.BoolVar(&cmd.allowUnencrypted, "allow-unencrypted", false, "read backup files that are not encrypted as is when an encryption keyring is set. Allows to keep using backups created before encryption was enabled.")
 }
 
 func (cmd *createSubcommand) Run(ctx context.Context, logger log.Logger, stdin io.Reader, stdout io.Writer) error {
@@ -54,6 +58,9 @@ func (cmd *createSubcommand) Run(ctx context.Context, logger log.Logger, stdin i
 		if cmd.backupPath != "" {
 			return fmt.Errorf("create: path cannot be used with server-side backups")
 		}
+		if cmd.encryptionKeyring != "" {
+			return fmt.Errorf("create: encryption keyring cannot be used with server-side backups")
+		}
 
 		manager = backup.NewServerSideAdapter(pool)
 	} else {
@@ -61,6 +68,17 @@ func (cmd *createSubcommand) Run(ctx context.Context, logger log.Logger, stdin i
 		if err != nil {
 			return fmt.Errorf("create: resolve sink: %w", err)
 		}
+		if cmd.encryptionKeyring != "" {
+			keyring, err := backup.LoadKeyring(cmd.encryptionKeyring)
+			if err != nil {
+				return fmt.Errorf("create: %w", err)
+			}
+			var opts []backup.EncryptedSinkOption
+			if cmd.allowUnencrypted {
+				opts = append(opts, backup.WithUnencryptedReads())
+			}
+			sink = backup.NewEncryptedSink(sink, keyring, opts...)
+		}
 
 		locator, err := backup.ResolveLocator(cmd.layout, sink)
 		if err != nil {
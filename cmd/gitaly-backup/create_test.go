@@ -2,9 +2,11 @@ package main
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"flag"
 	"io"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -14,6 +16,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/setup"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testserver"
@@ -126,3 +129,113 @@ func TestCreateSubcommand_serverSide(t *testing.T) {
 		require.FileExists(t, bundlePath)
 	}
 }
+
+func TestCreateSubcommand_encrypted(t *testing.T) {
+	cfg := testcfg.Build(t)
+
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll)
+
+	ctx := testhelper.Context(t)
+	path := testhelper.TempDir(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+	keyringPath := filepath.Join(testhelper.TempDir(t), "keyring.toml")
+	require.NoError(t, os.WriteFile(keyringPath, []byte(`active_key = "key"
+
+[[keys]]
+id = "key"
+key = "`+base64.StdEncoding.EncodeToString(make([]byte, 32))+`"
+`), perm.PrivateFile))
+
+	var stdin bytes.Buffer
+	require.NoError(t, json.NewEncoder(&stdin).Encode(map[string]string{
+		"address":         cfg.SocketPath,
+		"token":           cfg.Auth.Token,
+		"storage_name":    repo.StorageName,
+		"relative_path":   repo.RelativePath,
+		"gl_project_path": repo.GlProjectPath,
+	}))
+
+	cmd := createSubcommand{}
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	cmd.Flags(fs)
+
+	require.NoError(t, fs.Parse([]string{"-path", path, "-id", "the-new-backup", "-encryption-keyring", keyringPath}))
+	require.NoError(t, cmd.Run(ctx, testhelper.SharedLogger(t), &stdin, io.Discard))
+
+	bundleRelativePath := filepath.Join(strings.TrimSuffix(repo.RelativePath, ".git"), "the-new-backup", "001.bundle")
+
+	encrypted, err := os.ReadFile(filepath.Join(path, bundleRelativePath))
+	require.NoError(t, err)
+	require.False(t, bytes.HasPrefix(encrypted, []byte("# v2 git bundle")), "bundle stored in plaintext")
+
+	keyring, err := backup.LoadKeyring(keyringPath)
+	require.NoError(t, err)
+
+	reader, err := backup.NewEncryptedSink(backup.NewFilesystemSink(path), keyring).GetReader(ctx, bundleRelativePath)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, reader)
+
+	decrypted, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(decrypted, []byte("# v2 git bundle")))
+}
+
+func TestCreateSubcommand_encryptedIncrementalOnUnencryptedBackup(t *testing.T) {
+	cfg := testcfg.Build(t)
+
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll)
+
+	ctx := testhelper.Context(t)
+	path := testhelper.TempDir(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+	keyringPath := filepath.Join(testhelper.TempDir(t), "keyring.toml")
+	require.NoError(t, os.WriteFile(keyringPath, []byte(`active_key = "key"
+
+[[keys]]
+id = "key"
+key = "`+base64.StdEncoding.EncodeToString(make([]byte, 32))+`"
+`), perm.PrivateFile))
+
+	create := func(args ...string) error {
+		var stdin bytes.Buffer
+		require.NoError(t, json.NewEncoder(&stdin).Encode(map[string]string{
+			"address":         cfg.SocketPath,
+			"token":           cfg.Auth.Token,
+			"storage_name":    repo.StorageName,
+			"relative_path":   repo.RelativePath,
+			"gl_project_path": repo.GlProjectPath,
+		}))
+
+		cmd := createSubcommand{}
+		fs := flag.NewFlagSet("create", flag.ContinueOnError)
+		cmd.Flags(fs)
+
+		require.NoError(t, fs.Parse(append([]string{"-path", path, "-id", "the-new-backup"}, args...)))
+		return cmd.Run(ctx, testhelper.SharedLogger(t), &stdin, io.Discard)
+	}
+
+	// The full backup is created before encryption was enabled.
+	require.NoError(t, create())
+
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("feature"), gittest.WithMessage("feature"))
+
+	// The unencrypted LATEST files of the existing backup can't be read with a keyring alone.
+	require.ErrorContains(t, create("-incremental", "-encryption-keyring", keyringPath), backup.ErrNotEncrypted.Error())
+	require.NoError(t, create("-incremental", "-encryption-keyring", keyringPath, "-allow-unencrypted"))
+
+	backupPath := filepath.Join(path, strings.TrimSuffix(repo.RelativePath, ".git"), "the-new-backup")
+
+	plaintext, err := os.ReadFile(filepath.Join(backupPath, "001.bundle"))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(plaintext, []byte("# v2 git bundle")))
+
+	encrypted, err := os.ReadFile(filepath.Join(backupPath, "002.bundle"))
+	require.NoError(t, err)
+	require.False(t, bytes.HasPrefix(encrypted, []byte("# v2 git bundle")), "bundle stored in plaintext")
+}
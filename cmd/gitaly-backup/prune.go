@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/backup"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+type pruneRequest struct {
+	StorageName   string `json:"storage_name"`
+	RelativePath  string `json:"relative_path"`
+	GlProjectPath string `json:"gl_project_path"`
+}
+
+// pruneReport is written to stdout for every backup the retention policy was applied to and for every
+// file of a shared bundle that is no longer referenced.
+type pruneReport struct {
+	StorageName  string   `json:"storage_name,omitempty"`
+	RelativePath string   `json:"relative_path,omitempty"`
+	BackupID     string   `json:"backup_id,omitempty"`
+	SharedBundle string   `json:"shared_bundle,omitempty"`
+	Action       string   `json:"action"`
+	KeepReasons  []string `json:"keep_reasons,omitempty"`
+	DryRun       bool     `json:"dry_run"`
+}
+
+type pruneSubcommand struct {
+	backupPath        string
+	parallel          int
+	parallelStorage   int
+	keepLast          int
+	keepDaily         int
+	keepWeekly        int
+	dryRun            bool
+	encryptionKeyring string
+	allowUnencrypted  bool
+}
+
+func (cmd *pruneSubcommand) Flags(fs *flag.FlagSet) {
+	fs.StringVar(&cmd.backupPath, "path", "", "repository backup path")
+	fs.IntVar(&cmd.parallel, "parallel", runtime.NumCPU(), "maximum number of repositories pruned in parallel")
+	fs.IntVar(&cmd.parallelStorage, "parallel-storage", 2, "maximum number of repositories pruned in parallel per storage.")
+	fs.IntVar(&cmd.keepLast, "keep-last", 0, "number of most recent backups to keep.")
+	fs.IntVar(&cmd.keepDaily, "keep-daily", 0, "number of days to keep the most recent backup of each day for.")
+	fs.IntVar(&cmd.keepWeekly, "keep-weekly", 0, "number of weeks to keep the most recent backup of each week for.")
+	fs.BoolVar(&cmd.dryRun, "dry-run", false, "report the backups that would be deleted without deleting them.")
+	fs.StringVar(&cmd.encryptionKeyring, "encryption-keyring", "", "path to the keyring file the backup files were encrypted with.")
+	fs.BoolVar(&cmd.allowUnencrypted, "allow-unencrypted", false, "read backup files that are not encrypted as is when an encryption keyring is set. Allows to keep using backups created before encryption was enabled.")
+}
+
+func (cmd *pruneSubcommand) Run(ctx context.Context, logger log.Logger, stdin io.Reader, stdout io.Writer) error {
+	policy := backup.RetentionPolicy{
+		KeepLast:   cmd.keepLast,
+		KeepDaily:  cmd.keepDaily,
+		KeepWeekly: cmd.keepWeekly,
+	}
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("prune: %w", err)
+	}
+
+	sink, err := backup.ResolveSink(ctx, cmd.backupPath)
+	if err != nil {
+		return fmt.Errorf("prune: resolve sink: %w", err)
+	}
+	if cmd.encryptionKeyring != "" {
+		keyring, err := backup.LoadKeyring(cmd.encryptionKeyring)
+		if err != nil {
+			return fmt.Errorf("prune: %w", err)
+		}
+		var opts []backup.EncryptedSinkOption
+		if cmd.allowUnencrypted {
+			opts = append(opts, backup.WithUnencryptedReads())
+		}
+		sink = backup.NewEncryptedSink(sink, keyring, opts...)
+	}
+
+	pruner := backup.NewPruner(sink, policy, cmd.dryRun)
+
+	var reportMutex sync.Mutex
+	encoder := json.NewEncoder(stdout)
+	report := func(repo *gitalypb.Repository, decisions []backup.PruneDecision) {
+		reportMutex.Lock()
+		defer reportMutex.Unlock()
+
+		for _, decision := range decisions {
+			action := "delete"
+			if decision.Keep() {
+				action = "keep"
+			}
+
+			if err := encoder.Encode(pruneReport{
+				StorageName:  repo.StorageName,
+				RelativePath: repo.RelativePath,
+				BackupID:     decision.Chain.ID,
+				Action:       action,
+				KeepReasons:  decision.KeepReasons,
+				DryRun:       cmd.dryRun,
+			}); err != nil {
+				logger.WithError(err).Error("failed writing prune report")
+			}
+		}
+	}
+
+	var pipeline backup.Pipeline
+	pipeline = backup.NewLoggingPipeline(logger)
+	if cmd.parallel > 0 || cmd.parallelStorage > 0 {
+		pipeline = backup.NewParallelPipeline(pipeline, cmd.parallel, cmd.parallelStorage)
+	}
+
+	decoder := json.NewDecoder(stdin)
+	for {
+		var req pruneRequest
+		if err := decoder.Decode(&req); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("prune: %w", err)
+		}
+
+		pipeline.Handle(ctx, backup.NewPruneCommand(pruner, &gitalypb.Repository{
+			StorageName:   req.StorageName,
+			RelativePath:  req.RelativePath,
+			GlProjectPath: req.GlProjectPath,
+		}, report))
+	}
+
+	if err := pipeline.Done(); err != nil {
+		return fmt.Errorf("prune: %w", err)
+	}
+
+	// Shared bundles can only be collected once all backups referencing them have been pruned.
+	deleted, err := pruner.PruneSharedBundles(ctx)
+	if err != nil {
+		return fmt.Errorf("prune: %w", err)
+	}
+
+	for _, path := range deleted {
+		if err := encoder.Encode(pruneReport{
+			SharedBundle: path,
+			Action:       "delete",
+			DryRun:       cmd.dryRun,
+		}); err != nil {
+			logger.WithError(err).Error("failed writing prune report")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestPruneSubcommand(t *testing.T) {
+	t.Parallel()
+
+	writeBackups := func(t *testing.T, path string) {
+		now := time.Now()
+		for i, id := range []string{"20231001000000", "20231002000000", "20231003000000"} {
+			modTime := now.Add(-time.Duration(3-i) * time.Hour)
+			for name, content := range map[string]string{"001.bundle": "bundle", "LATEST": "001"} {
+				filePath := filepath.Join(path, "repo", id, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(filePath), perm.SharedDir))
+				require.NoError(t, os.WriteFile(filePath, []byte(content), perm.SharedFile))
+				require.NoError(t, os.Chtimes(filePath, modTime, modTime))
+			}
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(path, "repo", "LATEST"), []byte("20231003000000"), perm.SharedFile))
+	}
+
+	runPrune := func(t *testing.T, args ...string) ([]pruneReport, error) {
+		ctx := testhelper.Context(t)
+
+		var stdin bytes.Buffer
+		require.NoError(t, json.NewEncoder(&stdin).Encode(map[string]string{
+			"storage_name":  "default",
+			"relative_path": "repo.git",
+		}))
+
+		cmd := pruneSubcommand{}
+		fs := flag.NewFlagSet("prune", flag.ContinueOnError)
+		cmd.Flags(fs)
+		require.NoError(t, fs.Parse(args))
+
+		var stdout bytes.Buffer
+		if err := cmd.Run(ctx, testhelper.SharedLogger(t), &stdin, &stdout); err != nil {
+			return nil, err
+		}
+
+		var reports []pruneReport
+		decoder := json.NewDecoder(&stdout)
+		for {
+			var report pruneReport
+			if err := decoder.Decode(&report); err == io.EOF {
+				break
+			} else {
+				require.NoError(t, err)
+			}
+			reports = append(reports, report)
+		}
+
+		return reports, nil
+	}
+
+	t.Run("prune", func(t *testing.T) {
+		t.Parallel()
+
+		path := testhelper.TempDir(t)
+		writeBackups(t, path)
+
+		reports, err := runPrune(t, "-path", path, "-keep-last", "2")
+		require.NoError(t, err)
+		require.Equal(t, []pruneReport{
+			{StorageName: "default", RelativePath: "repo.git", BackupID: "20231003000000", Action: "keep", KeepReasons: []string{"latest", "last"}},
+			{StorageName: "default", RelativePath: "repo.git", BackupID: "20231002000000", Action: "keep", KeepReasons: []string{"last"}},
+			{StorageName: "default", RelativePath: "repo.git", BackupID: "20231001000000", Action: "delete"},
+		}, reports)
+
+		require.NoDirExists(t, filepath.Join(path, "repo", "20231001000000"))
+		require.DirExists(t, filepath.Join(path, "repo", "20231002000000"))
+		require.DirExists(t, filepath.Join(path, "repo", "20231003000000"))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		path := testhelper.TempDir(t)
+		writeBackups(t, path)
+
+		reports, err := runPrune(t, "-path", path, "-keep-last", "1", "-dry-run")
+		require.NoError(t, err)
+		require.Equal(t, []pruneReport{
+			{StorageName: "default", RelativePath: "repo.git", BackupID: "20231003000000", Action: "keep", KeepReasons: []string{"latest", "last"}, DryRun: true},
+			{StorageName: "default", RelativePath: "repo.git", BackupID: "20231002000000", Action: "delete", DryRun: true},
+			{StorageName: "default", RelativePath: "repo.git", BackupID: "20231001000000", Action: "delete", DryRun: true},
+		}, reports)
+
+		require.DirExists(t, filepath.Join(path, "repo", "20231001000000"))
+		require.DirExists(t, filepath.Join(path, "repo", "20231002000000"))
+	})
+
+	t.Run("unreferenced shared bundle", func(t *testing.T) {
+		t.Parallel()
+
+		path := testhelper.TempDir(t)
+		writeBackups(t, path)
+
+		sharedBundle := filepath.Join("_shared", "@pools", "pool", "key.bundle")
+		modTime := time.Now().Add(-48 * time.Hour)
+		require.NoError(t, os.MkdirAll(filepath.Join(path, filepath.Dir(sharedBundle)), perm.SharedDir))
+		require.NoError(t, os.WriteFile(filepath.Join(path, sharedBundle), []byte("bundle"), perm.SharedFile))
+		require.NoError(t, os.Chtimes(filepath.Join(path, sharedBundle), modTime, modTime))
+
+		reports, err := runPrune(t, "-path", path, "-keep-last", "3")
+		require.NoError(t, err)
+		require.Equal(t, pruneReport{SharedBundle: sharedBundle, Action: "delete"}, reports[len(reports)-1])
+
+		require.NoFileExists(t, filepath.Join(path, sharedBundle))
+	})
+
+	t.Run("missing retention policy", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := runPrune(t, "-path", testhelper.TempDir(t))
+		require.EqualError(t, err, "prune: retention policy: at least one rule must be set")
+	})
+}
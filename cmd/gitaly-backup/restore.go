@@ -33,19 +33,23 @@ type restoreSubcommand struct {
 	removeAllRepositories []string
 	backupID              string
 	serverSide            bool
+	encryptionKeyring     string
+	allowUnencrypted      bool
 }
 
 func (cmd *restoreSubcommand) Flags(fs *flag.FlagSet) {
 	fs.StringVar(&cmd.backupPath, "path", "", "repository backup path")
 	fs.IntVar(&cmd.parallel, "parallel", runtime.NumCPU(), "maximum number of parallel restores")
 	fs.IntVar(&cmd.parallelStorage, "parallel-storage", 2, "maximum number of parallel restores per storage. Note: actual parallelism when combined with `-parallel` depends on the order the repositories are received.")
-	fs.StringVar(&cmd.layout, "layout", "pointer", "how backup files are located. Either pointer or legacy.")
+	fs.StringVar(&cmd.layout, "layout", "pointer", "how backup files are located. Either pointer, dedup, or legacy.")
 	fs.Func("remove-all-repositories", "comma-separated list of storage names to have all repositories removed from before restoring.", func(removeAll string) error {
 		cmd.removeAllRepositories = strings.Split(removeAll, ",")
 		return nil
 	})
 	fs.StringVar(&cmd.backupID, "id", "", "ID of full backup to restore. If not specified, the latest backup is restored.")
 	fs.BoolVar(&cmd.serverSide, "server-side", false, "use server-side backups. Note: The feature is not ready for production use.")
+	fs.StringVar(&cmd.encryptionKeyring, "encryption-keyring", "", "path to the keyring file used to encrypt and decrypt the backup files. Backup files are not encrypted if not set.")
+	fs.BoolVar(&cmd.allowUnencrypted, "allow-unencrypted", false, "read backup files that are not encrypted as is when an encryption keyring is set. Allows to keep using backups created before encryption was enabled.")
 }
 
 func (cmd *restoreSubcommand) Run(ctx context.Context, logger log.Logger, stdin io.Reader, stdout io.Writer) error {
@@ -59,6 +63,9 @@ func (cmd *restoreSubcommand) Run(ctx context.Context, logger log.Logger, stdin
 		if cmd.backupPath != "" {
 			return fmt.Errorf("restore: path cannot be used with server-side backups")
 		}
+		if cmd.encryptionKeyring != "" {
+			return fmt.Errorf("restore: encryption keyring cannot be used with server-side backups")
+		}
 
 		manager = backup.NewServerSideAdapter(pool)
 	} else {
@@ -66,6 +73,17 @@ func (cmd *restoreSubcommand) Run(ctx context.Context, logger log.Logger, stdin
 		if err != nil {
 			return fmt.Errorf("restore: resolve sink: %w", err)
 		}
+		if cmd.encryptionKeyring != "" {
+			keyring, err := backup.LoadKeyring(cmd.encryptionKeyring)
+			if err != nil {
+				return fmt.Errorf("restore: %w", err)
+			}
+			var opts []backup.EncryptedSinkOption
+			if cmd.allowUnencrypted {
+				opts = append(opts, backup.WithUnencryptedReads())
+			}
+			sink = backup.NewEncryptedSink(sink, keyring, opts...)
+		}
 		locator, err := backup.ResolveLocator(cmd.layout, sink)
 		if err != nil {
 			return fmt.Errorf("restore: resolve locator: %w", err)
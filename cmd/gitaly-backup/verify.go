@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/backup"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/client"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// Verification statuses reported by the verify subcommand.
+const (
+	verifyStatusPass    = "pass"
+	verifyStatusFail    = "fail"
+	verifyStatusSkipped = "skipped"
+)
+
+// verifyReport is written to stdout for every repository whose backup was verified.
+type verifyReport struct {
+	StorageName   string `json:"storage_name"`
+	RelativePath  string `json:"relative_path"`
+	GlProjectPath string `json:"gl_project_path,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+type verifySubcommand struct {
+	backupPath        string
+	parallel          int
+	parallelStorage   int
+	layout            string
+	backupID          string
+	serverSide        bool
+	encryptionKeyring string
+	allowUnencrypted  bool
+}
+
+func (cmd *verifySubcommand) Flags(fs *flag.FlagSet) {
+	fs.StringVar(&cmd.backupPath, "path", "", "repository backup path")
+	fs.IntVar(&cmd.parallel, "parallel", runtime.NumCPU(), "maximum number of parallel verifications")
+	fs.IntVar(&cmd.parallelStorage, "parallel-storage", 2, "maximum number of parallel verifications per storage. Note: actual parallelism when combined with `-parallel` depends on the order the repositories are received.")
+	fs.StringVar(&cmd.layout, "layout", "pointer", "how backup files are located. Either pointer, dedup, or legacy.")
+	fs.StringVar(&cmd.backupID, "id", "", "ID of full backup to verify. If not specified, the latest backup is verified.")
+	fs.BoolVar(&cmd.serverSide, "server-side", false, "use server-side backups. Note: The feature is not ready for production use.")
+	fs.StringVar(&cmd.encryptionKeyring, "encryption-keyring", "", "path to the keyring file used to encrypt and decrypt the backup files. Backup files are not encrypted if not set.")
+	fs.BoolVar(&cmd.allowUnencrypted, "allow-unencrypted", false, "read backup files that are not encrypted as is when an encryption keyring is set. Allows to keep using backups created before encryption was enabled.")
+}
+
+func (cmd *verifySubcommand) Run(ctx context.Context, logger log.Logger, stdin io.Reader, stdout io.Writer) error {
+	pool := client.NewPool(client.WithDialOptions(client.UnaryInterceptor(), client.StreamInterceptor()))
+	defer func() {
+		_ = pool.Close()
+	}()
+
+	var manager backup.Strategy
+	if cmd.serverSide {
+		if cmd.backupPath != "" {
+			return fmt.Errorf("verify: path cannot be used with server-side backups")
+		}
+		if cmd.encryptionKeyring != "" {
+			return fmt.Errorf("verify: encryption keyring cannot be used with server-side backups")
+		}
+
+		manager = backup.NewServerSideAdapter(pool)
+	} else {
+		sink, err := backup.ResolveSink(ctx, cmd.backupPath)
+		if err != nil {
+			return fmt.Errorf("verify: resolve sink: %w", err)
+		}
+		if cmd.encryptionKeyring != "" {
+			keyring, err := backup.LoadKeyring(cmd.encryptionKeyring)
+			if err != nil {
+				return fmt.Errorf("verify: %w", err)
+			}
+			var opts []backup.EncryptedSinkOption
+			if cmd.allowUnencrypted {
+				opts = append(opts, backup.WithUnencryptedReads())
+			}
+			sink = backup.NewEncryptedSink(sink, keyring, opts...)
+		}
+		locator, err := backup.ResolveLocator(cmd.layout, sink)
+		if err != nil {
+			return fmt.Errorf("verify: resolve locator: %w", err)
+		}
+		manager = backup.NewManager(sink, locator, pool)
+	}
+
+	var reportMutex sync.Mutex
+	encoder := json.NewEncoder(stdout)
+	report := func(repo *gitalypb.Repository, err error) {
+		reportMutex.Lock()
+		defer reportMutex.Unlock()
+
+		entry := verifyReport{
+			StorageName:   repo.StorageName,
+			RelativePath:  repo.RelativePath,
+			GlProjectPath: repo.GlProjectPath,
+			Status:        verifyStatusPass,
+		}
+		switch {
+		case errors.Is(err, backup.ErrSkipped):
+			entry.Status = verifyStatusSkipped
+			entry.Error = err.Error()
+		case err != nil:
+			entry.Status = verifyStatusFail
+			entry.Error = err.Error()
+		}
+
+		if err := encoder.Encode(entry); err != nil {
+			logger.WithError(err).Error("failed writing verify report")
+		}
+	}
+
+	var pipeline backup.Pipeline
+	pipeline = backup.NewLoggingPipeline(logger)
+	if cmd.parallel > 0 || cmd.parallelStorage > 0 {
+		pipeline = backup.NewParallelPipeline(pipeline, cmd.parallel, cmd.parallelStorage)
+	}
+
+	decoder := json.NewDecoder(stdin)
+	for {
+		var sr serverRepository
+		if err := decoder.Decode(&sr); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("verify: %w", err)
+		}
+
+		repo := gitalypb.Repository{
+			StorageName:   sr.StorageName,
+			RelativePath:  sr.RelativePath,
+			GlProjectPath: sr.GlProjectPath,
+		}
+		pipeline.Handle(ctx, backup.NewVerifyCommand(manager, backup.VerifyRequest{
+			Server:           sr.ServerInfo,
+			Repository:       &repo,
+			VanityRepository: &repo,
+			BackupID:         cmd.backupID,
+		}, report))
+	}
+
+	if err := pipeline.Done(); err != nil {
+		return fmt.Errorf("verify: %w", err)
+	}
+	return nil
+}
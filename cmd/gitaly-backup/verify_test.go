@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/setup"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testserver"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestVerifySubcommand(t *testing.T) {
+	gittest.SkipWithSHA256(t)
+
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll)
+
+	ctx := testhelper.Context(t)
+	path := testhelper.TempDir(t)
+
+	var repos []*gitalypb.Repository
+	for i := 0; i < 3; i++ {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+		repos = append(repos, repo)
+	}
+
+	encodeRepos := func(t *testing.T, repos []*gitalypb.Repository) *bytes.Buffer {
+		var stdin bytes.Buffer
+		encoder := json.NewEncoder(&stdin)
+		for _, repo := range repos {
+			require.NoError(t, encoder.Encode(map[string]string{
+				"address":       cfg.SocketPath,
+				"token":         cfg.Auth.Token,
+				"storage_name":  repo.StorageName,
+				"relative_path": repo.RelativePath,
+			}))
+		}
+		return &stdin
+	}
+
+	createCmd := createSubcommand{}
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	createCmd.Flags(fs)
+	require.NoError(t, fs.Parse([]string{"-path", path, "-id", "the-new-backup"}))
+	require.NoError(t, createCmd.Run(ctx, testhelper.SharedLogger(t), encodeRepos(t, repos[:2]), io.Discard))
+
+	// Corrupt the backup of the second repository.
+	bundlePath := filepath.Join(path, strings.TrimSuffix(repos[1].RelativePath, ".git"), "the-new-backup", "001.bundle")
+	require.NoError(t, os.WriteFile(bundlePath, []byte("corrupt"), perm.SharedFile))
+
+	verifyCmd := verifySubcommand{}
+	fs = flag.NewFlagSet("verify", flag.ContinueOnError)
+	verifyCmd.Flags(fs)
+	require.NoError(t, fs.Parse([]string{"-path", path}))
+
+	var stdout bytes.Buffer
+	err := verifyCmd.Run(ctx, testhelper.SharedLogger(t), encodeRepos(t, repos), &stdout)
+	require.ErrorContains(t, err, "verify: pipeline: 1 failures encountered")
+
+	reports := map[string]verifyReport{}
+	decoder := json.NewDecoder(&stdout)
+	for {
+		var report verifyReport
+		if err := decoder.Decode(&report); err == io.EOF {
+			break
+		} else {
+			require.NoError(t, err)
+		}
+		reports[report.RelativePath] = report
+	}
+
+	require.Len(t, reports, 3)
+	require.Equal(t, verifyReport{
+		StorageName:  repos[0].StorageName,
+		RelativePath: repos[0].RelativePath,
+		Status:       verifyStatusPass,
+	}, reports[repos[0].RelativePath])
+	require.Equal(t, verifyStatusFail, reports[repos[1].RelativePath].Status)
+	require.Contains(t, reports[repos[1].RelativePath].Error, "verification failed")
+	require.Equal(t, verifyStatusSkipped, reports[repos[2].RelativePath].Status)
+}
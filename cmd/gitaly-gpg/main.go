@@ -29,6 +29,10 @@ func gpgApp() *cli.App {
 				return fmt.Errorf("reading signed key file %s : %w", cCtx.Args().First(), err)
 			}
 
+			// External signers are told about the commit's committer and repository. Both are passed
+			// along by Git and Gitaly via the environment.
+			signingKeys = signingKeys.WithContext(signature.SigningContextFromEnv(os.Environ()))
+
 			contents, err := io.ReadAll(cCtx.App.Reader)
 			if err != nil {
 				return fmt.Errorf("reading contents from stdin: %w", err)
@@ -36,6 +36,7 @@ require (
 	github.com/uber/jaeger-client-go v2.30.0+incompatible
 	github.com/urfave/cli/v2 v2.25.7
 	gitlab.com/gitlab-org/labkit v1.20.0
+	go.etcd.io/bbolt v1.3.7
 	go.uber.org/goleak v1.2.1
 	gocloud.dev v0.34.0
 	golang.org/x/crypto v0.13.0
@@ -4,10 +4,18 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
@@ -15,6 +23,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/counter"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/client"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,6 +34,9 @@ var (
 	ErrSkipped = errors.New("repository skipped")
 	// ErrDoesntExist means that the data was not found.
 	ErrDoesntExist = errors.New("doesn't exist")
+	// ErrVerificationFailed means that a backup could not be restored or the
+	// restored repository is not consistent with the backup.
+	ErrVerificationFailed = errors.New("verification failed")
 )
 
 // Sink is an abstraction over the real storage used for storing/restoring backups.
@@ -36,6 +48,19 @@ type Sink interface {
 	// GetReader returns a reader that servers the data stored by relativePath.
 	// If relativePath doesn't exists the ErrDoesntExist will be returned.
 	GetReader(ctx context.Context, relativePath string) (io.ReadCloser, error)
+	// List returns the files whose relative path starts with prefix.
+	List(ctx context.Context, prefix string) ([]SinkFile, error)
+	// Delete removes the data stored by relativePath. If relativePath doesn't
+	// exist the ErrDoesntExist will be returned.
+	Delete(ctx context.Context, relativePath string) error
+}
+
+// SinkFile describes a file stored in a Sink.
+type SinkFile struct {
+	// RelativePath is the path of the file relative to the root of the Sink.
+	RelativePath string
+	// ModTime is the time the file was last modified.
+	ModTime time.Time
 }
 
 // Backup represents all the information needed to restore a backup for a repository
@@ -56,6 +81,10 @@ type Step struct {
 	PreviousRefPath string
 	// CustomHooksPath is the path of the custom hooks archive
 	CustomHooksPath string
+	// SharedBundlePaths are the paths of bundles that are shared with other
+	// repositories. These bundles must be restored before the bundle at
+	// BundlePath.
+	SharedBundlePaths []string
 }
 
 // Locator finds sink backup paths for repositories
@@ -77,6 +106,18 @@ type Locator interface {
 	Find(ctx context.Context, repo *gitalypb.Repository, backupID string) (*Backup, error)
 }
 
+// SharedBundleLocator is implemented by locators that deduplicate the objects
+// of repositories which are connected to the same object pool. The objects of
+// the object pool are stored once in a chain of incremental shared bundles
+// which is referenced by the backups of all members.
+type SharedBundleLocator interface {
+	Locator
+
+	// SharedBundleDir returns the directory the chain of shared bundles of
+	// the object pool is stored in.
+	SharedBundleDir(pool *gitalypb.Repository) string
+}
+
 // Repository abstracts git access required to make a repository backup
 type Repository interface {
 	// ListRefs fetches the full set of refs and targets for the repository.
@@ -96,6 +137,18 @@ type Repository interface {
 	FetchBundle(ctx context.Context, reader io.Reader) error
 	// SetCustomHooks updates the custom hooks for the repository.
 	SetCustomHooks(ctx context.Context, reader io.Reader) error
+	// Fsck checks the integrity and connectivity of the objects in the
+	// repository. The output of git-fsck(1) is returned as error if the
+	// check fails.
+	Fsck(ctx context.Context) error
+	// ObjectPool returns the object pool the repository is connected to. nil
+	// is returned if the repository is not connected to an object pool.
+	ObjectPool(ctx context.Context) (*gitalypb.Repository, error)
+	// ResetRefs updates the refs of the repository to match refs. Existing
+	// refs that are not part of refs are deleted. HEAD is not modified.
+	ResetRefs(ctx context.Context, refs []git.Reference) error
+	// SetHeadReference points HEAD at the given reference.
+	SetHeadReference(ctx context.Context, target git.ReferenceName) error
 }
 
 // ResolveLocator returns a locator implementation based on a locator identifier.
@@ -109,6 +162,11 @@ func ResolveLocator(layout string, sink Sink) (Locator, error) {
 			Sink:     sink,
 			Fallback: legacy,
 		}, nil
+	case "dedup":
+		return DedupLocator{
+			Sink:     sink,
+			Fallback: legacy,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown layout: %q", layout)
 	}
@@ -123,6 +181,13 @@ type Manager struct {
 	// repositoryFactory returns an abstraction over git repositories in order
 	// to create and restore backups.
 	repositoryFactory func(ctx context.Context, repo *gitalypb.Repository, server storage.ServerInfo) (Repository, error)
+
+	// sharedBundleLocks makes sure that the shared bundles of an object pool
+	// are only written once when members of the same object pool are backed
+	// up concurrently by this manager. Concurrent backups by other processes
+	// are safe as they write the same bundles, see writeSharedBundle.
+	sharedBundleLocksMu sync.Mutex
+	sharedBundleLocks   map[string]*sync.Mutex
 }
 
 // NewManager creates and returns initialized *Manager instance.
@@ -219,7 +284,11 @@ func (mgr *Manager) Create(ctx context.Context, req *CreateRequest) error {
 	if err := mgr.writeRefs(ctx, step.RefPath, refs); err != nil {
 		return fmt.Errorf("manager: %w", err)
 	}
-	if err := mgr.writeBundle(ctx, repo, step, refs); err != nil {
+	sharedRefs, err := mgr.writeSharedBundle(ctx, repo, req.Server, step)
+	if err != nil {
+		return fmt.Errorf("manager: %w", err)
+	}
+	if err := mgr.writeBundle(ctx, repo, step, refs, sharedRefs); err != nil {
 		return fmt.Errorf("manager: %w", err)
 	}
 	if err := mgr.writeCustomHooks(ctx, repo, step.CustomHooksPath); err != nil {
@@ -271,8 +340,10 @@ func (mgr *Manager) Restore(ctx context.Context, req *RestoreRequest) error {
 		return fmt.Errorf("manager: %w", err)
 	}
 
+	var refs []git.Reference
+	restoredSharedBundles := map[string]bool{}
 	for _, step := range backup.Steps {
-		refs, err := mgr.readRefs(ctx, step.RefPath)
+		refs, err = mgr.readRefs(ctx, step.RefPath)
 		switch {
 		case errors.Is(err, ErrDoesntExist):
 			// For compatibility with existing backups we need to make sure the
@@ -297,7 +368,7 @@ func (mgr *Manager) Restore(ctx context.Context, req *RestoreRequest) error {
 		// Git bundles can not be created for empty repositories. Since empty
 		// repository backups do not contain a bundle, skip bundle restoration.
 		if len(refs) > 0 {
-			if err := mgr.restoreBundle(ctx, repo, step.BundlePath); err != nil {
+			if err := mgr.restoreBundles(ctx, repo, &step, restoredSharedBundles); err != nil {
 				return fmt.Errorf("manager: %w", err)
 			}
 		}
@@ -305,9 +376,140 @@ func (mgr *Manager) Restore(ctx context.Context, req *RestoreRequest) error {
 			return fmt.Errorf("manager: %w", err)
 		}
 	}
+
+	if len(restoredSharedBundles) > 0 {
+		if err := mgr.resetRefs(ctx, repo, refs); err != nil {
+			return fmt.Errorf("manager: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Verify restores a backup into a scratch repository and checks the result.
+// The objects of the scratch repository are checked with git-fsck(1) and its
+// refs are compared with the refs recorded in the backup. The scratch
+// repository is created in the storage of req.Repository and removed
+// afterwards. Failed checks are reported as ErrVerificationFailed. If
+// req.BackupID is empty, the latest backup will be used.
+func (mgr *Manager) Verify(ctx context.Context, req *VerifyRequest) (returnErr error) {
+	if req.VanityRepository == nil {
+		req.VanityRepository = req.Repository
+	}
+
+	var backup *Backup
+	var err error
+	if req.BackupID == "" {
+		backup, err = mgr.locator.FindLatest(ctx, req.VanityRepository)
+	} else {
+		backup, err = mgr.locator.Find(ctx, req.VanityRepository, req.BackupID)
+	}
+	switch {
+	case errors.Is(err, ErrDoesntExist):
+		return fmt.Errorf("manager: verify: %w: %s", ErrSkipped, err.Error())
+	case err != nil:
+		return fmt.Errorf("manager: verify: %w", err)
+	}
+
+	hash, err := git.ObjectHashByFormat(backup.ObjectFormat)
+	if err != nil {
+		return fmt.Errorf("manager: verify: %w", err)
+	}
+
+	scratch, err := mgr.repositoryFactory(ctx, newScratchRepository(req.Repository.GetStorageName()), req.Server)
+	if err != nil {
+		return fmt.Errorf("manager: verify: %w", err)
+	}
+
+	if err := scratch.Create(ctx, hash); err != nil {
+		return fmt.Errorf("manager: verify: %w", err)
+	}
+	defer func() {
+		if err := scratch.Remove(ctx); err != nil && returnErr == nil {
+			returnErr = fmt.Errorf("manager: verify: remove scratch repository: %w", err)
+		}
+	}()
+
+	var refs []git.Reference
+	restoredSharedBundles := map[string]bool{}
+	for i, step := range backup.Steps {
+		refs, err = mgr.readRefs(ctx, step.RefPath)
+		switch {
+		case errors.Is(err, ErrDoesntExist) && i == 0:
+			// There is no backup at all, see Restore.
+			return fmt.Errorf("manager: verify: %w: %s", ErrSkipped, err.Error())
+		case errors.Is(err, ErrDoesntExist):
+			return fmt.Errorf("manager: verify: %w: %s", ErrVerificationFailed, err.Error())
+		case err != nil:
+			return fmt.Errorf("manager: verify: %w", err)
+		}
+
+		if len(refs) > 0 {
+			if err := mgr.restoreBundles(ctx, scratch, &step, restoredSharedBundles); err != nil {
+				return fmt.Errorf("manager: verify: %w: %s", ErrVerificationFailed, err.Error())
+			}
+		}
+	}
+
+	if len(restoredSharedBundles) > 0 {
+		if err := mgr.resetRefs(ctx, scratch, refs); err != nil {
+			return fmt.Errorf("manager: verify: %w", err)
+		}
+	}
+
+	if err := scratch.Fsck(ctx); err != nil {
+		return fmt.Errorf("manager: verify: %w: %s", ErrVerificationFailed, err.Error())
+	}
+
+	restoredRefs, err := scratch.ListRefs(ctx)
+	if err != nil {
+		return fmt.Errorf("manager: verify: %w", err)
+	}
+
+	if diff := diffRefs(refs, restoredRefs); diff != "" {
+		return fmt.Errorf("manager: verify: %w: refs differ from backup: %s", ErrVerificationFailed, diff)
+	}
+
 	return nil
 }
 
+// newScratchRepository returns a uniquely named repository in the given
+// storage to verify backups in.
+func newScratchRepository(storageName string) *gitalypb.Repository {
+	return &gitalypb.Repository{
+		StorageName:  storageName,
+		RelativePath: fmt.Sprintf("@backup-verify/%s.git", uuid.New().String()),
+	}
+}
+
+// diffRefs describes how the actual refs differ from the expected refs. An
+// empty string is returned if they are equal.
+func diffRefs(expected, actual []git.Reference) string {
+	actualTargets := make(map[git.ReferenceName]string, len(actual))
+	for _, ref := range actual {
+		actualTargets[ref.Name] = ref.Target
+	}
+
+	var diffs []string
+	for _, ref := range expected {
+		target, ok := actualTargets[ref.Name]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s missing", ref.Name))
+		case target != ref.Target:
+			diffs = append(diffs, fmt.Sprintf("%s at %s instead of %s", ref.Name, target, ref.Target))
+		}
+		delete(actualTargets, ref.Name)
+	}
+
+	for name := range actualTargets {
+		diffs = append(diffs, fmt.Sprintf("%s unexpected", name))
+	}
+
+	sort.Strings(diffs)
+	return strings.Join(diffs, ", ")
+}
+
 // setContextServerInfo overwrites server with gitaly connection info from ctx metadata when server is zero.
 func setContextServerInfo(ctx context.Context, server *storage.ServerInfo, storageName string) error {
 	if !server.Zero() {
@@ -323,14 +525,294 @@ func setContextServerInfo(ctx context.Context, server *storage.ServerInfo, stora
 	return nil
 }
 
-func (mgr *Manager) writeBundle(ctx context.Context, repo Repository, step *Step, refs []git.Reference) (returnErr error) {
+// maxSharedBundleChainLength is the maximum number of shared bundles in the
+// chain of an object pool. Once reached, a new chain starting with a bundle of
+// all objects of the pool is written so that restores don't need to apply an
+// ever growing number of bundles and old chains can be pruned.
+const maxSharedBundleChainLength = 16
+
+// sharedBundle is a bundle in the chain of shared bundles of an object pool.
+type sharedBundle struct {
+	// key identifies the bundle.
+	key string
+	// refs are the refs of the object pool at the time the bundle was
+	// written.
+	refs []git.Reference
+	// chain are the paths of the bundles up to and including this bundle,
+	// oldest first.
+	chain []string
+}
+
+// writeSharedBundle writes the shared bundle of the object pool repo is
+// connected to, unless it has already been written. The bundle only contains
+// the objects which are not part of the previous shared bundle of the pool.
+// The paths of the chain of shared bundles are added to step and the refs of
+// the object pool are returned so that its objects can be excluded from the
+// bundle of repo. Nothing is done if the locator does not support shared
+// bundles.
+//
+// The key of a shared bundle is derived from its parent and the objects the
+// refs of the pool point to, so that concurrent backups write the same
+// bundle. The manifest of a shared bundle is written last, which is what makes
+// the bundle visible to other backups.
+func (mgr *Manager) writeSharedBundle(ctx context.Context, repo Repository, server storage.ServerInfo, step *Step) ([]git.Reference, error) {
+	locator, ok := mgr.locator.(SharedBundleLocator)
+	if !ok {
+		return nil, nil
+	}
+
+	poolRepo, err := repo.ObjectPool(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+	if poolRepo == nil {
+		return nil, nil
+	}
+
+	pool, err := mgr.repositoryFactory(ctx, poolRepo, server)
+	if err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+
+	poolRefs, err := pool.ListRefs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+
+	// HEAD of an object pool does not carry any meaning, so only its refs
+	// are bundled.
+	refs := make([]git.Reference, 0, len(poolRefs))
+	for _, ref := range poolRefs {
+		if ref.Name != "HEAD" {
+			refs = append(refs, ref)
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	dir := locator.SharedBundleDir(poolRepo)
+
+	unlock := mgr.lockSharedBundle(dir)
+	defer unlock()
+
+	parent, err := mgr.findLatestSharedBundle(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+	if parent != nil && sharedBundleKey("", parent.refs) == sharedBundleKey("", refs) {
+		step.SharedBundlePaths = append(step.SharedBundlePaths, parent.chain...)
+		return refs, nil
+	}
+	if parent != nil && len(parent.chain) >= maxSharedBundleChainLength {
+		parent = nil
+	}
+
+	var parentKey string
+	var chain []string
+	if parent != nil {
+		parentKey = parent.key
+		chain = append(chain, parent.chain...)
+	}
+
+	key := sharedBundleKey(parentKey, refs)
+
+	// Another backup may have written the bundle already.
+	existing, err := mgr.readSharedBundle(ctx, dir, key)
+	switch {
+	case err == nil:
+		if err := mgr.writeLatestSharedBundle(ctx, dir, key); err != nil {
+			return nil, fmt.Errorf("write shared bundle: %w", err)
+		}
+
+		step.SharedBundlePaths = append(step.SharedBundlePaths, existing.chain...)
+		return refs, nil
+	case !errors.Is(err, ErrDoesntExist):
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+
+	var patterns bytes.Buffer
+	if parent != nil {
+		for _, ref := range parent.refs {
+			fmt.Fprintf(&patterns, "^%s\n", ref.Target)
+		}
+	}
+	for _, ref := range refs {
+		fmt.Fprintln(&patterns, ref.Name)
+	}
+
+	path := filepath.Join(dir, key+".bundle")
+	if err := mgr.writeSharedBundleFile(ctx, pool, path, &patterns); err != nil {
+		if errors.Is(err, localrepo.ErrEmptyBundle) && parent != nil {
+			// Refs have only been removed from the pool, so all of its
+			// objects are part of the parent's chain already.
+			step.SharedBundlePaths = append(step.SharedBundlePaths, parent.chain...)
+			return refs, nil
+		}
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+	chain = append(chain, path)
+
+	if err := mgr.writeRefs(ctx, filepath.Join(dir, key+".refs"), refs); err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+	if err := writeManifest(ctx, mgr.sink, filepath.Join(dir, key+".shared"), chain); err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+	if err := mgr.writeLatestSharedBundle(ctx, dir, key); err != nil {
+		return nil, fmt.Errorf("write shared bundle: %w", err)
+	}
+
+	step.SharedBundlePaths = append(step.SharedBundlePaths, chain...)
+	return refs, nil
+}
+
+// writeSharedBundleFile writes the bundle of the objects of pool selected by
+// patterns to path. localrepo.ErrEmptyBundle is returned if the bundle would
+// be empty.
+func (mgr *Manager) writeSharedBundleFile(ctx context.Context, pool Repository, path string, patterns io.Reader) (returnErr error) {
+	w := NewLazyWriter(func() (io.WriteCloser, error) {
+		return mgr.sink.GetWriter(ctx, path)
+	})
+	var empty bool
+	defer func() {
+		if err := w.Close(); err != nil && returnErr == nil {
+			returnErr = err
+		}
+		// git-bundle(1) writes the bundle header before it determines that
+		// the bundle would be empty.
+		if empty {
+			if err := mgr.sink.Delete(ctx, path); err != nil && !errors.Is(err, ErrDoesntExist) {
+				returnErr = err
+			}
+		}
+	}()
+
+	if err := pool.CreateBundle(ctx, w, patterns); err != nil {
+		empty = errors.Is(err, localrepo.ErrEmptyBundle)
+		return err
+	}
+
+	return nil
+}
+
+// findLatestSharedBundle returns the shared bundle the LATEST file in dir
+// points to. nil is returned if there is none or if it is incomplete.
+func (mgr *Manager) findLatestSharedBundle(ctx context.Context, dir string) (*sharedBundle, error) {
+	r, err := mgr.sink.GetReader(ctx, filepath.Join(dir, "LATEST"))
+	switch {
+	case errors.Is(err, ErrDoesntExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("find latest shared bundle: %w", err)
+	}
+	defer r.Close()
+
+	latest, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("find latest shared bundle: %w", err)
+	}
+
+	bundle, err := mgr.readSharedBundle(ctx, dir, text.ChompBytes(latest))
+	switch {
+	case errors.Is(err, ErrDoesntExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("find latest shared bundle: %w", err)
+	}
+
+	return bundle, nil
+}
+
+// readSharedBundle reads the shared bundle identified by key. ErrDoesntExist
+// is returned if the bundle has not been completely written.
+func (mgr *Manager) readSharedBundle(ctx context.Context, dir, key string) (*sharedBundle, error) {
+	chain, err := readManifest(ctx, mgr.sink, filepath.Join(dir, key+".shared"))
+	if err != nil {
+		return nil, fmt.Errorf("read shared bundle: %w", err)
+	}
+
+	refs, err := mgr.readRefs(ctx, filepath.Join(dir, key+".refs"))
+	if err != nil {
+		return nil, fmt.Errorf("read shared bundle: %w", err)
+	}
+
+	return &sharedBundle{
+		key:   key,
+		refs:  refs,
+		chain: chain,
+	}, nil
+}
+
+// writeLatestSharedBundle points the LATEST file in dir to the shared bundle
+// identified by key.
+func (mgr *Manager) writeLatestSharedBundle(ctx context.Context, dir, key string) (returnErr error) {
+	w, err := mgr.sink.GetWriter(ctx, filepath.Join(dir, "LATEST"))
+	if err != nil {
+		return fmt.Errorf("write latest shared bundle: %w", err)
+	}
+	defer func() {
+		if err := w.Close(); err != nil && returnErr == nil {
+			returnErr = fmt.Errorf("write latest shared bundle: %w", err)
+		}
+	}()
+
+	if _, err := fmt.Fprintln(w, key); err != nil {
+		return fmt.Errorf("write latest shared bundle: %w", err)
+	}
+
+	return nil
+}
+
+// lockSharedBundle locks the shared bundles in dir and returns a function to
+// unlock them again.
+func (mgr *Manager) lockSharedBundle(dir string) func() {
+	mgr.sharedBundleLocksMu.Lock()
+	if mgr.sharedBundleLocks == nil {
+		mgr.sharedBundleLocks = map[string]*sync.Mutex{}
+	}
+	lock, ok := mgr.sharedBundleLocks[dir]
+	if !ok {
+		lock = &sync.Mutex{}
+		mgr.sharedBundleLocks[dir] = lock
+	}
+	mgr.sharedBundleLocksMu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// sharedBundleKey identifies the objects reachable from refs on top of the
+// shared bundle identified by parentKey. Two sets of refs pointing to the same
+// objects result in the same key, regardless of their names.
+func sharedBundleKey(parentKey string, refs []git.Reference) string {
+	targets := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		targets = append(targets, ref.Target)
+	}
+	sort.Strings(targets)
+
+	hash := sha256.New()
+	fmt.Fprintln(hash, parentKey)
+	for i, target := range targets {
+		if i > 0 && target == targets[i-1] {
+			continue
+		}
+		fmt.Fprintln(hash, target)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (mgr *Manager) writeBundle(ctx context.Context, repo Repository, step *Step, refs, sharedRefs []git.Reference) (returnErr error) {
 	if len(refs) == 0 {
 		return nil
 	}
 
 	var patterns io.Reader
-	// Full backup, no need to check for known refs.
-	if len(step.PreviousRefPath) > 0 {
+	// Full backup of a repository without shared objects, no need to check for
+	// known refs.
+	if len(step.PreviousRefPath) > 0 || len(sharedRefs) > 0 {
 		negatedRefs, err := mgr.negatedKnownRefs(ctx, step)
 		if err != nil {
 			return fmt.Errorf("write bundle: %w", err)
@@ -350,6 +832,14 @@ func (mgr *Manager) writeBundle(ctx context.Context, repo Repository, step *Step
 		go func() {
 			defer patternWriter.Close()
 
+			for _, ref := range sharedRefs {
+				_, err := fmt.Fprintf(patternWriter, "^%s\n", ref.Target)
+				if err != nil {
+					_ = patternWriter.CloseWithError(err)
+					return
+				}
+			}
+
 			for _, ref := range refs {
 				_, err := fmt.Fprintln(patternWriter, ref.Name)
 				if err != nil {
@@ -365,14 +855,35 @@ func (mgr *Manager) writeBundle(ctx context.Context, repo Repository, step *Step
 	w := NewLazyWriter(func() (io.WriteCloser, error) {
 		return mgr.sink.GetWriter(ctx, step.BundlePath)
 	})
+	var empty bool
 	defer func() {
 		if err := w.Close(); err != nil && returnErr == nil {
 			returnErr = fmt.Errorf("write bundle: %w", err)
 		}
+		// git-bundle(1) writes the bundle header before it determines that
+		// the bundle would be empty.
+		if empty && returnErr == nil {
+			if err := mgr.sink.Delete(ctx, step.BundlePath); err != nil && !errors.Is(err, ErrDoesntExist) {
+				returnErr = fmt.Errorf("write bundle: %w", err)
+			}
+		}
 	}()
 
 	if err := repo.CreateBundle(ctx, w, patterns); err != nil {
 		if errors.Is(err, localrepo.ErrEmptyBundle) {
+			// All new objects may well be part of the shared bundles
+			// already, in which case there is no bundle. The step is
+			// still needed to record the refs if they have changed.
+			if len(step.SharedBundlePaths) > 0 {
+				changed, err := mgr.refsChanged(ctx, step, refs)
+				if err != nil {
+					return fmt.Errorf("write bundle: %w", err)
+				}
+				if changed {
+					empty = true
+					return nil
+				}
+			}
 			return fmt.Errorf("write bundle: %w: no changes to bundle", ErrSkipped)
 		}
 		return fmt.Errorf("write bundle: %w", err)
@@ -381,6 +892,21 @@ func (mgr *Manager) writeBundle(ctx context.Context, repo Repository, step *Step
 	return nil
 }
 
+// refsChanged returns whether refs differ from the refs of the previous step.
+func (mgr *Manager) refsChanged(ctx context.Context, step *Step, refs []git.Reference) (bool, error) {
+	if len(step.PreviousRefPath) == 0 {
+		return true, nil
+	}
+
+	previousRefs, err := mgr.readRefs(ctx, step.PreviousRefPath)
+	if err != nil {
+		return false, err
+	}
+
+	updates, deletions := diffRefUpdates(previousRefs, refs)
+	return len(updates) > 0 || len(deletions) > 0, nil
+}
+
 func (mgr *Manager) negatedKnownRefs(ctx context.Context, step *Step) (io.ReadCloser, error) {
 	if len(step.PreviousRefPath) == 0 {
 		return io.NopCloser(new(bytes.Reader)), nil
@@ -444,6 +970,72 @@ func (mgr *Manager) readRefs(ctx context.Context, path string) ([]git.Reference,
 	return refs, nil
 }
 
+// restoreBundles restores the shared bundles of step followed by its own
+// bundle. Shared bundles which are recorded in restoredSharedBundles are
+// skipped as they have been restored for a previous step already.
+func (mgr *Manager) restoreBundles(ctx context.Context, repo Repository, step *Step, restoredSharedBundles map[string]bool) error {
+	for _, path := range step.SharedBundlePaths {
+		if restoredSharedBundles[path] {
+			continue
+		}
+		if err := mgr.restoreBundle(ctx, repo, path); err != nil {
+			return err
+		}
+		restoredSharedBundles[path] = true
+	}
+
+	err := mgr.restoreBundle(ctx, repo, step.BundlePath)
+	if errors.Is(err, ErrDoesntExist) && len(step.SharedBundlePaths) > 0 {
+		// All objects are part of the shared bundles, see writeBundle.
+		return nil
+	}
+	return err
+}
+
+// resetRefs updates the refs of repo to match refs. Restoring shared bundles
+// mirrors the refs of the object pool into the repository, these are removed
+// again here. Refs which were part of the shared bundles only are created.
+func (mgr *Manager) resetRefs(ctx context.Context, repo Repository, refs []git.Reference) error {
+	if err := repo.ResetRefs(ctx, refs); err != nil {
+		return fmt.Errorf("reset refs: %w", err)
+	}
+
+	for _, ref := range refs {
+		if ref.Name != "HEAD" {
+			continue
+		}
+
+		if target, ok := guessHead(ref, refs); ok {
+			if err := repo.SetHeadReference(ctx, target); err != nil {
+				return fmt.Errorf("reset refs: %w", err)
+			}
+		}
+		break
+	}
+
+	return nil
+}
+
+// guessHead determines the branch HEAD points to from the branches in refs,
+// preferring the default branches. See localrepo.Repo.GuessHead.
+func guessHead(head git.Reference, refs []git.Reference) (git.ReferenceName, bool) {
+	for _, name := range []git.ReferenceName{git.DefaultRef, git.LegacyDefaultRef} {
+		for _, ref := range refs {
+			if ref.Name == name && ref.Target == head.Target {
+				return ref.Name, true
+			}
+		}
+	}
+
+	for _, ref := range refs {
+		if _, isBranch := ref.Name.Branch(); isBranch && ref.Target == head.Target {
+			return ref.Name, true
+		}
+	}
+
+	return "", false
+}
+
 func (mgr *Manager) restoreBundle(ctx context.Context, repo Repository, path string) error {
 	reader, err := mgr.sink.GetReader(ctx, path)
 	if err != nil {
@@ -3,6 +3,7 @@ package backup_test
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -925,6 +926,327 @@ func TestManager_Restore_specific(t *testing.T) {
 	}
 }
 
+func TestManager_Verify(t *testing.T) {
+	gittest.SkipWithSHA256(t)
+
+	t.Parallel()
+
+	const backupID = "abc123"
+
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll)
+	repoCounter := counter.NewRepositoryCounter(cfg.Storages)
+
+	for _, managerTC := range []struct {
+		desc  string
+		setup func(t testing.TB, sink backup.Sink, locator backup.Locator) *backup.Manager
+	}{
+		{
+			desc: "RPC manager",
+			setup: func(tb testing.TB, sink backup.Sink, locator backup.Locator) *backup.Manager {
+				pool := client.NewPool()
+				tb.Cleanup(func() {
+					testhelper.MustClose(tb, pool)
+				})
+
+				return backup.NewManager(sink, locator, pool)
+			},
+		},
+		{
+			desc: "Local manager",
+			setup: func(tb testing.TB, sink backup.Sink, locator backup.Locator) *backup.Manager {
+				if testhelper.IsPraefectEnabled() {
+					tb.Skip("local backup manager expects to operate on the local filesystem so cannot operate through praefect")
+				}
+
+				storageLocator := config.NewLocator(cfg)
+				gitCmdFactory := gittest.NewCommandFactory(tb, cfg)
+				catfileCache := catfile.NewCache(cfg)
+				tb.Cleanup(catfileCache.Stop)
+				txManager := transaction.NewTrackingManager()
+
+				return backup.NewManagerLocal(sink, locator, storageLocator, gitCmdFactory, catfileCache, txManager, repoCounter)
+			},
+		},
+	} {
+		managerTC := managerTC
+
+		// The managers are not run in parallel so the scratch repositories
+		// left behind can be checked for.
+		t.Run(managerTC.desc, func(t *testing.T) {
+			ctx := testhelper.Context(t)
+
+			_, repoPath := gittest.CreateRepository(t, ctx, cfg)
+			commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+			gittest.WriteTag(t, cfg, repoPath, "v1.0.0", commitID.Revision())
+			repoBundle := gittest.BundleRepo(t, cfg, repoPath, "-")
+			repoRefs := gittest.Exec(t, cfg, "-C", repoPath, "show-ref", "--head")
+
+			backupRoot := testhelper.TempDir(t)
+
+			for _, tc := range []struct {
+				desc          string
+				files         map[string]any
+				backupID      string
+				expectedErr   error
+				expectedInErr string
+			}{
+				{
+					desc: "valid latest backup",
+					files: map[string]any{
+						"LATEST":                              backupID,
+						filepath.Join(backupID, "LATEST"):     "001",
+						filepath.Join(backupID, "001.bundle"): repoBundle,
+						filepath.Join(backupID, "001.refs"):   repoRefs,
+					},
+				},
+				{
+					desc: "valid specific backup",
+					files: map[string]any{
+						filepath.Join(backupID, "LATEST"):     "001",
+						filepath.Join(backupID, "001.bundle"): repoBundle,
+						filepath.Join(backupID, "001.refs"):   repoRefs,
+					},
+					backupID: backupID,
+				},
+				{
+					desc: "empty repository",
+					files: map[string]any{
+						"LATEST":                            backupID,
+						filepath.Join(backupID, "LATEST"):   "001",
+						filepath.Join(backupID, "001.refs"): "",
+					},
+				},
+				{
+					desc:        "missing backup",
+					files:       map[string]any{},
+					expectedErr: backup.ErrSkipped,
+				},
+				{
+					desc: "corrupt bundle",
+					files: map[string]any{
+						"LATEST":                              backupID,
+						filepath.Join(backupID, "LATEST"):     "001",
+						filepath.Join(backupID, "001.bundle"): "not a bundle",
+						filepath.Join(backupID, "001.refs"):   repoRefs,
+					},
+					expectedErr: backup.ErrVerificationFailed,
+				},
+				{
+					desc: "missing increment",
+					files: map[string]any{
+						"LATEST":                              backupID,
+						filepath.Join(backupID, "LATEST"):     "002",
+						filepath.Join(backupID, "001.bundle"): repoBundle,
+						filepath.Join(backupID, "001.refs"):   repoRefs,
+					},
+					expectedErr: backup.ErrVerificationFailed,
+				},
+				{
+					desc: "refs differ",
+					files: map[string]any{
+						"LATEST":                              backupID,
+						filepath.Join(backupID, "LATEST"):     "001",
+						filepath.Join(backupID, "001.bundle"): repoBundle,
+						filepath.Join(backupID, "001.refs"):   string(repoRefs) + commitID.String() + " refs/heads/missing\n",
+					},
+					expectedErr:   backup.ErrVerificationFailed,
+					expectedInErr: "refs/heads/missing missing",
+				},
+			} {
+				tc := tc
+
+				t.Run(tc.desc, func(t *testing.T) {
+					repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+					files := make(map[string]any, len(tc.files))
+					for path, content := range tc.files {
+						files[filepath.Join(stripRelativePath(t, repo), path)] = content
+					}
+					testhelper.WriteFiles(t, backupRoot, files)
+
+					sink := backup.NewFilesystemSink(backupRoot)
+					defer testhelper.MustClose(t, sink)
+
+					locator, err := backup.ResolveLocator("pointer", sink)
+					require.NoError(t, err)
+
+					err = managerTC.setup(t, sink, locator).Verify(ctx, &backup.VerifyRequest{
+						Server:           storage.ServerInfo{Address: cfg.SocketPath, Token: cfg.Auth.Token},
+						Repository:       repo,
+						VanityRepository: repo,
+						BackupID:         tc.backupID,
+					})
+					if tc.expectedErr != nil {
+						require.ErrorIs(t, err, tc.expectedErr)
+						require.Contains(t, err.Error(), tc.expectedInErr)
+					} else {
+						require.NoError(t, err)
+					}
+
+					if !testhelper.IsPraefectEnabled() {
+						scratchRepos, err := os.ReadDir(filepath.Join(cfg.Storages[0].Path, "@backup-verify"))
+						if !os.IsNotExist(err) {
+							require.NoError(t, err)
+						}
+						require.Empty(t, scratchRepos, "scratch repositories left behind")
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestManager_CreateRestore_dedup(t *testing.T) {
+	gittest.SkipWithSHA256(t)
+
+	t.Parallel()
+
+	const backupID = "abc123"
+
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll)
+	repoCounter := counter.NewRepositoryCounter(cfg.Storages)
+
+	for _, managerTC := range []struct {
+		desc  string
+		setup func(t testing.TB, sink backup.Sink, locator backup.Locator) *backup.Manager
+	}{
+		{
+			desc: "RPC manager",
+			setup: func(tb testing.TB, sink backup.Sink, locator backup.Locator) *backup.Manager {
+				pool := client.NewPool()
+				tb.Cleanup(func() {
+					testhelper.MustClose(tb, pool)
+				})
+
+				return backup.NewManager(sink, locator, pool)
+			},
+		},
+		{
+			desc: "Local manager",
+			setup: func(tb testing.TB, sink backup.Sink, locator backup.Locator) *backup.Manager {
+				if testhelper.IsPraefectEnabled() {
+					tb.Skip("local backup manager expects to operate on the local filesystem so cannot operate through praefect")
+				}
+
+				storageLocator := config.NewLocator(cfg)
+				gitCmdFactory := gittest.NewCommandFactory(tb, cfg)
+				catfileCache := catfile.NewCache(cfg)
+				tb.Cleanup(catfileCache.Stop)
+				txManager := transaction.NewTrackingManager()
+
+				return backup.NewManagerLocal(sink, locator, storageLocator, gitCmdFactory, catfileCache, txManager, repoCounter)
+			},
+		},
+	} {
+		managerTC := managerTC
+
+		t.Run(managerTC.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+
+			cc, err := client.Dial(ctx, cfg.SocketPath)
+			require.NoError(t, err)
+			defer testhelper.MustClose(t, cc)
+
+			sourceRepo, sourcePath := gittest.CreateRepository(t, ctx, cfg)
+			mainID := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithBranch("main"))
+			gittest.WriteTag(t, cfg, sourcePath, "v1.0.0", mainID.Revision())
+
+			poolProto, _ := gittest.CreateObjectPool(t, ctx, cfg, sourceRepo, gittest.CreateObjectPoolConfig{
+				LinkRepositoryToObjectPool: true,
+			})
+
+			forkRepo, forkPath := gittest.CreateRepository(t, ctx, cfg)
+			_, err = gitalypb.NewObjectPoolServiceClient(cc).LinkRepositoryToObjectPool(ctx, &gitalypb.LinkRepositoryToObjectPoolRequest{
+				ObjectPool: poolProto,
+				Repository: forkRepo,
+			})
+			require.NoError(t, err)
+			gittest.Exec(t, cfg, "-C", forkPath, "update-ref", "refs/heads/main", mainID.String())
+			gittest.WriteCommit(t, cfg, forkPath, gittest.WithBranch("feature"), gittest.WithParents(mainID))
+
+			backupRoot := testhelper.TempDir(t)
+			sink := backup.NewFilesystemSink(backupRoot)
+			defer testhelper.MustClose(t, sink)
+
+			locator, err := backup.ResolveLocator("dedup", sink)
+			require.NoError(t, err)
+
+			mgr := managerTC.setup(t, sink, locator)
+			server := storage.ServerInfo{Address: cfg.SocketPath, Token: cfg.Auth.Token}
+
+			for _, member := range []struct {
+				repo *gitalypb.Repository
+				path string
+			}{
+				{repo: sourceRepo, path: sourcePath},
+				{repo: forkRepo, path: forkPath},
+			} {
+				require.NoError(t, mgr.Create(ctx, &backup.CreateRequest{
+					Server:     server,
+					Repository: member.repo,
+					BackupID:   backupID,
+				}))
+			}
+
+			require.Len(t, listSharedBundles(t, backupRoot), 1)
+
+			// All objects of the source repository are part of the object pool.
+			require.NoFileExists(t, joinBackupPath(t, backupRoot, sourceRepo, backupID, "001.bundle"))
+			require.FileExists(t, joinBackupPath(t, backupRoot, forkRepo, backupID, "001.bundle"))
+
+			// Objects the object pool gains are written to a new shared bundle
+			// on top of the previous one.
+			gittest.WriteCommit(t, cfg, sourcePath, gittest.WithBranch("main"), gittest.WithParents(mainID))
+			_, err = gitalypb.NewObjectPoolServiceClient(cc).FetchIntoObjectPool(ctx, &gitalypb.FetchIntoObjectPoolRequest{
+				Origin:     sourceRepo,
+				ObjectPool: poolProto,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, mgr.Create(ctx, &backup.CreateRequest{
+				Server:      server,
+				Repository:  sourceRepo,
+				Incremental: true,
+			}))
+
+			require.Len(t, listSharedBundles(t, backupRoot), 2)
+			require.NoFileExists(t, joinBackupPath(t, backupRoot, sourceRepo, backupID, "002.bundle"))
+
+			manifest := testhelper.MustReadFile(t, joinBackupPath(t, backupRoot, sourceRepo, backupID, "002.shared"))
+			require.Len(t, strings.Split(strings.TrimSpace(string(manifest)), "\n"), 2)
+
+			for _, member := range []struct {
+				repo *gitalypb.Repository
+				path string
+			}{
+				{repo: sourceRepo, path: sourcePath},
+				{repo: forkRepo, path: forkPath},
+			} {
+				expectedRefs := gittest.Exec(t, cfg, "-C", member.path, "show-ref", "--head")
+
+				require.NoError(t, mgr.Verify(ctx, &backup.VerifyRequest{
+					Server:     server,
+					Repository: member.repo,
+				}))
+
+				require.NoError(t, mgr.Restore(ctx, &backup.RestoreRequest{
+					Server:     server,
+					Repository: member.repo,
+				}))
+
+				require.Equal(t, string(expectedRefs), string(gittest.Exec(t, cfg, "-C", member.path, "show-ref", "--head")))
+				gittest.Exec(t, cfg, "-C", member.path, "fsck", "--no-dangling")
+			}
+		})
+	}
+}
+
 func TestManager_CreateRestore_contextServerInfo(t *testing.T) {
 	gittest.SkipWithSHA256(t)
 
@@ -975,6 +1297,7 @@ func TestResolveLocator(t *testing.T) {
 	}{
 		{layout: "legacy"},
 		{layout: "pointer"},
+		{layout: "dedup"},
 		{
 			layout:      "unknown",
 			expectedErr: "unknown layout: \"unknown\"",
@@ -995,6 +1318,21 @@ func TestResolveLocator(t *testing.T) {
 	}
 }
 
+// listSharedBundles returns the paths of all shared bundles in the backup.
+func listSharedBundles(tb testing.TB, backupRoot string) []string {
+	var bundles []string
+	require.NoError(tb, filepath.WalkDir(filepath.Join(backupRoot, "_shared"), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filepath.Ext(path) == ".bundle" {
+			bundles = append(bundles, path)
+		}
+		return nil
+	}))
+	return bundles
+}
+
 func joinBackupPath(tb testing.TB, backupRoot string, repo *gitalypb.Repository, elements ...string) string {
 	return filepath.Join(append([]string{
 		backupRoot,
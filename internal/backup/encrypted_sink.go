@@ -0,0 +1,407 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	// encryptionMagic identifies the files written by EncryptedSink and the version of their format.
+	encryptionMagic = "GLBKENC\x01"
+	// encryptionSegmentSize is the size of the plaintext segments the data is encrypted in.
+	encryptionSegmentSize = 64 * 1024
+	// dataKeySize is the size of the data keys in bytes.
+	dataKeySize = 32
+	// noncePrefixSize is the size of the random prefix of the segment nonces. The remaining bytes of
+	// the 12 byte nonce hold the segment's index and whether it is the final segment.
+	noncePrefixSize = 7
+)
+
+// ErrNotEncrypted is returned when reading a file through EncryptedSink that wasn't written by it.
+var ErrNotEncrypted = errors.New("not encrypted")
+
+// errSegmentAuthentication is returned when a segment fails to decrypt. This happens if the data has been
+// tampered with, truncated or reordered.
+var errSegmentAuthentication = errors.New("segment authentication failed")
+
+// EncryptedSink is a Sink that encrypts the data written to the wrapped Sink and decrypts it when read
+// back. Every file is encrypted with its own random data key. The data key is wrapped by the KeyProvider
+// and stored in the file's header, so the data can't be read without access to the key encryption keys.
+//
+// The data is encrypted in segments with AES-256-GCM so it can be streamed. Each segment's nonce commits
+// to the segment's position and whether it is the last segment, so reordering or truncating the segments
+// is detected on read.
+type EncryptedSink struct {
+	sink             Sink
+	keys             KeyProvider
+	allowUnencrypted bool
+}
+
+// EncryptedSinkOption configures an EncryptedSink.
+type EncryptedSinkOption func(*EncryptedSink)
+
+// WithUnencryptedReads makes the EncryptedSink return the data of files that weren't written by it as
+// is instead of failing with ErrNotEncrypted. This allows to keep reading backups that were created
+// before encryption was enabled, for example to create incremental backups on top of them or to restore
+// them. New files are always encrypted.
+func WithUnencryptedReads() EncryptedSinkOption {
+	return func(s *EncryptedSink) {
+		s.allowUnencrypted = true
+	}
+}
+
+// NewEncryptedSink returns a Sink that encrypts the data stored in the given sink with data keys wrapped
+// by the given KeyProvider.
+func NewEncryptedSink(sink Sink, keys KeyProvider, opts ...EncryptedSinkOption) *EncryptedSink {
+	s := &EncryptedSink{
+		sink: sink,
+		keys: keys,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Close closes the wrapped Sink.
+func (s *EncryptedSink) Close() error {
+	return s.sink.Close()
+}
+
+// GetWriter returns a writer that encrypts the written data into relativePath in the wrapped Sink.
+// The final segment is written when the writer is closed, so it is the callers responsibility to Close
+// the writer and check the returned error.
+func (s *EncryptedSink) GetWriter(ctx context.Context, relativePath string) (io.WriteCloser, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("encrypted sink: generate data key: %w", err)
+	}
+
+	keyID, wrappedKey, err := s.keys.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypted sink: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypted sink: %w", err)
+	}
+
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, fmt.Errorf("encrypted sink: generate nonce prefix: %w", err)
+	}
+
+	header, err := marshalEncryptionHeader(keyID, wrappedKey, noncePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("encrypted sink: %w", err)
+	}
+
+	writer, err := s.sink.GetWriter(ctx, relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := writer.Write(header); err != nil {
+		_ = writer.Close()
+		return nil, fmt.Errorf("encrypted sink: write header: %w", err)
+	}
+
+	return &encryptingWriter{
+		writer:      writer,
+		aead:        aead,
+		header:      header,
+		noncePrefix: noncePrefix,
+		buffer:      make([]byte, 0, encryptionSegmentSize),
+	}, nil
+}
+
+// GetReader returns a reader that decrypts the data stored in relativePath in the wrapped Sink. If
+// relativePath doesn't exist, ErrDoesntExist is returned. If the file wasn't written by EncryptedSink,
+// ErrNotEncrypted is returned unless unencrypted reads are allowed, in which case the data is returned
+// as is. It is the caller's responsibility to Close the reader after usage.
+func (s *EncryptedSink) GetReader(ctx context.Context, relativePath string) (io.ReadCloser, error) {
+	reader, err := s.sink.GetReader(ctx, relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferedReader := bufio.NewReader(reader)
+
+	if s.allowUnencrypted {
+		// Peeking fails with io.EOF if the file is shorter than the magic, which means it can't
+		// have been written by EncryptedSink either.
+		magic, err := bufferedReader.Peek(len(encryptionMagic))
+		if err != nil && !errors.Is(err, io.EOF) {
+			_ = reader.Close()
+			return nil, fmt.Errorf("encrypted sink: read header of %q: %w", relativePath, err)
+		}
+
+		if string(magic) != encryptionMagic {
+			return unencryptedReader{
+				Reader: bufferedReader,
+				Closer: reader,
+			}, nil
+		}
+	}
+	header, keyID, wrappedKey, noncePrefix, err := readEncryptionHeader(bufferedReader)
+	if err != nil {
+		_ = reader.Close()
+		return nil, fmt.Errorf("encrypted sink: read header of %q: %w", relativePath, err)
+	}
+
+	dataKey, err := s.keys.UnwrapKey(ctx, keyID, wrappedKey)
+	if err != nil {
+		_ = reader.Close()
+		return nil, fmt.Errorf("encrypted sink: %q: %w", relativePath, err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		_ = reader.Close()
+		return nil, fmt.Errorf("encrypted sink: %w", err)
+	}
+
+	return &decryptingReader{
+		reader:      bufferedReader,
+		closer:      reader,
+		aead:        aead,
+		header:      header,
+		noncePrefix: noncePrefix,
+		ciphertext:  make([]byte, encryptionSegmentSize+aead.Overhead()),
+	}, nil
+}
+
+// List returns the files whose relative path starts with prefix in the wrapped Sink.
+func (s *EncryptedSink) List(ctx context.Context, prefix string) ([]SinkFile, error) {
+	return s.sink.List(ctx, prefix)
+}
+
+// Delete removes the data stored by relativePath from the wrapped Sink.
+func (s *EncryptedSink) Delete(ctx context.Context, relativePath string) error {
+	return s.sink.Delete(ctx, relativePath)
+}
+
+// marshalEncryptionHeader encodes the header written in front of the encrypted segments. The header is
+// authenticated as additional data of every segment.
+func marshalEncryptionHeader(keyID string, wrappedKey, noncePrefix []byte) ([]byte, error) {
+	if len(keyID) > math.MaxUint16 || len(wrappedKey) > math.MaxUint16 {
+		return nil, errors.New("key ID or wrapped key too long")
+	}
+
+	var header bytes.Buffer
+	header.WriteString(encryptionMagic)
+	_ = binary.Write(&header, binary.BigEndian, uint16(len(keyID)))
+	header.WriteString(keyID)
+	_ = binary.Write(&header, binary.BigEndian, uint16(len(wrappedKey)))
+	header.Write(wrappedKey)
+	header.Write(noncePrefix)
+
+	return header.Bytes(), nil
+}
+
+// readEncryptionHeader reads the header written by marshalEncryptionHeader. It returns the raw header
+// along with its decoded fields.
+func readEncryptionHeader(reader io.Reader) (header []byte, keyID string, wrappedKey, noncePrefix []byte, returnedErr error) {
+	var raw bytes.Buffer
+	reader = io.TeeReader(reader, &raw)
+
+	readField := func(size int) ([]byte, error) {
+		field := make([]byte, size)
+		if _, err := io.ReadFull(reader, field); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return nil, err
+		}
+
+		return field, nil
+	}
+
+	readLengthPrefixed := func() ([]byte, error) {
+		length, err := readField(2)
+		if err != nil {
+			return nil, err
+		}
+
+		return readField(int(binary.BigEndian.Uint16(length)))
+	}
+
+	magic, err := readField(len(encryptionMagic))
+	if err != nil || string(magic) != encryptionMagic {
+		return nil, "", nil, nil, ErrNotEncrypted
+	}
+
+	rawKeyID, err := readLengthPrefixed()
+	if err != nil {
+		return nil, "", nil, nil, fmt.Errorf("key ID: %w", err)
+	}
+
+	wrappedKey, err = readLengthPrefixed()
+	if err != nil {
+		return nil, "", nil, nil, fmt.Errorf("wrapped key: %w", err)
+	}
+
+	noncePrefix, err = readField(noncePrefixSize)
+	if err != nil {
+		return nil, "", nil, nil, fmt.Errorf("nonce prefix: %w", err)
+	}
+
+	return raw.Bytes(), string(rawKeyID), wrappedKey, noncePrefix, nil
+}
+
+// segmentNonce returns the nonce of the segment at the given index.
+func segmentNonce(noncePrefix []byte, index uint32, final bool) []byte {
+	nonce := make([]byte, noncePrefixSize, noncePrefixSize+5)
+	copy(nonce, noncePrefix)
+	nonce = binary.BigEndian.AppendUint32(nonce, index)
+	if final {
+		return append(nonce, 1)
+	}
+
+	return append(nonce, 0)
+}
+
+// encryptingWriter encrypts the written data in segments. A full segment is only sealed once more data
+// is written so the last segment, sealed on Close, is always marked final.
+type encryptingWriter struct {
+	writer      io.WriteCloser
+	aead        cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	segment     uint32
+	buffer      []byte
+	ciphertext  []byte
+}
+
+func (w *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if len(w.buffer) == encryptionSegmentSize {
+			if err := w.sealSegment(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := encryptionSegmentSize - len(w.buffer)
+		if n > len(p) {
+			n = len(p)
+		}
+
+		w.buffer = append(w.buffer, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+func (w *encryptingWriter) sealSegment(final bool) error {
+	if w.segment == math.MaxUint32 && !final {
+		return errors.New("encrypted sink: too many segments")
+	}
+
+	w.ciphertext = w.aead.Seal(w.ciphertext[:0], segmentNonce(w.noncePrefix, w.segment, final), w.buffer, w.header)
+	if _, err := w.writer.Write(w.ciphertext); err != nil {
+		return fmt.Errorf("encrypted sink: write segment: %w", err)
+	}
+
+	w.buffer = w.buffer[:0]
+	w.segment++
+
+	return nil
+}
+
+// Close seals the final segment and closes the wrapped writer.
+func (w *encryptingWriter) Close() error {
+	if err := w.sealSegment(true); err != nil {
+		_ = w.writer.Close()
+		return err
+	}
+
+	return w.writer.Close()
+}
+
+// unencryptedReader returns the data of a file that wasn't written by EncryptedSink as is.
+type unencryptedReader struct {
+	io.Reader
+	io.Closer
+}
+
+// decryptingReader decrypts the segments written by encryptingWriter.
+type decryptingReader struct {
+	reader      *bufio.Reader
+	closer      io.Closer
+	aead        cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	segment     uint32
+	ciphertext  []byte
+	plaintext   []byte
+	done        bool
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.plaintext) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		if err := r.openSegment(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.plaintext)
+	r.plaintext = r.plaintext[n:]
+
+	return n, nil
+}
+
+// openSegment reads and decrypts the next segment. The segment is final if no data follows it.
+func (r *decryptingReader) openSegment() error {
+	n, err := io.ReadFull(r.reader, r.ciphertext)
+	final := false
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		final = true
+	case errors.Is(err, io.EOF):
+		// The final segment always contains at least the authentication tag so the data
+		// has been truncated if there is none.
+		return fmt.Errorf("encrypted sink: segment %d: %w", r.segment, errSegmentAuthentication)
+	case err != nil:
+		return fmt.Errorf("encrypted sink: read segment: %w", err)
+	default:
+		if _, err := r.reader.Peek(1); errors.Is(err, io.EOF) {
+			final = true
+		} else if err != nil {
+			return fmt.Errorf("encrypted sink: read segment: %w", err)
+		}
+	}
+
+	plaintext, err := r.aead.Open(r.ciphertext[:0], segmentNonce(r.noncePrefix, r.segment, final), r.ciphertext[:n], r.header)
+	if err != nil {
+		return fmt.Errorf("encrypted sink: segment %d: %w", r.segment, errSegmentAuthentication)
+	}
+
+	r.plaintext = plaintext
+	r.done = final
+	r.segment++
+
+	return nil
+}
+
+func (r *decryptingReader) Close() error {
+	return r.closer.Close()
+}
@@ -0,0 +1,257 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestEncryptedSink(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	keyring, err := NewKeyring("key", map[string][]byte{"key": make([]byte, 32)})
+	require.NoError(t, err)
+
+	write := func(t *testing.T, sink Sink, relativePath string, data []byte) {
+		t.Helper()
+
+		writer, err := sink.GetWriter(ctx, relativePath)
+		require.NoError(t, err)
+
+		// Write in uneven chunks to exercise the segment boundaries.
+		for len(data) > 0 {
+			n := 1000
+			if n > len(data) {
+				n = len(data)
+			}
+
+			written, err := writer.Write(data[:n])
+			require.NoError(t, err)
+			require.Equal(t, n, written)
+
+			data = data[n:]
+		}
+
+		require.NoError(t, writer.Close())
+	}
+
+	read := func(t *testing.T, sink Sink, relativePath string) ([]byte, error) {
+		t.Helper()
+
+		reader, err := sink.GetReader(ctx, relativePath)
+		if err != nil {
+			return nil, err
+		}
+		defer testhelper.MustClose(t, reader)
+
+		return io.ReadAll(reader)
+	}
+
+	randomData := func(t *testing.T, size int) []byte {
+		t.Helper()
+
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+
+		return data
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		dir := testhelper.TempDir(t)
+		sink := NewEncryptedSink(NewFilesystemSink(dir), keyring)
+
+		for _, size := range []int{
+			0,
+			1,
+			encryptionSegmentSize - 1,
+			encryptionSegmentSize,
+			encryptionSegmentSize + 1,
+			3*encryptionSegmentSize + 5,
+		} {
+			data := randomData(t, size)
+			write(t, sink, "file", data)
+
+			encrypted, err := os.ReadFile(filepath.Join(dir, "file"))
+			require.NoError(t, err)
+			// A handful of random bytes may well occur in the ciphertext by chance.
+			if size > 16 {
+				require.False(t, bytes.Contains(encrypted, data), "data stored in plaintext")
+			}
+
+			decrypted, err := read(t, sink, "file")
+			require.NoError(t, err)
+			require.Equal(t, len(data), len(decrypted))
+			require.True(t, bytes.Equal(data, decrypted), "decrypted data differs")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		sink := NewEncryptedSink(NewFilesystemSink(testhelper.TempDir(t)), keyring)
+
+		_, err := read(t, sink, "missing")
+		require.Equal(t, ErrDoesntExist, err)
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		t.Parallel()
+
+		dir := testhelper.TempDir(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "file"), []byte("plaintext"), perm.PrivateFile))
+
+		_, err := read(t, NewEncryptedSink(NewFilesystemSink(dir), keyring), "file")
+		require.ErrorIs(t, err, ErrNotEncrypted)
+	})
+
+	t.Run("unencrypted reads", func(t *testing.T) {
+		t.Parallel()
+
+		dir := testhelper.TempDir(t)
+		sink := NewEncryptedSink(NewFilesystemSink(dir), keyring, WithUnencryptedReads())
+
+		// Files written before encryption was enabled are read as is, including ones that are
+		// shorter than the encryption header.
+		for _, data := range [][]byte{
+			{},
+			[]byte("short"),
+			randomData(t, 3*encryptionSegmentSize),
+		} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "plaintext"), data, perm.PrivateFile))
+
+			unencrypted, err := read(t, sink, "plaintext")
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(data, unencrypted), "unencrypted data differs")
+		}
+
+		// New files are still encrypted and decrypted on read.
+		data := randomData(t, encryptionSegmentSize+1)
+		write(t, sink, "encrypted", data)
+
+		encrypted, err := os.ReadFile(filepath.Join(dir, "encrypted"))
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(encrypted, data), "data stored in plaintext")
+
+		decrypted, err := read(t, sink, "encrypted")
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(data, decrypted), "decrypted data differs")
+
+		// Tampered encrypted files still fail to read.
+		encrypted[len(encrypted)-1] ^= 1
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "encrypted"), encrypted, perm.PrivateFile))
+
+		_, err = read(t, sink, "encrypted")
+		require.ErrorIs(t, err, errSegmentAuthentication)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		t.Parallel()
+
+		dir := testhelper.TempDir(t)
+		write(t, NewEncryptedSink(NewFilesystemSink(dir), keyring), "file", []byte("data"))
+
+		otherKeyring, err := NewKeyring("other", map[string][]byte{"other": make([]byte, 32)})
+		require.NoError(t, err)
+
+		_, err = read(t, NewEncryptedSink(NewFilesystemSink(dir), otherKeyring), "file")
+		require.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	for _, tc := range []struct {
+		desc   string
+		modify func([]byte) []byte
+	}{
+		{
+			desc: "tampered data",
+			modify: func(data []byte) []byte {
+				data[len(data)-encryptionSegmentSize] ^= 1
+				return data
+			},
+		},
+		{
+			desc: "tampered header",
+			modify: func(data []byte) []byte {
+				// Flip a bit in the wrapped key.
+				data[len(encryptionMagic)+2+len("key")+2+5] ^= 1
+				return data
+			},
+		},
+		{
+			desc: "truncated at segment boundary",
+			modify: func(data []byte) []byte {
+				// Drop the final segment holding the last 100 bytes and the authentication tag.
+				return data[:len(data)-100-16]
+			},
+		},
+		{
+			desc: "truncated final segment",
+			modify: func(data []byte) []byte {
+				return data[:len(data)-1]
+			},
+		},
+		{
+			desc: "appended data",
+			modify: func(data []byte) []byte {
+				return append(data, 0)
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			dir := testhelper.TempDir(t)
+			sink := NewEncryptedSink(NewFilesystemSink(dir), keyring)
+			write(t, sink, "file", randomData(t, 2*encryptionSegmentSize+100))
+
+			path := filepath.Join(dir, "file")
+			encrypted, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, tc.modify(encrypted), perm.PrivateFile))
+
+			_, err = read(t, sink, "file")
+			require.Error(t, err)
+			if !errors.Is(err, errSegmentAuthentication) {
+				require.ErrorContains(t, err, "message authentication failed")
+			}
+		})
+	}
+}
+
+// failingKeyProvider is a KeyProvider that fails to wrap and unwrap keys.
+type failingKeyProvider struct{}
+
+var errKeyProviderFailed = errors.New("key provider failed")
+
+func (failingKeyProvider) WrapKey(context.Context, []byte) (string, []byte, error) {
+	return "", nil, errKeyProviderFailed
+}
+
+func (failingKeyProvider) UnwrapKey(context.Context, string, []byte) ([]byte, error) {
+	return nil, errKeyProviderFailed
+}
+
+func TestEncryptedSink_keyProviderFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	sink := NewEncryptedSink(NewFilesystemSink(testhelper.TempDir(t)), failingKeyProvider{})
+
+	_, err := sink.GetWriter(ctx, "file")
+	require.ErrorIs(t, err, errKeyProviderFailed)
+}
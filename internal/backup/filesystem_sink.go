@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 )
@@ -55,6 +56,75 @@ func (fs *FilesystemSink) GetReader(ctx context.Context, relativePath string) (i
 	return f, nil
 }
 
+// List returns the files whose relative path starts with prefix.
+func (fs *FilesystemSink) List(ctx context.Context, prefix string) ([]SinkFile, error) {
+	// Only the directory containing the prefix needs to be walked as all of the
+	// matching files are within it.
+	root := filepath.Join(fs.path, filepath.Dir(prefix+"_"))
+
+	var files []SinkFile
+	if err := filepath.WalkDir(root, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(fs.path, path)
+		if err != nil {
+			return err
+		}
+
+		if !strings.HasPrefix(relativePath, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		files = append(files, SinkFile{
+			RelativePath: relativePath,
+			ModTime:      info.ModTime(),
+		})
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("filesystem sink: list: %w", err)
+	}
+
+	return files, nil
+}
+
+// Delete removes the file at relativePath. The parent directories left empty
+// are removed as well.
+func (fs *FilesystemSink) Delete(ctx context.Context, relativePath string) error {
+	path := filepath.Join(fs.path, relativePath)
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrDoesntExist
+		}
+		return fmt.Errorf("filesystem sink: %w", err)
+	}
+
+	root := filepath.Clean(fs.path)
+	for dir := filepath.Dir(path); strings.HasPrefix(dir, root+string(filepath.Separator)); dir = filepath.Dir(dir) {
+		// Removing the directory fails if it is not empty, in which case there is
+		// nothing more to clean up.
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
 // Close is a no-op to implement the Sink interface
 func (fs *FilesystemSink) Close() error {
 	return nil
@@ -113,3 +113,84 @@ func TestFilesystemSink_GetWriter(t *testing.T) {
 		require.EqualError(t, err, fmt.Sprintf(`filesystem sink: mkdir %s: not a directory`, filepath.Join(dir, "nested")))
 	})
 }
+
+func TestFilesystemSink_List(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	dir := testhelper.TempDir(t)
+	for _, relativePath := range []string{"repo/1/LATEST", "repo/1/001.bundle", "repo/2/LATEST", "repository/1/LATEST"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(relativePath)), perm.SharedDir))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, relativePath), []byte("test"), perm.SharedFile))
+	}
+
+	fsSink := NewFilesystemSink(dir)
+
+	for _, tc := range []struct {
+		desc          string
+		prefix        string
+		expectedPaths []string
+	}{
+		{
+			desc:          "directory prefix",
+			prefix:        "repo/",
+			expectedPaths: []string{"repo/1/001.bundle", "repo/1/LATEST", "repo/2/LATEST"},
+		},
+		{
+			desc:          "partial name prefix",
+			prefix:        "repo",
+			expectedPaths: []string{"repo/1/001.bundle", "repo/1/LATEST", "repo/2/LATEST", "repository/1/LATEST"},
+		},
+		{
+			desc:          "nested prefix",
+			prefix:        "repo/1/",
+			expectedPaths: []string{"repo/1/001.bundle", "repo/1/LATEST"},
+		},
+		{
+			desc:   "missing directory",
+			prefix: "missing/",
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := fsSink.List(ctx, tc.prefix)
+			require.NoError(t, err)
+
+			var paths []string
+			for _, file := range files {
+				require.False(t, file.ModTime.IsZero())
+				paths = append(paths, file.RelativePath)
+			}
+			require.ElementsMatch(t, tc.expectedPaths, paths)
+		})
+	}
+}
+
+func TestFilesystemSink_Delete(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	dir := testhelper.TempDir(t)
+	for _, relativePath := range []string{"repo/1/LATEST", "repo/1/001.bundle", "repo/2/LATEST"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(relativePath)), perm.SharedDir))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, relativePath), []byte("test"), perm.SharedFile))
+	}
+
+	fsSink := NewFilesystemSink(dir)
+
+	require.NoError(t, fsSink.Delete(ctx, "repo/1/001.bundle"))
+	require.NoFileExists(t, filepath.Join(dir, "repo/1/001.bundle"))
+	require.FileExists(t, filepath.Join(dir, "repo/1/LATEST"))
+
+	require.NoError(t, fsSink.Delete(ctx, "repo/1/LATEST"))
+	require.NoDirExists(t, filepath.Join(dir, "repo/1"))
+	require.FileExists(t, filepath.Join(dir, "repo/2/LATEST"))
+
+	require.Equal(t, ErrDoesntExist, fsSink.Delete(ctx, "repo/1/LATEST"))
+
+	require.NoError(t, fsSink.Delete(ctx, "repo/2/LATEST"))
+	require.NoDirExists(t, filepath.Join(dir, "repo"))
+	require.DirExists(t, dir)
+}
@@ -0,0 +1,170 @@
+package backup
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ErrUnknownKey is returned when a data key was wrapped with a key the KeyProvider doesn't have.
+var ErrUnknownKey = errors.New("unknown key")
+
+// KeyProvider wraps and unwraps the data keys the backups are encrypted with. The key encryption keys
+// never leave the provider so the provider may be backed by an external key management service.
+type KeyProvider interface {
+	// WrapKey encrypts the data key with the provider's current key encryption key. It returns the
+	// ID of the key encryption key along with the wrapped data key.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrappedKey []byte, err error)
+	// UnwrapKey decrypts a data key that was wrapped with the key encryption key of the given ID.
+	// ErrUnknownKey is returned if the provider doesn't have the key.
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// Keyring is a KeyProvider holding the key encryption keys locally. New data keys are wrapped with the
+// active key. The other keys are kept around to unwrap the data keys of older backups after the active
+// key has been rotated.
+type Keyring struct {
+	activeKeyID string
+	keys        map[string]cipher.AEAD
+}
+
+// keyringFile is the format of the keyring file loaded by LoadKeyring.
+type keyringFile struct {
+	// ActiveKey is the ID of the key used for wrapping new data keys.
+	ActiveKey string `toml:"active_key"`
+	// Keys are the keys in the keyring.
+	Keys []struct {
+		// ID identifies the key. It is recorded in the backups so the key to unwrap the data
+		// key with can be found.
+		ID string `toml:"id"`
+		// Key is the base64 encoded 256-bit AES key.
+		Key string `toml:"key"`
+	} `toml:"keys"`
+}
+
+// LoadKeyring loads a Keyring from the TOML file at the given path. The file looks like:
+//
+//	active_key = "2023-10"
+//
+//	[[keys]]
+//	id = "2023-10"
+//	key = "<base64 encoded 32 byte key>"
+func LoadKeyring(path string) (*Keyring, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load keyring: %w", err)
+	}
+
+	var file keyringFile
+	if err := toml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("load keyring: decode: %w", err)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for _, key := range file.Keys {
+		if _, ok := keys[key.ID]; ok {
+			return nil, fmt.Errorf("load keyring: duplicate key %q", key.ID)
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("load keyring: decode key %q: %w", key.ID, err)
+		}
+
+		keys[key.ID] = decoded
+	}
+
+	keyring, err := NewKeyring(file.ActiveKey, keys)
+	if err != nil {
+		return nil, fmt.Errorf("load keyring: %w", err)
+	}
+
+	return keyring, nil
+}
+
+// NewKeyring returns a Keyring with the given keys. The keys must be 256-bit AES keys and the active key
+// must be one of them.
+func NewKeyring(activeKeyID string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q: %w", activeKeyID, ErrUnknownKey)
+	}
+
+	keyring := &Keyring{
+		activeKeyID: activeKeyID,
+		keys:        make(map[string]cipher.AEAD, len(keys)),
+	}
+
+	for id, key := range keys {
+		if id == "" {
+			return nil, errors.New("key ID must not be empty")
+		}
+
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key %q: expected 32 bytes but got %d", id, len(key))
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+
+		keyring.keys[id] = aead
+	}
+
+	return keyring, nil
+}
+
+// WrapKey wraps the data key with the active key.
+func (k *Keyring) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	aead := k.keys[k.activeKeyID]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, fmt.Errorf("wrap key: generate nonce: %w", err)
+	}
+
+	// The key ID is authenticated so the wrapped key can't be passed off as wrapped by another key.
+	return k.activeKeyID, aead.Seal(nonce, nonce, dataKey, []byte(k.activeKeyID)), nil
+}
+
+// UnwrapKey unwraps a data key that was wrapped with the key of the given ID.
+func (k *Keyring) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unwrap key %q: %w", keyID, ErrUnknownKey)
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, fmt.Errorf("unwrap key %q: wrapped key too short", keyID)
+	}
+
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap key %q: %w", keyID, err)
+	}
+
+	return dataKey, nil
+}
+
+// newAEAD returns an AES-GCM AEAD for the given key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("new cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("new gcm: %w", err)
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,153 @@
+package backup
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestLoadKeyring(t *testing.T) {
+	t.Parallel()
+
+	encodedKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	for _, tc := range []struct {
+		desc        string
+		content     string
+		expectedErr string
+	}{
+		{
+			desc: "valid",
+			content: `active_key = "new"
+
+[[keys]]
+id = "old"
+key = "` + encodedKey + `"
+
+[[keys]]
+id = "new"
+key = "` + encodedKey + `"
+`,
+		},
+		{
+			desc:        "invalid TOML",
+			content:     "active_key = ",
+			expectedErr: "load keyring: decode: ",
+		},
+		{
+			desc: "invalid base64",
+			content: `active_key = "key"
+
+[[keys]]
+id = "key"
+key = "%%%"
+`,
+			expectedErr: `load keyring: decode key "key": illegal base64 data at input byte 0`,
+		},
+		{
+			desc: "invalid key size",
+			content: `active_key = "key"
+
+[[keys]]
+id = "key"
+key = "` + base64.StdEncoding.EncodeToString(make([]byte, 16)) + `"
+`,
+			expectedErr: `load keyring: key "key": expected 32 bytes but got 16`,
+		},
+		{
+			desc: "duplicate key",
+			content: `active_key = "key"
+
+[[keys]]
+id = "key"
+key = "` + encodedKey + `"
+
+[[keys]]
+id = "key"
+key = "` + encodedKey + `"
+`,
+			expectedErr: `load keyring: duplicate key "key"`,
+		},
+		{
+			desc: "missing active key",
+			content: `active_key = "missing"
+
+[[keys]]
+id = "key"
+key = "` + encodedKey + `"
+`,
+			expectedErr: `load keyring: active key "missing": unknown key`,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(testhelper.TempDir(t), "keyring.toml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), perm.PrivateFile))
+
+			keyring, err := LoadKeyring(path)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "new", keyring.activeKeyID)
+			require.Len(t, keyring.keys, 2)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadKeyring(filepath.Join(testhelper.TempDir(t), "missing"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestKeyring_wrapKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	oldKeyring, err := NewKeyring("old", map[string][]byte{
+		"old": make([]byte, 32),
+	})
+	require.NoError(t, err)
+
+	rotatedKeyring, err := NewKeyring("new", map[string][]byte{
+		"old": make([]byte, 32),
+		"new": append(make([]byte, 31), 1),
+	})
+	require.NoError(t, err)
+
+	dataKey := []byte("data key")
+
+	keyID, wrappedKey, err := oldKeyring.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "old", keyID)
+	require.NotContains(t, string(wrappedKey), string(dataKey))
+
+	// Data keys wrapped before the rotation can still be unwrapped.
+	unwrappedKey, err := rotatedKeyring.UnwrapKey(ctx, keyID, wrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrappedKey)
+
+	keyID, wrappedKey, err = rotatedKeyring.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "new", keyID)
+
+	_, err = oldKeyring.UnwrapKey(ctx, keyID, wrappedKey)
+	require.ErrorIs(t, err, ErrUnknownKey)
+
+	// The key ID is authenticated so the wrapped key can't be unwrapped with another key.
+	_, err = rotatedKeyring.UnwrapKey(ctx, "old", wrappedKey)
+	require.EqualError(t, err, `unwrap key "old": cipher: message authentication failed`)
+}
@@ -247,3 +247,178 @@ func (l PointerLocator) writeLatest(ctx context.Context, path, target string) (r
 
 	return nil
 }
+
+// sharedBundlesDir is the directory the shared bundles of object pools are
+// stored in by DedupLocator.
+const sharedBundlesDir = "_shared"
+
+// DedupLocator locates backup paths like PointerLocator, but additionally
+// stores the objects of object pools in bundles that are shared by all
+// members of the pool. The shared bundles of a pool form a chain: the first
+// bundle contains all objects of the pool and every following bundle only the
+// objects the pool gained since. A bundle is identified by its parent and the
+// set of objects its refs point to, so that concurrent backups of members of
+// the same pool end up writing the same bundle. The manifest of a shared
+// bundle lists the bundles it depends on and is written last, so that
+// partially written shared bundles are never referenced. The backup of a
+// member only contains the objects that are not part of the pool. The shared
+// bundles used by each step are listed in a manifest.
+//
+// Structure:
+//
+//	_shared/<pool relative path>/LATEST
+//	_shared/<pool relative path>/<key>.bundle
+//	_shared/<pool relative path>/<key>.refs
+//	_shared/<pool relative path>/<key>.shared
+//	<repo relative path>/LATEST
+//	<repo relative path>/<backup id>/LATEST
+//	<repo relative path>/<backup id>/<nnn>.bundle
+//	<repo relative path>/<backup id>/<nnn>.refs
+//	<repo relative path>/<backup id>/<nnn>.shared
+//	<repo relative path>/<backup id>/<nnn>.custom_hooks.tar
+type DedupLocator struct {
+	Sink     Sink
+	Fallback Locator
+}
+
+// BeginFull returns a tentative first step needed to create a new full backup.
+func (l DedupLocator) BeginFull(ctx context.Context, repo *gitalypb.Repository, backupID string) *Step {
+	return l.pointer().BeginFull(ctx, repo, backupID)
+}
+
+// BeginIncremental returns a tentative step needed to create a new incremental
+// backup. See PointerLocator.BeginIncremental.
+func (l DedupLocator) BeginIncremental(ctx context.Context, repo *gitalypb.Repository, fallbackBackupID string) (*Step, error) {
+	step, err := l.pointer().BeginIncremental(ctx, repo, fallbackBackupID)
+	if err != nil {
+		return nil, fmt.Errorf("dedup locator: %w", err)
+	}
+	return step, nil
+}
+
+// Commit persists the step, including the list of shared bundles it
+// references, so that it can be looked up by FindLatest.
+func (l DedupLocator) Commit(ctx context.Context, step *Step) error {
+	if len(step.SharedBundlePaths) > 0 {
+		if err := l.writeManifest(ctx, step); err != nil {
+			return fmt.Errorf("dedup locator: commit: %w", err)
+		}
+	}
+
+	if err := l.pointer().Commit(ctx, step); err != nil {
+		return fmt.Errorf("dedup locator: %w", err)
+	}
+	return nil
+}
+
+// FindLatest returns the paths committed by the latest call to Commit.
+func (l DedupLocator) FindLatest(ctx context.Context, repo *gitalypb.Repository) (*Backup, error) {
+	backup, err := l.pointer().FindLatest(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("dedup locator: %w", err)
+	}
+	if err := l.readManifests(ctx, backup); err != nil {
+		return nil, fmt.Errorf("dedup locator: find latest: %w", err)
+	}
+	return backup, nil
+}
+
+// Find returns the repository backup at the given backupID. If the backup does
+// not exist then the error ErrDoesntExist is returned.
+func (l DedupLocator) Find(ctx context.Context, repo *gitalypb.Repository, backupID string) (*Backup, error) {
+	backup, err := l.pointer().Find(ctx, repo, backupID)
+	if err != nil {
+		return nil, fmt.Errorf("dedup locator: %w", err)
+	}
+	if err := l.readManifests(ctx, backup); err != nil {
+		return nil, fmt.Errorf("dedup locator: find: %w", err)
+	}
+	return backup, nil
+}
+
+// SharedBundleDir returns the directory the chain of shared bundles of the
+// object pool is stored in.
+func (l DedupLocator) SharedBundleDir(pool *gitalypb.Repository) string {
+	return filepath.Join(sharedBundlesDir, strings.TrimSuffix(pool.RelativePath, ".git"))
+}
+
+func (l DedupLocator) pointer() PointerLocator {
+	return PointerLocator{
+		Sink:     l.Sink,
+		Fallback: l.Fallback,
+	}
+}
+
+func (l DedupLocator) writeManifest(ctx context.Context, step *Step) error {
+	return writeManifest(ctx, l.Sink, manifestPath(step), step.SharedBundlePaths)
+}
+
+func (l DedupLocator) readManifests(ctx context.Context, backup *Backup) error {
+	for i := range backup.Steps {
+		step := &backup.Steps[i]
+
+		paths, err := readManifest(ctx, l.Sink, manifestPath(step))
+		switch {
+		case errors.Is(err, ErrDoesntExist):
+			// Steps that do not use shared bundles have no manifest.
+			continue
+		case err != nil:
+			return err
+		}
+
+		step.SharedBundlePaths = append(step.SharedBundlePaths, paths...)
+	}
+
+	return nil
+}
+
+// writeManifest writes the list of shared bundle paths to path.
+func writeManifest(ctx context.Context, sink Sink, path string, paths []string) (returnErr error) {
+	w, err := sink.GetWriter(ctx, path)
+	if err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	defer func() {
+		if err := w.Close(); err != nil && returnErr == nil {
+			returnErr = fmt.Errorf("write manifest: %w", err)
+		}
+	}()
+
+	for _, path := range paths {
+		if _, err := fmt.Fprintln(w, path); err != nil {
+			return fmt.Errorf("write manifest: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readManifest reads the list of shared bundle paths written by
+// writeManifest.
+func readManifest(ctx context.Context, sink Sink, path string) ([]string, error) {
+	r, err := sink.GetReader(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	defer r.Close()
+
+	manifest, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var paths []string
+	for _, path := range strings.Split(text.ChompBytes(manifest), "\n") {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths, nil
+}
+
+// manifestPath returns the path of the file listing the shared bundles used
+// by step.
+func manifestPath(step *Step) string {
+	return strings.TrimSuffix(step.BundlePath, ".bundle") + ".shared"
+}
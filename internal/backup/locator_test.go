@@ -14,6 +14,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
 
 func TestLegacyLocator(t *testing.T) {
@@ -374,3 +375,93 @@ func TestPointerLocator(t *testing.T) {
 		})
 	})
 }
+
+func TestDedupLocator(t *testing.T) {
+	t.Parallel()
+
+	const backupID = "abc123"
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+		RelativePath:           t.Name(),
+	})
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+	t.Run("SharedBundleDir", func(t *testing.T) {
+		t.Parallel()
+
+		l := DedupLocator{}
+		require.Equal(t, filepath.Join("_shared", "@pools", "ab", "cd", "abcdef"), l.SharedBundleDir(&gitalypb.Repository{
+			RelativePath: "@pools/ab/cd/abcdef.git",
+		}))
+	})
+
+	t.Run("Commit/Find", func(t *testing.T) {
+		t.Parallel()
+
+		backupPath := testhelper.TempDir(t)
+		l := DedupLocator{
+			Sink: NewFilesystemSink(backupPath),
+		}
+
+		full := l.BeginFull(ctx, repo, backupID)
+		full.SharedBundlePaths = []string{
+			filepath.Join("_shared", "@pools", "aa", "aaaa.bundle"),
+			filepath.Join("_shared", "@pools", "aa", "bbbb.bundle"),
+		}
+		require.NoError(t, l.Commit(ctx, full))
+
+		incremental, err := l.BeginIncremental(ctx, repo, backupID)
+		require.NoError(t, err)
+		require.Empty(t, incremental.SharedBundlePaths)
+		require.NoError(t, l.Commit(ctx, incremental))
+
+		manifest := testhelper.MustReadFile(t, filepath.Join(backupPath, repo.RelativePath, backupID, "001.shared"))
+		require.Equal(t, filepath.Join("_shared", "@pools", "aa", "aaaa.bundle")+"\n"+filepath.Join("_shared", "@pools", "aa", "bbbb.bundle")+"\n", string(manifest))
+		require.NoFileExists(t, filepath.Join(backupPath, repo.RelativePath, backupID, "002.shared"))
+
+		expected := &Backup{
+			ObjectFormat: git.ObjectHashSHA1.Format,
+			Steps: []Step{
+				{
+					BundlePath:      filepath.Join(repo.RelativePath, backupID, "001.bundle"),
+					RefPath:         filepath.Join(repo.RelativePath, backupID, "001.refs"),
+					CustomHooksPath: filepath.Join(repo.RelativePath, backupID, "001.custom_hooks.tar"),
+					SharedBundlePaths: []string{
+						filepath.Join("_shared", "@pools", "aa", "aaaa.bundle"),
+						filepath.Join("_shared", "@pools", "aa", "bbbb.bundle"),
+					},
+				},
+				{
+					BundlePath:      filepath.Join(repo.RelativePath, backupID, "002.bundle"),
+					RefPath:         filepath.Join(repo.RelativePath, backupID, "002.refs"),
+					PreviousRefPath: filepath.Join(repo.RelativePath, backupID, "001.refs"),
+					CustomHooksPath: filepath.Join(repo.RelativePath, backupID, "002.custom_hooks.tar"),
+				},
+			},
+		}
+
+		latest, err := l.FindLatest(ctx, repo)
+		require.NoError(t, err)
+		require.Equal(t, expected, latest)
+
+		specific, err := l.Find(ctx, repo, backupID)
+		require.NoError(t, err)
+		require.Equal(t, expected, specific)
+	})
+
+	t.Run("Find not found", func(t *testing.T) {
+		t.Parallel()
+
+		backupPath := testhelper.TempDir(t)
+		l := DedupLocator{
+			Sink: NewFilesystemSink(backupPath),
+		}
+
+		_, err := l.Find(ctx, repo, backupID)
+		require.ErrorIs(t, err, ErrDoesntExist)
+	})
+}
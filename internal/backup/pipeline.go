@@ -17,6 +17,7 @@ type Strategy interface {
 	Create(context.Context, *CreateRequest) error
 	Restore(context.Context, *RestoreRequest) error
 	RemoveAllRepositories(context.Context, *RemoveAllRepositoriesRequest) error
+	Verify(context.Context, *VerifyRequest) error
 }
 
 // CreateRequest is the request to create a backup
@@ -52,6 +53,20 @@ type RestoreRequest struct {
 	BackupID string
 }
 
+// VerifyRequest is the request to verify a backup
+type VerifyRequest struct {
+	// Server contains gitaly server connection information required to call
+	// RPCs in the non-local backup.Manager configuration.
+	Server storage.ServerInfo
+	// Repository is the repository whose storage the backup is verified in.
+	Repository *gitalypb.Repository
+	// VanityRepository is used to determine the backup path.
+	VanityRepository *gitalypb.Repository
+	// BackupID is the ID of the full backup to verify. If not specified, the
+	// latest backup is verified.
+	BackupID string
+}
+
 // RemoveAllRepositoriesRequest is the request to remove all repositories in the specified
 // storage name.
 type RemoveAllRepositoriesRequest struct {
@@ -131,6 +146,83 @@ func (cmd RestoreCommand) Execute(ctx context.Context) error {
 	return cmd.strategy.Restore(ctx, &cmd.request)
 }
 
+// VerifyCommand verifies a backup for a repository
+type VerifyCommand struct {
+	strategy Strategy
+	request  VerifyRequest
+	report   func(*gitalypb.Repository, error)
+}
+
+// NewVerifyCommand builds a VerifyCommand. The outcome of the verification is
+// passed to report, which may be called concurrently by the pipeline.
+func NewVerifyCommand(strategy Strategy, request VerifyRequest, report func(*gitalypb.Repository, error)) *VerifyCommand {
+	return &VerifyCommand{
+		strategy: strategy,
+		request:  request,
+		report:   report,
+	}
+}
+
+// Repository is the repository that will be acted on
+func (cmd VerifyCommand) Repository() *gitalypb.Repository {
+	return cmd.request.Repository
+}
+
+// Name is the name of the command
+func (cmd VerifyCommand) Name() string {
+	return "verify"
+}
+
+// Execute performs the verification
+func (cmd VerifyCommand) Execute(ctx context.Context) error {
+	err := cmd.strategy.Verify(ctx, &cmd.request)
+	if cmd.report != nil {
+		cmd.report(cmd.request.Repository, err)
+	}
+	return err
+}
+
+// PruneCommand prunes the backups of a repository
+type PruneCommand struct {
+	pruner     *Pruner
+	repository *gitalypb.Repository
+	report     func(*gitalypb.Repository, []PruneDecision)
+}
+
+// NewPruneCommand builds a PruneCommand. The decisions made for the repository's
+// backups are passed to report, which may be called concurrently by the pipeline.
+func NewPruneCommand(pruner *Pruner, repository *gitalypb.Repository, report func(*gitalypb.Repository, []PruneDecision)) *PruneCommand {
+	return &PruneCommand{
+		pruner:     pruner,
+		repository: repository,
+		report:     report,
+	}
+}
+
+// Repository is the repository that will be acted on
+func (cmd PruneCommand) Repository() *gitalypb.Repository {
+	return cmd.repository
+}
+
+// Name is the name of the command
+func (cmd PruneCommand) Name() string {
+	return "prune"
+}
+
+// Execute performs the prune
+func (cmd PruneCommand) Execute(ctx context.Context) error {
+	decisions, err := cmd.pruner.Prune(ctx, cmd.repository)
+	if err != nil {
+		return err
+	}
+
+	if cmd.report != nil {
+		cmd.report(cmd.repository, decisions)
+	}
+
+	return nil
+}
+
 // PipelineErrors represents a summary of errors by repository
 type PipelineErrors []error
 
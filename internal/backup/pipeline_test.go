@@ -99,6 +99,7 @@ type MockStrategy struct {
 	CreateFunc                func(context.Context, *CreateRequest) error
 	RestoreFunc               func(context.Context, *RestoreRequest) error
 	RemoveAllRepositoriesFunc func(context.Context, *RemoveAllRepositoriesRequest) error
+	VerifyFunc                func(context.Context, *VerifyRequest) error
 }
 
 func (s MockStrategy) Create(ctx context.Context, req *CreateRequest) error {
@@ -122,6 +123,13 @@ func (s MockStrategy) RemoveAllRepositories(ctx context.Context, req *RemoveAllR
 	return nil
 }
 
+func (s MockStrategy) Verify(ctx context.Context, req *VerifyRequest) error {
+	if s.VerifyFunc != nil {
+		return s.VerifyFunc(ctx, req)
+	}
+	return nil
+}
+
 func testPipeline(t *testing.T, init func() Pipeline) {
 	t.Run("create command", func(t *testing.T) {
 		t.Parallel()
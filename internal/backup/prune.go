@@ -0,0 +1,371 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// Reasons for keeping a backup chain when pruning.
+const (
+	// KeepReasonLatest is given for the chain the repository's latest backup belongs to.
+	KeepReasonLatest = "latest"
+	// KeepReasonLast is given for the chains kept by RetentionPolicy.KeepLast.
+	KeepReasonLast = "last"
+	// KeepReasonDaily is given for the chains kept by RetentionPolicy.KeepDaily.
+	KeepReasonDaily = "daily"
+	// KeepReasonWeekly is given for the chains kept by RetentionPolicy.KeepWeekly.
+	KeepReasonWeekly = "weekly"
+)
+
+// RetentionPolicy determines which backups are kept when pruning. A backup chain is kept if any of the
+// rules keeps it.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backup chains to keep.
+	KeepLast int
+	// KeepDaily is the number of days for which the most recent backup chain of each day is kept.
+	KeepDaily int
+	// KeepWeekly is the number of weeks for which the most recent backup chain of each week is kept.
+	KeepWeekly int
+}
+
+// Validate checks the policy retains at least some backups.
+func (p RetentionPolicy) Validate() error {
+	if p.KeepLast < 0 || p.KeepDaily < 0 || p.KeepWeekly < 0 {
+		return errors.New("retention policy: values must not be negative")
+	}
+
+	if p.KeepLast == 0 && p.KeepDaily == 0 && p.KeepWeekly == 0 {
+		return errors.New("retention policy: at least one rule must be set")
+	}
+
+	return nil
+}
+
+// BackupChain is a full backup along with the incremental backups taken on top of it. An incremental
+// backup depends on every earlier step in its chain, so chains are always kept or deleted as a whole.
+type BackupChain struct {
+	// ID is the backup ID of the full backup.
+	ID string
+	// Files are the relative paths of the files belonging to the chain.
+	Files []string
+	// ModTime is the time the latest step was committed into the chain.
+	ModTime time.Time
+}
+
+// PruneDecision is the decision made for a backup chain when pruning.
+type PruneDecision struct {
+	// Chain is the chain the decision was made for.
+	Chain BackupChain
+	// KeepReasons are the reasons the chain is kept for. The chain is deleted if there are none.
+	KeepReasons []string
+}
+
+// Keep returns whether the chain is kept.
+func (d PruneDecision) Keep() bool {
+	return len(d.KeepReasons) > 0
+}
+
+// Pruner deletes the backups of a repository that are no longer retained by a RetentionPolicy. Only
+// backups in the pointer layout are pruned. The chain the repository's LATEST pointer refers to is
+// always kept as new incremental backups are based on it.
+type Pruner struct {
+	locator PointerLocator
+	policy  RetentionPolicy
+	dryRun  bool
+	now     func() time.Time
+}
+
+// NewPruner returns a Pruner that prunes the backups stored in the sink with the given policy. If
+// dryRun is set, the decisions are reported but nothing is deleted.
+func NewPruner(sink Sink, policy RetentionPolicy, dryRun bool) *Pruner {
+	return &Pruner{
+		locator: PointerLocator{Sink: sink},
+		policy:  policy,
+		dryRun:  dryRun,
+		now:     time.Now,
+	}
+}
+
+// Prune applies the retention policy to the backups of the repository. It returns the decisions made
+// for each of the repository's backup chains, ordered from newest to oldest.
+func (p *Pruner) Prune(ctx context.Context, repo *gitalypb.Repository) ([]PruneDecision, error) {
+	chains, latestID, err := p.locator.listChains(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("prune: %w", err)
+	}
+
+	decisions := p.policy.apply(chains, latestID, p.now())
+	if p.dryRun {
+		return decisions, nil
+	}
+
+	for _, decision := range decisions {
+		if decision.Keep() {
+			continue
+		}
+
+		if err := p.deleteChain(ctx, decision.Chain); err != nil {
+			return nil, fmt.Errorf("prune: delete backup %q: %w", decision.Chain.ID, err)
+		}
+	}
+
+	return decisions, nil
+}
+
+// deleteChain deletes the files of the chain. The chain's LATEST file is deleted last so an interrupted
+// deletion leaves the chain discoverable and it gets deleted on the next run.
+func (p *Pruner) deleteChain(ctx context.Context, chain BackupChain) error {
+	files := make([]string, 0, len(chain.Files))
+	var latest string
+	for _, file := range chain.Files {
+		if filepath.Base(file) == "LATEST" {
+			latest = file
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	if latest != "" {
+		files = append(files, latest)
+	}
+
+	for _, file := range files {
+		if err := p.locator.Sink.Delete(ctx, file); err != nil && !errors.Is(err, ErrDoesntExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sharedBundleGracePeriod is the time for which unreferenced shared bundles
+// are kept. Shared bundles are written before the backups referencing them are
+// committed, so recently written ones may be in use by backups in progress.
+const sharedBundleGracePeriod = 24 * time.Hour
+
+// PruneSharedBundles deletes the shared bundles of object pools which are
+// neither referenced by any backup nor part of the latest chain of shared
+// bundles of their pool. It should be run after the backups of all
+// repositories have been pruned. The paths of the deleted files are returned.
+// If dryRun is set, the files that would be deleted are returned instead.
+func (p *Pruner) PruneSharedBundles(ctx context.Context) ([]string, error) {
+	sharedFiles, err := p.locator.Sink.List(ctx, sharedBundlesDir+"/")
+	if err != nil {
+		return nil, fmt.Errorf("prune shared bundles: %w", err)
+	}
+	if len(sharedFiles) == 0 {
+		return nil, nil
+	}
+
+	files, err := p.locator.Sink.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("prune shared bundles: %w", err)
+	}
+
+	referenced := map[string]bool{}
+	markReferenced := func(manifestPath string) error {
+		paths, err := readManifest(ctx, p.locator.Sink, manifestPath)
+		switch {
+		case errors.Is(err, ErrDoesntExist):
+			// The manifest has been deleted since the sink was listed.
+			return nil
+		case err != nil:
+			return err
+		}
+
+		for _, path := range paths {
+			referenced[path] = true
+		}
+		return nil
+	}
+
+	for _, file := range files {
+		if strings.HasPrefix(file.RelativePath, sharedBundlesDir+"/") || filepath.Ext(file.RelativePath) != ".shared" {
+			continue
+		}
+
+		if err := markReferenced(file.RelativePath); err != nil {
+			return nil, fmt.Errorf("prune shared bundles: %w", err)
+		}
+	}
+
+	// The latest chain of each pool is kept so that new backups can build on
+	// top of it.
+	for _, file := range sharedFiles {
+		if filepath.Base(file.RelativePath) != "LATEST" {
+			continue
+		}
+
+		dir := filepath.Dir(file.RelativePath)
+		key, err := p.locator.findLatestID(ctx, dir)
+		if err != nil {
+			if errors.Is(err, ErrDoesntExist) {
+				continue
+			}
+			return nil, fmt.Errorf("prune shared bundles: %w", err)
+		}
+
+		if err := markReferenced(filepath.Join(dir, key+".shared")); err != nil {
+			return nil, fmt.Errorf("prune shared bundles: %w", err)
+		}
+	}
+
+	var unreferenced []string
+	for _, file := range sharedFiles {
+		if filepath.Base(file.RelativePath) == "LATEST" {
+			continue
+		}
+
+		// The refs and the manifest of a shared bundle are kept along with
+		// the bundle.
+		bundlePath := strings.TrimSuffix(file.RelativePath, filepath.Ext(file.RelativePath)) + ".bundle"
+		if referenced[bundlePath] || p.now().Sub(file.ModTime) < sharedBundleGracePeriod {
+			continue
+		}
+
+		unreferenced = append(unreferenced, file.RelativePath)
+	}
+
+	// Manifests are deleted first so that an interrupted deletion never leaves
+	// a manifest behind that refers to a deleted bundle.
+	sort.SliceStable(unreferenced, func(i, j int) bool {
+		return filepath.Ext(unreferenced[i]) == ".shared" && filepath.Ext(unreferenced[j]) != ".shared"
+	})
+
+	if p.dryRun {
+		return unreferenced, nil
+	}
+
+	for _, path := range unreferenced {
+		if err := p.locator.Sink.Delete(ctx, path); err != nil && !errors.Is(err, ErrDoesntExist) {
+			return nil, fmt.Errorf("prune shared bundles: delete %q: %w", path, err)
+		}
+	}
+
+	return unreferenced, nil
+}
+
+// apply decides which of the chains are kept. The decisions are ordered from newest to oldest chain.
+func (p RetentionPolicy) apply(chains []BackupChain, latestID string, now time.Time) []PruneDecision {
+	decisions := make([]PruneDecision, len(chains))
+	for i, chain := range chains {
+		decisions[i] = PruneDecision{Chain: chain}
+	}
+
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return decisions[i].Chain.ModTime.After(decisions[j].Chain.ModTime)
+	})
+
+	// keepNewestPerPeriod keeps the newest chain of each period the chains modified within the
+	// retention window fall into.
+	keepNewestPerPeriod := func(reason string, window time.Duration, period func(time.Time) string) {
+		seenPeriods := map[string]struct{}{}
+		for i := range decisions {
+			modTime := decisions[i].Chain.ModTime.UTC()
+			if now.Sub(modTime) >= window {
+				continue
+			}
+
+			key := period(modTime)
+			if _, ok := seenPeriods[key]; ok {
+				continue
+			}
+
+			seenPeriods[key] = struct{}{}
+			decisions[i].KeepReasons = append(decisions[i].KeepReasons, reason)
+		}
+	}
+
+	for i := range decisions {
+		if decisions[i].Chain.ID == latestID {
+			decisions[i].KeepReasons = append(decisions[i].KeepReasons, KeepReasonLatest)
+		}
+
+		if i < p.KeepLast {
+			decisions[i].KeepReasons = append(decisions[i].KeepReasons, KeepReasonLast)
+		}
+	}
+
+	if p.KeepDaily > 0 {
+		keepNewestPerPeriod(KeepReasonDaily, time.Duration(p.KeepDaily)*24*time.Hour, func(t time.Time) string {
+			return t.Format("2006-01-02")
+		})
+	}
+
+	if p.KeepWeekly > 0 {
+		keepNewestPerPeriod(KeepReasonWeekly, time.Duration(p.KeepWeekly)*7*24*time.Hour, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		})
+	}
+
+	return decisions
+}
+
+// listChains lists the backup chains of the repository along with the ID of the latest backup. Directories
+// without a LATEST file pointing to an increment are not considered chains. They may be backups still being
+// written, so they are left alone.
+func (l PointerLocator) listChains(ctx context.Context, repo *gitalypb.Repository) ([]BackupChain, string, error) {
+	repoPath := strings.TrimSuffix(repo.RelativePath, ".git")
+
+	latestID, err := l.findLatestID(ctx, repoPath)
+	if err != nil && !errors.Is(err, ErrDoesntExist) {
+		return nil, "", fmt.Errorf("list chains: %w", err)
+	}
+
+	files, err := l.Sink.List(ctx, repoPath+"/")
+	if err != nil {
+		return nil, "", fmt.Errorf("list chains: %w", err)
+	}
+
+	chainsByID := map[string]*BackupChain{}
+	var ids []string
+	for _, file := range files {
+		components := strings.Split(strings.TrimPrefix(file.RelativePath, repoPath+"/"), "/")
+		if len(components) != 2 {
+			continue
+		}
+
+		id := components[0]
+		chain, ok := chainsByID[id]
+		if !ok {
+			chain = &BackupChain{ID: id}
+			chainsByID[id] = chain
+			ids = append(ids, id)
+		}
+
+		chain.Files = append(chain.Files, file.RelativePath)
+		if components[1] == "LATEST" {
+			chain.ModTime = file.ModTime
+		}
+	}
+
+	var chains []BackupChain
+	for _, id := range ids {
+		incrementID, err := l.findLatestID(ctx, filepath.Join(repoPath, id))
+		if err != nil {
+			if errors.Is(err, ErrDoesntExist) {
+				continue
+			}
+
+			return nil, "", fmt.Errorf("list chains: %w", err)
+		}
+
+		if _, err := strconv.Atoi(incrementID); err != nil {
+			continue
+		}
+
+		chains = append(chains, *chainsByID[id])
+	}
+
+	return chains, latestID, nil
+}
@@ -0,0 +1,358 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		policy      RetentionPolicy
+		expectedErr string
+	}{
+		{
+			desc:   "keep last",
+			policy: RetentionPolicy{KeepLast: 1},
+		},
+		{
+			desc:   "keep daily and weekly",
+			policy: RetentionPolicy{KeepDaily: 7, KeepWeekly: 4},
+		},
+		{
+			desc:        "no rules",
+			expectedErr: "retention policy: at least one rule must be set",
+		},
+		{
+			desc:        "negative value",
+			policy:      RetentionPolicy{KeepLast: 1, KeepDaily: -1},
+			expectedErr: "retention policy: values must not be negative",
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.policy.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRetentionPolicy_apply(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday, the week starts on Monday 2023-10-09.
+	now := time.Date(2023, 10, 11, 12, 0, 0, 0, time.UTC)
+	chain := func(id string, modTime time.Time) BackupChain {
+		return BackupChain{ID: id, ModTime: modTime}
+	}
+
+	chains := []BackupChain{
+		chain("a", time.Date(2023, 9, 20, 1, 0, 0, 0, time.UTC)),
+		chain("b", time.Date(2023, 10, 2, 1, 0, 0, 0, time.UTC)),
+		chain("c", time.Date(2023, 10, 3, 1, 0, 0, 0, time.UTC)),
+		chain("d", time.Date(2023, 10, 10, 1, 0, 0, 0, time.UTC)),
+		chain("e", time.Date(2023, 10, 10, 20, 0, 0, 0, time.UTC)),
+		chain("f", time.Date(2023, 10, 11, 1, 0, 0, 0, time.UTC)),
+	}
+
+	for _, tc := range []struct {
+		desc            string
+		policy          RetentionPolicy
+		latestID        string
+		expectedReasons map[string][]string
+	}{
+		{
+			desc:     "keep last",
+			policy:   RetentionPolicy{KeepLast: 2},
+			latestID: "f",
+			expectedReasons: map[string][]string{
+				"f": {KeepReasonLatest, KeepReasonLast},
+				"e": {KeepReasonLast},
+			},
+		},
+		{
+			desc:     "latest is always kept",
+			policy:   RetentionPolicy{KeepLast: 1},
+			latestID: "a",
+			expectedReasons: map[string][]string{
+				"f": {KeepReasonLast},
+				"a": {KeepReasonLatest},
+			},
+		},
+		{
+			desc:     "keep daily",
+			policy:   RetentionPolicy{KeepDaily: 2},
+			latestID: "f",
+			expectedReasons: map[string][]string{
+				"f": {KeepReasonLatest, KeepReasonDaily},
+				"e": {KeepReasonDaily},
+			},
+		},
+		{
+			desc:     "keep weekly",
+			policy:   RetentionPolicy{KeepWeekly: 2},
+			latestID: "f",
+			expectedReasons: map[string][]string{
+				"f": {KeepReasonLatest, KeepReasonWeekly},
+				"c": {KeepReasonWeekly},
+			},
+		},
+		{
+			desc:     "combined rules",
+			policy:   RetentionPolicy{KeepLast: 1, KeepDaily: 2, KeepWeekly: 4},
+			latestID: "f",
+			expectedReasons: map[string][]string{
+				"f": {KeepReasonLatest, KeepReasonLast, KeepReasonDaily, KeepReasonWeekly},
+				"e": {KeepReasonDaily},
+				"c": {KeepReasonWeekly},
+				"a": {KeepReasonWeekly},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			decisions := tc.policy.apply(chains, tc.latestID, now)
+
+			var ids []string
+			reasons := map[string][]string{}
+			for _, decision := range decisions {
+				ids = append(ids, decision.Chain.ID)
+				if decision.Keep() {
+					reasons[decision.Chain.ID] = decision.KeepReasons
+				}
+			}
+
+			require.Equal(t, []string{"f", "e", "d", "c", "b", "a"}, ids)
+			require.Equal(t, tc.expectedReasons, reasons)
+		})
+	}
+}
+
+func TestPruner(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 11, 12, 0, 0, 0, time.UTC)
+	repo := &gitalypb.Repository{StorageName: "default", RelativePath: "@hashed/ab/cd/abcd.git"}
+
+	setup := func(t *testing.T) string {
+		dir := testhelper.TempDir(t)
+
+		writeFile := func(relativePath, content string, modTime time.Time) {
+			path := filepath.Join(dir, relativePath)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), perm.SharedDir))
+			require.NoError(t, os.WriteFile(path, []byte(content), perm.SharedFile))
+			require.NoError(t, os.Chtimes(path, modTime, modTime))
+		}
+
+		for i, id := range []string{"1", "2", "3"} {
+			modTime := now.Add(-time.Duration(3-i) * time.Hour)
+			writeFile(filepath.Join("@hashed/ab/cd/abcd", id, "001.bundle"), "bundle", modTime)
+			writeFile(filepath.Join("@hashed/ab/cd/abcd", id, "001.refs"), "refs", modTime)
+			writeFile(filepath.Join("@hashed/ab/cd/abcd", id, "002.bundle"), "bundle", modTime)
+			writeFile(filepath.Join("@hashed/ab/cd/abcd", id, "LATEST"), "002", modTime)
+		}
+
+		// A backup that is still being written has no LATEST file yet.
+		writeFile("@hashed/ab/cd/abcd/in-progress/001.bundle", "bundle", now.Add(-24*time.Hour))
+		writeFile("@hashed/ab/cd/abcd/LATEST", "3", now)
+
+		return dir
+	}
+
+	keptIDs := func(decisions []PruneDecision) []string {
+		var ids []string
+		for _, decision := range decisions {
+			if decision.Keep() {
+				ids = append(ids, decision.Chain.ID)
+			}
+		}
+		return ids
+	}
+
+	t.Run("prune", func(t *testing.T) {
+		t.Parallel()
+		ctx := testhelper.Context(t)
+
+		dir := setup(t)
+		pruner := NewPruner(NewFilesystemSink(dir), RetentionPolicy{KeepLast: 2}, false)
+		pruner.now = func() time.Time { return now }
+
+		decisions, err := pruner.Prune(ctx, repo)
+		require.NoError(t, err)
+		require.Len(t, decisions, 3)
+		require.Equal(t, []string{"3", "2"}, keptIDs(decisions))
+
+		require.NoDirExists(t, filepath.Join(dir, "@hashed/ab/cd/abcd/1"))
+		require.DirExists(t, filepath.Join(dir, "@hashed/ab/cd/abcd/2"))
+		require.DirExists(t, filepath.Join(dir, "@hashed/ab/cd/abcd/3"))
+		require.FileExists(t, filepath.Join(dir, "@hashed/ab/cd/abcd/in-progress/001.bundle"))
+		require.FileExists(t, filepath.Join(dir, "@hashed/ab/cd/abcd/LATEST"))
+
+		// Pruning again is a no-op.
+		decisions, err = pruner.Prune(ctx, repo)
+		require.NoError(t, err)
+		require.Len(t, decisions, 2)
+		require.Equal(t, []string{"3", "2"}, keptIDs(decisions))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+		ctx := testhelper.Context(t)
+
+		dir := setup(t)
+		pruner := NewPruner(NewFilesystemSink(dir), RetentionPolicy{KeepLast: 1}, true)
+		pruner.now = func() time.Time { return now }
+
+		decisions, err := pruner.Prune(ctx, repo)
+		require.NoError(t, err)
+		require.Equal(t, []string{"3"}, keptIDs(decisions))
+
+		for _, id := range []string{"1", "2", "3"} {
+			require.FileExists(t, filepath.Join(dir, "@hashed/ab/cd/abcd", id, "LATEST"))
+		}
+	})
+
+	t.Run("no backups", func(t *testing.T) {
+		t.Parallel()
+		ctx := testhelper.Context(t)
+
+		pruner := NewPruner(NewFilesystemSink(testhelper.TempDir(t)), RetentionPolicy{KeepLast: 1}, false)
+
+		decisions, err := pruner.Prune(ctx, repo)
+		require.NoError(t, err)
+		require.Empty(t, decisions)
+	})
+}
+
+func TestPruner_PruneSharedBundles(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 11, 12, 0, 0, 0, time.UTC)
+	poolDir := filepath.Join("_shared", "@pools", "aa", "bb", "pool")
+	sharedPath := func(key, ext string) string {
+		return filepath.Join(poolDir, key+ext)
+	}
+
+	setup := func(t *testing.T) string {
+		dir := testhelper.TempDir(t)
+
+		writeFile := func(relativePath, content string, modTime time.Time) {
+			path := filepath.Join(dir, relativePath)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), perm.SharedDir))
+			require.NoError(t, os.WriteFile(path, []byte(content), perm.SharedFile))
+			require.NoError(t, os.Chtimes(path, modTime, modTime))
+		}
+
+		writeSharedBundle := func(key string, chain []string, modTime time.Time) {
+			var manifest string
+			for _, key := range chain {
+				manifest += sharedPath(key, ".bundle") + "\n"
+			}
+
+			writeFile(sharedPath(key, ".bundle"), "bundle", modTime)
+			writeFile(sharedPath(key, ".refs"), "refs", modTime)
+			writeFile(sharedPath(key, ".shared"), manifest, modTime)
+		}
+
+		old := now.Add(-48 * time.Hour)
+
+		// An old chain of which only the first bundle is still referenced by
+		// a backup.
+		writeSharedBundle("k1", []string{"k1"}, old)
+		writeSharedBundle("k2", []string{"k1", "k2"}, old)
+		writeFile("@hashed/ab/cd/abcd/1/001.shared", sharedPath("k1", ".bundle")+"\n", old)
+
+		// The latest chain is kept even though no backup references it.
+		writeSharedBundle("k3", []string{"k3"}, old)
+		writeSharedBundle("k4", []string{"k3", "k4"}, old)
+		writeFile(filepath.Join(poolDir, "LATEST"), "k4\n", old)
+
+		// Bundles which have not been completely written.
+		writeFile(sharedPath("recent", ".bundle"), "bundle", now.Add(-time.Hour))
+		writeFile(sharedPath("stale", ".bundle"), "bundle", old)
+
+		return dir
+	}
+
+	expectedDeleted := []string{
+		sharedPath("k2", ".shared"),
+		sharedPath("k2", ".bundle"),
+		sharedPath("k2", ".refs"),
+		sharedPath("stale", ".bundle"),
+	}
+
+	t.Run("prune", func(t *testing.T) {
+		t.Parallel()
+		ctx := testhelper.Context(t)
+
+		dir := setup(t)
+		pruner := NewPruner(NewFilesystemSink(dir), RetentionPolicy{KeepLast: 1}, false)
+		pruner.now = func() time.Time { return now }
+
+		deleted, err := pruner.PruneSharedBundles(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedDeleted, deleted)
+		require.Equal(t, sharedPath("k2", ".shared"), deleted[0])
+
+		for _, path := range expectedDeleted {
+			require.NoFileExists(t, filepath.Join(dir, path))
+		}
+		for _, key := range []string{"k1", "k3", "k4"} {
+			require.FileExists(t, filepath.Join(dir, sharedPath(key, ".bundle")))
+			require.FileExists(t, filepath.Join(dir, sharedPath(key, ".refs")))
+			require.FileExists(t, filepath.Join(dir, sharedPath(key, ".shared")))
+		}
+		require.FileExists(t, filepath.Join(dir, sharedPath("recent", ".bundle")))
+		require.FileExists(t, filepath.Join(dir, poolDir, "LATEST"))
+
+		// Pruning again is a no-op.
+		deleted, err = pruner.PruneSharedBundles(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deleted)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+		ctx := testhelper.Context(t)
+
+		dir := setup(t)
+		pruner := NewPruner(NewFilesystemSink(dir), RetentionPolicy{KeepLast: 1}, true)
+		pruner.now = func() time.Time { return now }
+
+		deleted, err := pruner.PruneSharedBundles(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedDeleted, deleted)
+
+		for _, path := range expectedDeleted {
+			require.FileExists(t, filepath.Join(dir, path))
+		}
+	})
+
+	t.Run("no shared bundles", func(t *testing.T) {
+		t.Parallel()
+		ctx := testhelper.Context(t)
+
+		pruner := NewPruner(NewFilesystemSink(testhelper.TempDir(t)), RetentionPolicy{KeepLast: 1}, false)
+
+		deleted, err := pruner.PruneSharedBundles(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deleted)
+	})
+}
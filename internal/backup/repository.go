@@ -7,9 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/objectpool"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/repoutil"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/counter"
@@ -277,6 +280,113 @@ func (rr *remoteRepository) SetCustomHooks(ctx context.Context, reader io.Reader
 	return nil
 }
 
+// Fsck checks the integrity and connectivity of the objects in the repository.
+func (rr *remoteRepository) Fsck(ctx context.Context) error {
+	repoClient := rr.newRepoClient()
+	resp, err := repoClient.Fsck(ctx, &gitalypb.FsckRequest{Repository: rr.repo})
+	if err != nil {
+		return fmt.Errorf("remote repository: fsck: %w", err)
+	}
+	if len(resp.GetError()) > 0 {
+		return fmt.Errorf("remote repository: fsck: %s", resp.GetError())
+	}
+	return nil
+}
+
+// ObjectPool returns the object pool the repository is connected to.
+func (rr *remoteRepository) ObjectPool(ctx context.Context) (*gitalypb.Repository, error) {
+	poolClient := gitalypb.NewObjectPoolServiceClient(rr.conn)
+	resp, err := poolClient.GetObjectPool(ctx, &gitalypb.GetObjectPoolRequest{Repository: rr.repo})
+	if err != nil {
+		return nil, fmt.Errorf("remote repository: object pool: %w", err)
+	}
+	return resp.GetObjectPool().GetRepository(), nil
+}
+
+// resetRefsBatchSize is the maximum number of refs that are sent per request
+// when resetting refs.
+const resetRefsBatchSize = 1000
+
+// ResetRefs updates the refs of the repository to match refs.
+func (rr *remoteRepository) ResetRefs(ctx context.Context, refs []git.Reference) error {
+	current, err := rr.ListRefs(ctx)
+	if err != nil {
+		return fmt.Errorf("remote repository: reset refs: %w", err)
+	}
+
+	updates, deletions := diffRefUpdates(current, refs)
+
+	if len(updates) > 0 {
+		stream, err := rr.newRefClient().UpdateReferences(ctx)
+		if err != nil {
+			return fmt.Errorf("remote repository: reset refs: %w", err)
+		}
+
+		repo := rr.repo
+		for len(updates) > 0 {
+			n := len(updates)
+			if n > resetRefsBatchSize {
+				n = resetRefsBatchSize
+			}
+
+			request := &gitalypb.UpdateReferencesRequest{Repository: repo}
+			for _, ref := range updates[:n] {
+				request.Updates = append(request.Updates, &gitalypb.UpdateReferencesRequest_Update{
+					Reference:   []byte(ref.Name),
+					NewObjectId: []byte(ref.Target),
+				})
+			}
+			if err := stream.Send(request); err != nil {
+				return fmt.Errorf("remote repository: reset refs: %w", err)
+			}
+
+			// Only set `Repository` on the first `Send` of the stream
+			repo = nil
+			updates = updates[n:]
+		}
+
+		if _, err := stream.CloseAndRecv(); err != nil {
+			return fmt.Errorf("remote repository: reset refs: %w", err)
+		}
+	}
+
+	for len(deletions) > 0 {
+		n := len(deletions)
+		if n > resetRefsBatchSize {
+			n = resetRefsBatchSize
+		}
+
+		request := &gitalypb.DeleteRefsRequest{Repository: rr.repo}
+		for _, name := range deletions[:n] {
+			request.Refs = append(request.Refs, []byte(name))
+		}
+		resp, err := rr.newRefClient().DeleteRefs(ctx, request)
+		if err != nil {
+			return fmt.Errorf("remote repository: reset refs: %w", err)
+		}
+		if len(resp.GetGitError()) > 0 {
+			return fmt.Errorf("remote repository: reset refs: %s", resp.GetGitError())
+		}
+
+		deletions = deletions[n:]
+	}
+
+	return nil
+}
+
+// SetHeadReference points HEAD at the given reference.
+func (rr *remoteRepository) SetHeadReference(ctx context.Context, target git.ReferenceName) error {
+	repoClient := rr.newRepoClient()
+	if _, err := repoClient.WriteRef(ctx, &gitalypb.WriteRefRequest{
+		Repository: rr.repo,
+		Ref:        []byte("HEAD"),
+		Revision:   []byte(target),
+	}); err != nil {
+		return fmt.Errorf("remote repository: set head reference: %w", err)
+	}
+	return nil
+}
+
 func (rr *remoteRepository) newRepoClient() gitalypb.RepositoryServiceClient {
 	return gitalypb.NewRepositoryServiceClient(rr.conn)
 }
@@ -411,3 +521,126 @@ func (r *localRepository) SetCustomHooks(ctx context.Context, reader io.Reader)
 	}
 	return nil
 }
+
+// Fsck checks the integrity and connectivity of the objects in the repository.
+func (r *localRepository) Fsck(ctx context.Context) error {
+	var output bytes.Buffer
+	cmd, err := r.gitCmdFactory.New(ctx, r.repo,
+		git.Command{
+			Name: "fsck",
+			Flags: []git.Option{
+				git.Flag{Name: "--no-progress"},
+				git.Flag{Name: "--no-dangling"},
+			},
+		},
+		git.WithStdout(&output),
+		git.WithStderr(&output),
+	)
+	if err != nil {
+		return fmt.Errorf("local repository: fsck: %w", err)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("local repository: fsck: %s", output.String())
+	}
+	return nil
+}
+
+// ObjectPool returns the object pool the repository is connected to.
+func (r *localRepository) ObjectPool(ctx context.Context) (*gitalypb.Repository, error) {
+	// The object pool is only used to determine its location, so neither the
+	// catfile cache nor the housekeeping manager are required.
+	pool, err := objectpool.FromRepo(r.locator, r.gitCmdFactory, nil, r.txManager, nil, r.repo)
+	switch {
+	case errors.Is(err, objectpool.ErrAlternateObjectDirNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("local repository: object pool: %w", err)
+	case pool == nil:
+		return nil, nil
+	}
+	return pool.ToProto().GetRepository(), nil
+}
+
+// ResetRefs updates the refs of the repository to match refs.
+func (r *localRepository) ResetRefs(ctx context.Context, refs []git.Reference) (returnErr error) {
+	current, err := r.repo.GetReferences(ctx, "refs/")
+	if err != nil {
+		return fmt.Errorf("local repository: reset refs: %w", err)
+	}
+
+	updates, deletions := diffRefUpdates(current, refs)
+	if len(updates) == 0 && len(deletions) == 0 {
+		return nil
+	}
+
+	updater, err := updateref.New(ctx, r.repo)
+	if err != nil {
+		return fmt.Errorf("local repository: reset refs: %w", err)
+	}
+	defer func() {
+		if err := updater.Close(); err != nil && returnErr == nil {
+			returnErr = fmt.Errorf("local repository: reset refs: %w", err)
+		}
+	}()
+
+	if err := updater.Start(); err != nil {
+		return fmt.Errorf("local repository: reset refs: %w", err)
+	}
+	for _, ref := range updates {
+		if err := updater.Update(ref.Name, git.ObjectID(ref.Target), ""); err != nil {
+			return fmt.Errorf("local repository: reset refs: %w", err)
+		}
+	}
+	for _, name := range deletions {
+		if err := updater.Delete(name); err != nil {
+			return fmt.Errorf("local repository: reset refs: %w", err)
+		}
+	}
+	if err := updater.Commit(); err != nil {
+		return fmt.Errorf("local repository: reset refs: %w", err)
+	}
+
+	return nil
+}
+
+// SetHeadReference points HEAD at the given reference.
+func (r *localRepository) SetHeadReference(ctx context.Context, target git.ReferenceName) error {
+	if err := r.repo.SetDefaultBranch(ctx, r.txManager, target); err != nil {
+		return fmt.Errorf("local repository: set head reference: %w", err)
+	}
+	return nil
+}
+
+// diffRefUpdates determines the refs that need to be updated and the refs that
+// need to be deleted so that current matches wanted. HEAD is ignored.
+func diffRefUpdates(current, wanted []git.Reference) ([]git.Reference, []git.ReferenceName) {
+	currentTargets := make(map[git.ReferenceName]string, len(current))
+	for _, ref := range current {
+		if ref.Name == "HEAD" {
+			continue
+		}
+		currentTargets[ref.Name] = ref.Target
+	}
+
+	var updates []git.Reference
+	for _, ref := range wanted {
+		if ref.Name == "HEAD" {
+			continue
+		}
+		if target, ok := currentTargets[ref.Name]; !ok || target != ref.Target {
+			updates = append(updates, ref)
+		}
+		delete(currentTargets, ref.Name)
+	}
+
+	deletions := make([]git.ReferenceName, 0, len(currentTargets))
+	for name := range currentTargets {
+		deletions = append(deletions, name)
+	}
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i] < deletions[j]
+	})
+
+	return updates, deletions
+}
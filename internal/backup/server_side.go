@@ -14,7 +14,8 @@ import (
 
 // ServerSideAdapter allows calling the server-side backup RPCs `BackupRepository`
 // and `RestoreRepository` through `backup.Strategy` such that server-side
-// backups can be used with `backup.Pipeline`.
+// backups can be used with `backup.Pipeline`. Backups are verified through the
+// `VerifyRepositoryBackup` RPC.
 type ServerSideAdapter struct {
 	pool *client.Pool
 }
@@ -92,6 +93,39 @@ func (ss ServerSideAdapter) Restore(ctx context.Context, req *RestoreRequest) er
 	return nil
 }
 
+// Verify calls the VerifyRepositoryBackup RPC.
+func (ss ServerSideAdapter) Verify(ctx context.Context, req *VerifyRequest) error {
+	if err := setContextServerInfo(ctx, &req.Server, req.Repository.GetStorageName()); err != nil {
+		return fmt.Errorf("server-side verify: %w", err)
+	}
+
+	client, err := ss.newRepoClient(ctx, req.Server)
+	if err != nil {
+		return fmt.Errorf("server-side verify: %w", err)
+	}
+
+	_, err = client.VerifyRepositoryBackup(ctx, &gitalypb.VerifyRepositoryBackupRequest{
+		Repository:       req.Repository,
+		VanityRepository: req.VanityRepository,
+		BackupId:         req.BackupID,
+	})
+	if err != nil {
+		st := status.Convert(err)
+		for _, detail := range st.Details() {
+			switch detail.(type) {
+			case *gitalypb.VerifyRepositoryBackupResponse_SkippedError:
+				return fmt.Errorf("server-side verify: %w: %s", ErrSkipped, err.Error())
+			case *gitalypb.VerifyRepositoryBackupResponse_VerificationFailedError:
+				return fmt.Errorf("server-side verify: %w: %s", ErrVerificationFailed, err.Error())
+			}
+		}
+
+		return structerr.New("server-side verify: %w", err)
+	}
+
+	return nil
+}
+
 // RemoveAllRepositories removes all repositories in the specified storage name.
 func (ss ServerSideAdapter) RemoveAllRepositories(ctx context.Context, req *RemoveAllRepositoriesRequest) error {
 	if err := setContextServerInfo(ctx, &req.Server, req.StorageName); err != nil {
@@ -3,6 +3,9 @@ package backup_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,6 +16,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/setup"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/client"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
@@ -241,6 +245,71 @@ func TestServerSideAdapter_Restore(t *testing.T) {
 	}
 }
 
+func TestServerSideAdapter_Verify(t *testing.T) {
+	gittest.SkipWithSHA256(t)
+
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	backupRoot := testhelper.TempDir(t)
+	backupSink, err := backup.ResolveSink(ctx, backupRoot)
+	require.NoError(t, err)
+
+	backupLocator, err := backup.ResolveLocator("pointer", backupSink)
+	require.NoError(t, err)
+
+	cfg := testcfg.Build(t)
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll,
+		testserver.WithBackupSink(backupSink),
+		testserver.WithBackupLocator(backupLocator),
+	)
+
+	pool := client.NewPool()
+	defer testhelper.MustClose(t, pool)
+
+	adapter := backup.NewServerSideAdapter(pool)
+
+	ctx = testhelper.MergeIncomingMetadata(ctx, testcfg.GitalyServersMetadataFromCfg(t, cfg))
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+	require.NoError(t, adapter.Create(ctx, &backup.CreateRequest{
+		Repository:       repo,
+		VanityRepository: repo,
+		BackupID:         "abc123",
+	}))
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, adapter.Verify(ctx, &backup.VerifyRequest{
+			Repository:       repo,
+			VanityRepository: repo,
+		}))
+	})
+
+	t.Run("verification failed", func(t *testing.T) {
+		bundlePath := filepath.Join(backupRoot, strings.TrimSuffix(repo.GetRelativePath(), ".git"), "abc123", "001.bundle")
+		require.NoError(t, os.WriteFile(bundlePath, []byte("corrupt"), perm.SharedFile))
+
+		err := adapter.Verify(ctx, &backup.VerifyRequest{
+			Repository:       repo,
+			VanityRepository: repo,
+			BackupID:         "abc123",
+		})
+		require.ErrorIs(t, err, backup.ErrVerificationFailed)
+	})
+
+	t.Run("missing backup", func(t *testing.T) {
+		missingRepo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+		err := adapter.Verify(ctx, &backup.VerifyRequest{
+			Repository:       missingRepo,
+			VanityRepository: missingRepo,
+		})
+		require.ErrorIs(t, err, backup.ErrSkipped)
+	})
+}
+
 func TestServerSideAdapter_RemoveAllRepositories(t *testing.T) {
 	testhelper.SkipWithWAL(t, `
 RemoveAll is removing the entire content of the storage. This would also remove the database's and
@@ -2,6 +2,7 @@ package backup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
@@ -98,3 +99,39 @@ func (s *StorageServiceSink) GetReader(ctx context.Context, relativePath string)
 	}
 	return reader, nil
 }
+
+// List returns the files whose relative path starts with prefix.
+func (s *StorageServiceSink) List(ctx context.Context, prefix string) ([]SinkFile, error) {
+	var files []SinkFile
+
+	iterator := s.bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		object, err := iterator.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return files, nil
+			}
+			return nil, fmt.Errorf("storage service sink: list %q: %w", prefix, err)
+		}
+
+		if object.IsDir {
+			continue
+		}
+
+		files = append(files, SinkFile{
+			RelativePath: object.Key,
+			ModTime:      object.ModTime,
+		})
+	}
+}
+
+// Delete removes the data stored by relativePath from the configured bucket.
+func (s *StorageServiceSink) Delete(ctx context.Context, relativePath string) error {
+	if err := s.bucket.Delete(ctx, relativePath); err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			err = ErrDoesntExist
+		}
+		return fmt.Errorf("storage service sink: delete %q: %w", relativePath, err)
+	}
+	return nil
+}
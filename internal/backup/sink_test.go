@@ -146,4 +146,35 @@ func TestStorageServiceSink(t *testing.T) {
 		require.Equal(t, fmt.Errorf(`storage service sink: new reader for "not-existing": %w`, ErrDoesntExist), err)
 		require.Nil(t, reader)
 	})
+
+	t.Run("list and delete", func(t *testing.T) {
+		for _, relativePath := range []string{"list/a/1", "list/a/2", "list/b/1", "listing/1"} {
+			w, err := sss.GetWriter(ctx, relativePath)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(relativePath))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+		}
+
+		listPaths := func(prefix string) []string {
+			files, err := sss.List(ctx, prefix)
+			require.NoError(t, err)
+
+			var paths []string
+			for _, file := range files {
+				require.False(t, file.ModTime.IsZero())
+				paths = append(paths, file.RelativePath)
+			}
+			return paths
+		}
+
+		require.ElementsMatch(t, []string{"list/a/1", "list/a/2", "list/b/1"}, listPaths("list/"))
+		require.ElementsMatch(t, []string{"list/a/1", "list/a/2"}, listPaths("list/a/"))
+		require.Empty(t, listPaths("not-existing/"))
+
+		require.NoError(t, sss.Delete(ctx, "list/a/1"))
+		require.ElementsMatch(t, []string{"list/a/2", "list/b/1"}, listPaths("list/"))
+
+		require.Equal(t, fmt.Errorf(`storage service sink: delete "list/a/1": %w`, ErrDoesntExist), sss.Delete(ctx, "list/a/1"))
+	})
 }
@@ -11,6 +11,7 @@ import (
 // Cache is a stream-oriented cache which stores RPC responses keyed by protobuf requests.
 type Cache interface {
 	Streamer
+	ImmutableStreamer
 	Invalidator
 }
 
@@ -20,6 +21,13 @@ type Streamer interface {
 	PutStream(context.Context, *gitalypb.Repository, proto.Message, io.Reader) error
 }
 
+// ImmutableStreamer stores and retrieves the responses of requests whose response is fully determined by the
+// request itself. Contrary to Streamer, cached responses are not invalidated when the repository is modified.
+type ImmutableStreamer interface {
+	GetImmutableStream(context.Context, *gitalypb.Repository, proto.Message) (io.ReadCloser, error)
+	PutImmutableStream(context.Context, *gitalypb.Repository, proto.Message, io.Reader) error
+}
+
 // Invalidator is able to invalidate parts of the cache pertinent to a
 // specific repository. Before a repo mutating operation, StartLease should
 // be called. Once the operation is complete, the returned LeaseEnder should
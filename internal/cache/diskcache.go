@@ -84,6 +84,12 @@ type DiskCache struct {
 
 	walkersDone chan struct{}
 	walkerLoops []*dontpanic.Forever
+	// unwalkedDirs are directories skipped by the walkers. These are the directories of the
+	// immutable cache, which evicts entries itself.
+	unwalkedDirs map[string]struct{}
+
+	// immutableEntries tracks the entries of the immutable cache per storage.
+	immutableEntries map[string]*lruEntries
 
 	requestTotals              prometheus.Counter
 	missTotals                 prometheus.Counter
@@ -96,6 +102,9 @@ type DiskCache struct {
 	walkerErrorTotal           prometheus.Counter
 	walkerEmptyDirTotal        prometheus.Counter
 	walkerEmptyDirRemovalTotal prometheus.Counter
+	immutableRequestTotals     prometheus.Counter
+	immutableMissTotals        prometheus.Counter
+	immutableEvictionTotals    prometheus.Counter
 }
 
 // New will create a new DiskCache with the given Keyer.
@@ -113,8 +122,10 @@ func New(cfg config.Cfg, locator storage.Locator, logger log.Logger, opts ...Opt
 			Mutex: &sync.Mutex{},
 			m:     map[string]int{},
 		},
-		cacheConfig: cacheConfig,
-		walkersDone: make(chan struct{}),
+		cacheConfig:      cacheConfig,
+		walkersDone:      make(chan struct{}),
+		unwalkedDirs:     map[string]struct{}{},
+		immutableEntries: map[string]*lruEntries{},
 
 		requestTotals: prometheus.NewCounter(
 			prometheus.CounterOpts{
@@ -183,9 +194,35 @@ func New(cfg config.Cfg, locator storage.Locator, logger log.Logger, opts ...Opt
 				Help: "Total number of empty directories removed",
 			},
 		),
+		immutableRequestTotals: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "gitaly_diskcache_immutable_requests_total",
+				Help: "Total number of immutable disk cache requests",
+			},
+		),
+		immutableMissTotals: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "gitaly_diskcache_immutable_miss_total",
+				Help: "Total number of immutable disk cache misses",
+			},
+		),
+		immutableEvictionTotals: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "gitaly_diskcache_immutable_evictions_total",
+				Help: "Total number of immutable disk cache entries evicted to stay within the disk budget",
+			},
+		),
 	}
 	cache.keyer = newLeaseKeyer(locator, cache.countErr)
 
+	for _, storage := range cfg.Storages {
+		cache.immutableEntries[storage.Name] = newLRUEntries(cfg.ImmutableCache.MaxBytes)
+
+		if cacheDir, err := locator.CacheDir(storage.Name); err == nil {
+			cache.unwalkedDirs[immutableDir(cacheDir)] = struct{}{}
+		}
+	}
+
 	return cache
 }
 
@@ -206,6 +243,9 @@ func (c *DiskCache) Collect(metrics chan<- prometheus.Metric) {
 	c.walkerErrorTotal.Collect(metrics)
 	c.walkerEmptyDirTotal.Collect(metrics)
 	c.walkerEmptyDirRemovalTotal.Collect(metrics)
+	c.immutableRequestTotals.Collect(metrics)
+	c.immutableMissTotals.Collect(metrics)
+	c.immutableEvictionTotals.Collect(metrics)
 }
 
 func (c *DiskCache) countErr(err error) error {
@@ -275,7 +315,7 @@ func (irc instrumentedReadCloser) Read(p []byte) (n int, err error) {
 
 // PutStream will store a stream in a repo-namespace keyed by the digest of the
 // request protobuf message.
-func (c *DiskCache) PutStream(ctx context.Context, repo *gitalypb.Repository, req proto.Message, src io.Reader) (returnedErr error) {
+func (c *DiskCache) PutStream(ctx context.Context, repo *gitalypb.Repository, req proto.Message, src io.Reader) error {
 	reqPath, err := c.KeyPath(ctx, repo, req)
 	if err != nil {
 		return err
@@ -285,7 +325,12 @@ func (c *DiskCache) PutStream(ctx context.Context, repo *gitalypb.Repository, re
 		WithField("stream_path", reqPath).
 		Info("putting stream")
 
-	var n int64
+	_, err = c.writeStream(reqPath, src)
+	return err
+}
+
+// writeStream atomically writes the stream to the given path and returns the number of bytes written.
+func (c *DiskCache) writeStream(reqPath string, src io.Reader) (n int64, returnedErr error) {
 	isWinner := c.af.trackFile(reqPath)
 	defer func() {
 		if !isWinner() {
@@ -294,12 +339,12 @@ func (c *DiskCache) PutStream(ctx context.Context, repo *gitalypb.Repository, re
 	}()
 
 	if err := os.MkdirAll(filepath.Dir(reqPath), perm.SharedDir); err != nil {
-		return err
+		return 0, err
 	}
 
 	sf, err := safe.NewFileWriter(reqPath)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer func() {
 		if err := sf.Close(); err != nil && returnedErr == nil {
@@ -311,16 +356,16 @@ func (c *DiskCache) PutStream(ctx context.Context, repo *gitalypb.Repository, re
 
 	n, err = io.Copy(sf, src)
 	if err != nil {
-		return err
+		return n, err
 	}
 	c.bytesStoredtotals.Add(float64(n))
 
 	if err := sf.Commit(); err != nil {
 		c.errTotal.WithLabelValues("ErrSafefileCommit").Inc()
-		return err
+		return n, err
 	}
 
-	return nil
+	return n, nil
 }
 
 // KeyPath returns the cache path for the given request.
@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+)
+
+// lruEntries tracks the cached files of a storage in least-recently-used order so that the least recently
+// used ones can be evicted once the files exceed the disk budget. The tracked entries start out empty as
+// the cache directory is cleared whenever Gitaly starts.
+type lruEntries struct {
+	sync.Mutex
+	maxBytes  int64
+	usedBytes int64
+	// order contains the entries with the most recently used one at its front.
+	order    *list.List
+	elements map[string]*list.Element
+}
+
+type lruEntry struct {
+	path string
+	size int64
+}
+
+func newLRUEntries(maxBytes int64) *lruEntries {
+	return &lruEntries{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		elements: map[string]*list.Element{},
+	}
+}
+
+// touch marks the entry as the most recently used one. It returns false if the entry isn't tracked.
+func (e *lruEntries) touch(path string) bool {
+	e.Lock()
+	defer e.Unlock()
+
+	element, ok := e.elements[path]
+	if !ok {
+		return false
+	}
+
+	e.order.MoveToFront(element)
+	return true
+}
+
+// add tracks the entry as the most recently used one. It returns the paths of the least recently used
+// entries that need to be evicted so that the entries stay within the disk budget. This may include the
+// added entry itself in case it exceeds the budget on its own.
+func (e *lruEntries) add(path string, size int64) []string {
+	e.Lock()
+	defer e.Unlock()
+
+	if element, ok := e.elements[path]; ok {
+		e.usedBytes -= element.Value.(lruEntry).size
+		element.Value = lruEntry{path: path, size: size}
+		e.order.MoveToFront(element)
+	} else {
+		e.elements[path] = e.order.PushFront(lruEntry{path: path, size: size})
+	}
+	e.usedBytes += size
+
+	var evicted []string
+	for e.usedBytes > e.maxBytes {
+		entry := e.order.Remove(e.order.Back()).(lruEntry)
+		delete(e.elements, entry.path)
+		e.usedBytes -= entry.size
+
+		evicted = append(evicted, entry.path)
+	}
+
+	return evicted
+}
+
+// remove stops tracking the entry.
+func (e *lruEntries) remove(path string) {
+	e.Lock()
+	defer e.Unlock()
+
+	element, ok := e.elements[path]
+	if !ok {
+		return
+	}
+
+	e.order.Remove(element)
+	delete(e.elements, path)
+	e.usedBytes -= element.Value.(lruEntry).size
+}
+
+func (c *DiskCache) lruEntries(repo *gitalypb.Repository) (*lruEntries, error) {
+	entries, ok := c.immutableEntries[repo.GetStorageName()]
+	if !ok {
+		return nil, fmt.Errorf("immutable cache: storage not found: %q", repo.GetStorageName())
+	}
+
+	return entries, nil
+}
+
+// GetImmutableStream will fetch the cached stream for a request whose response is fully determined by the
+// request. It is the responsibility of the caller to close the stream when done.
+func (c *DiskCache) GetImmutableStream(ctx context.Context, repo *gitalypb.Repository, req proto.Message) (_ io.ReadCloser, err error) {
+	defer func() {
+		if err != nil {
+			c.immutableMissTotals.Inc()
+		}
+	}()
+
+	c.immutableRequestTotals.Inc()
+
+	entries, err := c.lruEntries(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	respPath, err := c.keyer.immutableKeyPath(ctx, repo, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entries.touch(respPath) {
+		return nil, ErrReqNotFound
+	}
+
+	log.FromContext(ctx).
+		WithField("stream_path", respPath).
+		Info("getting immutable stream")
+
+	respF, err := os.Open(respPath)
+	switch {
+	case os.IsNotExist(err):
+		// The entry may have been evicted concurrently.
+		entries.remove(respPath)
+		return nil, ErrReqNotFound
+	case err == nil:
+		break
+	default:
+		return nil, err
+	}
+
+	return instrumentedReadCloser{
+		ReadCloser: respF,
+		counter:    c.bytesFetchedtotals,
+	}, nil
+}
+
+// PutImmutableStream will store the stream for a request whose response is fully determined by the
+// request. The least recently used streams of the storage are evicted once the stored streams exceed
+// the disk budget.
+func (c *DiskCache) PutImmutableStream(ctx context.Context, repo *gitalypb.Repository, req proto.Message, src io.Reader) error {
+	entries, err := c.lruEntries(repo)
+	if err != nil {
+		return err
+	}
+
+	reqPath, err := c.keyer.immutableKeyPath(ctx, repo, req)
+	if err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).
+		WithField("stream_path", reqPath).
+		Info("putting immutable stream")
+
+	n, err := c.writeStream(reqPath, src)
+	if err != nil {
+		return err
+	}
+
+	for _, evictedPath := range entries.add(reqPath, n) {
+		if err := os.Remove(evictedPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("evicting immutable stream: %w", err)
+		}
+
+		c.immutableEvictionTotals.Inc()
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package cache
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	promtest "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestDiskCache_immutableStream(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	cfg.ImmutableCache = config.ImmutableCache{Enabled: true, MaxBytes: 10}
+
+	ctx := testhelper.Context(t)
+	ctx = testhelper.SetCtxGrpcMethod(ctx, "/gitaly.DiffService/CommitDiff")
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	locator := config.NewLocator(cfg)
+	logger := testhelper.SharedLogger(t)
+
+	newRequest := func(commitID string) *gitalypb.CommitDiffRequest {
+		return &gitalypb.CommitDiffRequest{
+			Repository:    repo,
+			LeftCommitId:  commitID,
+			RightCommitId: commitID,
+		}
+	}
+
+	expectGetMiss := func(t *testing.T, cache *DiskCache, req *gitalypb.CommitDiffRequest) {
+		_, err := cache.GetImmutableStream(ctx, repo, req)
+		require.Equal(t, ErrReqNotFound, err)
+	}
+
+	expectGetHit := func(t *testing.T, cache *DiskCache, req *gitalypb.CommitDiffRequest, expected string) {
+		stream, err := cache.GetImmutableStream(ctx, repo, req)
+		require.NoError(t, err)
+		defer testhelper.MustClose(t, stream)
+
+		actual, err := io.ReadAll(stream)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	}
+
+	t.Run("store and retrieve", func(t *testing.T) {
+		cache := New(cfg, locator, logger)
+
+		req := newRequest(strings.Repeat("1", 40))
+		expectGetMiss(t, cache, req)
+
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, req, strings.NewReader("content")))
+		expectGetHit(t, cache, req, "content")
+	})
+
+	t.Run("survives repository mutations", func(t *testing.T) {
+		cache := New(cfg, locator, logger)
+
+		req := newRequest(strings.Repeat("2", 40))
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, req, strings.NewReader("content")))
+
+		lease, err := cache.StartLease(repo)
+		require.NoError(t, err)
+		expectGetHit(t, cache, req, "content")
+
+		require.NoError(t, lease.EndLease(ctx))
+		expectGetHit(t, cache, req, "content")
+	})
+
+	t.Run("evicts least recently used entries", func(t *testing.T) {
+		cache := New(cfg, locator, logger)
+
+		reqA := newRequest(strings.Repeat("a", 40))
+		reqB := newRequest(strings.Repeat("b", 40))
+		reqC := newRequest(strings.Repeat("c", 40))
+
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, reqA, strings.NewReader("aaaa")))
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, reqB, strings.NewReader("bbbb")))
+
+		// Accessing the first entry makes the second one the least recently used entry.
+		expectGetHit(t, cache, reqA, "aaaa")
+
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, reqC, strings.NewReader("cccc")))
+
+		expectGetHit(t, cache, reqA, "aaaa")
+		expectGetMiss(t, cache, reqB)
+		expectGetHit(t, cache, reqC, "cccc")
+
+		evictedPath, err := cache.keyer.immutableKeyPath(ctx, repo, reqB)
+		require.NoError(t, err)
+		require.NoFileExists(t, evictedPath)
+		require.Equal(t, 1, int(promtest.ToFloat64(cache.immutableEvictionTotals)))
+	})
+
+	t.Run("entry exceeding the budget", func(t *testing.T) {
+		cache := New(cfg, locator, logger)
+
+		req := newRequest(strings.Repeat("d", 40))
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, req, strings.NewReader("exceeds the budget")))
+		expectGetMiss(t, cache, req)
+	})
+
+	t.Run("entry removed concurrently", func(t *testing.T) {
+		cache := New(cfg, locator, logger)
+
+		req := newRequest(strings.Repeat("e", 40))
+		require.NoError(t, cache.PutImmutableStream(ctx, repo, req, strings.NewReader("content")))
+
+		path, err := cache.keyer.immutableKeyPath(ctx, repo, req)
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(path))
+
+		expectGetMiss(t, cache, req)
+
+		// The entry should not count towards the budget anymore.
+		require.Zero(t, cache.immutableEntries[repo.StorageName].usedBytes)
+	})
+
+	t.Run("entries of previous runs are ignored", func(t *testing.T) {
+		req := newRequest(strings.Repeat("f", 40))
+		require.NoError(t, New(cfg, locator, logger).PutImmutableStream(ctx, repo, req, strings.NewReader("content")))
+
+		expectGetMiss(t, New(cfg, locator, logger), req)
+	})
+
+	t.Run("unknown storage", func(t *testing.T) {
+		cache := New(cfg, locator, logger)
+
+		unknownRepo := &gitalypb.Repository{StorageName: "unknown", RelativePath: repo.RelativePath}
+		_, err := cache.GetImmutableStream(ctx, unknownRepo, newRequest(strings.Repeat("1", 40)))
+		require.ErrorContains(t, err, `immutable cache: storage not found: "unknown"`)
+	})
+}
+
+func TestDiskCache_immutableStreamNotWalked(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	cfg.ImmutableCache = config.ImmutableCache{Enabled: true, MaxBytes: 1024}
+
+	ctx := testhelper.Context(t)
+	ctx = testhelper.SetCtxGrpcMethod(ctx, "/gitaly.DiffService/CommitDiff")
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	cache := New(cfg, config.NewLocator(cfg), testhelper.SharedLogger(t))
+
+	req := &gitalypb.CommitDiffRequest{
+		Repository:    repo,
+		LeftCommitId:  strings.Repeat("1", 40),
+		RightCommitId: strings.Repeat("2", 40),
+	}
+	require.NoError(t, cache.PutImmutableStream(ctx, repo, req, strings.NewReader("content")))
+
+	path, err := cache.keyer.immutableKeyPath(ctx, repo, req)
+	require.NoError(t, err)
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(-24*time.Hour)))
+
+	cacheDir, err := cache.locator.CacheDir(repo.StorageName)
+	require.NoError(t, err)
+	require.NoError(t, cache.cleanWalk(cacheDir))
+
+	require.FileExists(t, path)
+	stream, err := cache.GetImmutableStream(ctx, repo, req)
+	require.NoError(t, err)
+	testhelper.MustClose(t, stream)
+}
+
+func TestLRUEntries(t *testing.T) {
+	t.Parallel()
+
+	entries := newLRUEntries(10)
+
+	require.Empty(t, entries.add("a", 4))
+	require.Empty(t, entries.add("b", 4))
+	require.False(t, entries.touch("c"))
+	require.True(t, entries.touch("a"))
+
+	// Replacing an entry updates its size.
+	require.Empty(t, entries.add("b", 2))
+	require.Equal(t, int64(6), entries.usedBytes)
+
+	require.Equal(t, []string{"a"}, entries.add("c", 5))
+	require.Equal(t, []string{"b", "c", "d"}, entries.add("d", 11))
+	require.Zero(t, entries.usedBytes)
+
+	entries.remove("unknown")
+	require.Empty(t, entries.add("e", 5))
+	entries.remove("e")
+	require.Zero(t, entries.usedBytes)
+	require.Zero(t, entries.order.Len())
+}
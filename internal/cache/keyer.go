@@ -163,6 +163,28 @@ func (keyer leaseKeyer) keyPath(ctx context.Context, repo *gitalypb.Repository,
 	return radixPath(cDir, key)
 }
 
+// immutableKeyPath returns the unique key path for a request whose response is fully determined by the
+// request itself. In contrast to keyPath, the key doesn't depend on the current generation of the repo's
+// cache and thus stays valid across repository mutations. The context must contain the gRPC method in its
+// values.
+func (keyer leaseKeyer) immutableKeyPath(ctx context.Context, repo *gitalypb.Repository, req proto.Message) (string, error) {
+	if len(repo.GetRelativePath()) == 0 {
+		return "", fmt.Errorf("immutableKeyPath: relative path missing from %+v", repo)
+	}
+
+	key, err := compositeKeyHashHex(ctx, "", req)
+	if err != nil {
+		return "", err
+	}
+
+	cDir, err := keyer.cacheDir(repo)
+	if err != nil {
+		return "", err
+	}
+
+	return radixPath(immutableDir(cDir), key)
+}
+
 // radixPath is the same directory structure scheme used by git. This scheme
 // allows for the objects to be randomly distributed across folders based on
 // the first 2 hex chars of the key (i.e. 256 possible top level folders).
@@ -273,6 +295,7 @@ func (keyer leaseKeyer) currentGenID(ctx context.Context, repo *gitalypb.Reposit
 // func stateDir(repoDir string) string   { return filepath.Join(repoDir, "state") }
 func pendingDir(repoStateDir string) string { return filepath.Join(repoStateDir, "pending") }
 func latestPath(repoStateDir string) string { return filepath.Join(repoStateDir, "latest") }
+func immutableDir(cacheDir string) string   { return filepath.Join(cacheDir, "immutable") }
 
 // compositeKeyHashHex returns a hex encoded string that is a SHA256 hash sum of
 // the composite key made up of the following properties: Gitaly version, gRPC
@@ -284,7 +307,8 @@ func compositeKeyHashHex(ctx context.Context, genID string, req proto.Message) (
 		return "", ErrCtxMethodMissing
 	}
 
-	reqSum, err := proto.Marshal(req)
+	// Marshal deterministically so that requests containing maps always hash to the same key.
+	reqSum, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
 	if err != nil {
 		return "", err
 	}
@@ -2,7 +2,8 @@
 // cache folder on all storages listed in the config file. Upon configuration
 // validation, one worker will be started for each storage. The worker will
 // walk the cache directory tree and remove any files older than one hour. The
-// worker will walk the cache directory every ten minutes.
+// worker will walk the cache directory every ten minutes. Entries of the
+// immutable cache are skipped as they are evicted by the cache itself.
 package cache
 
 import (
@@ -42,6 +43,10 @@ func (c *DiskCache) cleanWalk(path string) error {
 		ePath := filepath.Join(path, e.Name())
 
 		if e.IsDir() {
+			if _, ok := c.unwalkedDirs[ePath]; ok {
+				continue
+			}
+
 			if err := c.cleanWalk(ePath); err != nil {
 				return err
 			}
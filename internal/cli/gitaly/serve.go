@@ -17,6 +17,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/bootstrap/starter"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cache"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
@@ -39,6 +40,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/env"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter/watchers"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/streamcache"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/tempdir"
@@ -50,6 +52,9 @@ import (
 	"google.golang.org/grpc"
 )
 
+// adaptiveCalibration is the interval at which the adaptive concurrency limits are calibrated.
+const adaptiveCalibration = 30 * time.Second
+
 func newServeCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "serve",
@@ -281,10 +286,48 @@ func run(cfg config.Cfg, logger log.Logger) error {
 		return fmt.Errorf("disk cache walkers: %w", err)
 	}
 
+	adaptiveWatchers := []limiter.ResourceWatcher{
+		watchers.NewCgroupCPUWatcher(cgroupMgr),
+		watchers.NewCgroupMemoryWatcher(cgroupMgr),
+	}
+
+	// Queue times are only recorded if the watcher is configured. Otherwise, the monitors are used
+	// as is.
+	wrapMonitor := func(monitor limiter.ConcurrencyMonitor) limiter.ConcurrencyMonitor {
+		return monitor
+	}
+	if threshold := cfg.AdaptiveLimiting.QueueTimeThreshold.Duration(); threshold > 0 {
+		queueTimeWatcher := watchers.NewQueueTimeWatcher(threshold)
+		adaptiveWatchers = append(adaptiveWatchers, queueTimeWatcher)
+		wrapMonitor = queueTimeWatcher.Monitor
+	}
+
+	if threshold := cfg.AdaptiveLimiting.SpawnTokenWaitThreshold.Duration(); threshold > 0 {
+		spawnTokenWatcher := watchers.NewSpawnTokenWatcher(threshold)
+		adaptiveWatchers = append(adaptiveWatchers, spawnTokenWatcher)
+		command.AddSpawnTokenWaitingTimeObserver(spawnTokenWatcher.Observe)
+	}
+
+	var serverOpts []server.Option
+	if len(cfg.AdaptiveLimiting.LatencyThresholds) > 0 {
+		thresholds := make(map[string]time.Duration, len(cfg.AdaptiveLimiting.LatencyThresholds))
+		for rpc, threshold := range cfg.AdaptiveLimiting.LatencyThresholds {
+			threshold := threshold
+			thresholds[rpc] = threshold.Duration()
+		}
+
+		latencyWatcher := watchers.NewLatencyWatcher(thresholds)
+		adaptiveWatchers = append(adaptiveWatchers, latencyWatcher)
+		serverOpts = append(serverOpts,
+			server.WithUnaryInterceptor(latencyWatcher.UnaryServerInterceptor()),
+			server.WithStreamInterceptor(latencyWatcher.StreamServerInterceptor()),
+		)
+	}
+
 	concurrencyLimitHandler := limithandler.New(
 		cfg,
 		limithandler.LimitConcurrencyByRepo,
-		limithandler.WithConcurrencyLimiters,
+		limithandler.WithMonitoredConcurrencyLimiters(wrapMonitor),
 	)
 
 	rateLimitHandler := limithandler.New(
@@ -300,12 +343,28 @@ func run(cfg config.Cfg, logger log.Logger) error {
 		cfg.PackObjectsLimiting.MaxConcurrency,
 		cfg.PackObjectsLimiting.MaxQueueLength,
 		cfg.PackObjectsLimiting.MaxQueueWait.Duration(),
-		packObjectsMonitor,
+		wrapMonitor(packObjectsMonitor),
 	)
 
 	prometheus.MustRegister(concurrencyLimitHandler, rateLimitHandler)
 	prometheus.MustRegister(packObjectsMonitor)
 
+	if adaptiveLimits := concurrencyLimitHandler.AdaptiveLimits(); len(adaptiveLimits) > 0 {
+		adaptiveCalculator := limiter.NewAdaptiveCalculator(
+			adaptiveCalibration,
+			logger.WithField("component", "adaptive_calculator"),
+			adaptiveLimits,
+			adaptiveWatchers,
+		)
+		prometheus.MustRegister(adaptiveCalculator)
+
+		stop, err := adaptiveCalculator.Start(ctx)
+		if err != nil {
+			return fmt.Errorf("start adaptive calculator: %w", err)
+		}
+		defer stop()
+	}
+
 	gitalyServerFactory := server.NewGitalyServerFactory(
 		cfg,
 		logger,
@@ -327,6 +386,17 @@ func run(cfg config.Cfg, logger log.Logger) error {
 		if err != nil {
 			return fmt.Errorf("resolve backup sink: %w", err)
 		}
+		if cfg.Backup.EncryptionKeyring != "" {
+			keyring, err := backup.LoadKeyring(cfg.Backup.EncryptionKeyring)
+			if err != nil {
+				return fmt.Errorf("load backup encryption keyring: %w", err)
+			}
+			var opts []backup.EncryptedSinkOption
+			if cfg.Backup.AllowUnencrypted {
+				opts = append(opts, backup.WithUnencryptedReads())
+			}
+			backupSink = backup.NewEncryptedSink(backupSink, keyring, opts...)
+		}
 		backupLocator, err = backup.ResolveLocator(cfg.Backup.Layout, backupSink)
 		if err != nil {
 			return fmt.Errorf("resolve backup locator: %w", err)
@@ -345,12 +415,12 @@ func run(cfg config.Cfg, logger log.Logger) error {
 
 		var srv *grpc.Server
 		if c.HandoverOnUpgrade {
-			srv, err = gitalyServerFactory.CreateExternal(c.IsSecure())
+			srv, err = gitalyServerFactory.CreateExternal(c.IsSecure(), serverOpts...)
 			if err != nil {
 				return fmt.Errorf("create external gRPC server: %w", err)
 			}
 		} else {
-			srv, err = gitalyServerFactory.CreateInternal()
+			srv, err = gitalyServerFactory.CreateInternal(serverOpts...)
 			if err != nil {
 				return fmt.Errorf("create internal gRPC server: %w", err)
 			}
@@ -72,6 +72,9 @@ func NewApp() *cli.App {
 			newSQLMigrateStatusCommand(),
 			newRemoveRepositoryCommand(),
 			newSetReplicationFactorCommand(),
+			newRebalanceCommand(),
+			newConsistencyReportCommand(),
+			newDrainStorageCommand(),
 		},
 		Flags: []cli.Flag{
 			&cli.StringFlag{
@@ -30,10 +30,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/metrics"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/rebalancer"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/reconciler"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/repocleaner"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/transaction"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/storageusage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/transactions"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/version"
 	"gitlab.com/gitlab-org/labkit/monitoring"
@@ -270,13 +273,31 @@ func server(
 		nodeSet       praefect.NodeSet
 		router        praefect.Router
 		primaryGetter praefect.PrimaryGetter
+		// storageDrainer is only set with repository-specific primaries as the other election
+		// strategies don't consider draining storages.
+		storageDrainer info.StorageDrainer
+		// storageUsage is only set with repository-specific primaries as the other routers don't
+		// place repositories by the storages' usage.
+		storageUsage praefect.StorageUsageGetter
+		// coordinatorOpts are the options the coordinator is created with.
+		coordinatorOpts []praefect.CoordinatorOpt
 	)
 	if conf.Failover.ElectionStrategy == config.ElectionStrategyPerRepository {
+		loadTracker := tracker.NewLoads()
+		for _, readDistribution := range conf.ReadDistributions() {
+			// The latencies of the nodes are only needed to distribute reads by their load.
+			if readDistribution == config.ReadDistributionLeastLoaded {
+				coordinatorOpts = append(coordinatorOpts, praefect.WithLoadTracker(loadTracker))
+				break
+			}
+		}
+
 		nodeSet, err = praefect.DialNodes(
 			ctx,
 			conf.VirtualStorages,
 			protoregistry.GitalyProtoPreregistered,
 			errTracker,
+			loadTracker,
 			clientHandshaker,
 			sidechannelRegistry,
 			logger,
@@ -302,17 +323,33 @@ func server(
 		elector := nodes.NewPerRepositoryElector(db)
 
 		primaryGetter = elector
-		assignmentStore = datastore.NewAssignmentStore(db, conf.StorageNames())
+		assignmentStore = datastore.NewAssignmentStore(db, conf.StorageNames(), conf.StorageZones())
+		storageDrainer = datastore.NewDrainingStorageStore(db)
+
+		if conf.StorageUsage.Enabled {
+			tracker := storageusage.NewTracker(logger, nodeSet.Connections(), conf.StorageUsage.FillThreshold)
+			go func() {
+				if err := tracker.Run(ctx, helper.NewTimerTicker(conf.StorageUsage.PollInterval.Duration())); err != nil {
+					logger.WithError(err).Error("storage usage tracker finished execution")
+				}
+			}()
 
+			storageUsage = tracker
+		}
+
+		random := praefect.NewLockedRandom(rand.New(rand.NewSource(time.Now().UnixNano())))
 		router = praefect.NewPerRepositoryRouter(
 			nodeSet.Connections(),
 			elector,
 			healthManager,
-			praefect.NewLockedRandom(rand.New(rand.NewSource(time.Now().UnixNano()))),
+			random,
 			csg,
 			assignmentStore,
 			rs,
 			conf.DefaultReplicationFactors(),
+			praefect.NewReadDistributors(conf, random, loadTracker),
+			conf.StorageZones(),
+			storageUsage,
 		)
 
 		if conf.BackgroundVerification.VerificationInterval > 0 {
@@ -324,6 +361,7 @@ func server(
 				healthManager,
 				conf.BackgroundVerification.VerificationInterval.Duration(),
 				conf.BackgroundVerification.DeleteInvalidRecords,
+				conf.BackgroundVerification.VerifyChecksums,
 			)
 			promreg.MustRegister(verifier)
 
@@ -342,6 +380,10 @@ func server(
 			logger.Info("background verifier is disabled")
 		}
 	} else {
+		if conf.StorageUsage.Enabled {
+			logger.Warn("Disabled storage usage aware placement as it is only implemented with repository-specific primaries.")
+		}
+
 		if conf.Failover.Enabled {
 			logger.WithField("election_strategy", conf.Failover.ElectionStrategy).Warn(
 				"Deprecated election stategy in use, migrate to repository specific primary nodes following https://docs.gitlab.com/ee/administration/gitaly/praefect.html#migrate-to-repository-specific-primary-gitaly-nodes. The other election strategies are scheduled for removal in GitLab 14.0.")
@@ -374,6 +416,7 @@ func server(
 			transactionManager,
 			conf,
 			protoregistry.GitalyProtoPreregistered,
+			coordinatorOpts...,
 		)
 
 		repl = praefect.NewReplMgr(
@@ -402,6 +445,8 @@ func server(
 			Registry:        protoregistry.GitalyProtoPreregistered,
 			Conns:           nodeSet.Connections(),
 			PrimaryGetter:   primaryGetter,
+			StorageDrainer:  storageDrainer,
+			StorageUsage:    storageUsage,
 			Checks:          service.ReadinessChecks(),
 		}, defaultServerOptions...)
 	)
@@ -505,6 +550,31 @@ func server(
 		}
 	}
 
+	if interval := conf.Rebalancing.SchedulingInterval.Duration(); interval > 0 {
+		if conf.MemoryQueueEnabled {
+			logger.Warn("Disabled rebalancing as it is only implemented using SQL queue and in-memory queue is configured.")
+		} else {
+			if conf.Reconciliation.SchedulingInterval.Duration() <= 0 {
+				logger.Warn("Rebalancing relies on automatic reconciliation to replicate moved repositories, but it is disabled.")
+			}
+
+			r := rebalancer.NewRebalancer(
+				logger,
+				db,
+				healthChecker,
+				conf.StorageNames(),
+				rebalancer.NewDiskStatistics(nodeSet.Connections()),
+				conf.Rebalancing.MaxConcurrentMoves,
+			)
+			promreg.MustRegister(r)
+			go func() {
+				if err := r.Run(ctx, helper.NewTimerTicker(interval)); err != nil {
+					logger.WithError(err).Error("rebalancer finished execution")
+				}
+			}()
+		}
+	}
+
 	if interval := conf.RepositoriesCleanup.RunInterval.Duration(); interval > 0 {
 		if db != nil {
 			go func() {
@@ -0,0 +1,138 @@
+package praefect
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+)
+
+const (
+	consistencyReportCmdName = "consistency-report"
+	paramLimit               = "limit"
+)
+
+func newConsistencyReportCommand() *cli.Command {
+	return &cli.Command{
+		Name:  consistencyReportCmdName,
+		Usage: "show replicas found inconsistent by checksum verification",
+		Description: `Show the decisions the background verifier made about replicas whose checksums differ from the
+checksums of the other replicas on the same generation. Checksum verification is enabled with the
+background_verification.verify_checksums configuration option.
+
+Returns a table with the following columns, newest decision first:
+
+- TIME: Time the decision was made.
+- VIRTUAL_STORAGE: Name of the virtual storage of the repository.
+- RELATIVE_PATH: Relative path of the repository.
+- STORAGE: Name of the physical storage of the inconsistent replica.
+- GENERATION: Generation the replicas were on when their checksums were compared.
+- CHECKSUM: Checksum of the inconsistent replica.
+- MAJORITY_CHECKSUM: Checksum the majority of the replicas agreed on, if any.
+- DECISION: Either "repair" if the replica was scheduled to be repaired from a replica with the majority's
+  checksum, "no_majority" if the replicas did not agree on a checksum and the replica was left in place, or
+  "primary_deferred" if the primary replica differed from the majority and is repaired if it still differs on
+  the next verification.
+- SOURCE_STORAGE: Name of the physical storage the replica is repaired from.
+
+Example: praefect --config praefect.config.toml consistency-report --virtual-storage default`,
+		HideHelpCommand: true,
+		Action:          consistencyReportAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  paramVirtualStorage,
+				Usage: "name of the virtual storage to show decisions for",
+			},
+			&cli.StringFlag{
+				Name:  paramRelativePath,
+				Usage: "relative path of the repository to show decisions for, requires --virtual-storage",
+			},
+			&cli.IntFlag{
+				Name:  paramLimit,
+				Usage: "maximum number of decisions to show",
+				Value: 100,
+			},
+		},
+		Before: func(ctx *cli.Context) error {
+			if ctx.Args().Present() {
+				_ = cli.ShowSubcommandHelp(ctx)
+				return cli.Exit(unexpectedPositionalArgsError{Command: ctx.Command.Name}, 1)
+			}
+			return nil
+		},
+	}
+}
+
+func consistencyReportAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	virtualStorage := appCtx.String(paramVirtualStorage)
+	relativePath := appCtx.String(paramRelativePath)
+	if relativePath != "" && virtualStorage == "" {
+		return fmt.Errorf("--%s requires --%s", paramRelativePath, paramVirtualStorage)
+	}
+
+	limit := appCtx.Int(paramLimit)
+	if limit <= 0 {
+		return fmt.Errorf("--%s must be positive", paramLimit)
+	}
+
+	db, clean, err := openDB(conf.DB, appCtx.App.ErrWriter)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	entries, err := datastore.NewConsistencyAudit(db).List(appCtx.Context, virtualStorage, relativePath, limit)
+	if err != nil {
+		return fmt.Errorf("list decisions: %w", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(appCtx.App.Writer, "No inconsistent replicas found.")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(appCtx.App.Writer)
+	table.SetHeader([]string{
+		"TIME", "VIRTUAL_STORAGE", "RELATIVE_PATH", "STORAGE", "GENERATION",
+		"CHECKSUM", "MAJORITY_CHECKSUM", "DECISION", "SOURCE_STORAGE",
+	})
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAutoFormatHeaders(false)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetTablePadding("\t") // pad with tabs
+	table.SetNoWhiteSpace(true)
+
+	for _, entry := range entries {
+		table.Append([]string{
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			entry.VirtualStorage,
+			entry.RelativePath,
+			entry.Storage,
+			strconv.Itoa(entry.Generation),
+			entry.Checksum,
+			entry.MajorityChecksum,
+			string(entry.Decision),
+			entry.SourceStorage,
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
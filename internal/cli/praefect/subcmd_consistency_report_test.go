@@ -0,0 +1,107 @@
+package praefect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestConsistencyReportSubcommand(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+	cfg := config.Config{
+		ListenAddr:      "/dev/null",
+		VirtualStorages: []*config.VirtualStorage{{Name: "virtual-storage", Nodes: []*config.Node{{Storage: "storage-1", Address: "localhost"}}}},
+		DB:              testdb.GetConfig(t, db.Name),
+	}
+	confPath := writeConfigToFile(t, cfg)
+
+	for _, tc := range []struct {
+		desc             string
+		args             []string
+		entries          []datastore.ConsistencyAuditEntry
+		expectedErr      error
+		expectedContains []string
+		expectedMissing  []string
+	}{
+		{
+			desc:        "unexpected positional arguments",
+			args:        []string{"positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: consistencyReportCmdName}, 1),
+		},
+		{
+			desc:        "relative path without virtual storage",
+			args:        []string{"-relative-path=relative-path"},
+			expectedErr: errors.New("--relative-path requires --virtual-storage"),
+		},
+		{
+			desc:        "non-positive limit",
+			args:        []string{"-limit=0"},
+			expectedErr: errors.New("--limit must be positive"),
+		},
+		{
+			desc:             "no decisions",
+			expectedContains: []string{"No inconsistent replicas found."},
+		},
+		{
+			desc: "decisions",
+			args: []string{"-virtual-storage=virtual-storage", "-relative-path=relative-path-1"},
+			entries: []datastore.ConsistencyAuditEntry{
+				{
+					RepositoryID:     1,
+					VirtualStorage:   "virtual-storage",
+					RelativePath:     "relative-path-1",
+					Storage:          "storage-1",
+					Generation:       5,
+					Checksum:         "divergent-checksum",
+					MajorityChecksum: "majority-checksum",
+					SourceStorage:    "storage-2",
+					Decision:         datastore.ConsistencyDecisionRepair,
+				},
+				{
+					RepositoryID:   2,
+					VirtualStorage: "virtual-storage",
+					RelativePath:   "relative-path-2",
+					Storage:        "storage-1",
+					Generation:     3,
+					Checksum:       "other-checksum",
+					Decision:       datastore.ConsistencyDecisionNoMajority,
+				},
+			},
+			expectedContains: []string{
+				"TIME", "MAJORITY_CHECKSUM", "SOURCE_STORAGE",
+				"relative-path-1", "divergent-checksum", "majority-checksum", "repair", "storage-2",
+			},
+			expectedMissing: []string{"relative-path-2"},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			db.TruncateAll(t)
+			require.NoError(t, datastore.NewConsistencyAudit(db).Record(ctx, tc.entries))
+
+			stdout, _, err := runApp(append([]string{"-config", confPath, consistencyReportCmdName}, tc.args...))
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			for _, expected := range tc.expectedContains {
+				require.Contains(t, stdout, expected)
+			}
+
+			for _, missing := range tc.expectedMissing {
+				require.NotContains(t, stdout, missing)
+			}
+		})
+	}
+}
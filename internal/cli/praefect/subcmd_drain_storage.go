@@ -0,0 +1,117 @@
+package praefect
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+const (
+	drainStorageCmdName = "drain-storage"
+	maintenanceCmdAlias = "maintenance"
+	paramUndrain        = "undrain"
+)
+
+func newDrainStorageCommand() *cli.Command {
+	return &cli.Command{
+		Name:    drainStorageCmdName,
+		Aliases: []string{maintenanceCmdAlias},
+		Usage:   "drain a physical storage for maintenance or undrain it",
+		Description: `Drain a physical storage for maintenance, for example before upgrading the operating system of the
+Gitaly node, or undrain the physical storage once the maintenance is done.
+
+Praefect stops routing new requests to a draining physical storage. Requests already in progress are allowed to
+finish. The repositories the physical storage is the primary of get new primaries right away. A repository keeps the
+draining physical storage as its primary if it has no other up to date replica. Writes to these repositories fail
+until the physical storage is restored. Writes to the other repositories are replicated to the draining physical
+storage once it's restored.
+
+A restored physical storage serves requests for a repository again once it has caught up with the repository's
+replication.
+
+Draining requires the per_repository election strategy.
+
+Draining only stops routing requests to the physical storage. To move the replicas off a physical storage
+permanently, use the "rebalance drain" subcommand instead.
+
+The command is also available as "maintenance".
+
+Example: praefect --config praefect.config.toml drain-storage --virtual-storage default --storage gitaly-1
+
+Example: praefect --config praefect.config.toml drain-storage --virtual-storage default --storage gitaly-1 --undrain`,
+		HideHelpCommand: true,
+		Action:          drainStorageAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     paramVirtualStorage,
+				Usage:    "name of the virtual storage the physical storage belongs to",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     paramStorage,
+				Usage:    "name of the physical storage to drain",
+				Required: true,
+			},
+			&cli.BoolFlag{
+				Name:  paramUndrain,
+				Usage: "undrain the physical storage and restore it",
+			},
+		},
+		Before: func(ctx *cli.Context) error {
+			if ctx.Args().Present() {
+				_ = cli.ShowSubcommandHelp(ctx)
+				return cli.Exit(unexpectedPositionalArgsError{Command: ctx.Command.Name}, 1)
+			}
+			return nil
+		},
+	}
+}
+
+func drainStorageAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	virtualStorage := appCtx.String(paramVirtualStorage)
+	storage := appCtx.String(paramStorage)
+	undrain := appCtx.Bool(paramUndrain)
+
+	nodeAddr, err := getNodeAddress(conf)
+	if err != nil {
+		return err
+	}
+
+	ctx := appCtx.Context
+	conn, err := subCmdDial(ctx, nodeAddr, conf.Auth.Token, defaultDialTimeout)
+	if err != nil {
+		return fmt.Errorf("error dialing: %w", err)
+	}
+	defer conn.Close()
+
+	client := gitalypb.NewPraefectInfoServiceClient(conn)
+	resp, err := client.SetStorageDraining(ctx, &gitalypb.SetStorageDrainingRequest{
+		VirtualStorage: virtualStorage,
+		Storage:        storage,
+		Draining:       !undrain,
+	})
+	if err != nil {
+		return err
+	}
+
+	if undrain {
+		fmt.Fprintf(appCtx.App.Writer, "restored storage %q\n", storage)
+		return nil
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "draining storage %q, elected new primaries for %d repositories\n", storage, resp.GetReelectedPrimaries())
+	if remaining := resp.GetRemainingPrimaries(); remaining > 0 {
+		fmt.Fprintf(appCtx.App.Writer, "%d repositories have no other up to date replica and can't be written to until the storage is restored\n", remaining)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package praefect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+type mockStorageDrainer struct {
+	drainStorage   func(ctx context.Context, virtualStorage, storage string) (int64, int64, error)
+	undrainStorage func(ctx context.Context, virtualStorage, storage string) error
+}
+
+func (m mockStorageDrainer) DrainStorage(ctx context.Context, virtualStorage, storage string) (int64, int64, error) {
+	return m.drainStorage(ctx, virtualStorage, storage)
+}
+
+func (m mockStorageDrainer) UndrainStorage(ctx context.Context, virtualStorage, storage string) error {
+	return m.undrainStorage(ctx, virtualStorage, storage)
+}
+
+func TestDrainStorageSubcommand(t *testing.T) {
+	t.Parallel()
+
+	drainer := mockStorageDrainer{
+		drainStorage: func(_ context.Context, virtualStorage, storage string) (int64, int64, error) {
+			if storage == "failing" {
+				return 0, 0, errors.New("database failure")
+			}
+
+			require.Equal(t, "virtual-storage", virtualStorage)
+			if storage == "gitaly-2" {
+				return 3, 2, nil
+			}
+
+			return 5, 0, nil
+		},
+		undrainStorage: func(_ context.Context, virtualStorage, storage string) error {
+			require.Equal(t, "virtual-storage", virtualStorage)
+			require.Equal(t, "gitaly-1", storage)
+			return nil
+		},
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		command        string
+		args           []string
+		storageDrainer info.StorageDrainer
+		expectedErr    error
+		expectedStdout string
+	}{
+		{
+			desc:        "unexpected positional arguments",
+			args:        []string{"-virtual-storage=virtual-storage", "-storage=gitaly-1", "positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: "drain-storage"}, 1),
+		},
+		{
+			desc:        "missing virtual storage",
+			args:        []string{"-storage=gitaly-1"},
+			expectedErr: errors.New(`Required flag "virtual-storage" not set`),
+		},
+		{
+			desc:        "missing storage",
+			args:        []string{"-virtual-storage=virtual-storage"},
+			expectedErr: errors.New(`Required flag "storage" not set`),
+		},
+		{
+			desc:        "unknown virtual storage",
+			args:        []string{"-virtual-storage=unknown", "-storage=gitaly-1"},
+			expectedErr: structerr.NewInvalidArgument(`unknown virtual storage: "unknown"`),
+		},
+		{
+			desc:        "unknown storage",
+			args:        []string{"-virtual-storage=virtual-storage", "-storage=unknown"},
+			expectedErr: structerr.NewInvalidArgument(`unknown storage: "unknown"`),
+		},
+		{
+			desc:        "draining unsupported",
+			args:        []string{"-virtual-storage=virtual-storage", "-storage=gitaly-1"},
+			expectedErr: structerr.NewFailedPrecondition("draining storages requires the per_repository election strategy"),
+		},
+		{
+			desc:           "drain fails",
+			args:           []string{"-virtual-storage=virtual-storage", "-storage=failing"},
+			storageDrainer: drainer,
+			expectedErr:    structerr.NewInternal("drain storage: database failure"),
+		},
+		{
+			desc:           "drained",
+			args:           []string{"-virtual-storage=virtual-storage", "-storage=gitaly-1"},
+			storageDrainer: drainer,
+			expectedStdout: "draining storage \"gitaly-1\", elected new primaries for 5 repositories\n",
+		},
+		{
+			desc:           "drained with remaining primaries",
+			args:           []string{"-virtual-storage=virtual-storage", "-storage=gitaly-2"},
+			storageDrainer: drainer,
+			expectedStdout: "draining storage \"gitaly-2\", elected new primaries for 3 repositories\n" +
+				"2 repositories have no other up to date replica and can't be written to until the storage is restored\n",
+		},
+		{
+			desc:           "drained via maintenance alias",
+			command:        maintenanceCmdAlias,
+			args:           []string{"-virtual-storage=virtual-storage", "-storage=gitaly-1"},
+			storageDrainer: drainer,
+			expectedStdout: "draining storage \"gitaly-1\", elected new primaries for 5 repositories\n",
+		},
+		{
+			desc:           "undrained",
+			args:           []string{"-virtual-storage=virtual-storage", "-storage=gitaly-1", "-undrain"},
+			storageDrainer: drainer,
+			expectedStdout: "restored storage \"gitaly-1\"\n",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			conf := config.Config{
+				VirtualStorages: []*config.VirtualStorage{
+					{
+						Name: "virtual-storage",
+						Nodes: []*config.Node{
+							{Storage: "gitaly-1", Address: "address-1"},
+							{Storage: "gitaly-2", Address: "address-2"},
+							{Storage: "failing", Address: "address-3"},
+						},
+					},
+				},
+			}
+
+			ln, clean := listenAndServe(t, []svcRegistrar{registerPraefectInfoServer(
+				info.NewServer(conf, nil, nil, nil, nil, tc.storageDrainer, nil),
+			)})
+			defer clean()
+
+			conf.SocketPath = ln.Addr().String()
+
+			command := tc.command
+			if command == "" {
+				command = drainStorageCmdName
+			}
+
+			stdout, stderr, err := runApp(append([]string{"-config", writeConfigToFile(t, conf), command}, tc.args...))
+			assert.Empty(t, stderr)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			if tc.expectedStdout != "" {
+				require.Equal(t, tc.expectedStdout, stdout)
+			}
+		})
+	}
+}
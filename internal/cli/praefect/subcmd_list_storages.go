@@ -1,11 +1,14 @@
 package praefect
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
 
 func newListStoragesCommand() *cli.Command {
@@ -20,6 +23,17 @@ Returns a table with the following columns:
 - NODE: Name the physical storage connected to the virtual storage.
 - ADDRESS: Address of the Gitaly node that manages the physial storage for the virtual storage.
 
+If storage usage aware placement is enabled, Praefect is asked for the disk usage of the physical storages and the
+table contains the following additional columns:
+
+- USED: Disk space in use on the physical storage.
+- AVAILABLE: Disk space available on the physical storage.
+- FILL: Percentage of the physical storage's disk space in use.
+- NEW_REPOSITORIES: Whether new repositories are created on the physical storage. New repositories are refused
+  once the physical storage has reached the fill threshold.
+
+Storages whose disk usage is not known yet are listed with unknown usage.
+
 If the virtual-storage flag:
 
 - Is specified, lists only physical storages for the specified virtual storage.
@@ -52,8 +66,36 @@ func listStoragesAction(ctx *cli.Context) error {
 		return err
 	}
 
+	virtualStorages := conf.VirtualStorages
+	if pickedVirtualStorage := ctx.String(paramVirtualStorage); pickedVirtualStorage != "" {
+		virtualStorages = nil
+		for _, virtualStorage := range conf.VirtualStorages {
+			if virtualStorage.Name == pickedVirtualStorage {
+				virtualStorages = append(virtualStorages, virtualStorage)
+			}
+		}
+
+		if len(virtualStorages) == 0 {
+			fmt.Fprintf(ctx.App.Writer, "No virtual storages named %s.\n", pickedVirtualStorage)
+
+			return nil
+		}
+	}
+
+	header := []string{"VIRTUAL_STORAGE", "NODE", "ADDRESS"}
+
+	var usage map[string]map[string]*gitalypb.GetStorageUsageResponse_StorageUsage
+	if conf.StorageUsage.Enabled {
+		header = append(header, "USED", "AVAILABLE", "FILL", "NEW_REPOSITORIES")
+
+		usage, err = getStorageUsage(ctx.Context, conf, ctx.String(paramVirtualStorage))
+		if err != nil {
+			return fmt.Errorf("get storage usage: %w", err)
+		}
+	}
+
 	table := tablewriter.NewWriter(ctx.App.Writer)
-	table.SetHeader([]string{"VIRTUAL_STORAGE", "NODE", "ADDRESS"})
+	table.SetHeader(header)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
 	table.SetAutoFormatHeaders(false)
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
@@ -65,33 +107,92 @@ func listStoragesAction(ctx *cli.Context) error {
 	table.SetTablePadding("\t") // pad with tabs
 	table.SetNoWhiteSpace(true)
 
-	if pickedVirtualStorage := ctx.String(paramVirtualStorage); pickedVirtualStorage != "" {
-		for _, virtualStorage := range conf.VirtualStorages {
-			if virtualStorage.Name != pickedVirtualStorage {
-				continue
+	for _, virtualStorage := range virtualStorages {
+		for _, node := range virtualStorage.Nodes {
+			row := []string{virtualStorage.Name, node.Storage, node.Address}
+			if conf.StorageUsage.Enabled {
+				row = append(row, formatStorageUsage(usage[virtualStorage.Name][node.Storage])...)
 			}
 
-			for _, node := range virtualStorage.Nodes {
-				table.Append([]string{virtualStorage.Name, node.Storage, node.Address})
-			}
+			table.Append(row)
+		}
+	}
 
-			table.Render()
+	table.Render()
 
-			return nil
-		}
+	return nil
+}
 
-		fmt.Fprintf(ctx.App.Writer, "No virtual storages named %s.\n", pickedVirtualStorage)
+// getStorageUsage asks Praefect for the disk usage of the physical storages. The usage is returned by virtual
+// storage and storage.
+func getStorageUsage(ctx context.Context, conf config.Config, virtualStorage string) (map[string]map[string]*gitalypb.GetStorageUsageResponse_StorageUsage, error) {
+	nodeAddr, err := getNodeAddress(conf)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil
+	conn, err := subCmdDial(ctx, nodeAddr, conf.Auth.Token, defaultDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing: %w", err)
 	}
+	defer conn.Close()
 
-	for _, virtualStorage := range conf.VirtualStorages {
-		for _, node := range virtualStorage.Nodes {
-			table.Append([]string{virtualStorage.Name, node.Storage, node.Address})
+	resp, err := gitalypb.NewPraefectInfoServiceClient(conn).GetStorageUsage(ctx, &gitalypb.GetStorageUsageRequest{
+		VirtualStorage: virtualStorage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := map[string]map[string]*gitalypb.GetStorageUsageResponse_StorageUsage{}
+	for _, storage := range resp.GetStorages() {
+		if usage[storage.GetVirtualStorage()] == nil {
+			usage[storage.GetVirtualStorage()] = map[string]*gitalypb.GetStorageUsageResponse_StorageUsage{}
 		}
+
+		usage[storage.GetVirtualStorage()][storage.GetStorage()] = storage
 	}
 
-	table.Render()
+	return usage, nil
+}
 
-	return nil
+// formatStorageUsage formats the storage's usage into the USED, AVAILABLE, FILL and NEW_REPOSITORIES columns.
+func formatStorageUsage(usage *gitalypb.GetStorageUsageResponse_StorageUsage) []string {
+	if usage == nil {
+		return []string{"unknown", "unknown", "unknown", "accepted"}
+	}
+
+	var fill float64
+	if total := usage.GetUsedBytes() + usage.GetAvailableBytes(); total > 0 {
+		fill = float64(usage.GetUsedBytes()) / float64(total) * 100
+	}
+
+	newRepositories := "accepted"
+	if usage.GetFull() {
+		newRepositories = "refused"
+	}
+
+	return []string{
+		formatBytes(usage.GetUsedBytes()),
+		formatBytes(usage.GetAvailableBytes()),
+		fmt.Sprintf("%.1f%%", fill),
+		newRepositories,
+	}
+}
+
+// formatBytes formats the number of bytes with a binary unit.
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	value := float64(bytes)
+	exponent := 0
+	for value >= unit && exponent < 5 {
+		value /= unit
+		exponent++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", value, "KMGTP"[exponent-1])
 }
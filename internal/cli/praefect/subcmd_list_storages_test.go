@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/storageusage"
 )
 
 func TestListStoragesSubcommand(t *testing.T) {
@@ -182,3 +184,84 @@ func TestListStoragesSubcommand(t *testing.T) {
 		})
 	})
 }
+
+type staticStorageUsage map[string]map[string]storageusage.Usage
+
+func (s staticStorageUsage) StorageUsage(virtualStorage string) map[string]storageusage.Usage {
+	return s[virtualStorage]
+}
+
+func TestListStoragesSubcommand_storageUsage(t *testing.T) {
+	t.Parallel()
+
+	conf := config.Config{
+		StorageUsage: config.StorageUsage{
+			Enabled:       true,
+			PollInterval:  config.DefaultStorageUsageConfig().PollInterval,
+			FillThreshold: 90,
+		},
+		VirtualStorages: []*config.VirtualStorage{
+			{
+				Name: "vs-1",
+				Nodes: []*config.Node{
+					{Storage: "storage-1", Address: "tcp://1.2.3.4"},
+					{Storage: "storage-2", Address: "tcp://4.3.2.1"},
+					{Storage: "storage-3", Address: "tcp://1.1.3.4"},
+				},
+			},
+		},
+	}
+
+	ln, clean := listenAndServe(t, []svcRegistrar{registerPraefectInfoServer(
+		info.NewServer(conf, nil, nil, nil, nil, nil, staticStorageUsage{
+			"vs-1": {
+				"storage-1": {UsedBytes: 1 << 30, AvailableBytes: 3 << 30},
+				"storage-2": {UsedBytes: 95 << 20, AvailableBytes: 5 << 20, Full: true},
+			},
+		}),
+	)})
+	defer clean()
+
+	conf.SocketPath = ln.Addr().String()
+
+	var expectedOutput bytes.Buffer
+	table := tablewriter.NewWriter(&expectedOutput)
+	table.SetHeader([]string{"VIRTUAL_STORAGE", "NODE", "ADDRESS", "USED", "AVAILABLE", "FILL", "NEW_REPOSITORIES"})
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAutoFormatHeaders(false)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetTablePadding("\t") // pad with tabs
+	table.SetNoWhiteSpace(true)
+	table.Append([]string{"vs-1", "storage-1", "tcp://1.2.3.4", "1.0 GiB", "3.0 GiB", "25.0%", "accepted"})
+	table.Append([]string{"vs-1", "storage-2", "tcp://4.3.2.1", "95.0 MiB", "5.0 MiB", "95.0%", "refused"})
+	table.Append([]string{"vs-1", "storage-3", "tcp://1.1.3.4", "unknown", "unknown", "unknown", "accepted"})
+	table.Render()
+
+	stdout, stderr, err := runApp([]string{"-config", writeConfigToFile(t, conf), "list-storages"})
+	assert.Empty(t, stderr)
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput.String(), stdout)
+}
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	for bytes, expected := range map[int64]string{
+		0:                 "0 B",
+		1023:              "1023 B",
+		1024:              "1.0 KiB",
+		1536:              "1.5 KiB",
+		10 << 30:          "10.0 GiB",
+		3 << 40:           "3.0 TiB",
+		1 << 50:           "1.0 PiB",
+		1 << 60:           "1024.0 PiB",
+		(1 << 20) - 1<<10: "1023.0 KiB",
+	} {
+		require.Equal(t, expected, formatBytes(bytes))
+	}
+}
@@ -0,0 +1,392 @@
+package praefect
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/rebalancer"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	rebalanceCmdName = "rebalance"
+	paramStorage     = "storage"
+	paramBy          = "by"
+)
+
+func newRebalanceCommand() *cli.Command {
+	return &cli.Command{
+		Name:  rebalanceCmdName,
+		Usage: "manage rebalancing of replicas",
+		Description: `Manage the rebalancing of repository replicas between the physical storages of a virtual storage.
+
+Each virtual storage can have a single rebalancing plan. Praefect moves replicas in the background according to the
+plan. A replica is moved by first replicating the repository to the target physical storage. The replica on the source
+physical storage is only removed once the target physical storage is up to date. The number of concurrent moves is
+limited by the rebalancing.max_concurrent_moves configuration option.
+
+Provides the following subcommands:
+
+- drain
+- equalize
+- cancel
+- status`,
+		HideHelpCommand: true,
+		Subcommands: []*cli.Command{
+			newRebalanceDrainCommand(),
+			newRebalanceEqualizeCommand(),
+			newRebalanceCancelCommand(),
+			newRebalanceStatusCommand(),
+		},
+	}
+}
+
+func rebalanceBefore(ctx *cli.Context) error {
+	if ctx.Args().Present() {
+		_ = cli.ShowSubcommandHelp(ctx)
+		return cli.Exit(unexpectedPositionalArgsError{Command: ctx.Command.Name}, 1)
+	}
+	return nil
+}
+
+func newRebalanceDrainCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "drain",
+		Usage: "move all replicas off physical storages",
+		Description: `Move all replicas off the specified physical storages.
+
+Replicas are moved to the healthy physical storages hosting the fewest repositories. If a repository is already hosted
+on every other physical storage, the replica on the drained physical storage is removed without a replacement. The
+drained physical storages can be removed from the configuration once the status subcommand reports the drain as
+completed.
+
+Replaces the current rebalancing plan of the virtual storage.
+
+Example: praefect --config praefect.config.toml rebalance drain --virtual-storage default --storage gitaly-1`,
+		HideHelpCommand: true,
+		Action:          rebalanceDrainAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     paramVirtualStorage,
+				Usage:    "name of the virtual storage to rebalance",
+				Required: true,
+			},
+			&cli.StringSliceFlag{
+				Name:     paramStorage,
+				Usage:    "name of the physical storage to drain, can be specified multiple times",
+				Required: true,
+			},
+		},
+		Before: rebalanceBefore,
+	}
+}
+
+func rebalanceDrainAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	virtualStorage := appCtx.String(paramVirtualStorage)
+	storages, err := configuredStorages(conf, virtualStorage)
+	if err != nil {
+		return err
+	}
+
+	drainedStorages := appCtx.StringSlice(paramStorage)
+	for _, storage := range drainedStorages {
+		if !slices.Contains(storages, storage) {
+			return fmt.Errorf("physical storage %q is not configured in virtual storage %q", storage, virtualStorage)
+		}
+	}
+
+	if len(uniqueStrings(drainedStorages)) >= len(storages) {
+		return errors.New("can't drain every physical storage of the virtual storage")
+	}
+
+	return setRebalancingPlan(appCtx, conf, rebalancer.Plan{
+		VirtualStorage:  virtualStorage,
+		Strategy:        rebalancer.StrategyDrain,
+		DrainedStorages: uniqueStrings(drainedStorages),
+	})
+}
+
+func newRebalanceEqualizeCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "equalize",
+		Usage: "spread replicas evenly across physical storages",
+		Description: `Move replicas until they are spread evenly across the physical storages.
+
+The --by flag selects what is equalized:
+
+- repositories: the number of repositories hosted on each physical storage. This is the default.
+- bytes: the disk space used by each physical storage as reported by the Gitaly nodes.
+
+Only repositories with a replication factor set are moved. Repositories without a replication factor are already
+hosted on every physical storage. Equalization continues until the plan is canceled, so physical storages added to the
+virtual storage later are filled as well.
+
+Replaces the current rebalancing plan of the virtual storage.
+
+Example: praefect --config praefect.config.toml rebalance equalize --virtual-storage default --by bytes`,
+		HideHelpCommand: true,
+		Action:          rebalanceEqualizeAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     paramVirtualStorage,
+				Usage:    "name of the virtual storage to rebalance",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  paramBy,
+				Usage: "what to equalize, either repositories or bytes",
+				Value: "repositories",
+			},
+		},
+		Before: rebalanceBefore,
+	}
+}
+
+func rebalanceEqualizeAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	virtualStorage := appCtx.String(paramVirtualStorage)
+	if _, err := configuredStorages(conf, virtualStorage); err != nil {
+		return err
+	}
+
+	var strategy rebalancer.Strategy
+	switch by := appCtx.String(paramBy); by {
+	case "repositories":
+		strategy = rebalancer.StrategyEqualizeRepositories
+	case "bytes":
+		strategy = rebalancer.StrategyEqualizeBytes
+	default:
+		return fmt.Errorf("unsupported value for --%s: %q", paramBy, by)
+	}
+
+	return setRebalancingPlan(appCtx, conf, rebalancer.Plan{
+		VirtualStorage: virtualStorage,
+		Strategy:       strategy,
+	})
+}
+
+func setRebalancingPlan(appCtx *cli.Context, conf config.Config, plan rebalancer.Plan) error {
+	db, clean, err := openDB(conf.DB, appCtx.App.ErrWriter)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	if err := rebalancer.SetPlan(appCtx.Context, db, plan); err != nil {
+		return fmt.Errorf("set plan: %w", err)
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "rebalancing plan of virtual storage %q set to %s\n", plan.VirtualStorage, describePlan(plan))
+
+	return nil
+}
+
+func newRebalanceCancelCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "cancel",
+		Usage: "cancel the rebalancing of a virtual storage",
+		Description: `Remove the rebalancing plan of a virtual storage.
+
+No new moves are started. Moves that are already in progress are completed.
+
+Example: praefect --config praefect.config.toml rebalance cancel --virtual-storage default`,
+		HideHelpCommand: true,
+		Action:          rebalanceCancelAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     paramVirtualStorage,
+				Usage:    "name of the virtual storage to cancel the rebalancing of",
+				Required: true,
+			},
+		},
+		Before: rebalanceBefore,
+	}
+}
+
+func rebalanceCancelAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	virtualStorage := appCtx.String(paramVirtualStorage)
+
+	db, clean, err := openDB(conf.DB, appCtx.App.ErrWriter)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	if err := rebalancer.CancelPlan(appCtx.Context, db, virtualStorage); err != nil {
+		if errors.Is(err, rebalancer.ErrPlanNotFound) {
+			return fmt.Errorf("virtual storage %q has no rebalancing plan", virtualStorage)
+		}
+
+		return fmt.Errorf("cancel plan: %w", err)
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "rebalancing plan of virtual storage %q canceled\n", virtualStorage)
+
+	return nil
+}
+
+func newRebalanceStatusCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "status",
+		Usage: "show the rebalancing progress",
+		Description: `Show the rebalancing plan and progress of virtual storages.
+
+Prints the plan of the virtual storage followed by a table with the following columns:
+
+- STORAGE: Name of the physical storage.
+- REPOSITORIES: Number of repositories assigned to the physical storage, including replicas being moved off it.
+- MOVING_IN: Number of replicas being moved onto the physical storage.
+- MOVING_OUT: Number of replicas being moved off the physical storage.
+
+If the virtual-storage flag:
+
+- Is specified, shows only the progress of the specified virtual storage.
+- Is not specified, shows the progress of all virtual storages.
+
+Example: praefect --config praefect.config.toml rebalance status --virtual-storage default`,
+		HideHelpCommand: true,
+		Action:          rebalanceStatusAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  paramVirtualStorage,
+				Usage: "name of the virtual storage to show the progress of",
+			},
+		},
+		Before: rebalanceBefore,
+	}
+}
+
+func rebalanceStatusAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	virtualStorages := conf.VirtualStorageNames()
+	if virtualStorage := appCtx.String(paramVirtualStorage); virtualStorage != "" {
+		if _, err := configuredStorages(conf, virtualStorage); err != nil {
+			return err
+		}
+
+		virtualStorages = []string{virtualStorage}
+	}
+
+	db, clean, err := openDB(conf.DB, appCtx.App.ErrWriter)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	storages := conf.StorageNames()
+	for i, virtualStorage := range virtualStorages {
+		progress, err := rebalancer.GetProgress(appCtx.Context, db, virtualStorage, storages[virtualStorage])
+		if err != nil {
+			return fmt.Errorf("get progress: %w", err)
+		}
+
+		if i > 0 {
+			fmt.Fprintln(appCtx.App.Writer)
+		}
+
+		switch {
+		case progress.Plan == nil:
+			fmt.Fprintf(appCtx.App.Writer, "Virtual storage %q has no rebalancing plan.\n", virtualStorage)
+		case progress.Completed():
+			fmt.Fprintf(appCtx.App.Writer, "Virtual storage %q: %s, completed.\n", virtualStorage, describePlan(*progress.Plan))
+		default:
+			fmt.Fprintf(appCtx.App.Writer, "Virtual storage %q: %s, in progress since %s.\n",
+				virtualStorage, describePlan(*progress.Plan), progress.Plan.CreatedAt.UTC().Format("2006-01-02 15:04:05 MST"))
+		}
+
+		table := tablewriter.NewWriter(appCtx.App.Writer)
+		table.SetHeader([]string{"STORAGE", "REPOSITORIES", "MOVING_IN", "MOVING_OUT"})
+		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+		table.SetAutoFormatHeaders(false)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.SetCenterSeparator("")
+		table.SetColumnSeparator("")
+		table.SetRowSeparator("")
+		table.SetHeaderLine(false)
+		table.SetBorder(false)
+		table.SetTablePadding("\t") // pad with tabs
+		table.SetNoWhiteSpace(true)
+
+		for _, storage := range progress.Storages {
+			table.Append([]string{
+				storage.Storage,
+				strconv.Itoa(storage.Repositories),
+				strconv.Itoa(storage.MovingIn),
+				strconv.Itoa(storage.MovingOut),
+			})
+		}
+
+		table.Render()
+	}
+
+	return nil
+}
+
+func describePlan(plan rebalancer.Plan) string {
+	switch plan.Strategy {
+	case rebalancer.StrategyDrain:
+		return "drain " + strings.Join(plan.DrainedStorages, ", ")
+	case rebalancer.StrategyEqualizeRepositories:
+		return "equalize repositories"
+	case rebalancer.StrategyEqualizeBytes:
+		return "equalize bytes"
+	default:
+		return string(plan.Strategy)
+	}
+}
+
+func configuredStorages(conf config.Config, virtualStorage string) ([]string, error) {
+	storages, ok := conf.StorageNames()[virtualStorage]
+	if !ok {
+		return nil, fmt.Errorf("virtual storage %q is not configured", virtualStorage)
+	}
+
+	return storages, nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		unique = append(unique, value)
+	}
+
+	return unique
+}
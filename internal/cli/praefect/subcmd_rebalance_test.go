@@ -0,0 +1,117 @@
+package praefect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestRebalanceSubcommand(t *testing.T) {
+	t.Parallel()
+	db := testdb.New(t)
+	dbCfg := testdb.GetConfig(t, db.Name)
+	cfg := config.Config{
+		ListenAddr: "/dev/null",
+		VirtualStorages: []*config.VirtualStorage{
+			{
+				Name: "virtual-storage",
+				Nodes: []*config.Node{
+					{Storage: "storage-1", Address: "localhost"},
+					{Storage: "storage-2", Address: "localhost"},
+				},
+			},
+		},
+		DB: dbCfg,
+	}
+	confPath := writeConfigToFile(t, cfg)
+
+	for _, tc := range []struct {
+		desc           string
+		args           []string
+		expectedErr    error
+		expectedOutput string
+	}{
+		{
+			desc:        "unexpected positional arguments",
+			args:        []string{"drain", "-virtual-storage=virtual-storage", "-storage=storage-1", "positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: "drain"}, 1),
+		},
+		{
+			desc:        "missing storage",
+			args:        []string{"drain", "-virtual-storage=virtual-storage"},
+			expectedErr: errors.New(`Required flag "storage" not set`),
+		},
+		{
+			desc:        "unknown virtual storage",
+			args:        []string{"drain", "-virtual-storage=non-existent", "-storage=storage-1"},
+			expectedErr: errors.New(`virtual storage "non-existent" is not configured`),
+		},
+		{
+			desc:        "unknown storage",
+			args:        []string{"drain", "-virtual-storage=virtual-storage", "-storage=non-existent"},
+			expectedErr: errors.New(`physical storage "non-existent" is not configured in virtual storage "virtual-storage"`),
+		},
+		{
+			desc:        "draining every storage",
+			args:        []string{"drain", "-virtual-storage=virtual-storage", "-storage=storage-1", "-storage=storage-2"},
+			expectedErr: errors.New("can't drain every physical storage of the virtual storage"),
+		},
+		{
+			desc:        "unsupported equalization",
+			args:        []string{"equalize", "-virtual-storage=virtual-storage", "-by=replicas"},
+			expectedErr: errors.New(`unsupported value for --by: "replicas"`),
+		},
+		{
+			desc:           "no plan",
+			args:           []string{"status"},
+			expectedOutput: `Virtual storage "virtual-storage" has no rebalancing plan.`,
+		},
+		{
+			desc:        "cancel without plan",
+			args:        []string{"cancel", "-virtual-storage=virtual-storage"},
+			expectedErr: errors.New(`virtual storage "virtual-storage" has no rebalancing plan`),
+		},
+		{
+			desc:           "equalize",
+			args:           []string{"equalize", "-virtual-storage=virtual-storage", "-by=bytes"},
+			expectedOutput: `rebalancing plan of virtual storage "virtual-storage" set to equalize bytes`,
+		},
+		{
+			desc:           "equalization in progress",
+			args:           []string{"status", "-virtual-storage=virtual-storage"},
+			expectedOutput: `Virtual storage "virtual-storage": equalize bytes, in progress since`,
+		},
+		{
+			desc:           "drain replaces the plan",
+			args:           []string{"drain", "-virtual-storage=virtual-storage", "-storage=storage-1", "-storage=storage-1"},
+			expectedOutput: `rebalancing plan of virtual storage "virtual-storage" set to drain storage-1`,
+		},
+		{
+			desc:           "drain completed",
+			args:           []string{"status", "-virtual-storage=virtual-storage"},
+			expectedOutput: `Virtual storage "virtual-storage": drain storage-1, completed.`,
+		},
+		{
+			desc:           "cancel",
+			args:           []string{"cancel", "-virtual-storage=virtual-storage"},
+			expectedOutput: `rebalancing plan of virtual storage "virtual-storage" canceled`,
+		},
+	} {
+		// The cases run in order as they build on each other's plans.
+		t.Run(tc.desc, func(t *testing.T) {
+			stdout, _, err := runApp(append([]string{"-config", confPath, rebalanceCmdName}, tc.args...))
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, stdout, tc.expectedOutput)
+		})
+	}
+}
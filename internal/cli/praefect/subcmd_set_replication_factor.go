@@ -31,6 +31,9 @@ When a new replication factor is specified, the subcommand:
   - More than the number of physical storages in the virtual storage.
   - Less than one.
 
+If the physical storages are configured with zones, storages are assigned from the zones with the fewest assignments
+and unassigned from the zones with the most assignments. This spreads the repository across as many zones as possible.
+
 The authoritative physical storage is never unassigned because it:
 
 - Accepts writes.
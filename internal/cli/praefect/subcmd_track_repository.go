@@ -197,6 +197,7 @@ func (req *trackRepositoryRequest) execRequest(ctx context.Context,
 		nil,
 		nil,
 		nil,
+		nil,
 		logger,
 	)
 	if err != nil {
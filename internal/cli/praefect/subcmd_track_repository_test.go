@@ -254,7 +254,7 @@ func TestTrackRepositorySubcommand(t *testing.T) {
 				assert.Empty(t, stderr)
 				require.NoError(t, err)
 
-				as := datastore.NewAssignmentStore(db, conf.StorageNames())
+				as := datastore.NewAssignmentStore(db, conf.StorageNames(), nil)
 
 				repositoryID, err := repoDS.GetRepositoryID(ctx, virtualStorageName, tc.relativePath)
 				require.NoError(t, err)
@@ -123,6 +123,12 @@ func init() {
 	prometheus.MustRegister(globalSpawnTokenManager)
 }
 
+// AddSpawnTokenWaitingTimeObserver registers a function with the spawn token manager shared by all commands that don't
+// use a dedicated one. See SpawnTokenManager.AddWaitingTimeObserver.
+func AddSpawnTokenWaitingTimeObserver(observer func(time.Duration)) {
+	globalSpawnTokenManager.AddWaitingTimeObserver(observer)
+}
+
 const (
 	// maxStderrBytes is at most how many bytes will be written to stderr
 	maxStderrBytes = 10000 // 10kb
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -40,6 +41,10 @@ type SpawnTokenManager struct {
 	spawnTokenWaitingLength   prometheus.Gauge
 	spawnWaitingTimeHistogram prometheus.Histogram
 	spawnForkingTimeHistogram prometheus.Histogram
+
+	// observersMutex guards waitingTimeObservers as observers may be added while tokens are handed out.
+	observersMutex       sync.RWMutex
+	waitingTimeObservers []func(time.Duration)
 }
 
 // Describe is used to describe Prometheus metrics.
@@ -101,6 +106,15 @@ func NewSpawnTokenManagerFromEnv() (*SpawnTokenManager, error) {
 	return NewSpawnTokenManager(spawnConfig), nil
 }
 
+// AddWaitingTimeObserver registers a function that is called with the time each caller of GetSpawnToken waited for a
+// spawn token, including callers which timed out. Observers may be added while the manager is in use.
+func (m *SpawnTokenManager) AddWaitingTimeObserver(observer func(time.Duration)) {
+	m.observersMutex.Lock()
+	defer m.observersMutex.Unlock()
+
+	m.waitingTimeObservers = append(m.waitingTimeObservers, observer)
+}
+
 // GetSpawnToken blocks until the caller either acquires a token or timeout. The caller is expected to call returned
 // function to put the token back to the queue.
 func (m *SpawnTokenManager) GetSpawnToken(ctx context.Context) (putToken func(), err error) {
@@ -143,6 +157,12 @@ func (m *SpawnTokenManager) recordQueuingTime(ctx context.Context, start time.Ti
 	delta := time.Since(start)
 	m.spawnWaitingTimeHistogram.Observe(delta.Seconds())
 
+	m.observersMutex.RLock()
+	for _, observer := range m.waitingTimeObservers {
+		observer(delta)
+	}
+	m.observersMutex.RUnlock()
+
 	if customFields := log.CustomFieldsFromContext(ctx); customFields != nil {
 		customFields.RecordSum("command.spawn_token_wait_ms", int(delta.Milliseconds()))
 		if len(msg) != 0 {
@@ -3,11 +3,14 @@ package command
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
@@ -223,3 +226,68 @@ gitaly_spawn_token_waiting_length 0
 		"gitaly_spawn_token_waiting_length",
 	))
 }
+
+func TestGetSpawnToken_WaitingTimeObserver(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	manager := NewSpawnTokenManager(SpawnConfig{
+		Timeout:     1 * time.Millisecond,
+		MaxParallel: 1,
+	})
+
+	var observed []time.Duration
+	manager.AddWaitingTimeObserver(func(wait time.Duration) {
+		observed = append(observed, wait)
+	})
+
+	putToken, err := manager.GetSpawnToken(ctx)
+	require.NoError(t, err)
+	require.Len(t, observed, 1)
+
+	// The second caller times out as the only token is taken.
+	_, err = manager.GetSpawnToken(ctx)
+	testhelper.RequireGrpcCode(t, err, codes.ResourceExhausted)
+	require.Len(t, observed, 2)
+	require.GreaterOrEqual(t, observed[1], time.Millisecond)
+
+	putToken()
+}
+
+func TestGetSpawnToken_WaitingTimeObserverAddedConcurrently(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	manager := NewSpawnTokenManager(SpawnConfig{
+		Timeout:     time.Minute,
+		MaxParallel: 10,
+	})
+
+	var observed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			manager.AddWaitingTimeObserver(func(time.Duration) {
+				observed.Add(1)
+			})
+		}()
+
+		go func() {
+			defer wg.Done()
+			putToken, err := manager.GetSpawnToken(ctx)
+			assert.NoError(t, err)
+			putToken()
+		}()
+	}
+	wg.Wait()
+
+	// All observers are registered now, so each of them observes the next caller.
+	before := observed.Load()
+	putToken, err := manager.GetSpawnToken(ctx)
+	require.NoError(t, err)
+	putToken()
+	require.Equal(t, before+10, observed.Load())
+}
@@ -0,0 +1,86 @@
+package codesearch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+)
+
+// backgroundUpdateTimeout is the time an update of the code search index in the background may take.
+const backgroundUpdateTimeout = 10 * time.Minute
+
+// BackgroundUpdater updates the code search indices of repositories in the background so that the callers
+// don't have to wait for the update, for example after a push. Updates are best-effort: errors are logged
+// but not returned. At most one update runs per repository at a time. Updates requested while one is running
+// are coalesced into a single update that runs once the current one has finished.
+type BackgroundUpdater struct {
+	mu sync.Mutex
+	// running contains the repositories whose index is being updated. The value is set if the update must
+	// be repeated once done because the repository has changed in the meantime.
+	running map[string]bool
+	wg      sync.WaitGroup
+}
+
+// NewBackgroundUpdater returns a new BackgroundUpdater.
+func NewBackgroundUpdater() *BackgroundUpdater {
+	return &BackgroundUpdater{
+		running: map[string]bool{},
+	}
+}
+
+// Update updates the code search index of the repository in the background in case the repository has an
+// index. The update is not canceled when the context is.
+func (u *BackgroundUpdater) Update(ctx context.Context, repo *localrepo.Repo) {
+	key := repo.GetStorageName() + ":" + repo.GetRelativePath()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if _, ok := u.running[key]; ok {
+		u.running[key] = true
+		return
+	}
+	u.running[key] = false
+
+	u.wg.Add(1)
+	go func() {
+		defer u.wg.Done()
+
+		ctx, cancel := context.WithTimeout(helper.SuppressCancellation(ctx), backgroundUpdateTimeout)
+		defer cancel()
+
+		for {
+			if _, err := UpdateIfExists(ctx, repo); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("updating code search index")
+			}
+
+			if !u.finish(key) {
+				return
+			}
+		}
+	}()
+}
+
+// finish marks the update of the repository as done unless it must be repeated. Returns whether the update
+// must be repeated.
+func (u *BackgroundUpdater) finish(key string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.running[key] {
+		u.running[key] = false
+		return true
+	}
+
+	delete(u.running, key)
+	return false
+}
+
+// Wait waits for the updates running in the background to finish.
+func (u *BackgroundUpdater) Wait() {
+	u.wg.Wait()
+}
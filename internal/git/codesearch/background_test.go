@@ -0,0 +1,63 @@
+package codesearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+)
+
+func TestBackgroundUpdater(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	createRepository := func(t *testing.T) (*localrepo.Repo, string) {
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+		return localrepo.NewTestRepo(t, cfg, repoProto), repoPath
+	}
+
+	t.Run("indexed repository", func(t *testing.T) {
+		repo, repoPath := createRepository(t)
+
+		_, err := Update(ctx, repo)
+		require.NoError(t, err)
+
+		commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "README.md", Mode: "100644", Content: "updated"},
+		))
+
+		// The update keeps on running when the caller's context is canceled.
+		updateCtx, cancel := context.WithCancel(ctx)
+		updater := NewBackgroundUpdater()
+		updater.Update(updateCtx, repo)
+		updater.Update(updateCtx, repo)
+		cancel()
+		updater.Wait()
+
+		indexedCommitID, err := loadIndexCommitID(repo)
+		require.NoError(t, err)
+		require.Equal(t, commitID.String(), indexedCommitID)
+	})
+
+	t.Run("repository without index", func(t *testing.T) {
+		repo, _ := createRepository(t)
+
+		updater := NewBackgroundUpdater()
+		updater.Update(ctx, repo)
+		updater.Wait()
+
+		_, err := loadIndexCommitID(repo)
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+}
@@ -0,0 +1,551 @@
+package codesearch
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+)
+
+// The index is stored as an inverted index so that searches only need to read the posting lists of
+// the trigrams they look for instead of decoding the whole index. All integers are stored in big-endian
+// byte order. The index consists of the following sections:
+//
+//   - The header, which is a single line consisting of the version and the indexed commit ID.
+//   - The file records, sorted by path. The position of a record in this section is the file's ID. Every
+//     record consists of the varint-prefixed path and blob ID followed by a byte of flags.
+//   - The file table, which contains the 64 bit offset of every file record.
+//   - The posting lists, which contain the sorted IDs of the files containing a trigram. The IDs are
+//     delta-encoded as unsigned varints.
+//   - The trigram table, which contains a 16 byte entry per trigram sorted by trigram. Every entry
+//     consists of the 32 bit trigram, the 32 bit size of its posting list and the 64 bit offset of
+//     the posting list.
+//   - The footer, which contains the 64 bit number of files, the offset of the file table, the
+//     number of trigrams and the offset of the trigram table.
+//
+// Files which are too large to be indexed are listed in the posting list of the unindexedTrigram,
+// which is not a valid trigram and sorts last.
+const (
+	trigramTableEntrySize = 16
+	footerSize            = 32
+	unindexedTrigram      = math.MaxUint32
+
+	// fileFlagUnindexed is set for files which are too large to be indexed.
+	fileFlagUnindexed = 1 << 0
+)
+
+// errCorruptIndex is returned when the index cannot be decoded.
+var errCorruptIndex = errors.New("corrupt index")
+
+// readIndexHeader reads the header of the index, which consists of the version and the indexed
+// commit ID. It returns the commit ID and the size of the header.
+func readIndexHeader(file io.Reader) (string, int64, error) {
+	header, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil {
+		return "", 0, fmt.Errorf("reading header: %w", err)
+	}
+
+	version, commitID, ok := strings.Cut(strings.TrimSuffix(header, "\n"), " ")
+	if !ok {
+		return "", 0, fmt.Errorf("invalid header %q", header)
+	}
+
+	if version != indexVersion {
+		return "", 0, fmt.Errorf("version mismatch %s vs %s", indexVersion, version)
+	}
+
+	return commitID, int64(len(header)), nil
+}
+
+// encoder writes the index while keeping track of the current offset.
+type encoder struct {
+	writer *bufio.Writer
+	offset uint64
+	buf    [binary.MaxVarintLen64]byte
+}
+
+func (e *encoder) write(data []byte) error {
+	n, err := e.writer.Write(data)
+	e.offset += uint64(n)
+	return err
+}
+
+func (e *encoder) writeUvarint(value uint64) error {
+	return e.write(e.buf[:binary.PutUvarint(e.buf[:], value)])
+}
+
+func (e *encoder) writeUint32(value uint32) error {
+	return e.write(binary.BigEndian.AppendUint32(e.buf[:0], value))
+}
+
+func (e *encoder) writeUint64(value uint64) error {
+	return e.write(binary.BigEndian.AppendUint64(e.buf[:0], value))
+}
+
+// encode writes the index in its on-disk format.
+func (idx *index) encode(w io.Writer) error {
+	e := &encoder{writer: bufio.NewWriter(w)}
+
+	if err := e.write([]byte(fmt.Sprintf("%s %s\n", indexVersion, idx.CommitID))); err != nil {
+		return fmt.Errorf("writing header: %w", err)
+	}
+
+	paths := make([]string, 0, len(idx.Files))
+	for path := range idx.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	if uint64(len(paths)) > math.MaxUint32 {
+		return fmt.Errorf("too many files: %d", len(paths))
+	}
+
+	// Posting lists are built in the order of file IDs, so they are sorted already.
+	postings := make(map[uint32][]uint32)
+	fileOffsets := make([]uint64, 0, len(paths))
+	for id, path := range paths {
+		file := idx.Files[path]
+		fileOffsets = append(fileOffsets, e.offset)
+
+		var flags byte
+		if file.Unindexed {
+			flags |= fileFlagUnindexed
+			postings[unindexedTrigram] = append(postings[unindexedTrigram], uint32(id))
+		}
+
+		for _, trigram := range file.Trigrams {
+			postings[trigram] = append(postings[trigram], uint32(id))
+		}
+
+		if err := e.writeUvarint(uint64(len(path))); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+		if err := e.write([]byte(path)); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+		if err := e.writeUvarint(uint64(len(file.BlobID))); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+		if err := e.write([]byte(file.BlobID)); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+		if err := e.write([]byte{flags}); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+	}
+
+	fileTableOffset := e.offset
+	for _, offset := range fileOffsets {
+		if err := e.writeUint64(offset); err != nil {
+			return fmt.Errorf("writing file table: %w", err)
+		}
+	}
+
+	trigrams := make([]uint32, 0, len(postings))
+	for trigram := range postings {
+		trigrams = append(trigrams, trigram)
+	}
+	sort.Slice(trigrams, func(i, j int) bool { return trigrams[i] < trigrams[j] })
+
+	type trigramTableEntry struct {
+		trigram, size uint32
+		offset        uint64
+	}
+
+	trigramTable := make([]trigramTableEntry, 0, len(trigrams))
+	for _, trigram := range trigrams {
+		entry := trigramTableEntry{trigram: trigram, offset: e.offset}
+
+		var previousID uint32
+		for i, id := range postings[trigram] {
+			delta := id
+			if i > 0 {
+				delta = id - previousID
+			}
+			previousID = id
+
+			if err := e.writeUvarint(uint64(delta)); err != nil {
+				return fmt.Errorf("writing posting list: %w", err)
+			}
+		}
+
+		entry.size = uint32(e.offset - entry.offset)
+		trigramTable = append(trigramTable, entry)
+	}
+
+	trigramTableOffset := e.offset
+	for _, entry := range trigramTable {
+		if err := e.writeUint32(entry.trigram); err != nil {
+			return fmt.Errorf("writing trigram table: %w", err)
+		}
+		if err := e.writeUint32(entry.size); err != nil {
+			return fmt.Errorf("writing trigram table: %w", err)
+		}
+		if err := e.writeUint64(entry.offset); err != nil {
+			return fmt.Errorf("writing trigram table: %w", err)
+		}
+	}
+
+	for _, value := range []uint64{uint64(len(paths)), fileTableOffset, uint64(len(trigramTable)), trigramTableOffset} {
+		if err := e.writeUint64(value); err != nil {
+			return fmt.Errorf("writing footer: %w", err)
+		}
+	}
+
+	if err := e.writer.Flush(); err != nil {
+		return fmt.Errorf("flushing: %w", err)
+	}
+
+	return nil
+}
+
+// indexReader reads the index on demand. Lookups of posting lists binary-search the trigram table, so
+// a search only reads the parts of the index that are relevant to it.
+type indexReader struct {
+	handle *os.File
+	// commitID is the indexed commit ID.
+	commitID string
+	// headerSize is the size of the header, which is where the file records start.
+	headerSize uint64
+	// fileCount is the number of files in the index.
+	fileCount uint64
+	// fileTableOffset is the offset of the file table.
+	fileTableOffset uint64
+	// trigramCount is the number of entries in the trigram table.
+	trigramCount uint64
+	// trigramTableOffset is the offset of the trigram table.
+	trigramTableOffset uint64
+}
+
+// indexReaderFile is a file read from the index.
+type indexReaderFile struct {
+	path      string
+	blobID    string
+	unindexed bool
+}
+
+// openIndex opens the index of the repository for reading. It returns ErrIndexNotFound in case the
+// repository does not have an index. The caller must close the reader.
+func openIndex(repo *localrepo.Repo) (_ *indexReader, returnedErr error) {
+	path, err := indexPath(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrIndexNotFound
+		}
+		return nil, fmt.Errorf("opening index: %w", err)
+	}
+	defer func() {
+		if returnedErr != nil {
+			file.Close()
+		}
+	}()
+
+	commitID, headerSize, err := readIndexHeader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat index: %w", err)
+	}
+
+	size := uint64(info.Size())
+	if size < uint64(headerSize)+footerSize {
+		return nil, fmt.Errorf("%w: truncated", errCorruptIndex)
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := file.ReadAt(footer, int64(size-footerSize)); err != nil {
+		return nil, fmt.Errorf("reading footer: %w", err)
+	}
+
+	reader := &indexReader{
+		handle:             file,
+		commitID:           commitID,
+		headerSize:         uint64(headerSize),
+		fileCount:          binary.BigEndian.Uint64(footer[0:]),
+		fileTableOffset:    binary.BigEndian.Uint64(footer[8:]),
+		trigramCount:       binary.BigEndian.Uint64(footer[16:]),
+		trigramTableOffset: binary.BigEndian.Uint64(footer[24:]),
+	}
+
+	// Verify that the sections are laid out as expected so that none of the offsets computed from the
+	// footer can overflow or point outside of the file.
+	if reader.fileCount > math.MaxUint32 || reader.trigramCount > math.MaxUint32 ||
+		reader.fileTableOffset < reader.headerSize ||
+		reader.fileTableOffset+8*reader.fileCount > reader.trigramTableOffset ||
+		reader.trigramTableOffset+trigramTableEntrySize*reader.trigramCount != size-footerSize {
+		return nil, fmt.Errorf("%w: invalid footer", errCorruptIndex)
+	}
+
+	return reader, nil
+}
+
+// Close closes the index.
+func (r *indexReader) Close() error {
+	return r.handle.Close()
+}
+
+// fileOffsets returns the offset of the file record with the given ID and the offset where the record
+// ends.
+func (r *indexReader) fileOffsets(id uint32) (uint64, uint64, error) {
+	if uint64(id) >= r.fileCount {
+		return 0, 0, fmt.Errorf("%w: invalid file ID %d", errCorruptIndex, id)
+	}
+
+	// The record ends where the next one starts, or where the file table starts for the last one.
+	length := 16
+	if uint64(id)+1 == r.fileCount {
+		length = 8
+	}
+
+	buf := make([]byte, length)
+	if _, err := r.handle.ReadAt(buf, int64(r.fileTableOffset+8*uint64(id))); err != nil {
+		return 0, 0, fmt.Errorf("reading file table: %w", err)
+	}
+
+	start, end := binary.BigEndian.Uint64(buf), r.fileTableOffset
+	if length == 16 {
+		end = binary.BigEndian.Uint64(buf[8:])
+	}
+
+	if start < r.headerSize || start > end || end > r.fileTableOffset {
+		return 0, 0, fmt.Errorf("%w: invalid file offset", errCorruptIndex)
+	}
+
+	return start, end, nil
+}
+
+// file reads the file with the given ID.
+func (r *indexReader) file(id uint32) (indexReaderFile, error) {
+	start, end, err := r.fileOffsets(id)
+	if err != nil {
+		return indexReaderFile{}, err
+	}
+
+	record := make([]byte, end-start)
+	if _, err := r.handle.ReadAt(record, int64(start)); err != nil {
+		return indexReaderFile{}, fmt.Errorf("reading file: %w", err)
+	}
+
+	readString := func() (string, bool) {
+		length, n := binary.Uvarint(record)
+		if n <= 0 || length > uint64(len(record)-n) {
+			return "", false
+		}
+
+		value := string(record[n : n+int(length)])
+		record = record[n+int(length):]
+		return value, true
+	}
+
+	path, ok := readString()
+	if !ok {
+		return indexReaderFile{}, fmt.Errorf("%w: invalid path", errCorruptIndex)
+	}
+
+	blobID, ok := readString()
+	if !ok || len(record) != 1 {
+		return indexReaderFile{}, fmt.Errorf("%w: invalid file", errCorruptIndex)
+	}
+
+	return indexReaderFile{
+		path:      path,
+		blobID:    blobID,
+		unindexed: record[0]&fileFlagUnindexed != 0,
+	}, nil
+}
+
+// trigramTableEntry reads the entry of the trigram table at the given position.
+func (r *indexReader) trigramTableEntry(i uint64) (uint32, uint32, uint64, error) {
+	buf := make([]byte, trigramTableEntrySize)
+	if _, err := r.handle.ReadAt(buf, int64(r.trigramTableOffset+i*trigramTableEntrySize)); err != nil {
+		return 0, 0, 0, fmt.Errorf("reading trigram table: %w", err)
+	}
+
+	return binary.BigEndian.Uint32(buf), binary.BigEndian.Uint32(buf[4:]), binary.BigEndian.Uint64(buf[8:]), nil
+}
+
+// readPostings reads and decodes the posting list of the given size at the given offset.
+func (r *indexReader) readPostings(size uint32, offset uint64) ([]uint32, error) {
+	if offset < r.fileTableOffset || offset+uint64(size) > r.trigramTableOffset {
+		return nil, fmt.Errorf("%w: invalid posting list offset", errCorruptIndex)
+	}
+
+	buf := make([]byte, size)
+	if _, err := r.handle.ReadAt(buf, int64(offset)); err != nil {
+		return nil, fmt.Errorf("reading posting list: %w", err)
+	}
+
+	var ids []uint32
+	var id uint64
+	for len(buf) > 0 {
+		delta, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return nil, fmt.Errorf("%w: invalid posting list", errCorruptIndex)
+		}
+		buf = buf[n:]
+
+		if len(ids) > 0 {
+			id += delta
+		} else {
+			id = delta
+		}
+
+		if id >= r.fileCount {
+			return nil, fmt.Errorf("%w: invalid file ID %d", errCorruptIndex, id)
+		}
+
+		ids = append(ids, uint32(id))
+	}
+
+	return ids, nil
+}
+
+// postings returns the sorted IDs of the files containing the trigram.
+func (r *indexReader) postings(trigram uint32) ([]uint32, error) {
+	var searchErr error
+	i := sort.Search(int(r.trigramCount), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+
+		entryTrigram, _, _, err := r.trigramTableEntry(uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+
+		return entryTrigram >= trigram
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	if uint64(i) == r.trigramCount {
+		return nil, nil
+	}
+
+	entryTrigram, size, offset, err := r.trigramTableEntry(uint64(i))
+	if err != nil {
+		return nil, err
+	}
+
+	if entryTrigram != trigram {
+		return nil, nil
+	}
+
+	return r.readPostings(size, offset)
+}
+
+// walkPostings calls the callback for each trigram in ascending order with its posting list.
+func (r *indexReader) walkPostings(callback func(trigram uint32, ids []uint32) error) error {
+	for i := uint64(0); i < r.trigramCount; i++ {
+		trigram, size, offset, err := r.trigramTableEntry(i)
+		if err != nil {
+			return err
+		}
+
+		ids, err := r.readPostings(size, offset)
+		if err != nil {
+			return err
+		}
+
+		if err := callback(trigram, ids); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// candidates returns the sorted IDs of the files that may contain all of the trigrams. These are the
+// files that contain all trigrams and the files which are too large to be indexed.
+func (r *indexReader) candidates(trigrams []uint32) ([]uint32, error) {
+	if len(trigrams) == 0 {
+		ids := make([]uint32, r.fileCount)
+		for i := range ids {
+			ids[i] = uint32(i)
+		}
+		return ids, nil
+	}
+
+	lists := make([][]uint32, 0, len(trigrams))
+	for _, trigram := range trigrams {
+		ids, err := r.postings(trigram)
+		if err != nil {
+			return nil, err
+		}
+
+		lists = append(lists, ids)
+	}
+
+	// Intersecting the shortest lists first keeps the intermediate results small.
+	sort.Slice(lists, func(i, j int) bool { return len(lists[i]) < len(lists[j]) })
+
+	ids := lists[0]
+	for _, list := range lists[1:] {
+		if len(ids) == 0 {
+			break
+		}
+		ids = intersect(ids, list)
+	}
+
+	unindexed, err := r.postings(unindexedTrigram)
+	if err != nil {
+		return nil, err
+	}
+
+	return union(ids, unindexed), nil
+}
+
+// intersect returns the IDs contained in both of the sorted lists.
+func intersect(a, b []uint32) []uint32 {
+	var result []uint32
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case a[0] < b[0]:
+			a = a[1:]
+		case a[0] > b[0]:
+			b = b[1:]
+		default:
+			result = append(result, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+
+	return result
+}
+
+// union returns the IDs contained in any of the sorted lists.
+func union(a, b []uint32) []uint32 {
+	result := make([]uint32, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		switch {
+		case len(b) == 0 || (len(a) > 0 && a[0] < b[0]):
+			result = append(result, a[0])
+			a = a[1:]
+		case len(a) == 0 || b[0] < a[0]:
+			result = append(result, b[0])
+			b = b[1:]
+		default:
+			result = append(result, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+
+	return result
+}
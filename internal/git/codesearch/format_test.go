@@ -0,0 +1,131 @@
+package codesearch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+)
+
+func TestIndexReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	idx := &index{
+		CommitID: "1e292f8fedd741b75372e19097c76d327140c312",
+		Files: map[string]indexedFile{
+			"hello.txt":   {BlobID: "blob-hello", Trigrams: contentTrigrams([]byte("hello world"))},
+			"dir/main.go": {BlobID: "blob-main", Trigrams: contentTrigrams([]byte("package main"))},
+			"large":       {BlobID: "blob-large", Unindexed: true},
+			"world.txt":   {BlobID: "blob-world", Trigrams: contentTrigrams([]byte("world"))},
+			"empty":       {BlobID: "blob-empty"},
+		},
+	}
+	require.NoError(t, idx.save(repo))
+
+	reader, err := openIndex(repo)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, reader)
+
+	require.Equal(t, idx.CommitID, reader.commitID)
+	require.EqualValues(t, 5, reader.fileCount)
+
+	// Files are sorted by their path.
+	for id, expected := range []indexReaderFile{
+		{path: "dir/main.go", blobID: "blob-main"},
+		{path: "empty", blobID: "blob-empty"},
+		{path: "hello.txt", blobID: "blob-hello"},
+		{path: "large", blobID: "blob-large", unindexed: true},
+		{path: "world.txt", blobID: "blob-world"},
+	} {
+		file, err := reader.file(uint32(id))
+		require.NoError(t, err)
+		require.Equal(t, expected, file)
+	}
+
+	_, err = reader.file(5)
+	require.ErrorIs(t, err, errCorruptIndex)
+
+	for _, tc := range []struct {
+		desc     string
+		trigrams []uint32
+		expected []uint32
+	}{
+		{
+			desc:     "no trigrams",
+			expected: []uint32{0, 1, 2, 3, 4},
+		},
+		{
+			desc:     "trigrams contained in multiple files",
+			trigrams: contentTrigrams([]byte("world")),
+			expected: []uint32{2, 3, 4},
+		},
+		{
+			desc:     "trigrams contained in a single file",
+			trigrams: contentTrigrams([]byte("hello world")),
+			expected: []uint32{2, 3},
+		},
+		{
+			desc:     "missing trigram",
+			trigrams: contentTrigrams([]byte("goodbye")),
+			expected: []uint32{3},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ids, err := reader.candidates(tc.trigrams)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+
+	loaded, err := loadIndex(repo)
+	require.NoError(t, err)
+	require.Equal(t, map[string]indexedFile{
+		"hello.txt":   {BlobID: "blob-hello", Trigrams: contentTrigrams([]byte("hello world"))},
+		"dir/main.go": {BlobID: "blob-main", Trigrams: contentTrigrams([]byte("package main"))},
+		"large":       {BlobID: "blob-large", Unindexed: true},
+		"world.txt":   {BlobID: "blob-world", Trigrams: contentTrigrams([]byte("world"))},
+		"empty":       {BlobID: "blob-empty"},
+	}, loaded.Files)
+
+	t.Run("corrupt index", func(t *testing.T) {
+		indexPath := filepath.Join(repoPath, indexFilename)
+
+		content, err := os.ReadFile(indexPath)
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(indexPath, content[:len(content)-1], perm.SharedFile))
+
+		_, err = openIndex(repo)
+		require.ErrorIs(t, err, errCorruptIndex)
+	})
+}
+
+func TestIntersect(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, intersect(nil, []uint32{1, 2}))
+	require.Empty(t, intersect([]uint32{1, 3}, []uint32{2, 4}))
+	require.Equal(t, []uint32{2, 5}, intersect([]uint32{1, 2, 3, 5}, []uint32{2, 4, 5, 6}))
+}
+
+func TestUnion(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, union(nil, nil))
+	require.Equal(t, []uint32{1, 2}, union(nil, []uint32{1, 2}))
+	require.Equal(t, []uint32{1, 2, 3, 4, 5, 6}, union([]uint32{1, 2, 3, 5}, []uint32{2, 4, 5, 6}))
+}
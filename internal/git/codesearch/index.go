@@ -0,0 +1,437 @@
+package codesearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gitpipe"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+)
+
+const (
+	// indexFilename is the name of the file in the repository that stores the code search index.
+	indexFilename = "gitaly-codesearch.index"
+	indexVersion  = "v2:gitaly"
+
+	// maxIndexedFileSize is the maximum size of files whose trigrams are indexed. Larger files are
+	// still searched, but they are considered to be a candidate for every query.
+	maxIndexedFileSize = 1024 * 1024
+	// binaryDetectionLength is the number of bytes that are inspected to determine whether a file is
+	// binary. It matches the heuristic used by Git.
+	binaryDetectionLength = 8000
+)
+
+// ErrIndexNotFound is returned when the repository doesn't have a code search index.
+var ErrIndexNotFound = errors.New("code search index not found")
+
+// index is the trigram index of the files of a single commit. This is the in-memory representation
+// that is used to update the index. Searches use the indexReader instead, which only reads the parts of
+// the index they need.
+type index struct {
+	// CommitID is the commit whose files have been indexed. It is empty in case the index has been
+	// created for a repository without a default branch.
+	CommitID string
+	// Files contains the indexed files, where the path of the file is its key.
+	Files map[string]indexedFile
+}
+
+// indexedFile is a single file of the index.
+type indexedFile struct {
+	// BlobID is the object ID of the file's blob.
+	BlobID string
+	// Trigrams are the sorted trigrams of the file's lower-cased content.
+	Trigrams []uint32
+	// Unindexed is set in case the file is too large for its trigrams to be indexed.
+	Unindexed bool
+}
+
+func newIndex() *index {
+	return &index{
+		Files: map[string]indexedFile{},
+	}
+}
+
+func indexPath(repo *localrepo.Repo) (string, error) {
+	repoPath, err := repo.Path()
+	if err != nil {
+		return "", fmt.Errorf("getting repo path: %w", err)
+	}
+
+	return filepath.Join(repoPath, indexFilename), nil
+}
+
+// loadIndexCommitID returns the commit ID of the index without loading the indexed files.
+func loadIndexCommitID(repo *localrepo.Repo) (string, error) {
+	path, err := indexPath(repo)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", ErrIndexNotFound
+		}
+		return "", fmt.Errorf("opening index: %w", err)
+	}
+	defer file.Close()
+
+	commitID, _, err := readIndexHeader(file)
+	if err != nil {
+		return "", err
+	}
+
+	return commitID, nil
+}
+
+// loadIndex loads the complete index of the repository into memory so that it can be updated. It
+// returns ErrIndexNotFound in case the repository does not have an index.
+func loadIndex(repo *localrepo.Repo) (*index, error) {
+	reader, err := openIndex(repo)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	loaded := newIndex()
+	loaded.CommitID = reader.commitID
+
+	files := make([]string, reader.fileCount)
+	for id := range files {
+		file, err := reader.file(uint32(id))
+		if err != nil {
+			return nil, err
+		}
+
+		files[id] = file.path
+		loaded.Files[file.path] = indexedFile{
+			BlobID:    file.blobID,
+			Unindexed: file.unindexed,
+		}
+	}
+
+	// The trigram table is sorted, so the trigrams of every file end up being sorted, too.
+	if err := reader.walkPostings(func(trigram uint32, ids []uint32) error {
+		if trigram == unindexedTrigram {
+			return nil
+		}
+
+		for _, id := range ids {
+			if uint64(id) >= reader.fileCount {
+				return fmt.Errorf("invalid file ID %d", id)
+			}
+
+			file := loaded.Files[files[id]]
+			file.Trigrams = append(file.Trigrams, trigram)
+			loaded.Files[files[id]] = file
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return loaded, nil
+}
+
+// save atomically writes the index into the repository.
+func (idx *index) save(repo *localrepo.Repo) error {
+	path, err := indexPath(repo)
+	if err != nil {
+		return err
+	}
+
+	tempPath, err := repo.StorageTempDir()
+	if err != nil {
+		return fmt.Errorf("locating temp dir: %w", err)
+	}
+
+	file, err := os.CreateTemp(tempPath, indexFilename)
+	if err != nil {
+		return fmt.Errorf("creating temp file: %w", err)
+	}
+	defer func() {
+		file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	if err := idx.encode(file); err != nil {
+		return fmt.Errorf("encoding index: %w", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("flushing index: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("closing index: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("renaming index: %w", err)
+	}
+
+	return nil
+}
+
+// Update creates the code search index of the repository in case it doesn't exist yet and updates
+// it to the commit the default branch points to. Returns the indexed commit ID, which is empty in case
+// the repository doesn't have a default branch.
+func Update(ctx context.Context, repo *localrepo.Repo) (git.ObjectID, error) {
+	created := false
+	idx, err := loadIndex(repo)
+	if err != nil {
+		if !errors.Is(err, ErrIndexNotFound) {
+			log.FromContext(ctx).WithError(err).Info("recreating code search index")
+		}
+
+		idx = newIndex()
+		created = true
+	}
+
+	commitID, changed, err := idx.update(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	// The index of a repository without a default branch is empty, but it must still be persisted
+	// so that it gets updated as soon as the default branch is created.
+	if created && !changed {
+		if err := idx.save(repo); err != nil {
+			return "", fmt.Errorf("saving index: %w", err)
+		}
+	}
+
+	return commitID, nil
+}
+
+// UpdateIfExists updates the code search index of the repository to the commit the default branch
+// points to in case the repository has an index. Returns whether the index has been changed.
+func UpdateIfExists(ctx context.Context, repo *localrepo.Repo) (bool, error) {
+	indexedCommitID, err := loadIndexCommitID(repo)
+	if err != nil {
+		if errors.Is(err, ErrIndexNotFound) {
+			return false, nil
+		}
+
+		// The index exists, but cannot be read anymore. We thus recreate it from scratch.
+		log.FromContext(ctx).WithError(err).Info("recreating code search index")
+		_, changed, err := newIndex().update(ctx, repo)
+		return changed, err
+	}
+
+	commitID, err := resolveDefaultBranch(ctx, repo)
+	if err != nil {
+		return false, err
+	}
+
+	// Avoid loading the whole index in case it is up-to-date already.
+	if commitID.String() == indexedCommitID {
+		return false, nil
+	}
+
+	idx, err := loadIndex(repo)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Info("recreating code search index")
+		idx = newIndex()
+	}
+
+	_, changed, err := idx.update(ctx, repo)
+	return changed, err
+}
+
+// resolveDefaultBranch resolves the commit the default branch points to. Returns an empty object ID in
+// case the default branch doesn't exist.
+func resolveDefaultBranch(ctx context.Context, repo *localrepo.Repo) (git.ObjectID, error) {
+	commitID, err := repo.ResolveRevision(ctx, "HEAD^{commit}")
+	if err != nil {
+		if errors.Is(err, git.ErrReferenceNotFound) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("resolving default branch: %w", err)
+	}
+
+	return commitID, nil
+}
+
+// update updates the index to the commit the default branch points to and saves it. Files that have
+// changed since the indexed commit are re-indexed, while the index is rebuilt from scratch in case
+// no commit has been indexed yet or the changes cannot be determined.
+func (idx *index) update(ctx context.Context, repo *localrepo.Repo) (git.ObjectID, bool, error) {
+	commitID, err := resolveDefaultBranch(ctx, repo)
+	if err != nil {
+		return "", false, err
+	}
+
+	if idx.CommitID == commitID.String() {
+		return commitID, false, nil
+	}
+
+	switch {
+	case commitID == "":
+		idx.Files = map[string]indexedFile{}
+	case idx.CommitID != "":
+		if err := idx.applyChanges(ctx, repo, idx.CommitID, commitID); err != nil {
+			if ctx.Err() != nil {
+				return "", false, err
+			}
+
+			// The previously indexed commit may not exist anymore, so we fall back to rebuilding
+			// the index.
+			log.FromContext(ctx).WithError(err).Info("rebuilding code search index")
+			idx.Files = map[string]indexedFile{}
+
+			if err := idx.build(ctx, repo, commitID); err != nil {
+				return "", false, err
+			}
+		}
+	default:
+		if err := idx.build(ctx, repo, commitID); err != nil {
+			return "", false, err
+		}
+	}
+
+	idx.CommitID = commitID.String()
+	if err := idx.save(repo); err != nil {
+		return "", false, fmt.Errorf("saving index: %w", err)
+	}
+
+	return commitID, true, nil
+}
+
+// build indexes all files of the given commit.
+func (idx *index) build(ctx context.Context, repo *localrepo.Repo, commitID git.ObjectID) error {
+	it := gitpipe.LsTree(ctx, repo, commitID.String(),
+		gitpipe.LsTreeWithRecursive(),
+		gitpipe.LsTreeWithBlobFilter(),
+	)
+
+	for it.Next() {
+		result := it.Result()
+
+		if err := idx.add(ctx, repo, string(result.ObjectName), result.OID); err != nil {
+			return err
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("listing files: %w", err)
+	}
+
+	return nil
+}
+
+// applyChanges re-indexes the files that have changed between the two commits.
+func (idx *index) applyChanges(ctx context.Context, repo *localrepo.Repo, oldCommitID string, newCommitID git.ObjectID) error {
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	it := gitpipe.DiffTree(ctx, repo, oldCommitID, newCommitID.String(),
+		gitpipe.DiffTreeWithRecursive(),
+		gitpipe.DiffTreeWithIgnoreSubmodules(),
+	)
+
+	for it.Next() {
+		result := it.Result()
+		path := string(result.ObjectName)
+
+		if objectHash.IsZeroOID(result.OID) {
+			delete(idx.Files, path)
+			continue
+		}
+
+		if err := idx.add(ctx, repo, path, result.OID); err != nil {
+			return err
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("listing changed files: %w", err)
+	}
+
+	return nil
+}
+
+// add indexes the file with the given path. Binary files are not indexed as they are never searched.
+func (idx *index) add(ctx context.Context, repo *localrepo.Repo, path string, blobID git.ObjectID) error {
+	info, err := repo.ReadObjectInfo(ctx, blobID.Revision())
+	if err != nil {
+		return fmt.Errorf("reading object info: %w", err)
+	}
+
+	// Submodules and other non-blob entries cannot be searched.
+	if !info.IsBlob() {
+		delete(idx.Files, path)
+		return nil
+	}
+
+	if info.Size > maxIndexedFileSize {
+		idx.Files[path] = indexedFile{BlobID: blobID.String(), Unindexed: true}
+		return nil
+	}
+
+	content, err := repo.ReadObject(ctx, blobID)
+	if err != nil {
+		return fmt.Errorf("reading blob: %w", err)
+	}
+
+	if isBinary(content) {
+		delete(idx.Files, path)
+		return nil
+	}
+
+	idx.Files[path] = indexedFile{
+		BlobID:   blobID.String(),
+		Trigrams: contentTrigrams(content),
+	}
+
+	return nil
+}
+
+// isBinary determines whether the content is binary the same way as Git does, that is by checking
+// whether it contains a NUL byte.
+func isBinary(content []byte) bool {
+	if len(content) > binaryDetectionLength {
+		content = content[:binaryDetectionLength]
+	}
+
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+// contentTrigrams returns the sorted set of trigrams of the lower-cased content.
+func contentTrigrams(content []byte) []uint32 {
+	set := make(map[uint32]struct{})
+	for i := 0; i+3 <= len(content); i++ {
+		set[trigram(content[i], content[i+1], content[i+2])] = struct{}{}
+	}
+
+	trigrams := make([]uint32, 0, len(set))
+	for t := range set {
+		trigrams = append(trigrams, t)
+	}
+	sort.Slice(trigrams, func(i, j int) bool { return trigrams[i] < trigrams[j] })
+
+	return trigrams
+}
+
+// trigram returns the trigram of the given three bytes. ASCII letters are lower-cased so that the
+// index can be used for case-insensitive queries, too.
+func trigram(a, b, c byte) uint32 {
+	return uint32(toLower(a))<<16 | uint32(toLower(b))<<8 | uint32(toLower(c))
+}
+
+func toLower(b byte) byte {
+	if 'A' <= b && b <= 'Z' {
+		return b + 'a' - 'A'
+	}
+	return b
+}
@@ -0,0 +1,170 @@
+package codesearch
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	t.Run("unborn default branch", func(t *testing.T) {
+		commitID, err := Update(ctx, repo)
+		require.NoError(t, err)
+		require.Empty(t, commitID)
+
+		idx, err := loadIndex(repo)
+		require.NoError(t, err)
+		require.Empty(t, idx.CommitID)
+		require.Empty(t, idx.Files)
+	})
+
+	largeContent := strings.Repeat("a", maxIndexedFileSize+1)
+
+	firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "README.md", Mode: "100644", Content: "Hello World"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00binary"},
+		gittest.TreeEntry{Path: "large", Mode: "100644", Content: largeContent},
+		gittest.TreeEntry{Path: "dir", Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+			{Path: "main.go", Mode: "100644", Content: "package main"},
+		})},
+	))
+
+	t.Run("initial build", func(t *testing.T) {
+		commitID, err := Update(ctx, repo)
+		require.NoError(t, err)
+		require.Equal(t, firstCommit, commitID)
+
+		idx, err := loadIndex(repo)
+		require.NoError(t, err)
+		require.Equal(t, firstCommit.String(), idx.CommitID)
+		require.Equal(t, map[string]indexedFile{
+			"README.md": {
+				BlobID:   gittest.ResolveRevision(t, cfg, repoPath, firstCommit.String()+":README.md").String(),
+				Trigrams: contentTrigrams([]byte("hello world")),
+			},
+			"dir/main.go": {
+				BlobID:   gittest.ResolveRevision(t, cfg, repoPath, firstCommit.String()+":dir/main.go").String(),
+				Trigrams: contentTrigrams([]byte("package main")),
+			},
+			"large": {
+				BlobID:    gittest.ResolveRevision(t, cfg, repoPath, firstCommit.String()+":large").String(),
+				Unindexed: true,
+			},
+		}, idx.Files)
+	})
+
+	t.Run("up-to-date index", func(t *testing.T) {
+		updated, err := UpdateIfExists(ctx, repo)
+		require.NoError(t, err)
+		require.False(t, updated)
+	})
+
+	secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithParents(firstCommit), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "README.md", Mode: "100644", Content: "Goodbye"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "no longer binary"},
+	))
+
+	t.Run("incremental update", func(t *testing.T) {
+		updated, err := UpdateIfExists(ctx, repo)
+		require.NoError(t, err)
+		require.True(t, updated)
+
+		idx, err := loadIndex(repo)
+		require.NoError(t, err)
+		require.Equal(t, secondCommit.String(), idx.CommitID)
+		require.Equal(t, map[string]indexedFile{
+			"README.md": {
+				BlobID:   gittest.ResolveRevision(t, cfg, repoPath, secondCommit.String()+":README.md").String(),
+				Trigrams: contentTrigrams([]byte("goodbye")),
+			},
+			"binary": {
+				BlobID:   gittest.ResolveRevision(t, cfg, repoPath, secondCommit.String()+":binary").String(),
+				Trigrams: contentTrigrams([]byte("no longer binary")),
+			},
+		}, idx.Files)
+	})
+
+	t.Run("indexed commit does not exist anymore", func(t *testing.T) {
+		idx, err := loadIndex(repo)
+		require.NoError(t, err)
+
+		idx.CommitID = gittest.DefaultObjectHash.HashData([]byte("missing")).String()
+		idx.Files["stale"] = indexedFile{BlobID: idx.CommitID}
+		require.NoError(t, idx.save(repo))
+
+		updated, err := UpdateIfExists(ctx, repo)
+		require.NoError(t, err)
+		require.True(t, updated)
+
+		idx, err = loadIndex(repo)
+		require.NoError(t, err)
+		require.Equal(t, secondCommit.String(), idx.CommitID)
+		require.NotContains(t, idx.Files, "stale")
+		require.Len(t, idx.Files, 2)
+	})
+
+	t.Run("corrupt index", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, indexFilename), []byte("garbage"), perm.SharedFile))
+
+		updated, err := UpdateIfExists(ctx, repo)
+		require.NoError(t, err)
+		require.True(t, updated)
+
+		idx, err := loadIndex(repo)
+		require.NoError(t, err)
+		require.Equal(t, secondCommit.String(), idx.CommitID)
+		require.Len(t, idx.Files, 2)
+	})
+}
+
+func TestUpdateIfExists_missingIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+	updated, err := UpdateIfExists(ctx, repo)
+	require.NoError(t, err)
+	require.False(t, updated)
+	require.NoFileExists(t, filepath.Join(repoPath, indexFilename))
+
+	_, err = loadIndex(repo)
+	require.Equal(t, ErrIndexNotFound, err)
+}
+
+func TestContentTrigrams(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, contentTrigrams([]byte("ab")))
+	require.Equal(t, []uint32{trigram('a', 'b', 'c')}, contentTrigrams([]byte("ABC")))
+	require.Equal(t, []uint32{
+		trigram('a', 'b', 'a'),
+		trigram('b', 'a', 'b'),
+	}, contentTrigrams([]byte("ababa")))
+}
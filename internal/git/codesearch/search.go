@@ -0,0 +1,575 @@
+package codesearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gitpipe"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+)
+
+const (
+	// maxMatchesPerFile is the maximum number of matching lines that are returned per file. All
+	// matching lines are counted regardless.
+	maxMatchesPerFile = 20
+	// maxLineLength is the maximum length of returned lines. Longer lines are truncated.
+	maxLineLength = 1024
+	// maxChangedFiles is the maximum number of files that may have changed between the indexed commit
+	// and the searched commit for the index to be used.
+	maxChangedFiles = 10000
+)
+
+// errTooManyChanges is returned when too many files have changed since the indexed commit.
+var errTooManyChanges = errors.New("too many changed files")
+
+// Query describes what to search for.
+type Query struct {
+	// Pattern is the regular expression to search for, using RE2 syntax.
+	Pattern string
+	// Literal causes the pattern to be matched literally instead of being interpreted as regular
+	// expression.
+	Literal bool
+	// CaseSensitive causes the pattern to be matched case-sensitively.
+	CaseSensitive bool
+	// PathFilters restrict the search to files matching any of the given glob patterns. An empty
+	// list of filters matches all files.
+	PathFilters []string
+}
+
+// CompiledQuery is a query that has been validated and can be searched for.
+type CompiledQuery struct {
+	query    Query
+	regexp   *regexp.Regexp
+	trigrams []uint32
+	filters  []*regexp.Regexp
+}
+
+// Compile validates and compiles the query.
+func Compile(query Query) (*CompiledQuery, error) {
+	if query.Pattern == "" {
+		return nil, errors.New("empty pattern")
+	}
+
+	pattern := query.Pattern
+	if query.Literal {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+
+	flags := syntax.Perl
+	if !query.CaseSensitive {
+		flags |= syntax.FoldCase
+	}
+
+	parsed, err := syntax.Parse(pattern, flags)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	if !query.CaseSensitive {
+		pattern = "(?i)" + pattern
+	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	filters := make([]*regexp.Regexp, 0, len(query.PathFilters))
+	for _, filter := range query.PathFilters {
+		filterRegexp, err := compileGlob(filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path filter %q: %w", filter, err)
+		}
+
+		filters = append(filters, filterRegexp)
+	}
+
+	return &CompiledQuery{
+		query:    query,
+		regexp:   compiled,
+		trigrams: queryTrigrams(parsed),
+		filters:  filters,
+	}, nil
+}
+
+// matchesPath determines whether the path matches any of the path filters.
+func (q *CompiledQuery) matchesPath(path string) bool {
+	if len(q.filters) == 0 {
+		return true
+	}
+
+	for _, filter := range q.filters {
+		if filter.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileGlob translates the glob pattern into a regular expression matching paths the same way as
+// Git's glob pathspec magic does: "*" and "?" don't match slashes while "**" matches across
+// directories. Patterns that match a directory match all of the files contained in it.
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, errors.New("empty pattern")
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				expr.WriteString("(?:.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				expr.WriteString(".*")
+				i++
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, errors.New("unterminated character class")
+			}
+
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			expr.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	expr.WriteString("(?:/.*)?$")
+
+	return regexp.Compile(expr.String())
+}
+
+// queryTrigrams returns the sorted set of trigrams that every line matching the regular expression
+// must contain.
+func queryTrigrams(re *syntax.Regexp) []uint32 {
+	set := make(map[uint32]struct{})
+
+	for _, literal := range requiredLiterals(re) {
+		for i := 0; i+3 <= len(literal.value); i++ {
+			a, b, c := literal.value[i], literal.value[i+1], literal.value[i+2]
+
+			// Case-insensitive matching follows Unicode case folding, where non-ASCII characters
+			// may fold to ASCII letters and vice versa. The index only folds ASCII letters, so
+			// these trigrams cannot be used.
+			if literal.foldCase && (!isFoldSafe(a) || !isFoldSafe(b) || !isFoldSafe(c)) {
+				continue
+			}
+
+			set[trigram(a, b, c)] = struct{}{}
+		}
+	}
+
+	trigrams := make([]uint32, 0, len(set))
+	for t := range set {
+		trigrams = append(trigrams, t)
+	}
+	sort.Slice(trigrams, func(i, j int) bool { return trigrams[i] < trigrams[j] })
+
+	return trigrams
+}
+
+// isFoldSafe determines whether the byte only case-folds to ASCII characters. This is not the case for
+// non-ASCII bytes and the letters "k" and "s", which fold to the Kelvin sign and long s, respectively.
+func isFoldSafe(b byte) bool {
+	switch toLower(b) {
+	case 'k', 's':
+		return false
+	default:
+		return b < 0x80
+	}
+}
+
+type literal struct {
+	value    []byte
+	foldCase bool
+}
+
+// requiredLiterals returns literals that must be part of any string matched by the regular expression.
+func requiredLiterals(re *syntax.Regexp) []literal {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return []literal{{
+			value:    []byte(string(re.Rune)),
+			foldCase: re.Flags&syntax.FoldCase != 0,
+		}}
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min >= 1 {
+			return requiredLiterals(re.Sub[0])
+		}
+		return nil
+	case syntax.OpConcat:
+		var literals []literal
+		for _, sub := range re.Sub {
+			literals = append(literals, requiredLiterals(sub)...)
+		}
+		return literals
+	default:
+		return nil
+	}
+}
+
+// Match is a line of a file that matches the query.
+type Match struct {
+	// LineNumber is the 1-based number of the line.
+	LineNumber uint64
+	// Line is the content of the line, without the trailing newline.
+	Line []byte
+}
+
+// Result is a file that matches the query.
+type Result struct {
+	// Path is the path of the file.
+	Path string
+	// BlobID is the object ID of the file's blob.
+	BlobID git.ObjectID
+	// MatchCount is the number of lines that match the query.
+	MatchCount uint64
+	// Matches are the first lines that match the query.
+	Matches []Match
+	// PathMatches is set in case the path of the file matches the query.
+	PathMatches bool
+}
+
+// Cursor is the position in the ranked search results after which a search continues.
+type Cursor struct {
+	// PathMatches is set in case the path of the last returned file matches the query.
+	PathMatches bool
+	// Path is the path of the last returned file.
+	Path string
+}
+
+// Page is a page of search results.
+type Page struct {
+	// Results are the matching files in order of their rank.
+	Results []Result
+	// Indexed is set in case the code search index has been used.
+	Indexed bool
+	// Next is the cursor of the next page. It is nil in case there are no more files that could match
+	// the query.
+	Next *Cursor
+}
+
+type candidate struct {
+	path string
+	// blobID is the object ID of the file's blob. It is only resolved when the file is searched in
+	// case it is empty.
+	blobID      git.ObjectID
+	pathMatches bool
+}
+
+// before determines whether the candidate is ranked before the cursor's position or at it.
+func (c candidate) before(cursor *Cursor) bool {
+	if c.pathMatches != cursor.PathMatches {
+		return c.pathMatches
+	}
+
+	return c.path <= cursor.Path
+}
+
+// Search searches the files of the given commit for the query. It uses the code search index of the
+// repository if one exists and falls back to git-grep(1) otherwise. The results are ranked so that
+// files whose path matches the query come first, where files of either group are ordered by their path.
+// The search starts after the given cursor in case it is set and stops as soon as limit files have been
+// found, unless the limit is negative. Only the files that are returned are read.
+func Search(ctx context.Context, repo *localrepo.Repo, commitID git.ObjectID, query *CompiledQuery, after *Cursor, limit int) (Page, error) {
+	candidates, indexed, err := indexCandidates(ctx, repo, commitID, query)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if !indexed {
+		candidates, err = grepCandidates(ctx, repo, commitID, query)
+		if err != nil {
+			return Page{}, err
+		}
+	}
+
+	for i := range candidates {
+		candidates[i].pathMatches = query.regexp.MatchString(candidates[i].path)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].pathMatches != candidates[j].pathMatches {
+			return candidates[i].pathMatches
+		}
+		return candidates[i].path < candidates[j].path
+	})
+
+	if after != nil {
+		start := sort.Search(len(candidates), func(i int) bool {
+			return !candidates[i].before(after)
+		})
+		candidates = candidates[start:]
+	}
+
+	page := Page{Indexed: indexed}
+	for i, candidate := range candidates {
+		if limit >= 0 && len(page.Results) >= limit {
+			break
+		}
+
+		result, ok, err := searchFile(ctx, repo, commitID, candidate, query)
+		if err != nil {
+			return Page{}, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		page.Results = append(page.Results, result)
+
+		// The search stops as soon as the page is full, so we cannot tell whether any of the
+		// remaining candidates matches. The next page may thus turn out to be empty.
+		if limit >= 0 && len(page.Results) == limit && i+1 < len(candidates) {
+			page.Next = &Cursor{PathMatches: result.PathMatches, Path: result.Path}
+		}
+	}
+
+	return page, nil
+}
+
+// indexCandidates returns the files that may match the query according to the index. In case the
+// index is outdated, files that have changed since the indexed commit are always considered to be
+// candidates. Returns false in case the index cannot be used.
+func indexCandidates(ctx context.Context, repo *localrepo.Repo, commitID git.ObjectID, query *CompiledQuery) ([]candidate, bool, error) {
+	reader, err := openIndex(repo)
+	if err != nil {
+		if !errors.Is(err, ErrIndexNotFound) {
+			log.FromContext(ctx).WithError(err).Warn("opening code search index")
+		}
+
+		return nil, false, nil
+	}
+	defer reader.Close()
+
+	if reader.commitID == "" {
+		return nil, false, nil
+	}
+
+	var changedFiles map[string]git.ObjectID
+	if reader.commitID != commitID.String() {
+		changedFiles, err = listChangedFiles(ctx, repo, reader.commitID, commitID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, false, err
+			}
+
+			log.FromContext(ctx).WithError(err).Info("not using outdated code search index")
+			return nil, false, nil
+		}
+	}
+
+	ids, err := reader.candidates(query.trigrams)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("reading code search index")
+		return nil, false, nil
+	}
+
+	var candidates []candidate
+	for _, id := range ids {
+		file, err := reader.file(id)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("reading code search index")
+			return nil, false, nil
+		}
+
+		if _, ok := changedFiles[file.path]; ok {
+			continue
+		}
+
+		if !query.matchesPath(file.path) {
+			continue
+		}
+
+		candidates = append(candidates, candidate{path: file.path, blobID: git.ObjectID(file.blobID)})
+	}
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	for path, blobID := range changedFiles {
+		if objectHash.IsZeroOID(blobID) || !query.matchesPath(path) {
+			continue
+		}
+
+		candidates = append(candidates, candidate{path: path, blobID: blobID})
+	}
+
+	return candidates, true, nil
+}
+
+// listChangedFiles returns the blob IDs of files that have changed between the two commits, where
+// deleted files have the zero object ID.
+func listChangedFiles(ctx context.Context, repo *localrepo.Repo, oldCommitID string, newCommitID git.ObjectID) (map[string]git.ObjectID, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	it := gitpipe.DiffTree(ctx, repo, oldCommitID, newCommitID.String(),
+		gitpipe.DiffTreeWithRecursive(),
+		gitpipe.DiffTreeWithIgnoreSubmodules(),
+	)
+
+	changedFiles := make(map[string]git.ObjectID)
+	for it.Next() {
+		if len(changedFiles) >= maxChangedFiles {
+			return nil, errTooManyChanges
+		}
+
+		result := it.Result()
+		changedFiles[string(result.ObjectName)] = result.OID
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("listing changed files: %w", err)
+	}
+
+	return changedFiles, nil
+}
+
+// grepCandidates returns the files that match the query according to git-grep(1).
+func grepCandidates(ctx context.Context, repo *localrepo.Repo, commitID git.ObjectID, query *CompiledQuery) ([]candidate, error) {
+	flags := []git.Option{
+		git.Flag{Name: "--files-with-matches"},
+		git.Flag{Name: "--null"},
+		git.Flag{Name: "-I"},
+	}
+	if !query.query.CaseSensitive {
+		flags = append(flags, git.Flag{Name: "--ignore-case"})
+	}
+	if query.query.Literal {
+		flags = append(flags, git.Flag{Name: "--fixed-strings"})
+	} else {
+		flags = append(flags, git.Flag{Name: "--perl-regexp"})
+	}
+	flags = append(flags, git.ValueFlag{Name: "-e", Value: query.query.Pattern})
+
+	pathspecs := make([]string, 0, len(query.query.PathFilters))
+	for _, filter := range query.query.PathFilters {
+		pathspecs = append(pathspecs, ":(glob)"+filter)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name:        "grep",
+		Flags:       flags,
+		Args:        []string{commitID.String()},
+		PostSepArgs: pathspecs,
+	}, git.WithStdout(&stdout), git.WithStderr(&stderr)); err != nil {
+		// git-grep(1) exits with 1 in case no file matched.
+		if code, ok := command.ExitStatus(err); ok && code == 1 && stderr.Len() == 0 {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("grep: %w, stderr: %q", err, stderr.String())
+	}
+
+	prefix := commitID.String() + ":"
+
+	var candidates []candidate
+	for _, name := range bytes.Split(stdout.Bytes(), []byte{0}) {
+		if len(name) == 0 {
+			continue
+		}
+
+		path := strings.TrimPrefix(string(name), prefix)
+		if !query.matchesPath(path) {
+			continue
+		}
+
+		candidates = append(candidates, candidate{path: path})
+	}
+
+	return candidates, nil
+}
+
+// searchFile searches the file for lines matching the query. Returns false in case the file doesn't
+// match.
+func searchFile(ctx context.Context, repo *localrepo.Repo, commitID git.ObjectID, candidate candidate, query *CompiledQuery) (Result, bool, error) {
+	if candidate.blobID == "" {
+		info, err := repo.ReadObjectInfo(ctx, git.Revision(commitID.String()+":"+candidate.path))
+		if err != nil {
+			return Result{}, false, fmt.Errorf("reading object info: %w", err)
+		}
+
+		candidate.blobID = info.ObjectID()
+	}
+
+	content, err := repo.ReadObject(ctx, candidate.blobID)
+	if err != nil {
+		return Result{}, false, fmt.Errorf("reading blob: %w", err)
+	}
+
+	if isBinary(content) {
+		return Result{}, false, nil
+	}
+
+	result := Result{
+		Path:        candidate.path,
+		BlobID:      candidate.blobID,
+		PathMatches: candidate.pathMatches,
+	}
+
+	var lineNumber uint64
+	for len(content) > 0 {
+		var line []byte
+		line, content, _ = bytes.Cut(content, []byte("\n"))
+		lineNumber++
+
+		if !query.regexp.Match(line) {
+			continue
+		}
+
+		result.MatchCount++
+		if len(result.Matches) < maxMatchesPerFile {
+			if len(line) > maxLineLength {
+				line = line[:maxLineLength]
+			}
+
+			result.Matches = append(result.Matches, Match{
+				LineNumber: lineNumber,
+				Line:       bytes.Clone(line),
+			})
+		}
+	}
+
+	if result.MatchCount == 0 {
+		return Result{}, false, nil
+	}
+
+	return result, true, nil
+}
@@ -0,0 +1,484 @@
+package codesearch
+
+import (
+	"regexp/syntax"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc          string
+		query         Query
+		expectedErr   string
+		matching      []string
+		notMatching   []string
+		expectedPaths map[string]bool
+	}{
+		{
+			desc:        "empty pattern",
+			query:       Query{},
+			expectedErr: "empty pattern",
+		},
+		{
+			desc:        "invalid pattern",
+			query:       Query{Pattern: "foo("},
+			expectedErr: "invalid pattern: error parsing regexp: missing closing ): `foo(`",
+		},
+		{
+			desc:        "invalid path filter",
+			query:       Query{Pattern: "foo", PathFilters: []string{"[abc"}},
+			expectedErr: "invalid path filter \"[abc\": unterminated character class",
+		},
+		{
+			desc:        "case-insensitive regular expression",
+			query:       Query{Pattern: "fo+"},
+			matching:    []string{"foo", "FOO", "xfox"},
+			notMatching: []string{"f", "bar"},
+		},
+		{
+			desc:        "case-sensitive regular expression",
+			query:       Query{Pattern: "fo+", CaseSensitive: true},
+			matching:    []string{"foo"},
+			notMatching: []string{"FOO"},
+		},
+		{
+			desc:        "literal pattern",
+			query:       Query{Pattern: "a.b(", Literal: true},
+			matching:    []string{"a.b(", "A.B("},
+			notMatching: []string{"axb("},
+		},
+		{
+			desc:  "path filters",
+			query: Query{Pattern: "foo", PathFilters: []string{"*.go", "doc"}},
+			expectedPaths: map[string]bool{
+				"main.go":        true,
+				"doc/README.md":  true,
+				"cmd/main.go":    false,
+				"main.rb":        false,
+				"docs/README.md": false,
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := Compile(tc.query)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, line := range tc.matching {
+				require.True(t, query.regexp.MatchString(line), line)
+			}
+			for _, line := range tc.notMatching {
+				require.False(t, query.regexp.MatchString(line), line)
+			}
+			for path, expected := range tc.expectedPaths {
+				require.Equal(t, expected, query.matchesPath(path), path)
+			}
+		})
+	}
+}
+
+func TestCompileGlob(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern     string
+		matching    []string
+		notMatching []string
+	}{
+		{
+			pattern:     "README.md",
+			matching:    []string{"README.md"},
+			notMatching: []string{"READMExmd", "doc/README.md"},
+		},
+		{
+			pattern:     "*.go",
+			matching:    []string{"main.go"},
+			notMatching: []string{"cmd/main.go", "main.gox"},
+		},
+		{
+			pattern:     "**/*.go",
+			matching:    []string{"main.go", "cmd/main.go", "cmd/gitaly/main.go"},
+			notMatching: []string{"main.rb"},
+		},
+		{
+			pattern:     "cmd/**",
+			matching:    []string{"cmd/main.go", "cmd/gitaly/main.go"},
+			notMatching: []string{"cmdx/main.go"},
+		},
+		{
+			pattern:     "doc",
+			matching:    []string{"doc", "doc/README.md", "doc/api/README.md"},
+			notMatching: []string{"docs/README.md"},
+		},
+		{
+			pattern:     "file?.txt",
+			matching:    []string{"file1.txt"},
+			notMatching: []string{"file.txt", "file/.txt"},
+		},
+		{
+			pattern:     "file[!0-4].txt",
+			matching:    []string{"file5.txt"},
+			notMatching: []string{"file1.txt"},
+		},
+		{
+			pattern:     `\*.txt`,
+			matching:    []string{"*.txt"},
+			notMatching: []string{"a.txt"},
+		},
+	} {
+		glob, err := compileGlob(tc.pattern)
+		require.NoError(t, err)
+
+		for _, path := range tc.matching {
+			require.True(t, glob.MatchString(path), "%q should match %q", tc.pattern, path)
+		}
+		for _, path := range tc.notMatching {
+			require.False(t, glob.MatchString(path), "%q should not match %q", tc.pattern, path)
+		}
+	}
+}
+
+func TestQueryTrigrams(t *testing.T) {
+	t.Parallel()
+
+	trigrams := func(strs ...string) []uint32 {
+		var content []byte
+		for _, str := range strs {
+			content = append(content, str...)
+			content = append(content, 0)
+		}
+
+		var result []uint32
+		for _, t := range contentTrigrams(content) {
+			if t&0xff != 0 && t&0xff00 != 0 && t&0xff0000 != 0 {
+				result = append(result, t)
+			}
+		}
+
+		return result
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		pattern  string
+		flags    syntax.Flags
+		expected []uint32
+	}{
+		{
+			desc:     "literal",
+			pattern:  "hello",
+			expected: trigrams("hello"),
+		},
+		{
+			desc:     "concatenation with wildcards",
+			pattern:  "func.*main",
+			expected: trigrams("func", "main"),
+		},
+		{
+			desc:     "optional literal",
+			pattern:  "foo(bar)?",
+			expected: trigrams("foo"),
+		},
+		{
+			desc:     "repetition",
+			pattern:  "(abc)+",
+			expected: trigrams("abc"),
+		},
+		{
+			desc:     "alternation",
+			pattern:  "foo|bar",
+			expected: trigrams(),
+		},
+		{
+			desc:     "case-insensitive literal",
+			pattern:  "HeLLo",
+			flags:    syntax.FoldCase,
+			expected: trigrams("hello"),
+		},
+		{
+			desc:     "case-insensitive literal with Unicode folding letters",
+			pattern:  "kelvins",
+			flags:    syntax.FoldCase,
+			expected: trigrams("elvin"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			re, err := syntax.Parse(tc.pattern, syntax.Perl|tc.flags)
+			require.NoError(t, err)
+
+			require.ElementsMatch(t, tc.expected, queryTrigrams(re))
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	indexedCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "main.go", Mode: "100644", Content: "package main\n\nfunc main() {\n\tprintln(\"Hello\")\n}\n"},
+		gittest.TreeEntry{Path: "hello.txt", Mode: "100644", Content: "hello\nhello world\n"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "hello\x00"},
+		gittest.TreeEntry{Path: "deleted.txt", Mode: "100644", Content: "hello from a deleted file\n"},
+	))
+
+	headCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(indexedCommit), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "main.go", Mode: "100644", Content: "package main\n\nfunc main() {\n\tprintln(\"Hello\")\n}\n"},
+		gittest.TreeEntry{Path: "hello.txt", Mode: "100644", Content: "hello\nhello world\n"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "hello\x00"},
+		gittest.TreeEntry{Path: "added.txt", Mode: "100644", Content: "Hello again\n"},
+	))
+
+	blobID := func(commitID git.ObjectID, path string) git.ObjectID {
+		return gittest.ResolveRevision(t, cfg, repoPath, commitID.String()+":"+path)
+	}
+
+	testCases := []struct {
+		desc            string
+		commitID        git.ObjectID
+		query           Query
+		expectedResults []Result
+	}{
+		{
+			desc:     "case-insensitive literal",
+			commitID: indexedCommit,
+			query:    Query{Pattern: "hello", Literal: true},
+			expectedResults: []Result{
+				{
+					Path:        "hello.txt",
+					BlobID:      blobID(indexedCommit, "hello.txt"),
+					PathMatches: true,
+					MatchCount:  2,
+					Matches: []Match{
+						{LineNumber: 1, Line: []byte("hello")},
+						{LineNumber: 2, Line: []byte("hello world")},
+					},
+				},
+				{
+					Path:       "deleted.txt",
+					BlobID:     blobID(indexedCommit, "deleted.txt"),
+					MatchCount: 1,
+					Matches: []Match{
+						{LineNumber: 1, Line: []byte("hello from a deleted file")},
+					},
+				},
+				{
+					Path:       "main.go",
+					BlobID:     blobID(indexedCommit, "main.go"),
+					MatchCount: 1,
+					Matches: []Match{
+						{LineNumber: 4, Line: []byte("\tprintln(\"Hello\")")},
+					},
+				},
+			},
+		},
+		{
+			desc:     "case-sensitive regular expression",
+			commitID: indexedCommit,
+			query:    Query{Pattern: `func \w+\(`, CaseSensitive: true},
+			expectedResults: []Result{
+				{
+					Path:       "main.go",
+					BlobID:     blobID(indexedCommit, "main.go"),
+					MatchCount: 1,
+					Matches: []Match{
+						{LineNumber: 3, Line: []byte("func main() {")},
+					},
+				},
+			},
+		},
+		{
+			desc:     "path filter",
+			commitID: indexedCommit,
+			query:    Query{Pattern: "hello", PathFilters: []string{"*.go"}},
+			expectedResults: []Result{
+				{
+					Path:       "main.go",
+					BlobID:     blobID(indexedCommit, "main.go"),
+					MatchCount: 1,
+					Matches: []Match{
+						{LineNumber: 4, Line: []byte("\tprintln(\"Hello\")")},
+					},
+				},
+			},
+		},
+		{
+			desc:     "no match",
+			commitID: indexedCommit,
+			query:    Query{Pattern: "does not exist"},
+		},
+		{
+			desc:     "commit newer than the index",
+			commitID: headCommit,
+			query:    Query{Pattern: "hello", CaseSensitive: true, PathFilters: []string{"*.txt"}},
+			expectedResults: []Result{
+				{
+					Path:        "hello.txt",
+					BlobID:      blobID(headCommit, "hello.txt"),
+					PathMatches: true,
+					MatchCount:  2,
+					Matches: []Match{
+						{LineNumber: 1, Line: []byte("hello")},
+						{LineNumber: 2, Line: []byte("hello world")},
+					},
+				},
+			},
+		},
+		{
+			desc:     "added file in commit newer than the index",
+			commitID: headCommit,
+			query:    Query{Pattern: "again"},
+			expectedResults: []Result{
+				{
+					Path:       "added.txt",
+					BlobID:     blobID(headCommit, "added.txt"),
+					MatchCount: 1,
+					Matches: []Match{
+						{LineNumber: 1, Line: []byte("Hello again")},
+					},
+				},
+			},
+		},
+	}
+
+	// Without an index, candidates are determined via git-grep(1).
+	for _, tc := range testCases {
+		compiled, err := Compile(tc.query)
+		require.NoError(t, err)
+
+		t.Run(tc.desc+" without index", func(t *testing.T) {
+			page, err := Search(ctx, repo, tc.commitID, compiled, nil, -1)
+			require.NoError(t, err)
+			require.False(t, page.Indexed)
+			require.Equal(t, tc.expectedResults, page.Results)
+			require.Nil(t, page.Next)
+		})
+	}
+
+	_, err := Update(ctx, repo)
+	require.NoError(t, err)
+
+	// The index must yield the same results as git-grep(1), even for commits newer than the
+	// indexed one.
+	for _, tc := range testCases {
+		compiled, err := Compile(tc.query)
+		require.NoError(t, err)
+
+		t.Run(tc.desc+" with index", func(t *testing.T) {
+			page, err := Search(ctx, repo, tc.commitID, compiled, nil, -1)
+			require.NoError(t, err)
+			require.True(t, page.Indexed)
+			require.Equal(t, tc.expectedResults, page.Results)
+			require.Nil(t, page.Next)
+		})
+	}
+}
+
+func TestSearch_pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "a.txt", Mode: "100644", Content: "foo bar\n"},
+		gittest.TreeEntry{Path: "b.txt", Mode: "100644", Content: "foobar\nfoo and bar\n"},
+		gittest.TreeEntry{Path: "c.txt", Mode: "100644", Content: "bar\n"},
+		gittest.TreeEntry{Path: "d.txt", Mode: "100644", Content: "foo-bar\n"},
+		gittest.TreeEntry{Path: "foo-bar.txt", Mode: "100644", Content: "foo bar\n"},
+		gittest.TreeEntry{Path: "x.txt", Mode: "100644", Content: "bar\nfoo\n"},
+	))
+
+	query, err := Compile(Query{Pattern: "foo.*bar"})
+	require.NoError(t, err)
+
+	paginate := func(t *testing.T, limit int) ([]string, int) {
+		var paths []string
+		var pages int
+		var cursor *Cursor
+
+		for {
+			page, err := Search(ctx, repo, commitID, query, cursor, limit)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page.Results), limit)
+			pages++
+
+			for _, result := range page.Results {
+				paths = append(paths, result.Path)
+			}
+
+			if page.Next == nil {
+				return paths, pages
+			}
+			cursor = page.Next
+		}
+	}
+
+	// Files whose path matches the query are ranked first.
+	expectedPaths := []string{"foo-bar.txt", "a.txt", "b.txt", "d.txt"}
+
+	t.Run("without index", func(t *testing.T) {
+		paths, pages := paginate(t, 2)
+		require.Equal(t, expectedPaths, paths)
+		require.Equal(t, 2, pages)
+
+		paths, pages = paginate(t, 1)
+		require.Equal(t, expectedPaths, paths)
+		require.Equal(t, 4, pages)
+	})
+
+	_, err = Update(ctx, repo)
+	require.NoError(t, err)
+
+	t.Run("with index", func(t *testing.T) {
+		paths, pages := paginate(t, 2)
+		require.Equal(t, expectedPaths, paths)
+		// The index considers "x.txt" to be a candidate as it contains all trigrams of the query,
+		// even though none of its lines matches. The last page thus turns out to be empty.
+		require.Equal(t, 3, pages)
+
+		paths, pages = paginate(t, 1)
+		require.Equal(t, expectedPaths, paths)
+		require.Equal(t, 5, pages)
+	})
+
+	t.Run("cursor between results", func(t *testing.T) {
+		page, err := Search(ctx, repo, commitID, query, &Cursor{Path: "c.txt"}, -1)
+		require.NoError(t, err)
+		require.Len(t, page.Results, 1)
+		require.Equal(t, "d.txt", page.Results[0].Path)
+		require.Nil(t, page.Next)
+	})
+}
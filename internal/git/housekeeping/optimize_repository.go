@@ -9,6 +9,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
@@ -230,6 +231,17 @@ func optimizeRepository(
 	}
 	timer.ObserveDuration()
 
+	timer = prometheus.NewTimer(m.tasksLatency.WithLabelValues("code-search-index"))
+	// The code search index is only a cache that speeds up searches, so failing to update it must
+	// not fail the maintenance that has already been performed.
+	if didUpdateIndex, err := codesearch.UpdateIfExists(ctx, repo); err != nil {
+		optimizations["updated_code_search_index"] = "failure"
+		logger.WithError(err).Warn("could not update code search index")
+	} else if didUpdateIndex {
+		optimizations["updated_code_search_index"] = "success"
+	}
+	timer.ObserveDuration()
+
 	totalStatus = "success"
 
 	return nil
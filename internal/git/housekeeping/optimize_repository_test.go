@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/stats"
@@ -1018,6 +1019,64 @@ func TestOptimizeRepository(t *testing.T) {
 				}
 			},
 		},
+		{
+			desc: "repository with code search index updates the index",
+			setup: func(t *testing.T, relativePath string) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+					SkipCreationViaService: true,
+					RelativePath:           relativePath,
+				})
+				repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+				_, err := codesearch.Update(ctx, repo)
+				require.NoError(t, err)
+				gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+				return setupData{
+					repo: repo,
+					expectedMetrics: []metric{
+						{name: "packed_objects_geometric", status: "success", count: 1},
+						{name: "written_commit_graph_full", status: "success", count: 1},
+						{name: "written_bitmap", status: "success", count: 1},
+						{name: "written_multi_pack_index", status: "success", count: 1},
+						{name: "updated_code_search_index", status: "success", count: 1},
+						{name: "total", status: "success", count: 1},
+					},
+				}
+			},
+		},
+		{
+			desc: "failing code search index update does not fail optimization",
+			setup: func(t *testing.T, relativePath string) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+					SkipCreationViaService: true,
+					RelativePath:           relativePath,
+				})
+
+				_, err := codesearch.Update(ctx, localrepo.NewTestRepo(t, cfg, repoProto))
+				require.NoError(t, err)
+				gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+				gitCmdFactory := errorInjectingCommandFactory{
+					CommandFactory: gittest.NewCommandFactory(t, cfg),
+					injectedErrors: map[string]error{
+						"ls-tree": assert.AnError,
+					},
+				}
+
+				return setupData{
+					repo: localrepo.New(config.NewLocator(cfg), gitCmdFactory, nil, repoProto),
+					expectedMetrics: []metric{
+						{name: "packed_objects_geometric", status: "success", count: 1},
+						{name: "written_commit_graph_full", status: "success", count: 1},
+						{name: "written_bitmap", status: "success", count: 1},
+						{name: "written_multi_pack_index", status: "success", count: 1},
+						{name: "updated_code_search_index", status: "failure", count: 1},
+						{name: "total", status: "success", count: 1},
+					},
+				}
+			},
+		},
 	} {
 		tc := tc
 
@@ -16,6 +16,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
 
@@ -164,6 +165,12 @@ func (repo *Repo) WriteCommit(ctx context.Context, cfg WriteCommitConfig) (git.O
 
 	var flags []git.Option
 
+	signCommit := featureflag.GPGSigning.IsEnabled(ctx) && cfg.SigningKey != ""
+	if signCommit {
+		flags = append(flags, git.Flag{Name: "--gpg-sign=" + cfg.SigningKey})
+		env = append(env, repo.SigningContext().Env()...)
+	}
+
 	for _, parent := range cfg.Parents {
 		flags = append(flags, git.ValueFlag{Name: "-p", Value: parent.String()})
 	}
@@ -179,8 +186,7 @@ func (repo *Repo) WriteCommit(ctx context.Context, cfg WriteCommitConfig) (git.O
 		git.WithEnv(env...),
 	}
 
-	if featureflag.GPGSigning.IsEnabled(ctx) && cfg.SigningKey != "" {
-		flags = append(flags, git.Flag{Name: "--gpg-sign=" + cfg.SigningKey})
+	if signCommit {
 		opts = append(opts, git.WithGitalyGPG())
 	}
 
@@ -223,6 +229,21 @@ func (repo *Repo) WriteCommit(ctx context.Context, cfg WriteCommitConfig) (git.O
 	return oid, nil
 }
 
+// SigningContext returns the context of the repository that is passed along to external signers.
+func (repo *Repo) SigningContext() signature.SigningContext {
+	signingContext := signature.SigningContext{
+		StorageName:  repo.GetStorageName(),
+		RelativePath: repo.GetRelativePath(),
+	}
+
+	if protoRepo, ok := repo.Repository.(*gitalypb.Repository); ok {
+		signingContext.GlRepository = protoRepo.GetGlRepository()
+		signingContext.GlProjectPath = protoRepo.GetGlProjectPath()
+	}
+
+	return signingContext
+}
+
 // InvalidCommitError is returned when the revision does not point to a valid commit object.
 type InvalidCommitError git.Revision
 
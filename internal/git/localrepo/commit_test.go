@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -386,6 +387,48 @@ func testWriteCommit(t *testing.T, ctx context.Context) {
 		)
 		require.NoError(tb, err)
 	})
+
+	t.Run("signed by external signing agent", func(t *testing.T) {
+		if !featureflag.GPGSigning.IsEnabled(ctx) {
+			t.Skip()
+		}
+
+		signingKeys, err := signature.ParseSigningKeys("testdata/signing_gpg_key")
+		require.NoError(t, err)
+
+		socketPath := filepath.Join(testhelper.TempDir(t), "agent.sock")
+		agent := signature.StartSigningAgent(t, socketPath, signingKeys)
+
+		oid, err := repo.WriteCommit(ctx, WriteCommitConfig{
+			TreeID:         treeA.OID,
+			AuthorName:     gittest.DefaultCommitterName,
+			AuthorEmail:    gittest.DefaultCommitterMail,
+			CommitterName:  gittest.DefaultCommitterName,
+			CommitterEmail: gittest.DefaultCommitterMail,
+			AuthorDate:     gittest.DefaultCommitTime,
+			CommitterDate:  gittest.DefaultCommitTime,
+			Message:        "my custom message",
+			SigningKey:     "unix:" + socketPath,
+		})
+		require.NoError(t, err)
+
+		data, err := repo.ReadObject(ctx, oid)
+		require.NoError(t, err)
+
+		gpgsig, dataWithoutGpgSig := signature.ExtractSignature(t, ctx, data)
+		require.NoError(t, signingKeys.Verify([]byte(gpgsig), []byte(dataWithoutGpgSig)))
+
+		require.Equal(t, []signature.SigningContext{
+			{
+				CommitterName:  gittest.DefaultCommitterName,
+				CommitterEmail: gittest.DefaultCommitterMail,
+				StorageName:    repoProto.GetStorageName(),
+				RelativePath:   repoProto.GetRelativePath(),
+				GlRepository:   repoProto.GetGlRepository(),
+				GlProjectPath:  repoProto.GetGlProjectPath(),
+			},
+		}, agent.Contexts())
+	})
 }
 
 func TestWriteCommit_validation(t *testing.T) {
@@ -33,6 +33,7 @@ func TestRepo_FetchInternal(t *testing.T) {
 			deps.GetLocator(),
 			deps.GetGitCmdFactory(),
 			deps.GetTxManager(),
+			deps.GetCatfileCache(),
 		))
 		gitalypb.RegisterHookServiceServer(srv, hook.NewServer(
 			deps.GetHookManager(),
@@ -52,6 +53,7 @@ func TestRepo_FetchInternal(t *testing.T) {
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	}, testserver.WithGitCommandFactory(protocolDetectingFactory))
 
@@ -34,6 +34,7 @@ func setupGitalyServer(t *testing.T) config.Cfg {
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 		gitalypb.RegisterCommitServiceServer(srv, commit.NewServer(
 			deps.GetCfg(),
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
@@ -114,6 +115,7 @@ type Cfg struct {
 	GitlabShell            GitlabShell         `toml:"gitlab-shell,omitempty" json:"gitlab-shell"`
 	Hooks                  Hooks               `toml:"hooks,omitempty" json:"hooks"`
 	Concurrency            []Concurrency       `toml:"concurrency,omitempty" json:"concurrency"`
+	AdaptiveLimiting       AdaptiveLimiting    `toml:"adaptive_limiting,omitempty" json:"adaptive_limiting"`
 	RateLimiting           []RateLimiting      `toml:"rate_limiting,omitempty" json:"rate_limiting"`
 	GracefulRestartTimeout duration.Duration   `toml:"graceful_restart_timeout,omitempty" json:"graceful_restart_timeout"`
 	DailyMaintenance       DailyJob            `toml:"daily_maintenance,omitempty" json:"daily_maintenance"`
@@ -121,6 +123,8 @@ type Cfg struct {
 	PackObjectsCache       StreamCacheConfig   `toml:"pack_objects_cache,omitempty" json:"pack_objects_cache"`
 	PackObjectsLimiting    PackObjectsLimiting `toml:"pack_objects_limiting,omitempty" json:"pack_objects_limiting"`
 	Backup                 BackupConfig        `toml:"backup,omitempty" json:"backup"`
+	ImmutableCache         ImmutableCache      `toml:"immutable_cache,omitempty" json:"immutable_cache"`
+	Transactions           Transactions        `toml:"transactions,omitempty" json:"transactions"`
 }
 
 // TLS configuration
@@ -360,6 +364,9 @@ func (cfg GitConfig) GlobalArgs() ([]string, error) {
 type Storage struct {
 	Name string `toml:"name"`
 	Path string `toml:"path"`
+	// SignatureVerification configures the keys that signatures of commits and tags in the storage's
+	// repositories are verified against by default.
+	SignatureVerification SignatureVerification `toml:"signature_verification,omitempty" json:"signature_verification"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
@@ -367,9 +374,44 @@ func (s Storage) Validate() error {
 	return cfgerror.New().
 		Append(cfgerror.NotEmpty(s.Name), "name").
 		Append(cfgerror.DirExists(s.Path), "path").
+		Append(s.SignatureVerification.Validate(), "signature_verification").
 		AsError()
 }
 
+// SignatureVerification configures the keys that signatures are verified against. Each of the files is
+// optional. Keys passed in with a request take precedence over the configured ones.
+type SignatureVerification struct {
+	// GPGKeyring is the path to an armored or binary OpenPGP keyring of the trusted public keys.
+	GPGKeyring string `toml:"gpg_keyring,omitempty" json:"gpg_keyring"`
+	// SSHAllowedSigners is the path to a file in the format of Git's `gpg.ssh.allowedSignersFile`.
+	SSHAllowedSigners string `toml:"ssh_allowed_signers,omitempty" json:"ssh_allowed_signers"`
+	// SSHRevokedKeys is the path to a file listing revoked SSH public keys in the `authorized_keys` format.
+	SSHRevokedKeys string `toml:"ssh_revoked_keys,omitempty" json:"ssh_revoked_keys"`
+	// X509CAFile is the path to a file with the PEM-encoded certificates of the trusted certificate
+	// authorities. It may additionally contain PEM-encoded revocation lists issued by the authorities.
+	X509CAFile string `toml:"x509_ca_file,omitempty" json:"x509_ca_file"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (sv SignatureVerification) Validate() error {
+	var errs cfgerror.ValidationErrors
+	for _, file := range []struct {
+		path string
+		key  string
+	}{
+		{path: sv.GPGKeyring, key: "gpg_keyring"},
+		{path: sv.SSHAllowedSigners, key: "ssh_allowed_signers"},
+		{path: sv.SSHRevokedKeys, key: "ssh_revoked_keys"},
+		{path: sv.X509CAFile, key: "x509_ca_file"},
+	} {
+		if file.path != "" {
+			errs = errs.Append(cfgerror.FileExists(file.path), file.key)
+		}
+	}
+
+	return errs.AsError()
+}
+
 func validateStorages(storages []Storage) error {
 	if len(storages) == 0 {
 		return cfgerror.NewValidationError(cfgerror.ErrNotSet)
@@ -439,6 +481,95 @@ type Concurrency struct {
 	// MaxQueueWait is the maximum time a request can remain in the concurrency queue
 	// waiting to be picked up by Gitaly
 	MaxQueueWait duration.Duration `toml:"max_queue_wait" json:"max_queue_wait"`
+	// Fairness enables per-tenant fair queueing. Instead of handing out concurrency slots in
+	// FIFO order, every tenant waiting for a slot gets a share of the slots proportional to
+	// its weight. The value determines how tenants are identified and must be one of
+	// FairnessClientName or FairnessUserID. Fair queueing is disabled if unset.
+	Fairness string `toml:"fairness,omitempty" json:"fairness,omitempty"`
+	// FairnessWeights maps tenants to their weight when fair queueing is enabled. Tenants
+	// without an explicit weight have a weight of 1.
+	FairnessWeights map[string]int `toml:"fairness_weights,omitempty" json:"fairness_weights,omitempty"`
+	// Adaptive makes the concurrency limit adapt to the load of the node. The limit starts at
+	// InitialLimit, grows towards MaxLimit while the node is healthy and shrinks towards MinLimit
+	// when any of the signals configured in AdaptiveLimiting reports the node to be saturated.
+	// MaxPerRepo is ignored if set.
+	Adaptive bool `toml:"adaptive,omitempty" json:"adaptive,omitempty"`
+	// InitialLimit is the concurrency limit an adaptive limit starts with.
+	InitialLimit int `toml:"initial_limit,omitempty" json:"initial_limit,omitempty"`
+	// MaxLimit is the maximum concurrency limit of an adaptive limit.
+	MaxLimit int `toml:"max_limit,omitempty" json:"max_limit,omitempty"`
+	// MinLimit is the minimum concurrency limit of an adaptive limit.
+	MinLimit int `toml:"min_limit,omitempty" json:"min_limit,omitempty"`
+}
+
+const (
+	// FairnessClientName identifies tenants by the client name passed via gRPC metadata.
+	FairnessClientName = "client_name"
+	// FairnessUserID identifies tenants by the user ID passed via gRPC metadata.
+	FairnessUserID = "user_id"
+)
+
+// Validate runs validation on all fields and compose all found errors.
+func (c Concurrency) Validate() error {
+	errs := cfgerror.New()
+	if c.Fairness != "" {
+		errs = errs.Append(cfgerror.IsSupportedValue(c.Fairness, FairnessClientName, FairnessUserID), "fairness")
+	}
+
+	tenants := make([]string, 0, len(c.FairnessWeights))
+	for tenant := range c.FairnessWeights {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+
+	for _, tenant := range tenants {
+		errs = errs.Append(cfgerror.Comparable(c.FairnessWeights[tenant]).GreaterThan(0), "fairness_weights", tenant)
+	}
+
+	if c.Adaptive {
+		errs = errs.
+			Append(cfgerror.Comparable(c.MinLimit).GreaterThan(0), "min_limit").
+			Append(cfgerror.Comparable(c.MaxLimit).GreaterOrEqual(c.MinLimit), "max_limit").
+			Append(cfgerror.Comparable(c.InitialLimit).InRange(c.MinLimit, c.MaxLimit, cfgerror.InRangeOptIncludeMin, cfgerror.InRangeOptIncludeMax), "initial_limit")
+	}
+
+	return errs.AsError()
+}
+
+// AdaptiveLimiting configures the signals adaptive concurrency limits are calibrated with. On top of the
+// cgroup CPU and memory pressure, which are watched whenever cgroups are configured, the limits back off
+// when the 99th percentile of any of the following durations exceeds its threshold. Signals without a
+// threshold are not watched.
+type AdaptiveLimiting struct {
+	// QueueTimeThreshold is the threshold of the time requests wait in the queues of the
+	// concurrency limiters.
+	QueueTimeThreshold duration.Duration `toml:"queue_time_threshold,omitempty" json:"queue_time_threshold,omitempty"`
+	// SpawnTokenWaitThreshold is the threshold of the time spent waiting for a token to spawn a
+	// process.
+	SpawnTokenWaitThreshold duration.Duration `toml:"spawn_token_wait_threshold,omitempty" json:"spawn_token_wait_threshold,omitempty"`
+	// LatencyThresholds maps full method names of RPCs, for example
+	// "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel", to the threshold of their latency.
+	LatencyThresholds map[string]duration.Duration `toml:"latency_thresholds,omitempty" json:"latency_thresholds,omitempty"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (al AdaptiveLimiting) Validate() error {
+	errs := cfgerror.New().
+		Append(cfgerror.Comparable(al.QueueTimeThreshold.Duration()).GreaterOrEqual(0), "queue_time_threshold").
+		Append(cfgerror.Comparable(al.SpawnTokenWaitThreshold.Duration()).GreaterOrEqual(0), "spawn_token_wait_threshold")
+
+	rpcs := make([]string, 0, len(al.LatencyThresholds))
+	for rpc := range al.LatencyThresholds {
+		rpcs = append(rpcs, rpc)
+	}
+	sort.Strings(rpcs)
+
+	for _, rpc := range rpcs {
+		threshold := al.LatencyThresholds[rpc]
+		errs = errs.Append(cfgerror.Comparable(threshold.Duration()).GreaterThan(0), "latency_thresholds", rpc)
+	}
+
+	return errs.AsError()
 }
 
 // RateLimiting allows endpoints to be limited to a maximum request rate per
@@ -487,6 +618,13 @@ type BackupConfig struct {
 	GoCloudURL string `toml:"go_cloud_url,omitempty" json:"go_cloud_url,omitempty"`
 	// Layout determines how backup files are located.
 	Layout string `toml:"layout,omitempty" json:"layout,omitempty"`
+	// EncryptionKeyring is the path to the keyring file holding the keys server-side backups
+	// are encrypted with. The backups are stored unencrypted if not set.
+	EncryptionKeyring string `toml:"encryption_keyring,omitempty" json:"encryption_keyring,omitempty"`
+	// AllowUnencrypted makes backup files that aren't encrypted readable as is when an encryption
+	// keyring is set, so backups created before encryption was enabled can still be restored and
+	// incremented.
+	AllowUnencrypted bool `toml:"allow_unencrypted,omitempty" json:"allow_unencrypted,omitempty"`
 }
 
 // Validate runs validation on all fields and returns any errors found.
@@ -500,11 +638,67 @@ func (bc BackupConfig) Validate() error {
 		errs = errs.Append(err, "go_cloud_url")
 	}
 
-	return errs.
-		Append(cfgerror.NotBlank(bc.Layout), "layout").
+	errs = errs.Append(cfgerror.NotBlank(bc.Layout), "layout")
+
+	if bc.EncryptionKeyring != "" {
+		errs = errs.Append(cfgerror.FileExists(bc.EncryptionKeyring), "encryption_keyring")
+	}
+
+	return errs.AsError()
+}
+
+// ImmutableCache configures the cache for responses of RPCs whose result is fully determined by the
+// object IDs they are invoked with. Cached responses stay valid across repository mutations, so
+// instead of being invalidated they are evicted in least-recently-used order once the cache of a
+// storage exceeds its budget. The cache is cleared whenever Gitaly starts.
+type ImmutableCache struct {
+	// Enabled enables the cache.
+	Enabled bool `toml:"enabled,omitempty" json:"enabled"`
+	// MaxBytes is the disk budget of the cache per storage. Defaults to 1GiB.
+	MaxBytes int64 `toml:"max_bytes,omitempty" json:"max_bytes"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (ic ImmutableCache) Validate() error {
+	if !ic.Enabled {
+		return nil
+	}
+
+	return cfgerror.New().
+		Append(cfgerror.Comparable(ic.MaxBytes).GreaterThan(0), "max_bytes").
 		AsError()
 }
 
+// Transactions configures the write-ahead log of the repositories when transactions are enabled.
+type Transactions struct {
+	// HistoryRetention is the number of latest applied log entries whose history is retained in each
+	// partition. The retained history allows for reading the past states of the repositories and for
+	// streaming their log events. History is not retained if unset.
+	HistoryRetention uint64 `toml:"history_retention,omitempty" json:"history_retention"`
+	// Database is the key-value store the partition assignments and the write-ahead logs of a storage
+	// are kept in. It must be one of TransactionsDatabaseBadger or TransactionsDatabaseBbolt. Badger is
+	// used if unset. The data isn't migrated between the databases, so the database of an existing
+	// storage must not be changed.
+	Database string `toml:"database,omitempty" json:"database,omitempty"`
+}
+
+const (
+	// TransactionsDatabaseBadger stores the transactions' state in Badger.
+	TransactionsDatabaseBadger = "badger"
+	// TransactionsDatabaseBbolt stores the transactions' state in bbolt.
+	TransactionsDatabaseBbolt = "bbolt"
+)
+
+// Validate runs validation on all fields and compose all found errors.
+func (t Transactions) Validate() error {
+	errs := cfgerror.New()
+	if t.Database != "" {
+		errs = errs.Append(cfgerror.IsSupportedValue(t.Database, TransactionsDatabaseBadger, TransactionsDatabaseBbolt), "database")
+	}
+
+	return errs.AsError()
+}
+
 // StreamCacheConfig contains settings for a streamcache instance.
 type StreamCacheConfig struct {
 	Enabled        bool              `toml:"enabled" json:"enabled"` // Default: false
@@ -661,8 +855,18 @@ func (cfg *Cfg) ValidateV2() error {
 		}},
 		{field: "cgroups", validate: cfg.Cgroups.Validate},
 		{field: "pack_objects_cache", validate: cfg.PackObjectsCache.Validate},
+		{field: "concurrency", validate: func() error {
+			var errs cfgerror.ValidationErrors
+			for i, concurrency := range cfg.Concurrency {
+				errs = errs.Append(concurrency.Validate(), fmt.Sprintf("[%d]", i))
+			}
+			return errs.AsError()
+		}},
+		{field: "adaptive_limiting", validate: cfg.AdaptiveLimiting.Validate},
 		{field: "pack_objects_limiting", validate: cfg.PackObjectsLimiting.Validate},
 		{field: "backup", validate: cfg.Backup.Validate},
+		{field: "immutable_cache", validate: cfg.ImmutableCache.Validate},
+		{field: "transactions", validate: cfg.Transactions.Validate},
 	} {
 		var fields []string
 		if check.field != "" {
@@ -706,6 +910,10 @@ func (cfg *Cfg) setDefaults() error {
 		cfg.Backup.Layout = "pointer"
 	}
 
+	if cfg.ImmutableCache.MaxBytes == 0 {
+		cfg.ImmutableCache.MaxBytes = 1 << 30
+	}
+
 	return nil
 }
 
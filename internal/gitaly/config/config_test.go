@@ -1754,6 +1754,134 @@ func TestPackObjectsLimiting_Validate(t *testing.T) {
 	)
 }
 
+func TestConcurrency_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Concurrency{}.Validate())
+	require.NoError(t, Concurrency{Fairness: FairnessClientName}.Validate())
+	require.NoError(t, Concurrency{Fairness: FairnessUserID, FairnessWeights: map[string]int{"1": 2}}.Validate())
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf(`%w: "tenant"`, cfgerror.ErrUnsupportedValue),
+				"fairness",
+			),
+		},
+		Concurrency{Fairness: "tenant"}.Validate(),
+	)
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: -1 is not greater than 0", cfgerror.ErrNotInRange),
+				"fairness_weights", "gitlab-ci",
+			),
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: 0 is not greater than 0", cfgerror.ErrNotInRange),
+				"fairness_weights", "gitlab-web",
+			),
+		},
+		Concurrency{
+			Fairness:        FairnessClientName,
+			FairnessWeights: map[string]int{"gitlab-web": 0, "gitlab-ci": -1},
+		}.Validate(),
+	)
+	require.NoError(t, Concurrency{Adaptive: true, MinLimit: 1, InitialLimit: 1, MaxLimit: 1}.Validate())
+	require.NoError(t, Concurrency{Adaptive: true, MinLimit: 1, InitialLimit: 5, MaxLimit: 10}.Validate())
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: 0 is not greater than 0", cfgerror.ErrNotInRange),
+				"min_limit",
+			),
+		},
+		Concurrency{Adaptive: true, InitialLimit: 0, MaxLimit: 10}.Validate(),
+	)
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: 5 is not greater than or equal to 10", cfgerror.ErrNotInRange),
+				"max_limit",
+			),
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: 20 out of [10, 5]", cfgerror.ErrNotInRange),
+				"initial_limit",
+			),
+		},
+		Concurrency{Adaptive: true, MinLimit: 10, InitialLimit: 20, MaxLimit: 5}.Validate(),
+	)
+}
+
+func TestAdaptiveLimiting_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, AdaptiveLimiting{}.Validate())
+	require.NoError(t, AdaptiveLimiting{
+		QueueTimeThreshold:      duration.Duration(time.Second),
+		SpawnTokenWaitThreshold: duration.Duration(time.Millisecond),
+		LatencyThresholds:       map[string]duration.Duration{"/gitaly.CommitService/FindCommit": duration.Duration(time.Second)},
+	}.Validate())
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: -1s is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+				"queue_time_threshold",
+			),
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: -1s is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+				"spawn_token_wait_threshold",
+			),
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: 0s is not greater than 0s", cfgerror.ErrNotInRange),
+				"latency_thresholds", "/gitaly.CommitService/FindCommit",
+			),
+		},
+		AdaptiveLimiting{
+			QueueTimeThreshold:      duration.Duration(-time.Second),
+			SpawnTokenWaitThreshold: duration.Duration(-time.Second),
+			LatencyThresholds:       map[string]duration.Duration{"/gitaly.CommitService/FindCommit": 0},
+		}.Validate(),
+	)
+}
+
+func TestLoadAdaptiveLimiting(t *testing.T) {
+	cfg, err := Load(strings.NewReader(`
+		[adaptive_limiting]
+		queue_time_threshold = "1s"
+		spawn_token_wait_threshold = "100ms"
+
+		[adaptive_limiting.latency_thresholds]
+		"/gitaly.SmartHTTPService/PostUploadPackWithSidechannel" = "30s"
+
+		[[concurrency]]
+		rpc = "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel"
+		adaptive = true
+		min_limit = 1
+		initial_limit = 10
+		max_limit = 20
+	`))
+	require.NoError(t, err)
+
+	require.Equal(t, AdaptiveLimiting{
+		QueueTimeThreshold:      duration.Duration(time.Second),
+		SpawnTokenWaitThreshold: duration.Duration(100 * time.Millisecond),
+		LatencyThresholds: map[string]duration.Duration{
+			"/gitaly.SmartHTTPService/PostUploadPackWithSidechannel": duration.Duration(30 * time.Second),
+		},
+	}, cfg.AdaptiveLimiting)
+	require.Equal(t, []Concurrency{{
+		RPC:          "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel",
+		Adaptive:     true,
+		MinLimit:     1,
+		InitialLimit: 10,
+		MaxLimit:     20,
+	}}, cfg.Concurrency)
+}
+
 func TestStorage_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -1769,6 +1897,40 @@ func TestStorage_Validate(t *testing.T) {
 			name:    "valid",
 			storage: Storage{Name: "name", Path: dirPath},
 		},
+		{
+			name: "valid with signature verification",
+			storage: Storage{
+				Name: "name",
+				Path: dirPath,
+				SignatureVerification: SignatureVerification{
+					GPGKeyring:        filePath,
+					SSHAllowedSigners: filePath,
+					SSHRevokedKeys:    filePath,
+					X509CAFile:        filePath,
+				},
+			},
+		},
+		{
+			name: "invalid signature verification",
+			storage: Storage{
+				Name: "name",
+				Path: dirPath,
+				SignatureVerification: SignatureVerification{
+					GPGKeyring: dirPath,
+					X509CAFile: filepath.Join(dirPath, "missing"),
+				},
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: %q", cfgerror.ErrNotFile, dirPath),
+					"signature_verification", "gpg_keyring",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: %q", cfgerror.ErrDoesntExist, filepath.Join(dirPath, "missing")),
+					"signature_verification", "x509_ca_file",
+				),
+			},
+		},
 		{
 			name:    "invalid",
 			storage: Storage{Name: "", Path: filePath},
@@ -2214,6 +2376,20 @@ func TestBackupConfig_Validate(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "encryption_keyring missing",
+			backupConfig: BackupConfig{
+				GoCloudURL:        "s3://my-bucket",
+				Layout:            "pointer",
+				EncryptionKeyring: "/does/not/exist",
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: %q", cfgerror.ErrDoesntExist, "/does/not/exist"),
+					"encryption_keyring",
+				),
+			},
+		},
 	} {
 		tc := tc
 
@@ -2226,6 +2402,42 @@ func TestBackupConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestImmutableCache_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ImmutableCache{}.Validate())
+	require.NoError(t, ImmutableCache{MaxBytes: -1}.Validate())
+	require.NoError(t, ImmutableCache{Enabled: true, MaxBytes: 1}.Validate())
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf("%w: 0 is not greater than 0", cfgerror.ErrNotInRange),
+				"max_bytes",
+			),
+		},
+		ImmutableCache{Enabled: true}.Validate(),
+	)
+}
+
+func TestTransactions_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Transactions{}.Validate())
+	require.NoError(t, Transactions{Database: TransactionsDatabaseBadger}.Validate())
+	require.NoError(t, Transactions{Database: TransactionsDatabaseBbolt}.Validate())
+	require.Equal(
+		t,
+		cfgerror.ValidationErrors{
+			cfgerror.NewValidationError(
+				fmt.Errorf(`%w: "leveldb"`, cfgerror.ErrUnsupportedValue),
+				"database",
+			),
+		},
+		Transactions{Database: "leveldb"}.Validate(),
+	)
+}
+
 func TestStreamCacheConfig_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -145,20 +145,27 @@ func (s *GitalyServerFactory) New(secure bool, opts ...Option) (*grpc.Server, er
 
 	streamServerInterceptors = append(streamServerInterceptors,
 		grpctracing.StreamServerTracingInterceptor(),
-		cache.StreamInvalidator(s.cacheInvalidator, protoregistry.GitalyProtoPreregistered, s.logger),
-		// Panic handler should remain last so that application panics will be
-		// converted to errors and logged
-		panichandler.StreamPanicHandler(s.logger),
+		cache.StreamInvalidator(s.diskCache, protoregistry.GitalyProtoPreregistered, s.logger),
 	)
-
 	unaryServerInterceptors = append(unaryServerInterceptors,
 		grpctracing.UnaryServerTracingInterceptor(),
-		cache.UnaryInvalidator(s.cacheInvalidator, protoregistry.GitalyProtoPreregistered, s.logger),
-		// Panic handler should remain last so that application panics will be
-		// converted to errors and logged
-		panichandler.UnaryPanicHandler(s.logger),
+		cache.UnaryInvalidator(s.diskCache, protoregistry.GitalyProtoPreregistered, s.logger),
 	)
 
+	if s.cfg.ImmutableCache.Enabled {
+		streamServerInterceptors = append(streamServerInterceptors,
+			cache.StreamImmutableCache(s.diskCache, protoregistry.GitalyProtoPreregistered, s.logger),
+		)
+		unaryServerInterceptors = append(unaryServerInterceptors,
+			cache.UnaryImmutableCache(s.diskCache, protoregistry.GitalyProtoPreregistered, s.logger),
+		)
+	}
+
+	// Panic handler should remain last so that application panics will be
+	// converted to errors and logged
+	streamServerInterceptors = append(streamServerInterceptors, panichandler.StreamPanicHandler(s.logger))
+	unaryServerInterceptors = append(unaryServerInterceptors, panichandler.UnaryPanicHandler(s.logger))
+
 	streamServerInterceptors = append(streamServerInterceptors, cfg.streamInterceptors...)
 	unaryServerInterceptors = append(unaryServerInterceptors, cfg.unaryInterceptors...)
 
@@ -13,13 +13,13 @@ import (
 
 // GitalyServerFactory is a factory of gitaly grpc servers
 type GitalyServerFactory struct {
-	registry         *backchannel.Registry
-	cacheInvalidator cache.Invalidator
-	limitHandlers    []*limithandler.LimiterMiddleware
-	cfg              config.Cfg
-	logger           log.Logger
-	externalServers  []*grpc.Server
-	internalServers  []*grpc.Server
+	registry        *backchannel.Registry
+	diskCache       cache.Cache
+	limitHandlers   []*limithandler.LimiterMiddleware
+	cfg             config.Cfg
+	logger          log.Logger
+	externalServers []*grpc.Server
+	internalServers []*grpc.Server
 }
 
 // NewGitalyServerFactory allows to create and start secure/insecure 'grpc.Server's.
@@ -27,15 +27,15 @@ func NewGitalyServerFactory(
 	cfg config.Cfg,
 	logger log.Logger,
 	registry *backchannel.Registry,
-	cacheInvalidator cache.Invalidator,
+	diskCache cache.Cache,
 	limitHandlers []*limithandler.LimiterMiddleware,
 ) *GitalyServerFactory {
 	return &GitalyServerFactory{
-		cfg:              cfg,
-		logger:           logger,
-		registry:         registry,
-		cacheInvalidator: cacheInvalidator,
-		limitHandlers:    limitHandlers,
+		cfg:           cfg,
+		logger:        logger,
+		registry:      registry,
+		diskCache:     diskCache,
+		limitHandlers: limitHandlers,
 	}
 }
 
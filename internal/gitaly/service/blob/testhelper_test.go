@@ -40,6 +40,7 @@ func setup(tb testing.TB, ctx context.Context) (config.Cfg, gitalypb.BlobService
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	})
 	cfg.SocketPath = addr
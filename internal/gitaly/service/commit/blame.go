@@ -0,0 +1,639 @@
+package commit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/tempdir"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var blameOutOfRangeErrorRegexp = regexp.MustCompile("^fatal: file .* has only \\d+ lines?\n$")
+
+func (s *server) Blame(in *gitalypb.BlameRequest, stream gitalypb.CommitService_BlameServer) error {
+	if err := validateBlameRequest(s.locator, in); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	startLine, err := decodeBlamePageToken(in.GetPaginationParams().GetPageToken())
+	if err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	// Without pagination parameters the whole file is blamed. Otherwise, the limit is interpreted the same
+	// as for all other paginated RPCs.
+	limit := -1
+	if in.GetPaginationParams() != nil {
+		limit = int(in.GetPaginationParams().GetLimit())
+		if limit == 0 {
+			return nil
+		}
+	}
+
+	ctx := stream.Context()
+
+	repo := s.localrepo(in.GetRepository())
+	revision := string(in.GetRevision())
+	path := string(in.GetPath())
+
+	var flags []git.Option
+	if ignoreRevisionsPath := in.GetIgnoreRevisionsPath(); len(ignoreRevisionsPath) > 0 {
+		ignoreRevsFile, err := s.writeIgnoredRevisions(ctx, repo, revision, ignoreRevisionsPath)
+		if err != nil {
+			return err
+		}
+
+		flags = append(flags, git.ValueFlag{Name: "--ignore-revs-file", Value: ignoreRevsFile})
+	}
+
+	sender := &blameSender{stream: stream}
+	chunker := chunk.New(sender)
+	sentCommits := map[string]bool{}
+
+	var nextCursor string
+	var sentRanges int
+	sendRange := func(commit *gitalypb.BlameResponse_Commit, blameRange *gitalypb.BlameResponse_Range) error {
+		if limit >= 0 && sentRanges >= limit {
+			nextCursor = strconv.FormatUint(blameRange.GetStartLine(), 10)
+			return errBlameLimitReached
+		}
+
+		if !sentCommits[commit.GetId()] {
+			sentCommits[commit.GetId()] = true
+
+			if err := chunker.Send(commit); err != nil {
+				return fmt.Errorf("sending commit: %w", err)
+			}
+		}
+
+		if err := chunker.Send(blameRange); err != nil {
+			return fmt.Errorf("sending range: %w", err)
+		}
+		sentRanges++
+
+		return nil
+	}
+
+	// git-blame(1) only prints its porcelain output once the whole requested range has been blamed. Pages are
+	// thus blamed in windows of lines so that blaming a page doesn't require blaming the remainder of the file.
+	// Without pagination the whole file is blamed at once.
+	windowLines := uint64(0)
+	if limit >= 0 {
+		windowLines = blameWindowLines
+		if uint64(limit) > windowLines {
+			windowLines = uint64(limit)
+		}
+	}
+
+	// The last range of a window is held back until the next window has been blamed, as the range may
+	// continue in the next window.
+	var heldCommit *gitalypb.BlameResponse_Commit
+	var heldRange *gitalypb.BlameResponse_Range
+
+	err = func() error {
+		for windowStart := startLine; ; {
+			var blamedLines uint64
+			err := s.blameWindow(ctx, repo, flags, revision, path, windowStart, windowLines, func(commit *gitalypb.BlameResponse_Commit, blameRange *gitalypb.BlameResponse_Range) error {
+				firstRange := blamedLines == 0
+				blamedLines += blameRange.GetLineCount()
+
+				if heldRange != nil {
+					// Only ranges split at the window boundary are merged, as git-blame(1) may
+					// emit separate ranges for contiguous lines, e.g. when revisions are ignored.
+					if firstRange && continuesBlameRange(heldRange, blameRange) {
+						heldRange.LineCount += blameRange.GetLineCount()
+						return nil
+					}
+
+					if err := sendRange(heldCommit, heldRange); err != nil {
+						return err
+					}
+				}
+
+				heldCommit, heldRange = commit, blameRange
+				return nil
+			})
+			if err != nil {
+				// The previous window may have ended exactly at the end of the file.
+				var outOfRange blameOutOfRangeError
+				if windowStart == startLine || !errors.As(err, &outOfRange) {
+					return err
+				}
+			}
+
+			if windowLines == 0 || blamedLines < windowLines {
+				break
+			}
+
+			windowStart += blamedLines
+		}
+
+		if heldRange != nil {
+			return sendRange(heldCommit, heldRange)
+		}
+
+		return nil
+	}()
+	if err != nil && !errors.Is(err, errBlameLimitReached) {
+		var outOfRange blameOutOfRangeError
+		if errors.As(err, &outOfRange) {
+			return blameError(outOfRange.err, outOfRange.stderr, path, revision, in.GetPath())
+		}
+
+		return err
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return fmt.Errorf("flushing blame: %w", err)
+	}
+
+	if nextCursor != "" {
+		if err := stream.Send(&gitalypb.BlameResponse{
+			PaginationCursor: &gitalypb.PaginationCursor{NextCursor: nextCursor},
+		}); err != nil {
+			return fmt.Errorf("sending pagination cursor: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// blameWindowLines is the minimum number of lines blamed at once when a page of the blame is requested.
+const blameWindowLines = 1000
+
+// blameOutOfRangeError is returned by blameWindow if the window starts after the end of the file.
+type blameOutOfRangeError struct {
+	err    error
+	stderr string
+}
+
+func (e blameOutOfRangeError) Error() string {
+	return fmt.Sprintf("blame out of range: %s", e.err)
+}
+
+// blameWindow blames the given number of lines starting at the 1-based start line and invokes the callback for each
+// of the line ranges. If lines is zero, the remainder of the file is blamed. Parsing stops as soon as the callback
+// returns an error, which is then returned.
+func (s *server) blameWindow(
+	ctx context.Context,
+	repo *localrepo.Repo,
+	flags []git.Option,
+	revision, path string,
+	startLine, lines uint64,
+	callback func(*gitalypb.BlameResponse_Commit, *gitalypb.BlameResponse_Range) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	flags = append([]git.Option{git.Flag{Name: "--porcelain"}}, flags...)
+	if lines > 0 {
+		flags = append(flags, git.ValueFlag{Name: "-L", Value: fmt.Sprintf("%d,+%d", startLine, lines)})
+	} else if startLine > 1 {
+		flags = append(flags, git.ValueFlag{Name: "-L", Value: fmt.Sprintf("%d,", startLine)})
+	}
+
+	var stderr strings.Builder
+	cmd, err := repo.Exec(ctx, git.Command{
+		Name:        "blame",
+		Flags:       flags,
+		Args:        []string{revision},
+		PostSepArgs: []string{path},
+	}, git.WithSetupStdout(), git.WithStderr(&stderr))
+	if err != nil {
+		return fmt.Errorf("starting blame: %w", err)
+	}
+
+	parseErr := parseBlamePorcelain(cmd, callback)
+	if parseErr != nil && !errors.Is(parseErr, errBlameLimitReached) {
+		parseErr = structerr.NewInternal("parsing blame: %w", parseErr)
+	}
+
+	if errors.Is(parseErr, errBlameLimitReached) {
+		// We're not interested in the remaining output, so we can stop blaming the file.
+		cancel()
+		_ = cmd.Wait()
+		return parseErr
+	}
+
+	if err := cmd.Wait(); err != nil {
+		if blameOutOfRangeErrorRegexp.MatchString(stderr.String()) {
+			return blameOutOfRangeError{err: err, stderr: stderr.String()}
+		}
+
+		return blameError(err, stderr.String(), path, revision, []byte(path))
+	}
+
+	return parseErr
+}
+
+// continuesBlameRange determines whether the range continues the previous range. This is the case when a range of
+// lines blamed to the same commit has been split up at the boundary of two blamed windows.
+func continuesBlameRange(previous, blameRange *gitalypb.BlameResponse_Range) bool {
+	return previous.GetCommitId() == blameRange.GetCommitId() &&
+		previous.GetStartLine()+previous.GetLineCount() == blameRange.GetStartLine() &&
+		previous.GetOriginalStartLine()+previous.GetLineCount() == blameRange.GetOriginalStartLine() &&
+		bytes.Equal(previous.GetOriginalPath(), blameRange.GetOriginalPath())
+}
+
+func blameError(err error, stderr, path, revision string, rawPath []byte) error {
+	if strings.HasPrefix(stderr, "fatal: no such path ") {
+		return structerr.NewNotFound("path not found in revision").
+			WithMetadata("path", path).
+			WithMetadata("revision", revision).
+			WithDetail(&gitalypb.BlameError{
+				Error: &gitalypb.BlameError_PathNotFound{
+					PathNotFound: &gitalypb.PathNotFoundError{
+						Path: rawPath,
+					},
+				},
+			})
+	}
+
+	if blameOutOfRangeErrorRegexp.MatchString(stderr) {
+		return structerr.NewInvalidArgument("page token is outside of the file length").
+			WithMetadata("path", path).
+			WithMetadata("revision", revision)
+	}
+
+	return structerr.New("blaming file: %w", err).WithMetadata("stderr", stderr)
+}
+
+// writeIgnoredRevisions reads the revisions that are to be ignored from the blob at the given path and
+// writes them into a temporary file that can be passed to git-blame(1). The revisions are validated so
+// that invalid entries result in a proper error instead of git-blame(1) dying.
+func (s *server) writeIgnoredRevisions(ctx context.Context, repo *localrepo.Repo, revision string, path []byte) (string, error) {
+	objectInfo, err := repo.ReadObjectInfo(ctx, git.Revision(revision+":"+string(path)))
+	if err != nil {
+		if errors.As(err, new(localrepo.InvalidObjectError)) {
+			return "", structerr.NewNotFound("ignore revisions path not found in revision").
+				WithMetadata("path", string(path)).
+				WithMetadata("revision", revision).
+				WithDetail(&gitalypb.BlameError{
+					Error: &gitalypb.BlameError_IgnoreRevisionsPathNotFound{
+						IgnoreRevisionsPathNotFound: &gitalypb.PathNotFoundError{
+							Path: path,
+						},
+					},
+				})
+		}
+
+		return "", fmt.Errorf("reading ignore revisions info: %w", err)
+	}
+
+	if objectInfo.Type != "blob" {
+		return "", structerr.NewInvalidArgument("ignore revisions path is not a blob").
+			WithMetadata("path", string(path)).
+			WithMetadata("type", objectInfo.Type)
+	}
+
+	content, err := repo.ReadObject(ctx, objectInfo.Oid)
+	if err != nil {
+		return "", fmt.Errorf("reading ignore revisions: %w", err)
+	}
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return "", fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	ignoredRevisions, err := parseIgnoredRevisions(objectHash, content)
+	if err != nil {
+		return "", structerr.NewInvalidArgument("%w", err).WithMetadata("path", string(path))
+	}
+
+	tempDir, err := tempdir.New(ctx, repo.GetStorageName(), s.locator)
+	if err != nil {
+		return "", fmt.Errorf("creating temporary directory: %w", err)
+	}
+
+	ignoreRevsFile := filepath.Join(tempDir.Path(), "ignore-revs")
+	if err := os.WriteFile(ignoreRevsFile, ignoredRevisions, perm.PrivateFile); err != nil {
+		return "", fmt.Errorf("writing ignore revisions: %w", err)
+	}
+
+	return ignoreRevsFile, nil
+}
+
+// parseIgnoredRevisions parses the ignored revisions in the format accepted by the `--ignore-revs-file`
+// option of git-blame(1) and returns them as newline-separated list of object IDs.
+func parseIgnoredRevisions(objectHash git.ObjectHash, content []byte) ([]byte, error) {
+	var ignoredRevisions bytes.Buffer
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if err := objectHash.ValidateHex(line); err != nil {
+			return nil, fmt.Errorf("invalid ignored revision %q: %w", line, err)
+		}
+
+		ignoredRevisions.WriteString(line + "\n")
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning ignored revisions: %w", err)
+	}
+
+	return ignoredRevisions.Bytes(), nil
+}
+
+func validateBlameRequest(locator storage.Locator, in *gitalypb.BlameRequest) error {
+	if err := locator.ValidateRepository(in.GetRepository()); err != nil {
+		return err
+	}
+	if err := git.ValidateRevision(in.GetRevision()); err != nil {
+		return err
+	}
+
+	if len(in.GetPath()) == 0 {
+		return fmt.Errorf("empty Path")
+	}
+
+	if !filepath.IsLocal(string(in.GetPath())) {
+		return structerr.NewInvalidArgument("path escapes repository root").
+			WithMetadata("path", string(in.GetPath()))
+	}
+
+	if ignoreRevisionsPath := in.GetIgnoreRevisionsPath(); len(ignoreRevisionsPath) > 0 && !filepath.IsLocal(string(ignoreRevisionsPath)) {
+		return structerr.NewInvalidArgument("ignore revisions path escapes repository root").
+			WithMetadata("path", string(ignoreRevisionsPath))
+	}
+
+	return nil
+}
+
+// decodeBlamePageToken decodes the page token into the 1-based line number the blame should start at.
+func decodeBlamePageToken(token string) (uint64, error) {
+	if token == "" {
+		return 1, nil
+	}
+
+	startLine, err := strconv.ParseUint(token, 10, 64)
+	if err != nil || startLine == 0 {
+		return 0, fmt.Errorf("invalid page token %q", token)
+	}
+
+	return startLine, nil
+}
+
+// errBlameLimitReached is returned by the range callback to stop parsing once the limit of line ranges
+// has been reached.
+var errBlameLimitReached = errors.New("blame limit reached")
+
+// parseBlamePorcelain parses the output of `git blame --porcelain` and invokes the callback for each line
+// range with the commit the range has been blamed to. Parsing stops as soon as the callback returns an
+// error.
+func parseBlamePorcelain(r io.Reader, callback func(*gitalypb.BlameResponse_Commit, *gitalypb.BlameResponse_Range) error) error {
+	commits := map[string]*gitalypb.BlameResponse_Commit{}
+	// paths tracks the current path of the blamed file per commit. git-blame(1) only prints the path
+	// in case it differs from the previously printed path for the same commit.
+	paths := map[string][]byte{}
+
+	var current *gitalypb.BlameResponse_Commit
+	var pending *gitalypb.BlameResponse_Range
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 10*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		// Lines of the blamed file are prefixed with a tab and terminate the headers of a line.
+		if bytes.HasPrefix(line, []byte("\t")) {
+			if pending != nil {
+				pending.OriginalPath = paths[pending.GetCommitId()]
+				if err := callback(current, pending); err != nil {
+					return err
+				}
+				pending = nil
+			}
+
+			continue
+		}
+
+		key, value, _ := bytes.Cut(line, []byte(" "))
+
+		if header, ok := parseBlameHeader(key, value); ok {
+			commit, ok := commits[header.commitID]
+			if !ok {
+				commit = &gitalypb.BlameResponse_Commit{Id: header.commitID}
+				commits[header.commitID] = commit
+			}
+			current = commit
+
+			// Only the first line of a group of lines carries the number of lines in the group.
+			if header.lineCount > 0 {
+				pending = &gitalypb.BlameResponse_Range{
+					CommitId:          header.commitID,
+					StartLine:         header.finalLine,
+					LineCount:         header.lineCount,
+					OriginalStartLine: header.originalLine,
+				}
+			}
+
+			continue
+		}
+
+		if current == nil {
+			return fmt.Errorf("unexpected line before header: %q", line)
+		}
+
+		if err := parseBlameCommitHeader(current, paths, key, value); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanning blame: %w", err)
+	}
+
+	if pending != nil {
+		return fmt.Errorf("missing line for range starting at line %d", pending.GetStartLine())
+	}
+
+	return nil
+}
+
+type blameHeader struct {
+	commitID     string
+	originalLine uint64
+	finalLine    uint64
+	lineCount    uint64
+}
+
+// parseBlameHeader parses the header of a blamed line which has the format `<oid> <original-line>
+// <final-line> [<line-count>]`.
+func parseBlameHeader(key, value []byte) (blameHeader, bool) {
+	if !isHexObjectID(key) {
+		return blameHeader{}, false
+	}
+
+	fields := strings.Fields(string(value))
+	if len(fields) != 2 && len(fields) != 3 {
+		return blameHeader{}, false
+	}
+
+	numbers := make([]uint64, len(fields))
+	for i, field := range fields {
+		number, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return blameHeader{}, false
+		}
+		numbers[i] = number
+	}
+
+	header := blameHeader{
+		commitID:     string(key),
+		originalLine: numbers[0],
+		finalLine:    numbers[1],
+	}
+	if len(numbers) == 3 {
+		header.lineCount = numbers[2]
+	}
+
+	return header, true
+}
+
+func isHexObjectID(key []byte) bool {
+	if len(key) != git.ObjectHashSHA1.EncodedLen() && len(key) != git.ObjectHashSHA256.EncodedLen() {
+		return false
+	}
+
+	for _, c := range key {
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseBlameCommitHeader parses a header describing the commit a line has been blamed to.
+func parseBlameCommitHeader(commit *gitalypb.BlameResponse_Commit, paths map[string][]byte, key, value []byte) error {
+	switch string(key) {
+	case "author":
+		blameAuthor(&commit.Author).Name = bytes.Clone(value)
+	case "author-mail":
+		blameAuthor(&commit.Author).Email = bytes.Trim(value, "<>")
+	case "author-time":
+		date, err := parseBlameTime(value)
+		if err != nil {
+			return err
+		}
+		blameAuthor(&commit.Author).Date = date
+	case "author-tz":
+		blameAuthor(&commit.Author).Timezone = bytes.Clone(value)
+	case "committer":
+		blameAuthor(&commit.Committer).Name = bytes.Clone(value)
+	case "committer-mail":
+		blameAuthor(&commit.Committer).Email = bytes.Trim(value, "<>")
+	case "committer-time":
+		date, err := parseBlameTime(value)
+		if err != nil {
+			return err
+		}
+		blameAuthor(&commit.Committer).Date = date
+	case "committer-tz":
+		blameAuthor(&commit.Committer).Timezone = bytes.Clone(value)
+	case "summary":
+		commit.Summary = bytes.Clone(value)
+	case "previous":
+		previousCommitID, previousPath, ok := bytes.Cut(value, []byte(" "))
+		if !ok {
+			return fmt.Errorf("invalid previous header: %q", value)
+		}
+
+		path, err := unquoteBlamePath(previousPath)
+		if err != nil {
+			return err
+		}
+
+		commit.PreviousCommitId = string(previousCommitID)
+		commit.PreviousPath = path
+	case "boundary":
+		commit.Boundary = true
+	case "filename":
+		path, err := unquoteBlamePath(value)
+		if err != nil {
+			return err
+		}
+
+		paths[commit.GetId()] = path
+	}
+
+	// Unknown headers like "ignored" or "unblamable" are skipped.
+	return nil
+}
+
+func blameAuthor(author **gitalypb.CommitAuthor) *gitalypb.CommitAuthor {
+	if *author == nil {
+		*author = &gitalypb.CommitAuthor{}
+	}
+	return *author
+}
+
+func parseBlameTime(value []byte) (*timestamppb.Timestamp, error) {
+	seconds, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing time: %w", err)
+	}
+
+	return &timestamppb.Timestamp{Seconds: seconds}, nil
+}
+
+// unquoteBlamePath unquotes paths that have been C-quoted by Git because they contain special characters.
+func unquoteBlamePath(path []byte) ([]byte, error) {
+	if !bytes.HasPrefix(path, []byte(`"`)) {
+		return bytes.Clone(path), nil
+	}
+
+	unquoted, err := strconv.Unquote(string(path))
+	if err != nil {
+		return nil, fmt.Errorf("unquoting path %q: %w", path, err)
+	}
+
+	return []byte(unquoted), nil
+}
+
+type blameSender struct {
+	stream   gitalypb.CommitService_BlameServer
+	response *gitalypb.BlameResponse
+}
+
+func (s *blameSender) Reset() {
+	s.response = &gitalypb.BlameResponse{}
+}
+
+func (s *blameSender) Append(m proto.Message) {
+	switch m := m.(type) {
+	case *gitalypb.BlameResponse_Commit:
+		s.response.Commits = append(s.response.Commits, m)
+	case *gitalypb.BlameResponse_Range:
+		s.response.Ranges = append(s.response.Ranges, m)
+	}
+}
+
+func (s *blameSender) Send() error {
+	return s.stream.Send(s.response)
+}
@@ -0,0 +1,652 @@
+package commit
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestBlame(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupCommitService(t, ctx)
+
+	type setupData struct {
+		request          *gitalypb.BlameRequest
+		expectedErr      error
+		expectedResponse *gitalypb.BlameResponse
+	}
+
+	// setupHistory writes two commits, where the second commit changes the first line of the file
+	// written by the first commit.
+	setupHistory := func(t *testing.T, extraEntries ...gittest.TreeEntry) (*gitalypb.Repository, git.ObjectID, git.ObjectID) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+		commitA := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "path", Mode: "100644", Content: "a\n1\n1\n"},
+		))
+		commitB := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+			append([]gittest.TreeEntry{
+				{Path: "path", Mode: "100644", Content: "b\n1\n1\n"},
+			}, extraEntries...)...,
+		), gittest.WithParents(commitA))
+
+		return repo, commitA, commitB
+	}
+
+	commitA := func(id git.ObjectID) *gitalypb.BlameResponse_Commit {
+		return &gitalypb.BlameResponse_Commit{
+			Id:        id.String(),
+			Author:    gittest.DefaultCommitAuthor,
+			Committer: gittest.DefaultCommitAuthor,
+			Summary:   []byte("message"),
+			Boundary:  true,
+		}
+	}
+
+	commitB := func(id, parent git.ObjectID) *gitalypb.BlameResponse_Commit {
+		return &gitalypb.BlameResponse_Commit{
+			Id:               id.String(),
+			Author:           gittest.DefaultCommitAuthor,
+			Committer:        gittest.DefaultCommitAuthor,
+			Summary:          []byte("message"),
+			PreviousCommitId: parent.String(),
+			PreviousPath:     []byte("path"),
+		}
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		setup func(t *testing.T) setupData
+	}{
+		{
+			desc: "unset repository",
+			setup: func(t *testing.T) setupData {
+				return setupData{
+					request:     &gitalypb.BlameRequest{},
+					expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+				}
+			},
+		},
+		{
+			desc: "unset revision",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+					},
+					expectedErr: structerr.NewInvalidArgument("empty revision"),
+				}
+			},
+		},
+		{
+			desc: "unset path",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+						Revision:   []byte("abcdef"),
+					},
+					expectedErr: structerr.NewInvalidArgument("empty Path"),
+				}
+			},
+		},
+		{
+			desc: "path escapes repository root",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+						Revision:   []byte("abcdef"),
+						Path:       []byte("../escape"),
+					},
+					expectedErr: testhelper.ToInterceptedMetadata(
+						structerr.NewInvalidArgument("path escapes repository root").
+							WithMetadata("path", "../escape"),
+					),
+				}
+			},
+		},
+		{
+			desc: "ignore revisions path escapes repository root",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:          repo,
+						Revision:            []byte("abcdef"),
+						Path:                []byte("path"),
+						IgnoreRevisionsPath: []byte("../escape"),
+					},
+					expectedErr: testhelper.ToInterceptedMetadata(
+						structerr.NewInvalidArgument("ignore revisions path escapes repository root").
+							WithMetadata("path", "../escape"),
+					),
+				}
+			},
+		},
+		{
+			desc: "invalid page token",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:       repo,
+						Revision:         []byte("abcdef"),
+						Path:             []byte("path"),
+						PaginationParams: &gitalypb.PaginationParameter{PageToken: "foo", Limit: 1},
+					},
+					expectedErr: structerr.NewInvalidArgument(`invalid page token "foo"`),
+				}
+			},
+		},
+		{
+			desc: "missing path",
+			setup: func(t *testing.T) setupData {
+				repo, _, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+						Revision:   []byte(commit),
+						Path:       []byte("does-not-exist"),
+					},
+					expectedErr: testhelper.ToInterceptedMetadata(
+						structerr.NewNotFound("path not found in revision").
+							WithMetadata("revision", commit.String()).
+							WithMetadata("path", "does-not-exist").
+							WithDetail(&gitalypb.BlameError{
+								Error: &gitalypb.BlameError_PathNotFound{
+									PathNotFound: &gitalypb.PathNotFoundError{
+										Path: []byte("does-not-exist"),
+									},
+								},
+							}),
+					),
+				}
+			},
+		},
+		{
+			desc: "page token outside of the file length",
+			setup: func(t *testing.T) setupData {
+				repo, _, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:       repo,
+						Revision:         []byte(commit),
+						Path:             []byte("path"),
+						PaginationParams: &gitalypb.PaginationParameter{PageToken: "10", Limit: 1},
+					},
+					expectedErr: testhelper.ToInterceptedMetadata(
+						structerr.NewInvalidArgument("page token is outside of the file length").
+							WithMetadata("revision", commit.String()).
+							WithMetadata("path", "path"),
+					),
+				}
+			},
+		},
+		{
+			desc: "whole file",
+			setup: func(t *testing.T) setupData {
+				repo, parent, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+						Revision:   []byte(commit),
+						Path:       []byte("path"),
+					},
+					expectedResponse: &gitalypb.BlameResponse{
+						Commits: []*gitalypb.BlameResponse_Commit{
+							commitB(commit, parent),
+							commitA(parent),
+						},
+						Ranges: []*gitalypb.BlameResponse_Range{
+							{CommitId: commit.String(), StartLine: 1, LineCount: 1, OriginalStartLine: 1, OriginalPath: []byte("path")},
+							{CommitId: parent.String(), StartLine: 2, LineCount: 2, OriginalStartLine: 2, OriginalPath: []byte("path")},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "empty file",
+			setup: func(t *testing.T) setupData {
+				repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				emptyBlob := gittest.WriteBlob(t, cfg, repoPath, []byte{})
+				commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "path", Mode: "100644", OID: emptyBlob},
+				))
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+						Revision:   []byte(commit),
+						Path:       []byte("path"),
+					},
+					expectedResponse: &gitalypb.BlameResponse{},
+				}
+			},
+		},
+		{
+			desc: "renamed file",
+			setup: func(t *testing.T) setupData {
+				repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				parent := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "old path", Mode: "100644", Content: "a\n1\n1\n"},
+				))
+				commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "new\tpath", Mode: "100644", Content: "b\n1\n1\n"},
+				), gittest.WithParents(parent))
+
+				expectedCommit := commitB(commit, parent)
+				expectedCommit.PreviousPath = []byte("old path")
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository: repo,
+						Revision:   []byte(commit),
+						Path:       []byte("new\tpath"),
+					},
+					expectedResponse: &gitalypb.BlameResponse{
+						Commits: []*gitalypb.BlameResponse_Commit{
+							expectedCommit,
+							commitA(parent),
+						},
+						Ranges: []*gitalypb.BlameResponse_Range{
+							{CommitId: commit.String(), StartLine: 1, LineCount: 1, OriginalStartLine: 1, OriginalPath: []byte("new\tpath")},
+							{CommitId: parent.String(), StartLine: 2, LineCount: 2, OriginalStartLine: 2, OriginalPath: []byte("old path")},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "zero limit",
+			setup: func(t *testing.T) setupData {
+				repo, _, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:       repo,
+						Revision:         []byte(commit),
+						Path:             []byte("path"),
+						PaginationParams: &gitalypb.PaginationParameter{},
+					},
+					expectedResponse: &gitalypb.BlameResponse{},
+				}
+			},
+		},
+		{
+			desc: "first page",
+			setup: func(t *testing.T) setupData {
+				repo, parent, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:       repo,
+						Revision:         []byte(commit),
+						Path:             []byte("path"),
+						PaginationParams: &gitalypb.PaginationParameter{Limit: 1},
+					},
+					expectedResponse: &gitalypb.BlameResponse{
+						Commits: []*gitalypb.BlameResponse_Commit{
+							commitB(commit, parent),
+						},
+						Ranges: []*gitalypb.BlameResponse_Range{
+							{CommitId: commit.String(), StartLine: 1, LineCount: 1, OriginalStartLine: 1, OriginalPath: []byte("path")},
+						},
+						PaginationCursor: &gitalypb.PaginationCursor{NextCursor: "2"},
+					},
+				}
+			},
+		},
+		{
+			desc: "last page",
+			setup: func(t *testing.T) setupData {
+				repo, parent, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:       repo,
+						Revision:         []byte(commit),
+						Path:             []byte("path"),
+						PaginationParams: &gitalypb.PaginationParameter{PageToken: "2", Limit: 1},
+					},
+					expectedResponse: &gitalypb.BlameResponse{
+						Commits: []*gitalypb.BlameResponse_Commit{
+							commitA(parent),
+						},
+						Ranges: []*gitalypb.BlameResponse_Range{
+							{CommitId: parent.String(), StartLine: 2, LineCount: 2, OriginalStartLine: 2, OriginalPath: []byte("path")},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "page starting in the middle of a range",
+			setup: func(t *testing.T) setupData {
+				repo, parent, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:       repo,
+						Revision:         []byte(commit),
+						Path:             []byte("path"),
+						PaginationParams: &gitalypb.PaginationParameter{PageToken: "3", Limit: -1},
+					},
+					expectedResponse: &gitalypb.BlameResponse{
+						Commits: []*gitalypb.BlameResponse_Commit{
+							commitA(parent),
+						},
+						Ranges: []*gitalypb.BlameResponse_Range{
+							{CommitId: parent.String(), StartLine: 3, LineCount: 1, OriginalStartLine: 3, OriginalPath: []byte("path")},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "ignored revisions",
+			setup: func(t *testing.T) setupData {
+				repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				parent := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "path", Mode: "100644", Content: "if (true) { return; }\n1\n1\n"},
+				))
+				// The commit only reformats the first line, which is why it is being ignored.
+				commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "path", Mode: "100644", Content: "if (true)  { return; }\n1\n1\n"},
+				), gittest.WithParents(parent))
+				head := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "path", Mode: "100644", Content: "if (true)  { return; }\n1\n1\n"},
+					gittest.TreeEntry{Path: ".git-blame-ignore-revs", Mode: "100644", Content: "# Reformatting\n" + commit.String() + " # trailing comment\n\n"},
+				), gittest.WithParents(commit))
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:          repo,
+						Revision:            []byte(head),
+						Path:                []byte("path"),
+						IgnoreRevisionsPath: []byte(".git-blame-ignore-revs"),
+					},
+					expectedResponse: &gitalypb.BlameResponse{
+						Commits: []*gitalypb.BlameResponse_Commit{
+							commitA(parent),
+						},
+						Ranges: []*gitalypb.BlameResponse_Range{
+							{CommitId: parent.String(), StartLine: 1, LineCount: 1, OriginalStartLine: 1, OriginalPath: []byte("path")},
+							{CommitId: parent.String(), StartLine: 2, LineCount: 2, OriginalStartLine: 2, OriginalPath: []byte("path")},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "missing ignore revisions path",
+			setup: func(t *testing.T) setupData {
+				repo, _, commit := setupHistory(t)
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:          repo,
+						Revision:            []byte(commit),
+						Path:                []byte("path"),
+						IgnoreRevisionsPath: []byte(".git-blame-ignore-revs"),
+					},
+					expectedErr: testhelper.ToInterceptedMetadata(
+						structerr.NewNotFound("ignore revisions path not found in revision").
+							WithMetadata("revision", commit.String()).
+							WithMetadata("path", ".git-blame-ignore-revs").
+							WithDetail(&gitalypb.BlameError{
+								Error: &gitalypb.BlameError_IgnoreRevisionsPathNotFound{
+									IgnoreRevisionsPathNotFound: &gitalypb.PathNotFoundError{
+										Path: []byte(".git-blame-ignore-revs"),
+									},
+								},
+							}),
+					),
+				}
+			},
+		},
+		{
+			desc: "invalid ignored revision",
+			setup: func(t *testing.T) setupData {
+				repo, _, commit := setupHistory(t, gittest.TreeEntry{
+					Path: ".git-blame-ignore-revs", Mode: "100644", Content: "main\n",
+				})
+
+				return setupData{
+					request: &gitalypb.BlameRequest{
+						Repository:          repo,
+						Revision:            []byte(commit),
+						Path:                []byte("path"),
+						IgnoreRevisionsPath: []byte(".git-blame-ignore-revs"),
+					},
+					expectedErr: testhelper.ToInterceptedMetadata(
+						structerr.NewInvalidArgument(`invalid ignored revision "main": %w`, git.InvalidObjectIDLengthError{
+							OID:           "main",
+							CorrectLength: gittest.DefaultObjectHash.EncodedLen(),
+							Length:        4,
+						}).WithMetadata("path", ".git-blame-ignore-revs"),
+					),
+				}
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			setup := tc.setup(t)
+
+			stream, err := client.Blame(ctx, setup.request)
+			require.NoError(t, err)
+
+			response, err := receiveBlame(stream)
+			testhelper.RequireGrpcError(t, setup.expectedErr, err, protocmp.SortRepeatedFields(&spb.Status{}, "details"))
+			if setup.expectedErr == nil {
+				testhelper.ProtoEqual(t, setup.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestBlame_pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupCommitService(t, ctx)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	// Every commit changes a different line of the file so that there is one range per commit.
+	lines := []string{"1", "2", "3", "4", "5"}
+	var commit git.ObjectID
+	for i := range lines {
+		lines[i] = "changed"
+
+		var parents []git.ObjectID
+		if commit != "" {
+			parents = append(parents, commit)
+		}
+
+		commit = gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "path", Mode: "100644", Content: strings.Join(lines, "\n") + "\n"},
+		), gittest.WithParents(parents...))
+	}
+
+	request := &gitalypb.BlameRequest{
+		Repository: repo,
+		Revision:   []byte(commit),
+		Path:       []byte("path"),
+	}
+
+	stream, err := client.Blame(ctx, request)
+	require.NoError(t, err)
+	expected, err := receiveBlame(stream)
+	require.NoError(t, err)
+	require.Len(t, expected.GetRanges(), len(lines))
+
+	// Blaming the file page by page should result in the same ranges as blaming the file at once.
+	var ranges []*gitalypb.BlameResponse_Range
+	var pages int
+	request.PaginationParams = &gitalypb.PaginationParameter{Limit: 2}
+	for {
+		stream, err := client.Blame(ctx, request)
+		require.NoError(t, err)
+
+		page, err := receiveBlame(stream)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.GetRanges()), 2)
+
+		ranges = append(ranges, page.GetRanges()...)
+		pages++
+
+		if page.GetPaginationCursor() == nil {
+			break
+		}
+		request.PaginationParams.PageToken = page.GetPaginationCursor().GetNextCursor()
+	}
+
+	require.Equal(t, 3, pages)
+	testhelper.ProtoEqual(t, expected.GetRanges(), ranges)
+}
+
+func TestBlame_paginationAcrossWindows(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupCommitService(t, ctx)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	lines := make([]string, 2*blameWindowLines+10)
+	for i := range lines {
+		lines[i] = strconv.Itoa(i)
+	}
+
+	firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "path", Mode: "100644", Content: strings.Join(lines, "\n") + "\n"},
+	))
+
+	// The ranges blamed to the first commit span the boundaries of the windows blamed at once.
+	lines[blameWindowLines+4] = "changed"
+	lines[len(lines)-1] = "changed"
+	secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "path", Mode: "100644", Content: strings.Join(lines, "\n") + "\n"},
+	), gittest.WithParents(firstCommit))
+
+	expectedRanges := []*gitalypb.BlameResponse_Range{
+		{CommitId: firstCommit.String(), StartLine: 1, LineCount: blameWindowLines + 4, OriginalStartLine: 1, OriginalPath: []byte("path")},
+		{CommitId: secondCommit.String(), StartLine: blameWindowLines + 5, LineCount: 1, OriginalStartLine: blameWindowLines + 5, OriginalPath: []byte("path")},
+		{CommitId: firstCommit.String(), StartLine: blameWindowLines + 6, LineCount: blameWindowLines + 4, OriginalStartLine: blameWindowLines + 6, OriginalPath: []byte("path")},
+		{CommitId: secondCommit.String(), StartLine: 2*blameWindowLines + 10, LineCount: 1, OriginalStartLine: 2*blameWindowLines + 10, OriginalPath: []byte("path")},
+	}
+
+	for _, tc := range []struct {
+		desc          string
+		limit         int32
+		expectedPages int
+	}{
+		{desc: "single range per page", limit: 1, expectedPages: 4},
+		{desc: "multiple ranges per page", limit: 3, expectedPages: 2},
+		{desc: "all ranges on one page", limit: 10, expectedPages: 1},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			request := &gitalypb.BlameRequest{
+				Repository:       repo,
+				Revision:         []byte(secondCommit),
+				Path:             []byte("path"),
+				PaginationParams: &gitalypb.PaginationParameter{Limit: tc.limit},
+			}
+
+			var ranges []*gitalypb.BlameResponse_Range
+			var pages int
+			for {
+				stream, err := client.Blame(ctx, request)
+				require.NoError(t, err)
+
+				page, err := receiveBlame(stream)
+				require.NoError(t, err)
+
+				ranges = append(ranges, page.GetRanges()...)
+				pages++
+
+				if page.GetPaginationCursor() == nil {
+					break
+				}
+				request.PaginationParams.PageToken = page.GetPaginationCursor().GetNextCursor()
+			}
+
+			require.Equal(t, tc.expectedPages, pages)
+			testhelper.ProtoEqual(t, expectedRanges, ranges)
+		})
+	}
+}
+
+// receiveBlame receives all messages of the stream and merges them into a single response.
+func receiveBlame(stream gitalypb.CommitService_BlameClient) (*gitalypb.BlameResponse, error) {
+	response := &gitalypb.BlameResponse{}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return response, nil
+			}
+			return nil, err
+		}
+
+		response.Commits = append(response.Commits, msg.GetCommits()...)
+		response.Ranges = append(response.Ranges, msg.GetRanges()...)
+		if msg.GetPaginationCursor() != nil {
+			response.PaginationCursor = msg.GetPaginationCursor()
+		}
+	}
+}
+
+func TestParseIgnoredRevisions(t *testing.T) {
+	t.Parallel()
+
+	commitID := strings.Repeat("1", gittest.DefaultObjectHash.EncodedLen())
+
+	ignoredRevisions, err := parseIgnoredRevisions(gittest.DefaultObjectHash, []byte(
+		"# comment\n\n  "+commitID+"  # trailing comment\n",
+	))
+	require.NoError(t, err)
+	require.Equal(t, commitID+"\n", string(ignoredRevisions))
+
+	_, err = parseIgnoredRevisions(gittest.DefaultObjectHash, []byte(commitID[:10]+"\n"))
+	require.Error(t, err)
+}
@@ -3,9 +3,11 @@ package commit
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
@@ -15,6 +17,11 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/streamio"
 )
 
+// signatureVerificationTimeout bounds the time spent verifying whether the signatures of a request have been
+// created by Gitaly. External signers are asked once for each signed commit, so the bound applies to the request
+// as a whole rather than to each commit.
+const signatureVerificationTimeout = time.Minute
+
 func (s *server) GetCommitSignatures(request *gitalypb.GetCommitSignaturesRequest, stream gitalypb.CommitService_GetCommitSignaturesServer) error {
 	ctx := stream.Context()
 
@@ -45,8 +52,13 @@ func (s *server) GetCommitSignatures(request *gitalypb.GetCommitSignaturesReques
 		if err != nil {
 			return fmt.Errorf("failed to parse signing key: %w", err)
 		}
+
+		signingKeys = signingKeys.WithContext(repo.SigningContext())
 	}
 
+	verificationCtx, cancelVerification := context.WithTimeout(ctx, signatureVerificationTimeout)
+	defer cancelVerification()
+
 	for _, commitID := range request.CommitIds {
 		commitObj, err := objectReader.Object(ctx, git.Revision(commitID)+"^{commit}")
 		if err != nil {
@@ -62,9 +74,13 @@ func (s *server) GetCommitSignatures(request *gitalypb.GetCommitSignaturesReques
 		}
 
 		signer := gitalypb.GetCommitSignaturesResponse_SIGNER_USER
-		if signingKeys != nil {
-			if err := signingKeys.Verify(signatureKey, commitText); err == nil {
+		if signingKeys != nil && len(signatureKey) > 0 {
+			if err := signingKeys.VerifyContext(verificationCtx, signatureKey, commitText); err == nil {
 				signer = gitalypb.GetCommitSignaturesResponse_SIGNER_SYSTEM
+			} else if errors.Is(verificationCtx.Err(), context.DeadlineExceeded) {
+				return structerr.NewDeadlineExceeded("verifying signatures: %w", err)
+			} else if errors.Is(verificationCtx.Err(), context.Canceled) {
+				return structerr.NewCanceled("verifying signatures: %w", err)
 			}
 		}
 
@@ -2,7 +2,10 @@ package commit
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -14,6 +17,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
@@ -430,3 +434,79 @@ committer Bug Fixer <bugfixer@email.com> 1584564725 +0100
 
 	return commitID, commitData
 }
+
+func TestGetCommitSignatures_externalSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := featureflag.ContextWithFeatureFlag(testhelper.Context(t), featureflag.GPGSigning, true)
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyGPG(t, cfg)
+
+	agentKey, err := signature.ParseSigningKeys("testdata/signing_ssh_key_ed25519")
+	require.NoError(t, err)
+
+	socketPath := filepath.Join(testhelper.TempDir(t), "agent.sock")
+	agent := signature.StartSigningAgent(t, socketPath, agentKey)
+
+	cfg.Git.SigningKey = "unix:" + socketPath
+	cfg.SocketPath = startTestServices(t, cfg)
+	client := newCommitServiceClient(t, cfg.SocketPath)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	treeID := gittest.DefaultObjectHash.EmptyTreeOID
+	writeSignedCommit := func(t *testing.T, signingKey string) git.ObjectID {
+		commitID, err := repo.WriteCommit(ctx, localrepo.WriteCommitConfig{
+			TreeID:         treeID,
+			AuthorName:     gittest.DefaultCommitterName,
+			AuthorEmail:    gittest.DefaultCommitterMail,
+			CommitterName:  gittest.DefaultCommitterName,
+			CommitterEmail: gittest.DefaultCommitterMail,
+			AuthorDate:     gittest.DefaultCommitTime,
+			CommitterDate:  gittest.DefaultCommitTime,
+			Message:        signingKey,
+			SigningKey:     signingKey,
+		})
+		require.NoError(t, err)
+		return commitID
+	}
+
+	// The first commit is signed with the agent's key, the second one with a key the agent doesn't know.
+	agentCommitID := writeSignedCommit(t, "testdata/signing_ssh_key_ed25519")
+	userCommitID := writeSignedCommit(t, "testdata/signing_ssh_key_rsa")
+	unsignedCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+
+	stream, err := client.GetCommitSignatures(ctx, &gitalypb.GetCommitSignaturesRequest{
+		Repository: repoProto,
+		CommitIds:  []string{agentCommitID.String(), userCommitID.String(), unsignedCommitID.String()},
+	})
+	require.NoError(t, err)
+
+	signers := map[string]gitalypb.GetCommitSignaturesResponse_Signer{}
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		if response.GetCommitId() != "" {
+			signers[response.GetCommitId()] = response.GetSigner()
+		}
+	}
+
+	require.Equal(t, map[string]gitalypb.GetCommitSignaturesResponse_Signer{
+		agentCommitID.String(): gitalypb.GetCommitSignaturesResponse_SIGNER_SYSTEM,
+		userCommitID.String():  gitalypb.GetCommitSignaturesResponse_SIGNER_USER,
+	}, signers)
+
+	// The agent is asked to verify each signed commit with the context of the repository.
+	repositoryContext := signature.SigningContext{
+		StorageName:   repoProto.GetStorageName(),
+		RelativePath:  repoProto.GetRelativePath(),
+		GlRepository:  repoProto.GetGlRepository(),
+		GlProjectPath: repoProto.GetGlProjectPath(),
+	}
+	require.Equal(t, []signature.SigningContext{repositoryContext, repositoryContext}, agent.Contexts())
+}
@@ -57,6 +57,7 @@ func startTestServices(tb testing.TB, cfg config.Cfg, opts ...testserver.GitalyS
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	}, opts...)
 }
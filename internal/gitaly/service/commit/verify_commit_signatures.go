@@ -0,0 +1,231 @@
+package commit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *server) VerifyCommitSignatures(request *gitalypb.VerifyCommitSignaturesRequest, stream gitalypb.CommitService_VerifyCommitSignaturesServer) error {
+	ctx := stream.Context()
+
+	if err := s.locator.ValidateRepository(request.GetRepository()); err != nil {
+		return err
+	}
+
+	repo := s.localrepo(request.GetRepository())
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	if err := validateVerifyCommitSignaturesRequest(objectHash, request); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	trustedKeys, err := s.trustedKeys(request.GetRepository().GetStorageName(), request.GetTrustedKeys())
+	if err != nil {
+		return err
+	}
+
+	objectReader, cancel, err := s.catfileCache.ObjectReader(ctx, repo)
+	if err != nil {
+		return structerr.NewInternal("%w", err)
+	}
+	defer cancel()
+
+	chunker := chunk.New(&verdictSender{
+		send: func(verdicts []*gitalypb.VerifyCommitSignaturesResponse_Verdict) error {
+			return stream.Send(&gitalypb.VerifyCommitSignaturesResponse{
+				Verdicts: verdicts,
+			})
+		},
+	})
+
+	parser := catfile.NewParser()
+	for _, objectID := range request.GetObjectIds() {
+		object, err := objectReader.Object(ctx, git.Revision(objectID))
+		if err != nil {
+			if errors.As(err, &catfile.NotFoundError{}) {
+				continue
+			}
+			return structerr.NewInternal("reading object: %w", err)
+		}
+
+		raw, err := io.ReadAll(object)
+		if err != nil {
+			return structerr.NewInternal("reading object: %w", err)
+		}
+
+		var signatureText, signedText []byte
+		var signedAt time.Time
+		switch object.Type {
+		case "commit":
+			if signatureText, signedText, err = extractSignature(bytes.NewReader(raw)); err != nil {
+				return structerr.NewInternal("extracting signature: %w", err)
+			}
+
+			commit, err := parser.ParseCommit(newBufferedObject(object, raw))
+			if err != nil {
+				return structerr.NewInternal("parsing commit: %w", err)
+			}
+			signedAt = commit.GetCommitter().GetDate().AsTime()
+		case "tag":
+			signatureText, signedText = catfile.ExtractTagSignature(raw)
+
+			tag, err := parser.ParseTag(newBufferedObject(object, raw))
+			if err != nil {
+				return structerr.NewInternal("parsing tag: %w", err)
+			}
+			signedAt = tag.GetTagger().GetDate().AsTime()
+		default:
+			return structerr.NewInvalidArgument("object is neither a commit nor a tag").
+				WithMetadata("object_id", objectID).
+				WithMetadata("object_type", object.Type)
+		}
+
+		verdict := &gitalypb.VerifyCommitSignaturesResponse_Verdict{
+			ObjectId: objectID,
+			Status:   gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNSIGNED,
+		}
+		if len(signatureText) > 0 {
+			verdict = verdictToProto(objectID, trustedKeys.Verify(signatureText, signedText, signedAt))
+		}
+
+		if err := chunker.Send(verdict); err != nil {
+			return structerr.NewInternal("sending verdict: %w", err)
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return structerr.NewInternal("flushing verdicts: %w", err)
+	}
+
+	return nil
+}
+
+func validateVerifyCommitSignaturesRequest(objectHash git.ObjectHash, request *gitalypb.VerifyCommitSignaturesRequest) error {
+	if len(request.GetObjectIds()) == 0 {
+		return errors.New("empty ObjectIds")
+	}
+
+	for _, objectID := range request.GetObjectIds() {
+		if err := objectHash.ValidateHex(objectID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trustedKeys returns the keys to verify signatures against. Keys passed in with the request take
+// precedence over the keys configured for the storage.
+func (s *server) trustedKeys(storageName string, requestKeys *gitalypb.VerifyCommitSignaturesRequest_TrustedKeys) (*signature.TrustedKeys, error) {
+	storage, _ := s.cfg.Storage(storageName)
+	configuredKeys := storage.SignatureVerification
+
+	keys := make([][]byte, 0, 4)
+	for _, key := range []struct {
+		fromRequest []byte
+		configured  string
+	}{
+		{fromRequest: requestKeys.GetGpgKeyring(), configured: configuredKeys.GPGKeyring},
+		{fromRequest: requestKeys.GetSshAllowedSigners(), configured: configuredKeys.SSHAllowedSigners},
+		{fromRequest: requestKeys.GetSshRevokedKeys(), configured: configuredKeys.SSHRevokedKeys},
+		{fromRequest: requestKeys.GetX509CaCertificates(), configured: configuredKeys.X509CAFile},
+	} {
+		if len(key.fromRequest) > 0 || key.configured == "" {
+			keys = append(keys, key.fromRequest)
+			continue
+		}
+
+		configured, err := os.ReadFile(key.configured)
+		if err != nil {
+			return nil, structerr.NewInternal("reading configured keys: %w", err)
+		}
+
+		keys = append(keys, configured)
+	}
+
+	trustedKeys, err := signature.ParseTrustedKeys(keys[0], keys[1], keys[2], keys[3])
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	return trustedKeys, nil
+}
+
+func verdictToProto(objectID string, verdict signature.Verdict) *gitalypb.VerifyCommitSignaturesResponse_Verdict {
+	signatureType := gitalypb.SignatureType_NONE
+	switch verdict.Type {
+	case signature.TypeGPG:
+		signatureType = gitalypb.SignatureType_PGP
+	case signature.TypeSSH:
+		signatureType = gitalypb.SignatureType_SSH
+	case signature.TypeX509:
+		signatureType = gitalypb.SignatureType_X509
+	}
+
+	status := gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNVERIFIED
+	switch verdict.Status {
+	case signature.StatusVerified:
+		status = gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_VERIFIED
+	case signature.StatusUnknownKey:
+		status = gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNKNOWN_KEY
+	case signature.StatusExpired:
+		status = gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_EXPIRED
+	case signature.StatusRevoked:
+		status = gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_REVOKED
+	}
+
+	return &gitalypb.VerifyCommitSignaturesResponse_Verdict{
+		ObjectId:       objectID,
+		SignatureType:  signatureType,
+		Status:         status,
+		KeyFingerprint: verdict.KeyFingerprint,
+		Signer:         verdict.Signer,
+	}
+}
+
+// bufferedObject is an object whose contents have already been read into memory so that they can be
+// parsed more than once.
+type bufferedObject struct {
+	git.ObjectInfo
+	*bytes.Reader
+}
+
+func newBufferedObject(object *catfile.Object, raw []byte) bufferedObject {
+	return bufferedObject{
+		ObjectInfo: &object.ObjectInfo,
+		Reader:     bytes.NewReader(raw),
+	}
+}
+
+type verdictSender struct {
+	verdicts []*gitalypb.VerifyCommitSignaturesResponse_Verdict
+	send     func([]*gitalypb.VerifyCommitSignaturesResponse_Verdict) error
+}
+
+func (s *verdictSender) Reset() {
+	s.verdicts = s.verdicts[:0]
+}
+
+func (s *verdictSender) Append(m proto.Message) {
+	s.verdicts = append(s.verdicts, m.(*gitalypb.VerifyCommitSignaturesResponse_Verdict))
+}
+
+func (s *verdictSender) Send() error {
+	return s.send(s.verdicts)
+}
@@ -0,0 +1,301 @@
+package commit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestVerifyCommitSignatures(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	trustedKey := readSSHSigningKey(t, "testdata/signing_ssh_key_ed25519")
+	untrustedKey := readSSHSigningKey(t, "testdata/signing_ssh_key_rsa")
+
+	trustedAuthorizedKey := ssh.MarshalAuthorizedKey(trustedKey.PrivateKey.PublicKey())
+	untrustedAuthorizedKey := ssh.MarshalAuthorizedKey(untrustedKey.PrivateKey.PublicKey())
+
+	// The storage trusts the trusted key by default.
+	allowedSignersPath := filepath.Join(testhelper.TempDir(t), "allowed_signers")
+	require.NoError(t, os.WriteFile(allowedSignersPath, append([]byte("bugfixer@email.com "), trustedAuthorizedKey...), perm.SharedFile))
+
+	cfg := testcfg.Build(t)
+	cfg.Storages[0].SignatureVerification.SSHAllowedSigners = allowedSignersPath
+	cfg.SocketPath = startTestServices(t, cfg)
+	client := newCommitServiceClient(t, cfg.SocketPath)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	unsignedCommitID := gittest.WriteCommit(t, cfg, repoPath)
+	trustedCommitID := createSSHSignedCommit(t, cfg, repoPath, trustedKey, "trusted commit")
+	untrustedCommitID := createSSHSignedCommit(t, cfg, repoPath, untrustedKey, "untrusted commit")
+	garbageCommitID, _ := createCommitWithSignature(t, cfg, repoPath, "gpgsig", "-----BEGIN SSH SIGNATURE-----\ngarbage\n-----END SSH SIGNATURE-----", "garbage commit")
+	trustedTagID := createSSHSignedTag(t, cfg, repoPath, trustedKey, trustedCommitID)
+	blobID := gittest.WriteBlob(t, cfg, repoPath, []byte("blob"))
+
+	trustedFingerprint := ssh.FingerprintSHA256(trustedKey.PrivateKey.PublicKey())
+	untrustedFingerprint := ssh.FingerprintSHA256(untrustedKey.PrivateKey.PublicKey())
+
+	for _, tc := range []struct {
+		desc             string
+		request          *gitalypb.VerifyCommitSignaturesRequest
+		expectedErr      error
+		expectedVerdicts []*gitalypb.VerifyCommitSignaturesResponse_Verdict
+	}{
+		{
+			desc: "unset repository",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				ObjectIds: []string{trustedCommitID.String()},
+			},
+			expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+		},
+		{
+			desc: "unset object IDs",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+			},
+			expectedErr: structerr.NewInvalidArgument("empty ObjectIds"),
+		},
+		{
+			desc: "abbreviated object ID",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{"a17a9f6"},
+			},
+			expectedErr: structerr.NewInvalidArgument(`invalid object ID: "a17a9f6", expected length %v, got 7`, gittest.DefaultObjectHash.EncodedLen()),
+		},
+		{
+			desc: "invalid trusted keys",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{trustedCommitID.String()},
+				TrustedKeys: &gitalypb.VerifyCommitSignaturesRequest_TrustedKeys{
+					SshAllowedSigners: []byte("bugfixer@email.com ssh-ed25519 garbage"),
+				},
+			},
+			expectedErr: structerr.NewInvalidArgument("parse ssh allowed signers: line 1: ssh: no key found"),
+		},
+		{
+			desc: "blob",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{blobID.String()},
+			},
+			expectedErr: testhelper.ToInterceptedMetadata(
+				structerr.NewInvalidArgument("object is neither a commit nor a tag").
+					WithMetadata("object_id", blobID.String()).
+					WithMetadata("object_type", "blob"),
+			),
+		},
+		{
+			desc: "nonexistent object",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{gittest.DefaultObjectHash.HashData([]byte("nonexistent")).String()},
+			},
+		},
+		{
+			desc: "keys configured for the storage",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds: []string{
+					unsignedCommitID.String(),
+					trustedCommitID.String(),
+					untrustedCommitID.String(),
+					garbageCommitID.String(),
+					trustedTagID.String(),
+				},
+			},
+			expectedVerdicts: []*gitalypb.VerifyCommitSignaturesResponse_Verdict{
+				{
+					ObjectId: unsignedCommitID.String(),
+					Status:   gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNSIGNED,
+				},
+				{
+					ObjectId:       trustedCommitID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_VERIFIED,
+					KeyFingerprint: trustedFingerprint,
+					Signer:         "bugfixer@email.com",
+				},
+				{
+					ObjectId:       untrustedCommitID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNKNOWN_KEY,
+					KeyFingerprint: untrustedFingerprint,
+				},
+				{
+					ObjectId:      garbageCommitID.String(),
+					SignatureType: gitalypb.SignatureType_SSH,
+					Status:        gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNVERIFIED,
+				},
+				{
+					ObjectId:       trustedTagID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_VERIFIED,
+					KeyFingerprint: trustedFingerprint,
+					Signer:         "bugfixer@email.com",
+				},
+			},
+		},
+		{
+			desc: "keys passed in with the request take precedence",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds: []string{
+					trustedCommitID.String(),
+					untrustedCommitID.String(),
+				},
+				TrustedKeys: &gitalypb.VerifyCommitSignaturesRequest_TrustedKeys{
+					SshAllowedSigners: append([]byte("other@email.com "), untrustedAuthorizedKey...),
+				},
+			},
+			expectedVerdicts: []*gitalypb.VerifyCommitSignaturesResponse_Verdict{
+				{
+					ObjectId:       trustedCommitID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_UNKNOWN_KEY,
+					KeyFingerprint: trustedFingerprint,
+				},
+				{
+					ObjectId:       untrustedCommitID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_VERIFIED,
+					KeyFingerprint: untrustedFingerprint,
+					Signer:         "other@email.com",
+				},
+			},
+		},
+		{
+			desc: "revoked key",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{trustedCommitID.String()},
+				TrustedKeys: &gitalypb.VerifyCommitSignaturesRequest_TrustedKeys{
+					SshRevokedKeys: trustedAuthorizedKey,
+				},
+			},
+			expectedVerdicts: []*gitalypb.VerifyCommitSignaturesResponse_Verdict{
+				{
+					ObjectId:       trustedCommitID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_REVOKED,
+					KeyFingerprint: trustedFingerprint,
+				},
+			},
+		},
+		{
+			desc: "key expired at the committer date",
+			request: &gitalypb.VerifyCommitSignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{trustedCommitID.String()},
+				TrustedKeys: &gitalypb.VerifyCommitSignaturesRequest_TrustedKeys{
+					// The commits are committed in March 2020.
+					SshAllowedSigners: append([]byte(`bugfixer@email.com valid-before="20200101" `), trustedAuthorizedKey...),
+				},
+			},
+			expectedVerdicts: []*gitalypb.VerifyCommitSignaturesResponse_Verdict{
+				{
+					ObjectId:       trustedCommitID.String(),
+					SignatureType:  gitalypb.SignatureType_SSH,
+					Status:         gitalypb.VerifyCommitSignaturesResponse_Verdict_STATUS_EXPIRED,
+					KeyFingerprint: trustedFingerprint,
+					Signer:         "bugfixer@email.com",
+				},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := client.VerifyCommitSignatures(ctx, tc.request)
+			require.NoError(t, err)
+
+			verdicts, err := testhelper.ReceiveAndFold(stream.Recv, func(
+				result []*gitalypb.VerifyCommitSignaturesResponse_Verdict,
+				response *gitalypb.VerifyCommitSignaturesResponse,
+			) []*gitalypb.VerifyCommitSignaturesResponse_Verdict {
+				return append(result, response.GetVerdicts()...)
+			})
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedVerdicts, verdicts)
+		})
+	}
+}
+
+func readSSHSigningKey(tb testing.TB, path string) *signature.SSHSigningKey {
+	tb.Helper()
+
+	key, err := os.ReadFile(path)
+	require.NoError(tb, err)
+
+	privateKey, err := ssh.ParsePrivateKey(key)
+	require.NoError(tb, err)
+
+	return &signature.SSHSigningKey{PrivateKey: privateKey}
+}
+
+// createSSHSignedCommit creates a commit signed with the key. The commit is written the same way as by
+// createCommitWithSignature.
+func createSSHSignedCommit(t *testing.T, cfg config.Cfg, repoPath string, key *signature.SSHSigningKey, commitMessage string) git.ObjectID {
+	t.Helper()
+
+	commitData := fmt.Sprintf(`tree %s
+author Bug Fixer <bugfixer@email.com> 1584564725 +0100
+committer Bug Fixer <bugfixer@email.com> 1584564725 +0100
+
+%s
+`, gittest.DefaultObjectHash.EmptyTreeOID, commitMessage)
+
+	signature, err := key.CreateSignature([]byte(commitData))
+	require.NoError(t, err)
+
+	commitID, signedCommitData := createCommitWithSignature(t, cfg, repoPath, "gpgsig", strings.TrimSuffix(string(signature), "\n"), commitMessage)
+	require.Equal(t, commitData, signedCommitData)
+
+	return commitID
+}
+
+func createSSHSignedTag(tb testing.TB, cfg config.Cfg, repoPath string, key *signature.SSHSigningKey, commitID git.ObjectID) git.ObjectID {
+	tb.Helper()
+
+	tagData := fmt.Sprintf(`object %s
+type commit
+tag v1.0.0
+tagger Bug Fixer <bugfixer@email.com> 1584564725 +0100
+
+Signed tag
+`, commitID)
+
+	signature, err := key.CreateSignature([]byte(tagData))
+	require.NoError(tb, err)
+
+	stdout := gittest.ExecOpts(tb, cfg, gittest.ExecConfig{
+		Stdin: strings.NewReader(tagData + string(signature)),
+	}, "-C", repoPath, "hash-object", "-w", "-t", "tag", "--stdin")
+
+	tagID, err := gittest.DefaultObjectHash.FromHex(text.ChompBytes(stdout))
+	require.NoError(tb, err)
+
+	return tagID
+}
@@ -39,6 +39,7 @@ func setupDiffService(tb testing.TB, opt ...testserver.GitalyServerOpt) (config.
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	}, opt...)
 	cfg.SocketPath = addr
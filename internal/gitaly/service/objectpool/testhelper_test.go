@@ -89,6 +89,7 @@ func runObjectPoolServer(t *testing.T, cfg config.Cfg, locator storage.Locator,
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	}, append(opts, testserver.WithLocator(locator), testserver.WithLogger(logger))...)
 }
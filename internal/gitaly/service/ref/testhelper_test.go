@@ -70,6 +70,7 @@ func runRefServiceServer(tb testing.TB, cfg config.Cfg) string {
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	})
 }
@@ -43,6 +43,7 @@ func setupRemoteService(t *testing.T, ctx context.Context, opts ...testserver.Gi
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 	}, opts...)
 	cfg.SocketPath = addr
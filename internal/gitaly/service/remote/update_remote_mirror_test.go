@@ -591,6 +591,7 @@ func TestUpdateRemoteMirror(t *testing.T) {
 					deps.GetBackupSink(),
 					deps.GetBackupLocator(),
 					deps.GetRepositoryCounter(),
+					deps.GetPartitionManager(),
 				))
 			})
 			cfg.SocketPath = addr
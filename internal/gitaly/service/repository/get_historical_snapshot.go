@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/storagemgr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetHistoricalSnapshot returns the references of a repository as they were at a past point in the
+// repository's write-ahead log. Only the references and the default branch are covered as the rest of
+// the repository's state, such as its custom hooks, is not retained in the log's history.
+func (s *server) GetHistoricalSnapshot(request *gitalypb.GetHistoricalSnapshotRequest, stream gitalypb.RepositoryService_GetHistoricalSnapshotServer) (returnedErr error) {
+	ctx := stream.Context()
+
+	if err := s.locator.ValidateRepository(request.GetRepository()); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	if request.GetPointInTime() == nil {
+		return structerr.NewInvalidArgument("empty point in time")
+	}
+
+	if s.partitionManager == nil {
+		return structerr.NewFailedPrecondition("transactions are not enabled")
+	}
+
+	transaction, err := s.partitionManager.Begin(ctx, request.GetRepository(), storagemgr.TransactionOptions{ReadOnly: true})
+	if err != nil {
+		return structerr.NewInternal("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := transaction.Rollback(); err != nil {
+			log.FromContext(ctx).WithError(err).Error("failed rolling back transaction")
+		}
+	}()
+
+	var snapshot storagemgr.HistoricalSnapshot
+	switch pointInTime := request.GetPointInTime().(type) {
+	case *gitalypb.GetHistoricalSnapshotRequest_LogIndex:
+		snapshot, err = transaction.HistoricalSnapshotAtLogIndex(ctx, storagemgr.LogIndex(pointInTime.LogIndex))
+	case *gitalypb.GetHistoricalSnapshotRequest_Timestamp:
+		snapshot, err = transaction.HistoricalSnapshotAtTime(ctx, pointInTime.Timestamp.AsTime())
+	}
+	if err != nil {
+		return structerr.New("read historical snapshot: %w", err)
+	}
+
+	response := &gitalypb.GetHistoricalSnapshotResponse{
+		LogIndex:      uint64(snapshot.LogIndex),
+		DefaultBranch: []byte(snapshot.DefaultBranch),
+	}
+	if !snapshot.AppliedAt.IsZero() {
+		response.AppliedAt = timestamppb.New(snapshot.AppliedAt)
+	}
+
+	sender := &historicalSnapshotSender{stream: stream, header: response}
+	chunker := chunk.New(sender)
+	for _, reference := range snapshot.References {
+		if err := chunker.Send(&gitalypb.GetHistoricalSnapshotResponse_Reference{
+			Name:   []byte(reference.Name),
+			Target: reference.Target,
+		}); err != nil {
+			return structerr.NewInternal("sending reference: %w", err)
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return structerr.NewInternal("flushing references: %w", err)
+	}
+
+	// The header is sent along with the first chunk of references. If there were no references, it
+	// still has to be sent on its own.
+	if sender.header != nil {
+		if err := stream.Send(sender.header); err != nil {
+			return structerr.NewInternal("sending response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type historicalSnapshotSender struct {
+	stream gitalypb.RepositoryService_GetHistoricalSnapshotServer
+	// header contains the fields that are only sent in the first response. It is cleared once sent.
+	header     *gitalypb.GetHistoricalSnapshotResponse
+	references []*gitalypb.GetHistoricalSnapshotResponse_Reference
+}
+
+func (s *historicalSnapshotSender) Reset() {
+	s.references = s.references[:0]
+}
+
+func (s *historicalSnapshotSender) Append(m proto.Message) {
+	s.references = append(s.references, m.(*gitalypb.GetHistoricalSnapshotResponse_Reference))
+}
+
+func (s *historicalSnapshotSender) Send() error {
+	response := &gitalypb.GetHistoricalSnapshotResponse{}
+	if s.header != nil {
+		response, s.header = s.header, nil
+	}
+
+	response.References = s.references
+
+	return s.stream.Send(response)
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/storagemgr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetHistoricalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryService(t)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+	getHistoricalSnapshot := func(t *testing.T, request *gitalypb.GetHistoricalSnapshotRequest) ([]*gitalypb.GetHistoricalSnapshotResponse, error) {
+		t.Helper()
+
+		stream, err := client.GetHistoricalSnapshot(ctx, request)
+		require.NoError(t, err)
+
+		var responses []*gitalypb.GetHistoricalSnapshotResponse
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return responses, nil
+				}
+
+				return nil, err
+			}
+
+			responses = append(responses, response)
+		}
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		request     *gitalypb.GetHistoricalSnapshotRequest
+		expectedErr error
+	}{
+		{
+			desc: "unset repository",
+			request: &gitalypb.GetHistoricalSnapshotRequest{
+				PointInTime: &gitalypb.GetHistoricalSnapshotRequest_LogIndex{LogIndex: 1},
+			},
+			expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+		},
+		{
+			desc: "unset point in time",
+			request: &gitalypb.GetHistoricalSnapshotRequest{
+				Repository: repo,
+			},
+			expectedErr: structerr.NewInvalidArgument("empty point in time"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := getHistoricalSnapshot(t, tc.request)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+		})
+	}
+
+	t.Run("transactions disabled", func(t *testing.T) {
+		if testhelper.IsWALEnabled() {
+			t.Skip("transactions are enabled")
+		}
+
+		_, err := getHistoricalSnapshot(t, &gitalypb.GetHistoricalSnapshotRequest{
+			Repository:  repo,
+			PointInTime: &gitalypb.GetHistoricalSnapshotRequest_LogIndex{LogIndex: 1},
+		})
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("transactions are not enabled"), err)
+	})
+
+	t.Run("log index newer than the read index", func(t *testing.T) {
+		if !testhelper.IsWALEnabled() {
+			t.Skip("transactions are not enabled")
+		}
+
+		_, err := getHistoricalSnapshot(t, &gitalypb.GetHistoricalSnapshotRequest{
+			Repository:  repo,
+			PointInTime: &gitalypb.GetHistoricalSnapshotRequest_LogIndex{LogIndex: math.MaxUint64},
+		})
+		testhelper.RequireGrpcCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("history retained", func(t *testing.T) {
+		if !testhelper.IsWALEnabled() {
+			t.Skip("transactions are not enabled")
+		}
+
+		cfg := testcfg.Build(t)
+		client, socketPath, partitionManager := runRepositoryServiceWithPartitionManager(t, cfg)
+		cfg.SocketPath = socketPath
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("first"))
+		secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("second"))
+
+		getHistoricalSnapshot := func(t *testing.T, request *gitalypb.GetHistoricalSnapshotRequest) []*gitalypb.GetHistoricalSnapshotResponse {
+			t.Helper()
+
+			stream, err := client.GetHistoricalSnapshot(ctx, request)
+			require.NoError(t, err)
+
+			responses, err := testhelper.ReceiveAndFold(stream.Recv, func(result []*gitalypb.GetHistoricalSnapshotResponse, response *gitalypb.GetHistoricalSnapshotResponse) []*gitalypb.GetHistoricalSnapshotResponse {
+				return append(result, response)
+			})
+			require.NoError(t, err)
+
+			return responses
+		}
+
+		referencesOf := func(responses []*gitalypb.GetHistoricalSnapshotResponse) map[string]string {
+			references := map[string]string{}
+			for _, response := range responses {
+				for _, reference := range response.GetReferences() {
+					references[string(reference.GetName())] = reference.GetTarget()
+				}
+			}
+			return references
+		}
+
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: firstCommit},
+		})
+		beforeUpdates := time.Now()
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/main": {OldOID: firstCommit, NewOID: secondCommit},
+		})
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/feature": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: firstCommit},
+		})
+
+		// The state at a past time is read at the latest log entry applied before it.
+		responses := getHistoricalSnapshot(t, &gitalypb.GetHistoricalSnapshotRequest{
+			Repository:  repo,
+			PointInTime: &gitalypb.GetHistoricalSnapshotRequest_Timestamp{Timestamp: timestamppb.New(beforeUpdates)},
+		})
+		require.Equal(t, map[string]string{"refs/heads/main": firstCommit.String()}, referencesOf(responses))
+		require.NotNil(t, responses[0].GetAppliedAt())
+		require.False(t, responses[0].GetAppliedAt().AsTime().After(beforeUpdates))
+		logIndex := responses[0].GetLogIndex()
+
+		for _, tc := range []struct {
+			desc               string
+			logIndex           uint64
+			expectedReferences map[string]string
+		}{
+			{
+				desc:               "log index read at the past time",
+				logIndex:           logIndex,
+				expectedReferences: map[string]string{"refs/heads/main": firstCommit.String()},
+			},
+			{
+				desc:               "log index of the update of the branch",
+				logIndex:           logIndex + 1,
+				expectedReferences: map[string]string{"refs/heads/main": secondCommit.String()},
+			},
+			{
+				desc:     "log index of the creation of the branch",
+				logIndex: logIndex + 2,
+				expectedReferences: map[string]string{
+					"refs/heads/main":    secondCommit.String(),
+					"refs/heads/feature": firstCommit.String(),
+				},
+			},
+		} {
+			responses := getHistoricalSnapshot(t, &gitalypb.GetHistoricalSnapshotRequest{
+				Repository:  repo,
+				PointInTime: &gitalypb.GetHistoricalSnapshotRequest_LogIndex{LogIndex: tc.logIndex},
+			})
+			require.Equal(t, tc.logIndex, responses[0].GetLogIndex(), tc.desc)
+			require.Equal(t, []byte("refs/heads/main"), responses[0].GetDefaultBranch(), tc.desc)
+			require.Equal(t, tc.expectedReferences, referencesOf(responses), tc.desc)
+		}
+	})
+
+	t.Run("history not retained", func(t *testing.T) {
+		if !testhelper.IsWALEnabled() {
+			t.Skip("transactions are not enabled")
+		}
+
+		// Only the history of the latest log entry is retained, so the time prior to the first log
+		// entry can't be read anymore.
+		cfg := testcfg.Build(t, testcfg.WithBase(config.Cfg{
+			Transactions: config.Transactions{HistoryRetention: 1},
+		}))
+		client, socketPath, partitionManager := runRepositoryServiceWithPartitionManager(t, cfg)
+		cfg.SocketPath = socketPath
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath)
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/feature": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+
+		stream, err := client.GetHistoricalSnapshot(ctx, &gitalypb.GetHistoricalSnapshotRequest{
+			Repository:  repo,
+			PointInTime: &gitalypb.GetHistoricalSnapshotRequest_Timestamp{Timestamp: timestamppb.New(time.Unix(0, 0))},
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		testhelper.RequireGrpcError(t, structerr.New("read historical snapshot: %w", storagemgr.ErrHistoryNotRetained), err)
+	})
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *server) SearchCode(req *gitalypb.SearchCodeRequest, stream gitalypb.RepositoryService_SearchCodeServer) error {
+	ctx := stream.Context()
+
+	if err := validateSearchCodeRequest(s.locator, req); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	query, err := codesearch.Compile(codesearch.Query{
+		Pattern:       req.GetQuery(),
+		Literal:       req.GetLiteral(),
+		CaseSensitive: req.GetCaseSensitive(),
+		PathFilters:   req.GetPathFilters(),
+	})
+	if err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	cursor, err := decodeSearchCodePageToken(req.GetPaginationParams().GetPageToken())
+	if err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	// Without pagination parameters all matching files are returned. Otherwise, the limit is
+	// interpreted the same as for all other paginated RPCs.
+	limit := -1
+	if req.GetPaginationParams() != nil {
+		limit = int(req.GetPaginationParams().GetLimit())
+		if limit == 0 {
+			return nil
+		}
+	}
+
+	repo := s.localrepo(req.GetRepository())
+
+	revision := git.Revision("HEAD")
+	if len(req.GetRevision()) > 0 {
+		revision = git.Revision(req.GetRevision())
+	}
+
+	commitID, err := repo.ResolveRevision(ctx, revision+"^{commit}")
+	if err != nil {
+		if errors.Is(err, git.ErrReferenceNotFound) {
+			// Repositories without a default branch don't have anything that could be searched.
+			if len(req.GetRevision()) == 0 {
+				return nil
+			}
+
+			return structerr.NewNotFound("revision not found").WithMetadata("revision", revision.String())
+		}
+
+		return fmt.Errorf("resolving revision: %w", err)
+	}
+
+	page, err := codesearch.Search(ctx, repo, commitID, query, cursor, limit)
+	if err != nil {
+		return structerr.NewInternal("searching code: %w", err)
+	}
+
+	var nextCursor string
+	if page.Next != nil {
+		nextCursor = encodeSearchCodePageToken(page.Next)
+	}
+
+	sender := &searchCodeSender{
+		stream:   stream,
+		commitID: commitID.String(),
+		indexed:  page.Indexed,
+	}
+
+	chunker := chunk.New(sender)
+	for _, result := range page.Results {
+		file := &gitalypb.SearchCodeResponse_File{
+			Path:       []byte(result.Path),
+			BlobId:     result.BlobID.String(),
+			MatchCount: result.MatchCount,
+		}
+
+		for _, match := range result.Matches {
+			file.Matches = append(file.Matches, &gitalypb.SearchCodeResponse_Match{
+				LineNumber: match.LineNumber,
+				Line:       match.Line,
+			})
+		}
+
+		if err := chunker.Send(file); err != nil {
+			return fmt.Errorf("sending file: %w", err)
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return fmt.Errorf("flushing files: %w", err)
+	}
+
+	// The commit ID and whether the index has been used are always sent, even if no file matched.
+	if nextCursor != "" || !sender.sent {
+		response := sender.newResponse()
+		if nextCursor != "" {
+			response.PaginationCursor = &gitalypb.PaginationCursor{NextCursor: nextCursor}
+		}
+
+		if err := stream.Send(response); err != nil {
+			return fmt.Errorf("sending pagination cursor: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateSearchCodeRequest(locator storage.Locator, req *gitalypb.SearchCodeRequest) error {
+	if err := locator.ValidateRepository(req.GetRepository()); err != nil {
+		return err
+	}
+
+	if len(req.GetQuery()) == 0 {
+		return errors.New("no query given")
+	}
+
+	if len(req.GetQuery()) > searchFilesFilterMaxLength {
+		return errors.New("query exceeds maximum length")
+	}
+
+	if len(req.GetRevision()) > 0 {
+		if err := git.ValidateRevision(req.GetRevision()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeSearchCodePageToken encodes the cursor into an opaque page token. The token consists of a
+// byte that indicates whether the path matches the query followed by the path.
+func encodeSearchCodePageToken(cursor *codesearch.Cursor) string {
+	pathMatches := byte('0')
+	if cursor.PathMatches {
+		pathMatches = '1'
+	}
+
+	return base64.RawURLEncoding.EncodeToString(append([]byte{pathMatches}, cursor.Path...))
+}
+
+// decodeSearchCodePageToken decodes the page token into the cursor after which the search continues.
+func decodeSearchCodePageToken(token string) (*codesearch.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(decoded) < 2 || (decoded[0] != '0' && decoded[0] != '1') {
+		return nil, fmt.Errorf("invalid page token %q", token)
+	}
+
+	return &codesearch.Cursor{
+		PathMatches: decoded[0] == '1',
+		Path:        string(decoded[1:]),
+	}, nil
+}
+
+type searchCodeSender struct {
+	stream   gitalypb.RepositoryService_SearchCodeServer
+	commitID string
+	indexed  bool
+	response *gitalypb.SearchCodeResponse
+	sent     bool
+}
+
+func (s *searchCodeSender) newResponse() *gitalypb.SearchCodeResponse {
+	return &gitalypb.SearchCodeResponse{
+		CommitId: s.commitID,
+		Indexed:  s.indexed,
+	}
+}
+
+func (s *searchCodeSender) Reset() {
+	s.response = s.newResponse()
+}
+
+func (s *searchCodeSender) Append(m proto.Message) {
+	s.response.Files = append(s.response.Files, m.(*gitalypb.SearchCodeResponse_File))
+}
+
+func (s *searchCodeSender) Send() error {
+	s.sent = true
+	return s.stream.Send(s.response)
+}
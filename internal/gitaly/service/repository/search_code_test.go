@@ -0,0 +1,365 @@
+package repository
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestSearchCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryService(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	mainCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "main.go", Mode: "100644", Content: "package main\n\nfunc main() {\n\tprintln(\"Hello\")\n}\n"},
+		gittest.TreeEntry{Path: "hello.txt", Mode: "100644", Content: "hello\nhello world\n"},
+		gittest.TreeEntry{Path: "README.md", Mode: "100644", Content: "Say hello\n"},
+	))
+	branchCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("branch"), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "branch.txt", Mode: "100644", Content: "hello from a branch\n"},
+	))
+
+	blobID := func(commitID git.ObjectID, path string) string {
+		return gittest.ResolveRevision(t, cfg, repoPath, commitID.String()+":"+path).String()
+	}
+
+	helloFile := &gitalypb.SearchCodeResponse_File{
+		Path:       []byte("hello.txt"),
+		BlobId:     blobID(mainCommit, "hello.txt"),
+		MatchCount: 2,
+		Matches: []*gitalypb.SearchCodeResponse_Match{
+			{LineNumber: 1, Line: []byte("hello")},
+			{LineNumber: 2, Line: []byte("hello world")},
+		},
+	}
+	readmeFile := &gitalypb.SearchCodeResponse_File{
+		Path:       []byte("README.md"),
+		BlobId:     blobID(mainCommit, "README.md"),
+		MatchCount: 1,
+		Matches: []*gitalypb.SearchCodeResponse_Match{
+			{LineNumber: 1, Line: []byte("Say hello")},
+		},
+	}
+	mainFile := &gitalypb.SearchCodeResponse_File{
+		Path:       []byte("main.go"),
+		BlobId:     blobID(mainCommit, "main.go"),
+		MatchCount: 1,
+		Matches: []*gitalypb.SearchCodeResponse_Match{
+			{LineNumber: 4, Line: []byte("\tprintln(\"Hello\")")},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc             string
+		request          *gitalypb.SearchCodeRequest
+		expectedErr      error
+		expectedCommitID string
+		expectedFiles    []*gitalypb.SearchCodeResponse_File
+		expectedCursor   string
+	}{
+		{
+			desc: "no repository",
+			request: &gitalypb.SearchCodeRequest{
+				Query: "hello",
+			},
+			expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+		},
+		{
+			desc: "no query",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+			},
+			expectedErr: structerr.NewInvalidArgument("no query given"),
+		},
+		{
+			desc: "query too long",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      strings.Repeat("a", searchFilesFilterMaxLength+1),
+			},
+			expectedErr: structerr.NewInvalidArgument("query exceeds maximum length"),
+		},
+		{
+			desc: "invalid revision",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello",
+				Revision:   []byte("-branch"),
+			},
+			expectedErr: structerr.NewInvalidArgument("revision can't start with '-'"),
+		},
+		{
+			desc: "invalid pattern",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello(",
+			},
+			expectedErr: structerr.NewInvalidArgument("invalid pattern: error parsing regexp: missing closing ): `hello(`"),
+		},
+		{
+			desc: "invalid page token",
+			request: &gitalypb.SearchCodeRequest{
+				Repository:       repoProto,
+				Query:            "hello",
+				PaginationParams: &gitalypb.PaginationParameter{PageToken: "foo"},
+			},
+			expectedErr: structerr.NewInvalidArgument("invalid page token %q", "foo"),
+		},
+		{
+			desc: "missing revision",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello",
+				Revision:   []byte("does-not-exist"),
+			},
+			expectedErr: testhelper.ToInterceptedMetadata(
+				structerr.NewNotFound("revision not found").WithMetadata("revision", "does-not-exist"),
+			),
+		},
+		{
+			desc: "default branch",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello",
+			},
+			expectedCommitID: mainCommit.String(),
+			expectedFiles:    []*gitalypb.SearchCodeResponse_File{helloFile, readmeFile, mainFile},
+		},
+		{
+			desc: "case-sensitive literal with path filter",
+			request: &gitalypb.SearchCodeRequest{
+				Repository:    repoProto,
+				Query:         "Hello",
+				Literal:       true,
+				CaseSensitive: true,
+				PathFilters:   []string{"*.go"},
+			},
+			expectedCommitID: mainCommit.String(),
+			expectedFiles:    []*gitalypb.SearchCodeResponse_File{mainFile},
+		},
+		{
+			desc: "revision",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello",
+				Revision:   []byte("branch"),
+			},
+			expectedCommitID: branchCommit.String(),
+			expectedFiles: []*gitalypb.SearchCodeResponse_File{
+				{
+					Path:       []byte("branch.txt"),
+					BlobId:     blobID(branchCommit, "branch.txt"),
+					MatchCount: 1,
+					Matches: []*gitalypb.SearchCodeResponse_Match{
+						{LineNumber: 1, Line: []byte("hello from a branch")},
+					},
+				},
+			},
+		},
+		{
+			desc: "no matches",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "does not exist",
+			},
+			expectedCommitID: mainCommit.String(),
+		},
+		{
+			desc: "zero limit",
+			request: &gitalypb.SearchCodeRequest{
+				Repository:       repoProto,
+				Query:            "hello",
+				PaginationParams: &gitalypb.PaginationParameter{Limit: 0},
+			},
+		},
+		{
+			desc: "first page",
+			request: &gitalypb.SearchCodeRequest{
+				Repository:       repoProto,
+				Query:            "hello",
+				PaginationParams: &gitalypb.PaginationParameter{Limit: 2},
+			},
+			expectedCommitID: mainCommit.String(),
+			expectedFiles:    []*gitalypb.SearchCodeResponse_File{helloFile, readmeFile},
+			expectedCursor:   encodeSearchCodePageToken(&codesearch.Cursor{Path: "README.md"}),
+		},
+		{
+			desc: "last page",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello",
+				PaginationParams: &gitalypb.PaginationParameter{
+					Limit:     2,
+					PageToken: encodeSearchCodePageToken(&codesearch.Cursor{Path: "README.md"}),
+				},
+			},
+			expectedCommitID: mainCommit.String(),
+			expectedFiles:    []*gitalypb.SearchCodeResponse_File{mainFile},
+		},
+		{
+			desc: "page token after the last file",
+			request: &gitalypb.SearchCodeRequest{
+				Repository: repoProto,
+				Query:      "hello",
+				PaginationParams: &gitalypb.PaginationParameter{
+					Limit:     2,
+					PageToken: encodeSearchCodePageToken(&codesearch.Cursor{Path: "zzz"}),
+				},
+			},
+			expectedCommitID: mainCommit.String(),
+		},
+		{
+			desc: "negative limit",
+			request: &gitalypb.SearchCodeRequest{
+				Repository:       repoProto,
+				Query:            "hello",
+				PaginationParams: &gitalypb.PaginationParameter{Limit: -1},
+			},
+			expectedCommitID: mainCommit.String(),
+			expectedFiles:    []*gitalypb.SearchCodeResponse_File{helloFile, readmeFile, mainFile},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			commitID, indexed, files, cursor, err := receiveSearchCode(t, client, tc.request)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			require.False(t, indexed)
+			require.Equal(t, tc.expectedCommitID, commitID)
+			testhelper.ProtoEqual(t, tc.expectedFiles, files)
+			require.Equal(t, tc.expectedCursor, cursor)
+		})
+	}
+}
+
+func TestSearchCode_indexed(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryService(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	oldCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "hello.txt", Mode: "100644", Content: "hello\n"},
+	))
+
+	response, err := client.UpdateCodeSearchIndex(ctx, &gitalypb.UpdateCodeSearchIndexRequest{
+		Repository: repoProto,
+	})
+	require.NoError(t, err)
+	testhelper.ProtoEqual(t, &gitalypb.UpdateCodeSearchIndexResponse{CommitId: oldCommit.String()}, response)
+
+	// The index is outdated now, but should still be used.
+	newCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithParents(oldCommit), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "hello.txt", Mode: "100644", Content: "hello\n"},
+		gittest.TreeEntry{Path: "new.txt", Mode: "100644", Content: "hello again\n"},
+	))
+
+	commitID, indexed, files, cursor, err := receiveSearchCode(t, client, &gitalypb.SearchCodeRequest{
+		Repository: repoProto,
+		Query:      "hello",
+	})
+	require.NoError(t, err)
+	require.True(t, indexed)
+	require.Equal(t, newCommit.String(), commitID)
+	require.Empty(t, cursor)
+	testhelper.ProtoEqual(t, []*gitalypb.SearchCodeResponse_File{
+		{
+			Path:       []byte("hello.txt"),
+			BlobId:     gittest.ResolveRevision(t, cfg, repoPath, newCommit.String()+":hello.txt").String(),
+			MatchCount: 1,
+			Matches:    []*gitalypb.SearchCodeResponse_Match{{LineNumber: 1, Line: []byte("hello")}},
+		},
+		{
+			Path:       []byte("new.txt"),
+			BlobId:     gittest.ResolveRevision(t, cfg, repoPath, newCommit.String()+":new.txt").String(),
+			MatchCount: 1,
+			Matches:    []*gitalypb.SearchCodeResponse_Match{{LineNumber: 1, Line: []byte("hello again")}},
+		},
+	}, files)
+
+	// Pages are served from the index, too.
+	var paths []string
+	var pageToken string
+	for {
+		_, indexed, files, cursor, err := receiveSearchCode(t, client, &gitalypb.SearchCodeRequest{
+			Repository:       repoProto,
+			Query:            "hello",
+			Revision:         []byte(newCommit),
+			PaginationParams: &gitalypb.PaginationParameter{Limit: 1, PageToken: pageToken},
+		})
+		require.NoError(t, err)
+		require.True(t, indexed)
+		require.Len(t, files, 1)
+
+		paths = append(paths, string(files[0].GetPath()))
+		if cursor == "" {
+			break
+		}
+		pageToken = cursor
+	}
+	require.Equal(t, []string{"hello.txt", "new.txt"}, paths)
+}
+
+func TestSearchCode_emptyRepository(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryService(t)
+
+	repoProto, _ := gittest.CreateRepository(t, ctx, cfg)
+
+	commitID, indexed, files, cursor, err := receiveSearchCode(t, client, &gitalypb.SearchCodeRequest{
+		Repository: repoProto,
+		Query:      "hello",
+	})
+	require.NoError(t, err)
+	require.False(t, indexed)
+	require.Empty(t, commitID)
+	require.Empty(t, files)
+	require.Empty(t, cursor)
+}
+
+func receiveSearchCode(t *testing.T, client gitalypb.RepositoryServiceClient, request *gitalypb.SearchCodeRequest) (string, bool, []*gitalypb.SearchCodeResponse_File, string, error) {
+	t.Helper()
+
+	stream, err := client.SearchCode(testhelper.Context(t), request)
+	require.NoError(t, err)
+
+	var commitID, cursor string
+	var indexed bool
+	var files []*gitalypb.SearchCodeResponse_File
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return commitID, indexed, files, cursor, nil
+			}
+			return "", false, nil, "", err
+		}
+
+		commitID = response.GetCommitId()
+		indexed = response.GetIndexed()
+		files = append(files, response.GetFiles()...)
+		if response.GetPaginationCursor() != nil {
+			cursor = response.GetPaginationCursor().GetNextCursor()
+		}
+	}
+}
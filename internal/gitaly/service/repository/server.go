@@ -12,6 +12,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/counter"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/storagemgr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/client"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
@@ -32,6 +33,7 @@ type server struct {
 	backupSink          backup.Sink
 	backupLocator       backup.Locator
 	repositoryCounter   *counter.RepositoryCounter
+	partitionManager    *storagemgr.PartitionManager
 
 	licenseCache *unarycache.Cache[git.ObjectID, *gitalypb.FindLicenseResponse]
 }
@@ -48,6 +50,7 @@ func NewServer(
 	backupSink backup.Sink,
 	backupLocator backup.Locator,
 	repositoryCounter *counter.RepositoryCounter,
+	partitionManager *storagemgr.PartitionManager,
 ) gitalypb.RepositoryServiceServer {
 	return &server{
 		locator:             locator,
@@ -61,6 +64,7 @@ func NewServer(
 		backupSink:          backupSink,
 		backupLocator:       backupLocator,
 		repositoryCounter:   repositoryCounter,
+		partitionManager:    partitionManager,
 
 		licenseCache: newLicenseCache(),
 	}
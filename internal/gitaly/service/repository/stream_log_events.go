@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/storagemgr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StreamLogEvents streams the changes committed to the repository through its write-ahead log.
+func (s *server) StreamLogEvents(request *gitalypb.StreamLogEventsRequest, stream gitalypb.RepositoryService_StreamLogEventsServer) error {
+	ctx := stream.Context()
+
+	if err := s.locator.ValidateRepository(request.GetRepository()); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	if s.partitionManager == nil {
+		return structerr.NewFailedPrecondition("transactions are not enabled")
+	}
+
+	chunker := chunk.New(&logEventsSender{stream: stream})
+	if err := s.partitionManager.WatchLog(ctx, request.GetRepository(), storagemgr.LogIndex(request.GetAfterLogIndex()), func(events []storagemgr.LogEvent) error {
+		for _, event := range events {
+			response := &gitalypb.StreamLogEventsResponse_Event{
+				LogIndex:           uint64(event.LogIndex),
+				AppliedAt:          timestamppb.New(event.AppliedAt),
+				DefaultBranch:      []byte(event.DefaultBranch),
+				CustomHooksUpdated: event.CustomHooksUpdated,
+			}
+
+			for _, change := range event.ReferenceChanges {
+				response.ReferenceChanges = append(response.ReferenceChanges, &gitalypb.StreamLogEventsResponse_ReferenceChange{
+					ReferenceName: []byte(change.ReferenceName),
+					OldOid:        change.OldOID.String(),
+					NewOid:        change.NewOID.String(),
+				})
+			}
+
+			if err := chunker.Send(response); err != nil {
+				return structerr.NewInternal("sending event: %w", err)
+			}
+		}
+
+		// The events are flushed right away so the client receives them without waiting for further
+		// changes to be committed.
+		if err := chunker.Flush(); err != nil {
+			return structerr.NewInternal("flushing events: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return structerr.New("watch log: %w", err)
+	}
+
+	return nil
+}
+
+type logEventsSender struct {
+	stream gitalypb.RepositoryService_StreamLogEventsServer
+	events []*gitalypb.StreamLogEventsResponse_Event
+}
+
+func (s *logEventsSender) Reset() {
+	s.events = s.events[:0]
+}
+
+func (s *logEventsSender) Append(m proto.Message) {
+	s.events = append(s.events, m.(*gitalypb.StreamLogEventsResponse_Event))
+}
+
+func (s *logEventsSender) Send() error {
+	return s.stream.Send(&gitalypb.StreamLogEventsResponse{Events: s.events})
+}
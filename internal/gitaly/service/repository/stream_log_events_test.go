@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/storagemgr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+)
+
+func TestStreamLogEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryService(t)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+	streamLogEvents := func(t *testing.T, request *gitalypb.StreamLogEventsRequest) error {
+		t.Helper()
+
+		stream, err := client.StreamLogEvents(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		_, err = stream.Recv()
+		return err
+	}
+
+	t.Run("unset repository", func(t *testing.T) {
+		testhelper.RequireGrpcError(t,
+			structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+			streamLogEvents(t, &gitalypb.StreamLogEventsRequest{}),
+		)
+	})
+
+	t.Run("transactions disabled", func(t *testing.T) {
+		if testhelper.IsWALEnabled() {
+			t.Skip("transactions are enabled")
+		}
+
+		testhelper.RequireGrpcError(t,
+			structerr.NewFailedPrecondition("transactions are not enabled"),
+			streamLogEvents(t, &gitalypb.StreamLogEventsRequest{Repository: repo}),
+		)
+	})
+
+	t.Run("events are streamed", func(t *testing.T) {
+		if !testhelper.IsWALEnabled() {
+			t.Skip("transactions are not enabled")
+		}
+
+		cfg := testcfg.Build(t)
+		client, socketPath, partitionManager := runRepositoryServiceWithPartitionManager(t, cfg)
+		cfg.SocketPath = socketPath
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("first"))
+		secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("second"))
+
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: firstCommit},
+		})
+
+		streamCtx, cancelStream := context.WithCancel(ctx)
+		defer cancelStream()
+
+		stream, err := client.StreamLogEvents(streamCtx, &gitalypb.StreamLogEventsRequest{Repository: repo})
+		require.NoError(t, err)
+
+		// Events of the log entries applied before the stream started are sent first.
+		response, err := stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, response.GetEvents(), 1)
+		firstEvent := response.GetEvents()[0]
+		require.NotNil(t, firstEvent.GetAppliedAt())
+		require.Equal(t, []*gitalypb.StreamLogEventsResponse_ReferenceChange{
+			{ReferenceName: []byte("refs/heads/main"), OldOid: gittest.DefaultObjectHash.ZeroOID.String(), NewOid: firstCommit.String()},
+		}, firstEvent.GetReferenceChanges())
+
+		// Events of log entries applied later on are streamed as they are applied.
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/main": {OldOID: firstCommit, NewOID: secondCommit},
+		})
+
+		response, err = stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, response.GetEvents(), 1)
+		require.Equal(t, firstEvent.GetLogIndex()+1, response.GetEvents()[0].GetLogIndex())
+		require.Equal(t, []*gitalypb.StreamLogEventsResponse_ReferenceChange{
+			{ReferenceName: []byte("refs/heads/main"), OldOid: firstCommit.String(), NewOid: secondCommit.String()},
+		}, response.GetEvents()[0].GetReferenceChanges())
+
+		// The stream can be resumed after the last received event.
+		resumedStream, err := client.StreamLogEvents(streamCtx, &gitalypb.StreamLogEventsRequest{
+			Repository:    repo,
+			AfterLogIndex: firstEvent.GetLogIndex(),
+		})
+		require.NoError(t, err)
+
+		response, err = resumedStream.Recv()
+		require.NoError(t, err)
+		require.Len(t, response.GetEvents(), 1)
+		require.Equal(t, firstEvent.GetLogIndex()+1, response.GetEvents()[0].GetLogIndex())
+
+		cancelStream()
+		_, err = stream.Recv()
+		testhelper.RequireGrpcCode(t, err, codes.Canceled)
+	})
+
+	t.Run("history not retained", func(t *testing.T) {
+		if !testhelper.IsWALEnabled() {
+			t.Skip("transactions are not enabled")
+		}
+
+		// Only the history of the latest log entry is retained, so the stream can't start from the
+		// beginning of the log anymore.
+		cfg := testcfg.Build(t, testcfg.WithBase(config.Cfg{
+			Transactions: config.Transactions{HistoryRetention: 1},
+		}))
+		client, socketPath, partitionManager := runRepositoryServiceWithPartitionManager(t, cfg)
+		cfg.SocketPath = socketPath
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath)
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+		commitReferenceUpdates(t, ctx, partitionManager, repo, storagemgr.ReferenceUpdates{
+			"refs/heads/feature": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+
+		stream, err := client.StreamLogEvents(ctx, &gitalypb.StreamLogEventsRequest{Repository: repo})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		testhelper.RequireGrpcError(t, structerr.New("watch log: %w", storagemgr.ErrHistoryNotRetained), err)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/ref"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/remote"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/ssh"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage/storagemgr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/client"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
@@ -55,7 +56,17 @@ func newMuxedRepositoryClient(t *testing.T, ctx context.Context, cfg config.Cfg,
 }
 
 func runRepositoryService(tb testing.TB, cfg config.Cfg, opts ...testserver.GitalyServerOpt) (gitalypb.RepositoryServiceClient, string) {
+	client, serverSocketPath, _ := runRepositoryServiceWithPartitionManager(tb, cfg, opts...)
+	return client, serverSocketPath
+}
+
+// runRepositoryServiceWithPartitionManager runs the repository service like runRepositoryService and additionally
+// returns the server's partition manager. The partition manager is nil if transactions are not enabled.
+func runRepositoryServiceWithPartitionManager(tb testing.TB, cfg config.Cfg, opts ...testserver.GitalyServerOpt) (gitalypb.RepositoryServiceClient, string, *storagemgr.PartitionManager) {
+	var partitionManager *storagemgr.PartitionManager
 	serverSocketPath := testserver.RunGitalyServer(tb, cfg, func(srv *grpc.Server, deps *service.Dependencies) {
+		partitionManager = deps.GetPartitionManager()
+
 		gitalypb.RegisterRepositoryServiceServer(srv, NewServer(
 			cfg,
 			deps.GetLocator(),
@@ -67,6 +78,7 @@ func runRepositoryService(tb testing.TB, cfg config.Cfg, opts ...testserver.Gita
 			deps.GetBackupSink(),
 			deps.GetBackupLocator(),
 			deps.GetRepositoryCounter(),
+			deps.GetPartitionManager(),
 		))
 		gitalypb.RegisterHookServiceServer(srv, hookservice.NewServer(
 			deps.GetHookManager(),
@@ -86,6 +98,7 @@ func runRepositoryService(tb testing.TB, cfg config.Cfg, opts ...testserver.Gita
 			deps.GetLocator(),
 			deps.GetGitCmdFactory(),
 			deps.GetTxManager(),
+			deps.GetCatfileCache(),
 		))
 		gitalypb.RegisterRefServiceServer(srv, ref.NewServer(
 			deps.GetLocator(),
@@ -109,7 +122,18 @@ func runRepositoryService(tb testing.TB, cfg config.Cfg, opts ...testserver.Gita
 		))
 	}, opts...)
 
-	return newRepositoryClient(tb, cfg, serverSocketPath), serverSocketPath
+	return newRepositoryClient(tb, cfg, serverSocketPath), serverSocketPath, partitionManager
+}
+
+// commitReferenceUpdates commits the reference updates to the repository through its write-ahead log.
+func commitReferenceUpdates(tb testing.TB, ctx context.Context, partitionManager *storagemgr.PartitionManager, repo *gitalypb.Repository, updates storagemgr.ReferenceUpdates) {
+	tb.Helper()
+
+	transaction, err := partitionManager.Begin(ctx, repo, storagemgr.TransactionOptions{})
+	require.NoError(tb, err)
+
+	transaction.UpdateReferences(updates)
+	require.NoError(tb, transaction.Commit(ctx))
 }
 
 func setupRepositoryService(tb testing.TB, opts ...testserver.GitalyServerOpt) (config.Cfg, gitalypb.RepositoryServiceClient) {
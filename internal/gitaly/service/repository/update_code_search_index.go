@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func (s *server) UpdateCodeSearchIndex(ctx context.Context, in *gitalypb.UpdateCodeSearchIndexRequest) (*gitalypb.UpdateCodeSearchIndexResponse, error) {
+	if err := s.locator.ValidateRepository(in.GetRepository()); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	commitID, err := codesearch.Update(ctx, s.localrepo(in.GetRepository()))
+	if err != nil {
+		return nil, structerr.NewInternal("updating code search index: %w", err)
+	}
+
+	return &gitalypb.UpdateCodeSearchIndexResponse{CommitId: commitID.String()}, nil
+}
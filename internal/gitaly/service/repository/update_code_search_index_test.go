@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestUpdateCodeSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryService(t)
+
+	t.Run("missing repository", func(t *testing.T) {
+		_, err := client.UpdateCodeSearchIndex(ctx, &gitalypb.UpdateCodeSearchIndexRequest{})
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet), err)
+	})
+
+	t.Run("empty repository", func(t *testing.T) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+		response, err := client.UpdateCodeSearchIndex(ctx, &gitalypb.UpdateCodeSearchIndexRequest{Repository: repo})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.UpdateCodeSearchIndexResponse{}, response)
+		require.FileExists(t, filepath.Join(repoPath, "gitaly-codesearch.index"))
+	})
+
+	t.Run("repository with default branch", func(t *testing.T) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+		firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+		response, err := client.UpdateCodeSearchIndex(ctx, &gitalypb.UpdateCodeSearchIndexRequest{Repository: repo})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.UpdateCodeSearchIndexResponse{CommitId: firstCommit.String()}, response)
+
+		secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch), gittest.WithParents(firstCommit))
+
+		response, err = client.UpdateCodeSearchIndex(ctx, &gitalypb.UpdateCodeSearchIndexRequest{Repository: repo})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.UpdateCodeSearchIndexResponse{CommitId: secondCommit.String()}, response)
+	})
+}
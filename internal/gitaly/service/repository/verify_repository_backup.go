@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/backup"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func (s *server) VerifyRepositoryBackup(ctx context.Context, in *gitalypb.VerifyRepositoryBackupRequest) (*gitalypb.VerifyRepositoryBackupResponse, error) {
+	if s.backupSink == nil || s.backupLocator == nil {
+		return nil, structerr.NewFailedPrecondition("verify repository backup: server-side backups are not configured")
+	}
+	if err := s.validateVerifyRepositoryBackupRequest(in); err != nil {
+		return nil, structerr.NewInvalidArgument("verify repository backup: %w", err)
+	}
+
+	manager := backup.NewManagerLocal(
+		s.backupSink,
+		s.backupLocator,
+		s.locator,
+		s.gitCmdFactory,
+		s.catfileCache,
+		s.txManager,
+		s.repositoryCounter,
+	)
+
+	err := manager.Verify(ctx, &backup.VerifyRequest{
+		Repository:       in.GetRepository(),
+		VanityRepository: in.GetVanityRepository(),
+		BackupID:         in.GetBackupId(),
+	})
+	switch {
+	case errors.Is(err, backup.ErrSkipped):
+		return nil, structerr.NewFailedPrecondition("verify repository backup: %w", err).WithDetail(
+			&gitalypb.VerifyRepositoryBackupResponse_SkippedError{},
+		)
+	case errors.Is(err, backup.ErrVerificationFailed):
+		return nil, structerr.NewFailedPrecondition("verify repository backup: %w", err).WithDetail(
+			&gitalypb.VerifyRepositoryBackupResponse_VerificationFailedError{},
+		)
+	case err != nil:
+		return nil, structerr.NewInternal("verify repository backup: %w", err)
+	}
+
+	return &gitalypb.VerifyRepositoryBackupResponse{}, nil
+}
+
+func (s *server) validateVerifyRepositoryBackupRequest(in *gitalypb.VerifyRepositoryBackupRequest) error {
+	if err := s.locator.ValidateRepository(in.GetRepository(),
+		storage.WithSkipRepositoryExistenceCheck(),
+	); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+
+	if err := s.locator.ValidateRepository(in.GetVanityRepository(),
+		storage.WithSkipStorageExistenceCheck(),
+	); err != nil {
+		return fmt.Errorf("vanity repository: %w", err)
+	}
+
+	return nil
+}
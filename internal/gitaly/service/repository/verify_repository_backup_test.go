@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/backup"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testserver"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestVerifyRepositoryBackup(t *testing.T) {
+	gittest.SkipWithSHA256(t)
+
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	writeBackup := func(t *testing.T, ctx context.Context, cfg config.Cfg, backupSink backup.Sink, backupLocator backup.Locator, repo *gitalypb.Repository, extraRefs string) {
+		_, templateRepoPath := gittest.CreateRepository(t, ctx, cfg)
+		oid := gittest.WriteCommit(t, cfg, templateRepoPath, gittest.WithBranch(git.DefaultBranch))
+		gittest.WriteCommit(t, cfg, templateRepoPath, gittest.WithBranch("feature"), gittest.WithParents(oid))
+
+		step := backupLocator.BeginFull(ctx, repo, "abc123")
+
+		w, err := backupSink.GetWriter(ctx, step.BundlePath)
+		require.NoError(t, err)
+		_, err = w.Write(gittest.BundleRepo(t, cfg, templateRepoPath, "-"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		w, err = backupSink.GetWriter(ctx, step.RefPath)
+		require.NoError(t, err)
+		_, err = w.Write(gittest.Exec(t, cfg, "-C", templateRepoPath, "show-ref", "--head"))
+		require.NoError(t, err)
+		_, err = w.Write([]byte(extraRefs))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		require.NoError(t, backupLocator.Commit(ctx, step))
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		setup       func(t *testing.T, ctx context.Context, backupSink backup.Sink, backupLocator backup.Locator) (gitalypb.RepositoryServiceClient, *gitalypb.Repository)
+		expectedErr error
+	}{
+		{
+			desc: "success",
+			setup: func(t *testing.T, ctx context.Context, backupSink backup.Sink, backupLocator backup.Locator) (gitalypb.RepositoryServiceClient, *gitalypb.Repository) {
+				cfg, client := setupRepositoryService(t,
+					testserver.WithBackupSink(backupSink),
+					testserver.WithBackupLocator(backupLocator),
+				)
+
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+				writeBackup(t, ctx, cfg, backupSink, backupLocator, repo, "")
+
+				return client, repo
+			},
+		},
+		{
+			desc: "refs differ",
+			setup: func(t *testing.T, ctx context.Context, backupSink backup.Sink, backupLocator backup.Locator) (gitalypb.RepositoryServiceClient, *gitalypb.Repository) {
+				cfg, client := setupRepositoryService(t,
+					testserver.WithBackupSink(backupSink),
+					testserver.WithBackupLocator(backupLocator),
+				)
+
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+				writeBackup(t, ctx, cfg, backupSink, backupLocator, repo, gittest.DefaultObjectHash.ZeroOID.String()+" refs/heads/missing\n")
+
+				return client, repo
+			},
+			expectedErr: structerr.NewFailedPrecondition("verify repository backup: manager: verify: verification failed: refs differ from backup: refs/heads/missing missing").WithDetail(
+				&gitalypb.VerifyRepositoryBackupResponse_VerificationFailedError{},
+			),
+		},
+		{
+			desc: "missing backup",
+			setup: func(t *testing.T, ctx context.Context, backupSink backup.Sink, backupLocator backup.Locator) (gitalypb.RepositoryServiceClient, *gitalypb.Repository) {
+				cfg, client := setupRepositoryService(t,
+					testserver.WithBackupSink(backupSink),
+					testserver.WithBackupLocator(backupLocator),
+				)
+
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return client, repo
+			},
+			expectedErr: structerr.NewFailedPrecondition("verify repository backup: manager: verify: repository skipped: read refs: doesn't exist").WithDetail(
+				&gitalypb.VerifyRepositoryBackupResponse_SkippedError{},
+			),
+		},
+		{
+			desc: "missing backup sink",
+			setup: func(t *testing.T, ctx context.Context, backupSink backup.Sink, backupLocator backup.Locator) (gitalypb.RepositoryServiceClient, *gitalypb.Repository) {
+				cfg, client := setupRepositoryService(t,
+					testserver.WithBackupLocator(backupLocator),
+				)
+
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return client, repo
+			},
+			expectedErr: structerr.NewFailedPrecondition("verify repository backup: server-side backups are not configured"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			backupRoot := testhelper.TempDir(t)
+			backupSink, err := backup.ResolveSink(ctx, backupRoot)
+			require.NoError(t, err)
+
+			backupLocator, err := backup.ResolveLocator("pointer", backupSink)
+			require.NoError(t, err)
+
+			client, repo := tc.setup(t, ctx, backupSink, backupLocator)
+
+			response, err := client.VerifyRepositoryBackup(ctx, &gitalypb.VerifyRepositoryBackupRequest{
+				Repository:       repo,
+				VanityRepository: repo,
+			})
+			if tc.expectedErr != nil {
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			testhelper.ProtoEqual(t, &gitalypb.VerifyRepositoryBackupResponse{}, response)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/blob"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service/cleanup"
@@ -48,6 +49,10 @@ var (
 		},
 		[]string{"git_negotiation_feature"},
 	)
+
+	// codeSearchIndexUpdater is shared by the services accepting pushes so that the code search index
+	// of a repository is only updated once at a time.
+	codeSearchIndexUpdater = codesearch.NewBackgroundUpdater()
 )
 
 // RegisterAll will register all the known gRPC services on  the provided gRPC service instance.
@@ -101,19 +106,24 @@ func RegisterAll(srv *grpc.Server, deps *service.Dependencies) {
 		deps.GetBackupSink(),
 		deps.GetBackupLocator(),
 		deps.GetRepositoryCounter(),
+		deps.GetPartitionManager(),
 	))
 	gitalypb.RegisterSSHServiceServer(srv, ssh.NewServer(
 		deps.GetLocator(),
 		deps.GetGitCmdFactory(),
 		deps.GetTxManager(),
+		deps.GetCatfileCache(),
 		ssh.WithPackfileNegotiationMetrics(sshPackfileNegotiationMetrics),
+		ssh.WithCodeSearchIndexUpdater(codeSearchIndexUpdater),
 	))
 	gitalypb.RegisterSmartHTTPServiceServer(srv, smarthttp.NewServer(
 		deps.GetLocator(),
 		deps.GetGitCmdFactory(),
 		deps.GetTxManager(),
+		deps.GetCatfileCache(),
 		deps.GetDiskCache(),
 		smarthttp.WithPackfileNegotiationMetrics(smarthttpPackfileNegotiationMetrics),
+		smarthttp.WithCodeSearchIndexUpdater(codeSearchIndexUpdater),
 	))
 	gitalypb.RegisterConflictsServiceServer(srv, conflicts.NewServer(
 		deps.GetHookManager(),
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
@@ -90,6 +91,10 @@ func (s *server) PostReceivePack(stream gitalypb.SmartHTTPService_PostReceivePac
 		}
 	}
 
+	// Keep the code search index in sync with the pushed default branch. The index is updated in the
+	// background so that the push doesn't wait for it, and failing to update it doesn't fail the push.
+	s.codeSearchIndexUpdater.Update(ctx, localrepo.New(s.locator, s.gitCmdFactory, s.catfileCache, req.GetRepository()))
+
 	return nil
 }
 
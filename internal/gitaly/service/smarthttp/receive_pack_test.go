@@ -13,7 +13,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/pktline"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	gitalyhook "gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/hook"
@@ -338,6 +340,51 @@ func TestPostReceivePack_rejectViaHooks(t *testing.T) {
 	}, response)
 }
 
+func TestPostReceivePack_codeSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	cfg := testcfg.Build(t)
+	codeSearchIndexUpdater := codesearch.NewBackgroundUpdater()
+	cfg.SocketPath = runSmartHTTPServer(t, cfg, WithCodeSearchIndexUpdater(codeSearchIndexUpdater))
+	testcfg.BuildGitalyHooks(t, cfg)
+
+	client := newSmartHTTPClient(t, cfg.SocketPath, cfg.Auth.Token)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repoProto.GlProjectPath = "project/path"
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(git.DefaultBranch))
+
+	indexedCommitID, err := codesearch.Update(ctx, repo)
+	require.NoError(t, err)
+
+	push := setupSimplePush(t, ctx, cfg, repoPath, git.DefaultRef)
+
+	stream, err := client.PostReceivePack(ctx)
+	require.NoError(t, err)
+
+	push.perform(t, stream, &gitalypb.PostReceivePackRequest{
+		Repository:   repoProto,
+		GlUsername:   "user",
+		GlId:         "123",
+		GlRepository: "project-456",
+	})
+
+	pushedCommitID := push.refUpdates[0].to
+	require.NotEqual(t, indexedCommitID, pushedCommitID)
+	require.Equal(t, pushedCommitID, gittest.ResolveRevision(t, cfg, repoPath, git.DefaultRef.String()))
+
+	// The index is updated in the background after the push, so there is nothing left to do once it
+	// has finished.
+	codeSearchIndexUpdater.Wait()
+	updated, err := codesearch.UpdateIfExists(ctx, repo)
+	require.NoError(t, err)
+	require.False(t, updated)
+}
+
 func TestPostReceivePack_requestValidation(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cache"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
@@ -16,6 +18,8 @@ type server struct {
 	packfileNegotiationMetrics *prometheus.CounterVec
 	infoRefCache               infoRefCache
 	txManager                  transaction.Manager
+	catfileCache               catfile.Cache
+	codeSearchIndexUpdater     *codesearch.BackgroundUpdater
 }
 
 // NewServer creates a new instance of a grpc SmartHTTPServer
@@ -23,6 +27,7 @@ func NewServer(
 	locator storage.Locator,
 	gitCmdFactory git.CommandFactory,
 	txManager transaction.Manager,
+	catfileCache catfile.Cache,
 	cache cache.Streamer,
 	serverOpts ...ServerOpt,
 ) gitalypb.SmartHTTPServiceServer {
@@ -30,11 +35,13 @@ func NewServer(
 		locator:       locator,
 		gitCmdFactory: gitCmdFactory,
 		txManager:     txManager,
+		catfileCache:  catfileCache,
 		packfileNegotiationMetrics: prometheus.NewCounterVec(
 			prometheus.CounterOpts{},
 			[]string{"git_negotiation_feature"},
 		),
-		infoRefCache: newInfoRefCache(cache),
+		infoRefCache:           newInfoRefCache(cache),
+		codeSearchIndexUpdater: codesearch.NewBackgroundUpdater(),
 	}
 
 	for _, serverOpt := range serverOpts {
@@ -53,3 +60,11 @@ func WithPackfileNegotiationMetrics(c *prometheus.CounterVec) ServerOpt {
 		s.packfileNegotiationMetrics = c
 	}
 }
+
+// WithCodeSearchIndexUpdater sets the updater that updates the code search indices of repositories after
+// pushes.
+func WithCodeSearchIndexUpdater(updater *codesearch.BackgroundUpdater) ServerOpt {
+	return func(s *server) {
+		s.codeSearchIndexUpdater = updater
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
@@ -157,6 +158,10 @@ func (s *server) sshReceivePack(stream gitalypb.SSHService_SSHReceivePackServer,
 		}
 	}
 
+	// Keep the code search index in sync with the pushed default branch. The index is updated in the
+	// background so that the push doesn't wait for it, and failing to update it doesn't fail the push.
+	s.codeSearchIndexUpdater.Update(ctx, localrepo.New(s.locator, s.gitCmdFactory, s.catfileCache, req.GetRepository()))
+
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
@@ -334,6 +335,44 @@ func TestReceive_gitProtocol(t *testing.T) {
 	require.Contains(t, envData, fmt.Sprintf("GIT_PROTOCOL=%s\n", git.ProtocolV2))
 }
 
+func TestReceivePack_codeSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	codeSearchIndexUpdater := codesearch.NewBackgroundUpdater()
+	cfg.SocketPath = runSSHServerWithOptions(t, cfg, []ServerOpt{WithCodeSearchIndexUpdater(codeSearchIndexUpdater)})
+
+	remoteRepoProto, remoteRepoPath := gittest.CreateRepository(t, ctx, cfg)
+	remoteRepo := localrepo.NewTestRepo(t, cfg, remoteRepoProto)
+
+	// The branch that is pushed is the default branch of the remote repository.
+	gittest.Exec(t, cfg, "-C", remoteRepoPath, "symbolic-ref", "HEAD", "refs/heads/master")
+
+	indexedCommitID, err := codesearch.Update(ctx, remoteRepo)
+	require.NoError(t, err)
+	require.Empty(t, indexedCommitID)
+
+	lHead, rHead, err := setupRepoAndPush(t, ctx, cfg, &gitalypb.SSHReceivePackRequest{
+		Repository:   remoteRepoProto,
+		GlRepository: "project-123",
+		GlId:         "1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, lHead, rHead)
+
+	// The index is updated in the background after the push, so there is nothing left to do once it
+	// has finished.
+	codeSearchIndexUpdater.Wait()
+	updated, err := codesearch.UpdateIfExists(ctx, remoteRepo)
+	require.NoError(t, err)
+	require.False(t, updated)
+}
+
 func TestReceivePack_hookFailure(t *testing.T) {
 	t.Parallel()
 
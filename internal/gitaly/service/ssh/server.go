@@ -5,6 +5,8 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/codesearch"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
@@ -21,9 +23,11 @@ type server struct {
 	locator                                  storage.Locator
 	gitCmdFactory                            git.CommandFactory
 	txManager                                transaction.Manager
+	catfileCache                             catfile.Cache
 	uploadPackRequestTimeoutTickerFactory    func() helper.Ticker
 	uploadArchiveRequestTimeoutTickerFactory func() helper.Ticker
 	packfileNegotiationMetrics               *prometheus.CounterVec
+	codeSearchIndexUpdater                   *codesearch.BackgroundUpdater
 }
 
 // NewServer creates a new instance of a grpc SSHServer
@@ -31,12 +35,14 @@ func NewServer(
 	locator storage.Locator,
 	gitCmdFactory git.CommandFactory,
 	txManager transaction.Manager,
+	catfileCache catfile.Cache,
 	serverOpts ...ServerOpt,
 ) gitalypb.SSHServiceServer {
 	s := &server{
 		locator:       locator,
 		gitCmdFactory: gitCmdFactory,
 		txManager:     txManager,
+		catfileCache:  catfileCache,
 		uploadPackRequestTimeoutTickerFactory: func() helper.Ticker {
 			return helper.NewTimerTicker(defaultUploadPackRequestTimeout)
 		},
@@ -47,6 +53,7 @@ func NewServer(
 			prometheus.CounterOpts{},
 			[]string{"git_negotiation_feature"},
 		),
+		codeSearchIndexUpdater: codesearch.NewBackgroundUpdater(),
 	}
 
 	for _, serverOpt := range serverOpts {
@@ -79,3 +86,11 @@ func WithPackfileNegotiationMetrics(c *prometheus.CounterVec) ServerOpt {
 		s.packfileNegotiationMetrics = c
 	}
 }
+
+// WithCodeSearchIndexUpdater sets the updater that updates the code search indices of repositories after
+// pushes.
+func WithCodeSearchIndexUpdater(updater *codesearch.BackgroundUpdater) ServerOpt {
+	return func(s *server) {
+		s.codeSearchIndexUpdater = updater
+	}
+}
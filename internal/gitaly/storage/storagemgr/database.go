@@ -1,64 +1,253 @@
 package storagemgr
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+
 	"github.com/dgraph-io/badger/v4"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+)
+
+var (
+	// ErrKeyNotFound is returned when the key looked up from the database doesn't exist.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrDatabaseClosed is returned when the database is accessed after it has been closed.
+	ErrDatabaseClosed = errors.New("database closed")
 )
 
-// OpenDatabase opens a new database handle to a database at the given path.
-func OpenDatabase(logger badger.Logger, databasePath string) (*badger.DB, error) {
+// Database is the key-value store the storage's state is stored in. It holds the partition assignments
+// of the repositories and the write-ahead log of the partitions. The keys are kept sorted in lexicographical
+// byte order. The implementations must guarantee that writes are durable once Update or WriteBatch.Flush
+// return successfully.
+type Database interface {
+	// NewWriteBatch returns a new write batch for writing multiple keys at once.
+	NewWriteBatch() WriteBatch
+	// View runs the handler in a read-only transaction.
+	View(func(DatabaseTransaction) error) error
+	// Update runs the handler in a read-write transaction. The changes are committed if the handler
+	// returns without an error and discarded otherwise.
+	Update(func(DatabaseTransaction) error) error
+	// Close closes the database. ErrDatabaseClosed is returned from further accesses.
+	Close() error
+}
+
+// WriteBatch batches writes to the database. The writes are not visible until the batch has been flushed.
+type WriteBatch interface {
+	// Set sets the key to the value.
+	Set(key []byte, value []byte) error
+	// Flush commits the writes in the batch.
+	Flush() error
+	// Cancel discards the writes in the batch if it hasn't been flushed yet.
+	Cancel()
+}
+
+// DatabaseTransaction is a transaction in the database. The keys and values returned from the transaction
+// are only valid until the transaction ends.
+type DatabaseTransaction interface {
+	// Get returns the item stored at the key. ErrKeyNotFound is returned if the key doesn't exist.
+	Get(key []byte) (Item, error)
+	// Set sets the key to the value.
+	Set(key []byte, value []byte) error
+	// Delete deletes the key.
+	Delete(key []byte) error
+	// NewIterator returns an iterator over the keys in the transaction. The iterator must be closed
+	// before the transaction ends.
+	NewIterator(IteratorOptions) Iterator
+}
+
+// Item is a key-value pair in the database.
+type Item interface {
+	// Key returns the key of the item. The key is only valid until the iterator is moved.
+	Key() []byte
+	// KeyCopy copies the key of the item into dst and returns it. A new slice is allocated if dst is
+	// not large enough.
+	KeyCopy(dst []byte) []byte
+	// Value calls the function with the value of the item. The value is only valid during the call.
+	Value(func(value []byte) error) error
+}
+
+// IteratorOptions configure the iteration.
+type IteratorOptions struct {
+	// Prefix limits the iteration to the keys with the given prefix.
+	Prefix []byte
+	// Reverse iterates the keys in descending order.
+	Reverse bool
+}
+
+// Iterator iterates over the keys in the database.
+type Iterator interface {
+	// Rewind positions the iterator on the first key in the iteration order.
+	Rewind()
+	// Seek positions the iterator on the first key that is greater than or equal to the given key. In
+	// reverse iteration, the iterator is positioned on the first key that is less than or equal to the
+	// given key.
+	Seek(key []byte)
+	// Valid returns whether the iterator is positioned on a key matching the prefix.
+	Valid() bool
+	// Next moves the iterator to the next key in the iteration order.
+	Next()
+	// Item returns the item the iterator is positioned on. Item must only be called if Valid returns true.
+	Item() Item
+	// Close releases the resources held by the iterator.
+	Close()
+}
+
+// prefixSuccessor returns the smallest key that is greater than every key with the given prefix. nil is
+// returned if there is no such key, which is the case when the prefix is empty or consists only of 0xff bytes.
+func prefixSuccessor(prefix []byte) []byte {
+	successor := bytes.Clone(prefix)
+	for i := len(successor) - 1; i >= 0; i-- {
+		if successor[i] != 0xff {
+			successor[i]++
+			return successor[:i+1]
+		}
+	}
+
+	return nil
+}
+
+// NewDatabaseOpener returns the DatabaseOpener of the given database as configured in the transactions
+// configuration. Badger is used if no database is configured.
+func NewDatabaseOpener(database string) (DatabaseOpener, error) {
+	switch database {
+	case "", config.TransactionsDatabaseBadger:
+		return OpenDatabase, nil
+	case config.TransactionsDatabaseBbolt:
+		return OpenBoltDatabase, nil
+	default:
+		return nil, fmt.Errorf("unsupported database: %q", database)
+	}
+}
+
+// OpenDatabase opens a new Badger backed database handle to a database in the given directory.
+func OpenDatabase(logger log.Logger, databasePath string) (Database, error) {
 	dbOptions := badger.DefaultOptions(databasePath)
 	// Enable SyncWrites to ensure all writes are persisted to disk before considering
 	// them committed.
 	dbOptions.SyncWrites = true
 	dbOptions.Logger = logger
 
-	return badger.Open(dbOptions)
+	db, err := badger.Open(dbOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return badgerDatabase{db: db}, nil
 }
 
-// databaseAdapter adapts a *badger.DB to the internal database interface used by the hooks in tests.
-type databaseAdapter struct{ *badger.DB }
+// badgerDatabase implements Database on top of Badger.
+type badgerDatabase struct{ db *badger.DB }
 
-// newDatabaseAdapter adapts a *badger.DB to conform to the internal database interface used for
-// hooking into during testing.
-func newDatabaseAdapter(db *badger.DB) database {
-	return databaseAdapter{DB: db}
+// translateBadgerError converts the errors Badger returns into the errors defined by the Database interface.
+func translateBadgerError(err error) error {
+	switch {
+	case errors.Is(err, badger.ErrKeyNotFound):
+		return ErrKeyNotFound
+	case errors.Is(err, badger.ErrDBClosed):
+		return ErrDatabaseClosed
+	default:
+		return err
+	}
 }
 
-// NewWriteBatch calls badger.*DB.NewWriteBatch. Refer to Badger's documentation for details.
-func (db databaseAdapter) NewWriteBatch() writeBatch {
-	return db.DB.NewWriteBatch()
+func (db badgerDatabase) NewWriteBatch() WriteBatch {
+	return badgerWriteBatch{batch: db.db.NewWriteBatch()}
 }
 
-// View calls badger.*DB.View. Refer to Badger's documentation for details.
-func (db databaseAdapter) View(handler func(databaseTransaction) error) error {
-	return db.DB.View(func(txn *badger.Txn) error { return handler(txn) })
+func (db badgerDatabase) View(handler func(DatabaseTransaction) error) error {
+	return translateBadgerError(db.db.View(func(txn *badger.Txn) error {
+		return handler(badgerTransaction{txn: txn})
+	}))
 }
 
-// Update calls badger.*DB.View. Refer to Badger's documentation for details.
-func (db databaseAdapter) Update(handler func(databaseTransaction) error) error {
-	return db.DB.Update(func(txn *badger.Txn) error { return handler(txn) })
+func (db badgerDatabase) Update(handler func(DatabaseTransaction) error) error {
+	return translateBadgerError(db.db.Update(func(txn *badger.Txn) error {
+		return handler(badgerTransaction{txn: txn})
+	}))
 }
 
-// database is the Badger.DB interface used by TransactionManager. Refer to Badger's documentation
-// for details.
-type database interface {
-	NewWriteBatch() writeBatch
-	View(func(databaseTransaction) error) error
-	Update(func(databaseTransaction) error) error
+func (db badgerDatabase) Close() error {
+	return db.db.Close()
 }
 
-// writeBatch is the interface of Badger.WriteBatch used by TransactionManager. Refer to Badger's
-// documentation for details.
-type writeBatch interface {
-	Set([]byte, []byte) error
-	Flush() error
-	Cancel()
+// badgerWriteBatch implements WriteBatch on top of Badger's write batch.
+type badgerWriteBatch struct{ batch *badger.WriteBatch }
+
+func (wb badgerWriteBatch) Set(key, value []byte) error {
+	return translateBadgerError(wb.batch.Set(key, value))
+}
+
+func (wb badgerWriteBatch) Flush() error {
+	return translateBadgerError(wb.batch.Flush())
+}
+
+func (wb badgerWriteBatch) Cancel() {
+	wb.batch.Cancel()
+}
+
+// badgerTransaction implements DatabaseTransaction on top of Badger's transaction.
+type badgerTransaction struct{ txn *badger.Txn }
+
+func (txn badgerTransaction) Get(key []byte) (Item, error) {
+	item, err := txn.txn.Get(key)
+	if err != nil {
+		return nil, translateBadgerError(err)
+	}
+
+	return item, nil
+}
+
+func (txn badgerTransaction) Set(key, value []byte) error {
+	return translateBadgerError(txn.txn.Set(key, value))
+}
+
+func (txn badgerTransaction) Delete(key []byte) error {
+	return translateBadgerError(txn.txn.Delete(key))
+}
+
+func (txn badgerTransaction) NewIterator(options IteratorOptions) Iterator {
+	badgerOptions := badger.DefaultIteratorOptions
+	badgerOptions.Prefix = options.Prefix
+	badgerOptions.Reverse = options.Reverse
+	if options.Reverse {
+		// Badger doesn't provide a way to rewind a reverse iterator onto the last key with the prefix.
+		// The prefix is matched by the adapter instead so the iterator can be positioned past the
+		// prefixed keys and stepped back from there.
+		badgerOptions.Prefix = nil
+	}
+
+	return badgerIterator{
+		iterator: txn.txn.NewIterator(badgerOptions),
+		options:  options,
+	}
+}
+
+// badgerIterator implements Iterator on top of Badger's iterator.
+type badgerIterator struct {
+	iterator *badger.Iterator
+	options  IteratorOptions
 }
 
-// databaseTransaction is the interface of *Badger.Txn used by TransactionManager. Refer to Badger's
-// documentation for details
-type databaseTransaction interface {
-	Get([]byte) (*badger.Item, error)
-	Delete([]byte) error
-	NewIterator(badger.IteratorOptions) *badger.Iterator
+func (it badgerIterator) Rewind() {
+	successor := prefixSuccessor(it.options.Prefix)
+	if !it.options.Reverse || successor == nil {
+		it.iterator.Rewind()
+		return
+	}
+
+	if it.iterator.Seek(successor); it.iterator.Valid() && bytes.Equal(it.iterator.Item().Key(), successor) {
+		it.iterator.Next()
+	}
 }
+
+func (it badgerIterator) Seek(key []byte) { it.iterator.Seek(key) }
+
+func (it badgerIterator) Valid() bool { return it.iterator.ValidForPrefix(it.options.Prefix) }
+
+func (it badgerIterator) Next() { it.iterator.Next() }
+
+func (it badgerIterator) Item() Item { return it.iterator.Item() }
+
+func (it badgerIterator) Close() { it.iterator.Close() }
@@ -0,0 +1,216 @@
+package storagemgr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"go.etcd.io/bbolt"
+)
+
+// boltDatabaseFileName is the name of the file the bbolt database is stored in within the database directory.
+const boltDatabaseFileName = "bbolt.db"
+
+// boltBucket is the name of the bucket all of the keys are stored in. The Database interface exposes a single
+// flat key space so a single bucket is sufficient.
+var boltBucket = []byte("storagemgr")
+
+// OpenBoltDatabase opens a new bbolt backed database handle to a database in the given directory. bbolt keeps
+// its data in a single memory-mapped file and doesn't run background compactions, which makes it lighter on
+// resources than Badger at the cost of write throughput.
+func OpenBoltDatabase(logger log.Logger, databasePath string) (Database, error) {
+	db, err := bbolt.Open(filepath.Join(databasePath, boltDatabaseFileName), perm.PrivateFile, &bbolt.Options{
+		// bbolt locks the database file exclusively. Fail instead of blocking forever if another
+		// process is holding the lock.
+		Timeout: time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	}); err != nil {
+		return nil, errors.Join(fmt.Errorf("create bucket: %w", err), db.Close())
+	}
+
+	return boltDatabase{db: db}, nil
+}
+
+// boltDatabase implements Database on top of bbolt.
+type boltDatabase struct{ db *bbolt.DB }
+
+// translateBoltError converts the errors bbolt returns into the errors defined by the Database interface.
+func translateBoltError(err error) error {
+	if errors.Is(err, bbolt.ErrDatabaseNotOpen) {
+		return ErrDatabaseClosed
+	}
+
+	return err
+}
+
+func (db boltDatabase) NewWriteBatch() WriteBatch {
+	return &boltWriteBatch{db: db}
+}
+
+func (db boltDatabase) View(handler func(DatabaseTransaction) error) error {
+	return translateBoltError(db.db.View(func(tx *bbolt.Tx) error {
+		return handler(boltTransaction{bucket: tx.Bucket(boltBucket)})
+	}))
+}
+
+func (db boltDatabase) Update(handler func(DatabaseTransaction) error) error {
+	return translateBoltError(db.db.Update(func(tx *bbolt.Tx) error {
+		return handler(boltTransaction{bucket: tx.Bucket(boltBucket)})
+	}))
+}
+
+func (db boltDatabase) Close() error {
+	return db.db.Close()
+}
+
+// boltWriteBatch implements WriteBatch by buffering the writes in memory and committing them in a single
+// read-write transaction when flushed.
+type boltWriteBatch struct {
+	db     boltDatabase
+	writes []boltWrite
+}
+
+// boltWrite is a single write buffered in a boltWriteBatch.
+type boltWrite struct {
+	key   []byte
+	value []byte
+}
+
+func (wb *boltWriteBatch) Set(key, value []byte) error {
+	// The caller may reuse the buffers after Set returns so copy them.
+	wb.writes = append(wb.writes, boltWrite{
+		key:   bytes.Clone(key),
+		value: append([]byte{}, value...),
+	})
+
+	return nil
+}
+
+func (wb *boltWriteBatch) Flush() error {
+	writes := wb.writes
+	wb.writes = nil
+
+	return wb.db.Update(func(txn DatabaseTransaction) error {
+		for _, write := range writes {
+			if err := txn.Set(write.key, write.value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (wb *boltWriteBatch) Cancel() {
+	wb.writes = nil
+}
+
+// boltTransaction implements DatabaseTransaction on top of a bbolt transaction.
+type boltTransaction struct{ bucket *bbolt.Bucket }
+
+func (txn boltTransaction) Get(key []byte) (Item, error) {
+	// The cursor is used instead of Bucket.Get as the latter doesn't differentiate between
+	// a missing key and an empty value.
+	foundKey, value := txn.bucket.Cursor().Seek(key)
+	if foundKey == nil || !bytes.Equal(foundKey, key) {
+		return nil, ErrKeyNotFound
+	}
+
+	return boltItem{key: foundKey, value: value}, nil
+}
+
+func (txn boltTransaction) Set(key, value []byte) error {
+	return txn.bucket.Put(key, value)
+}
+
+func (txn boltTransaction) Delete(key []byte) error {
+	return txn.bucket.Delete(key)
+}
+
+func (txn boltTransaction) NewIterator(options IteratorOptions) Iterator {
+	return &boltIterator{cursor: txn.bucket.Cursor(), options: options}
+}
+
+// boltItem implements Item for a key-value pair read from bbolt.
+type boltItem struct {
+	key   []byte
+	value []byte
+}
+
+func (item boltItem) Key() []byte { return item.key }
+
+func (item boltItem) KeyCopy(dst []byte) []byte { return append(dst[:0], item.key...) }
+
+func (item boltItem) Value(fn func([]byte) error) error { return fn(item.value) }
+
+// boltIterator implements Iterator on top of a bbolt cursor.
+type boltIterator struct {
+	cursor  *bbolt.Cursor
+	options IteratorOptions
+	key     []byte
+	value   []byte
+}
+
+func (it *boltIterator) Rewind() {
+	if !it.options.Reverse {
+		it.key, it.value = it.cursor.Seek(it.options.Prefix)
+		return
+	}
+
+	successor := prefixSuccessor(it.options.Prefix)
+	if successor == nil {
+		it.key, it.value = it.cursor.Last()
+		return
+	}
+
+	it.seekReverse(successor)
+	if it.key != nil && bytes.Equal(it.key, successor) {
+		it.key, it.value = it.cursor.Prev()
+	}
+}
+
+func (it *boltIterator) Seek(key []byte) {
+	if !it.options.Reverse {
+		it.key, it.value = it.cursor.Seek(key)
+		return
+	}
+
+	it.seekReverse(key)
+}
+
+// seekReverse positions the cursor on the last key that is less than or equal to the given key.
+func (it *boltIterator) seekReverse(key []byte) {
+	if it.key, it.value = it.cursor.Seek(key); it.key == nil {
+		it.key, it.value = it.cursor.Last()
+	} else if !bytes.Equal(it.key, key) {
+		it.key, it.value = it.cursor.Prev()
+	}
+}
+
+func (it *boltIterator) Valid() bool {
+	return it.key != nil && bytes.HasPrefix(it.key, it.options.Prefix)
+}
+
+func (it *boltIterator) Next() {
+	if it.options.Reverse {
+		it.key, it.value = it.cursor.Prev()
+		return
+	}
+
+	it.key, it.value = it.cursor.Next()
+}
+
+func (it *boltIterator) Item() Item { return boltItem{key: it.key, value: it.value} }
+
+func (it *boltIterator) Close() {}
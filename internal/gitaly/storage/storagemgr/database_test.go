@@ -0,0 +1,311 @@
+package storagemgr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestDatabase(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		openDatabase DatabaseOpener
+	}{
+		{desc: "badger", openDatabase: OpenDatabase},
+		{desc: "bbolt", openDatabase: OpenBoltDatabase},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			testDatabase(t, tc.openDatabase)
+		})
+	}
+}
+
+// testDatabase is the conformance test suite every Database implementation is expected to pass.
+func testDatabase(t *testing.T, openDatabase DatabaseOpener) {
+	setup := func(t *testing.T) Database {
+		t.Helper()
+
+		db, err := openDatabase(testhelper.SharedLogger(t), t.TempDir())
+		require.NoError(t, err)
+		t.Cleanup(func() { testhelper.MustClose(t, db) })
+
+		return db
+	}
+
+	set := func(t *testing.T, db Database, keyValues map[string]string) {
+		t.Helper()
+
+		wb := db.NewWriteBatch()
+		defer wb.Cancel()
+
+		for key, value := range keyValues {
+			require.NoError(t, wb.Set([]byte(key), []byte(value)))
+		}
+
+		require.NoError(t, wb.Flush())
+	}
+
+	get := func(t *testing.T, db Database, key string) (string, error) {
+		t.Helper()
+
+		var value string
+		err := db.View(func(txn DatabaseTransaction) error {
+			item, err := txn.Get([]byte(key))
+			if err != nil {
+				return err
+			}
+
+			require.Equal(t, key, string(item.Key()))
+			return item.Value(func(v []byte) error {
+				value = string(v)
+				return nil
+			})
+		})
+
+		return value, err
+	}
+
+	iterate := func(t *testing.T, db Database, options IteratorOptions, seek []byte) []string {
+		t.Helper()
+
+		keys := []string{}
+		require.NoError(t, db.View(func(txn DatabaseTransaction) error {
+			iterator := txn.NewIterator(options)
+			defer iterator.Close()
+
+			if seek != nil {
+				iterator.Seek(seek)
+			} else {
+				iterator.Rewind()
+			}
+
+			for ; iterator.Valid(); iterator.Next() {
+				keys = append(keys, string(iterator.Item().KeyCopy(nil)))
+			}
+
+			return nil
+		}))
+
+		return keys
+	}
+
+	t.Run("missing key", func(t *testing.T) {
+		db := setup(t)
+
+		require.NoError(t, db.View(func(txn DatabaseTransaction) error {
+			item, err := txn.Get([]byte("missing"))
+			require.Equal(t, ErrKeyNotFound, err)
+			require.Nil(t, item)
+			return nil
+		}))
+	})
+
+	t.Run("write batch", func(t *testing.T) {
+		db := setup(t)
+
+		set(t, db, map[string]string{"key-1": "value-1", "key-2": ""})
+
+		value, err := get(t, db, "key-1")
+		require.NoError(t, err)
+		require.Equal(t, "value-1", value)
+
+		value, err = get(t, db, "key-2")
+		require.NoError(t, err)
+		require.Empty(t, value)
+
+		set(t, db, map[string]string{"key-1": "value-2"})
+
+		value, err = get(t, db, "key-1")
+		require.NoError(t, err)
+		require.Equal(t, "value-2", value)
+	})
+
+	t.Run("canceled write batch", func(t *testing.T) {
+		db := setup(t)
+
+		wb := db.NewWriteBatch()
+		require.NoError(t, wb.Set([]byte("key"), []byte("value")))
+		wb.Cancel()
+
+		_, err := get(t, db, "key")
+		require.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		db := setup(t)
+
+		set(t, db, map[string]string{"deleted": "value"})
+
+		require.NoError(t, db.Update(func(txn DatabaseTransaction) error {
+			require.NoError(t, txn.Set([]byte("set"), []byte("value")))
+			require.NoError(t, txn.Delete([]byte("deleted")))
+
+			// The transaction's own writes are visible to it.
+			item, err := txn.Get([]byte("set"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("set"), item.Key())
+
+			_, err = txn.Get([]byte("deleted"))
+			require.Equal(t, ErrKeyNotFound, err)
+
+			return nil
+		}))
+
+		value, err := get(t, db, "set")
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+
+		_, err = get(t, db, "deleted")
+		require.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("failed update is rolled back", func(t *testing.T) {
+		db := setup(t)
+
+		set(t, db, map[string]string{"deleted": "value"})
+
+		expectedErr := errors.New("expected error")
+		require.Equal(t, expectedErr, db.Update(func(txn DatabaseTransaction) error {
+			require.NoError(t, txn.Set([]byte("set"), []byte("value")))
+			require.NoError(t, txn.Delete([]byte("deleted")))
+			return expectedErr
+		}))
+
+		_, err := get(t, db, "set")
+		require.Equal(t, ErrKeyNotFound, err)
+
+		value, err := get(t, db, "deleted")
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+	})
+
+	t.Run("iteration", func(t *testing.T) {
+		db := setup(t)
+
+		set(t, db, map[string]string{
+			"a":               "",
+			"prefix":          "",
+			"prefix/1":        "",
+			"prefix/2":        "",
+			"prefix/3":        "",
+			"prefix/\xff":     "",
+			"prefix/\xff\x01": "",
+			"prefix0":         "",
+			"z":               "",
+		})
+
+		for _, tc := range []struct {
+			desc         string
+			options      IteratorOptions
+			seek         []byte
+			expectedKeys []string
+		}{
+			{
+				desc:         "all keys",
+				expectedKeys: []string{"a", "prefix", "prefix/1", "prefix/2", "prefix/3", "prefix/\xff", "prefix/\xff\x01", "prefix0", "z"},
+			},
+			{
+				desc:         "all keys in reverse",
+				options:      IteratorOptions{Reverse: true},
+				expectedKeys: []string{"z", "prefix0", "prefix/\xff\x01", "prefix/\xff", "prefix/3", "prefix/2", "prefix/1", "prefix", "a"},
+			},
+			{
+				desc:         "prefix",
+				options:      IteratorOptions{Prefix: []byte("prefix/")},
+				expectedKeys: []string{"prefix/1", "prefix/2", "prefix/3", "prefix/\xff", "prefix/\xff\x01"},
+			},
+			{
+				desc:         "prefix in reverse",
+				options:      IteratorOptions{Prefix: []byte("prefix/"), Reverse: true},
+				expectedKeys: []string{"prefix/\xff\x01", "prefix/\xff", "prefix/3", "prefix/2", "prefix/1"},
+			},
+			{
+				desc:         "prefix without keys",
+				options:      IteratorOptions{Prefix: []byte("missing/")},
+				expectedKeys: []string{},
+			},
+			{
+				desc:         "prefix without keys in reverse",
+				options:      IteratorOptions{Prefix: []byte("missing/"), Reverse: true},
+				expectedKeys: []string{},
+			},
+			{
+				desc:         "seek to existing key",
+				options:      IteratorOptions{Prefix: []byte("prefix/")},
+				seek:         []byte("prefix/2"),
+				expectedKeys: []string{"prefix/2", "prefix/3", "prefix/\xff", "prefix/\xff\x01"},
+			},
+			{
+				desc:         "seek to missing key",
+				options:      IteratorOptions{Prefix: []byte("prefix/")},
+				seek:         []byte("prefix/25"),
+				expectedKeys: []string{"prefix/3", "prefix/\xff", "prefix/\xff\x01"},
+			},
+			{
+				desc:         "seek to existing key in reverse",
+				options:      IteratorOptions{Prefix: []byte("prefix/"), Reverse: true},
+				seek:         []byte("prefix/2"),
+				expectedKeys: []string{"prefix/2", "prefix/1"},
+			},
+			{
+				desc:         "seek to missing key in reverse",
+				options:      IteratorOptions{Prefix: []byte("prefix/"), Reverse: true},
+				seek:         []byte("prefix/25"),
+				expectedKeys: []string{"prefix/2", "prefix/1"},
+			},
+			{
+				desc:         "seek past the last key in reverse",
+				options:      IteratorOptions{Reverse: true},
+				seek:         []byte("zz"),
+				expectedKeys: []string{"z", "prefix0", "prefix/\xff\x01", "prefix/\xff", "prefix/3", "prefix/2", "prefix/1", "prefix", "a"},
+			},
+		} {
+			tc := tc
+
+			t.Run(tc.desc, func(t *testing.T) {
+				require.Equal(t, tc.expectedKeys, iterate(t, db, tc.options, tc.seek))
+			})
+		}
+	})
+
+	t.Run("persisted across reopening", func(t *testing.T) {
+		databasePath := t.TempDir()
+
+		db, err := openDatabase(testhelper.SharedLogger(t), databasePath)
+		require.NoError(t, err)
+
+		set(t, db, map[string]string{"key": "value"})
+		testhelper.MustClose(t, db)
+
+		db, err = openDatabase(testhelper.SharedLogger(t), databasePath)
+		require.NoError(t, err)
+		defer testhelper.MustClose(t, db)
+
+		value, err := get(t, db, "key")
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+	})
+
+	t.Run("closed database", func(t *testing.T) {
+		db, err := openDatabase(testhelper.SharedLogger(t), t.TempDir())
+		require.NoError(t, err)
+		testhelper.MustClose(t, db)
+
+		require.Equal(t, ErrDatabaseClosed, db.View(func(DatabaseTransaction) error {
+			t.Fatal("the handler should not be called")
+			return nil
+		}))
+
+		require.Equal(t, ErrDatabaseClosed, db.Update(func(DatabaseTransaction) error {
+			t.Fatal("the handler should not be called")
+			return nil
+		}))
+	})
+}
@@ -0,0 +1,450 @@
+package storagemgr
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrHistoryNotRetained is returned when a historical snapshot is requested at a point that is not
+// retained in the write-ahead log's history anymore.
+var ErrHistoryNotRetained = structerr.NewNotFound("history not retained")
+
+// HistoricalSnapshot describes the references of a repository as they were at a past log index. The history
+// only records the reference and default branch changes so the rest of the repository's state, such as its
+// custom hooks, is not included.
+type HistoricalSnapshot struct {
+	// LogIndex is the index of the log entry the snapshot was read at.
+	LogIndex LogIndex
+	// AppliedAt is the time the log entry at LogIndex was applied. It's the zero time if the
+	// time is not known.
+	AppliedAt time.Time
+	// DefaultBranch is the reference the default branch pointed to.
+	DefaultBranch git.ReferenceName
+	// References are the references that existed, sorted by their names.
+	References []git.Reference
+}
+
+// historyEntry is a log entry retained in the write-ahead log's history.
+type historyEntry struct {
+	// index is the index of the log entry.
+	index LogIndex
+	// entry is the history recorded for the log entry.
+	entry *gitalypb.LogHistoryEntry
+}
+
+// HistoricalSnapshotAtLogIndex returns the references of the repository as they were after the log entry
+// at the given index was applied. Log index 0 refers to the state prior to any log entries. The index must
+// not be newer than the transaction's read index. ErrHistoryNotRetained is returned if the history needed
+// to reconstruct the state has not been retained.
+//
+// If the history retention is disabled, only the state at the transaction's read index can be read. In that
+// case the returned references may include changes committed after the transaction began.
+func (txn *Transaction) HistoricalSnapshotAtLogIndex(ctx context.Context, index LogIndex) (HistoricalSnapshot, error) {
+	if index > txn.snapshot.ReadIndex {
+		return HistoricalSnapshot{}, structerr.NewInvalidArgument("log index is newer than the read index").
+			WithMetadata("log_index", index).
+			WithMetadata("read_index", txn.snapshot.ReadIndex)
+	}
+
+	state, err := txn.readCurrentState(ctx)
+	if err != nil {
+		return HistoricalSnapshot{}, err
+	}
+
+	return txn.reconstructSnapshot(ctx, index, state)
+}
+
+// HistoricalSnapshotAtTime returns the references of the repository as they were at the given time. The
+// snapshot is read at the latest log entry that was applied at or before the given time. ErrHistoryNotRetained
+// is returned if the history doesn't reach back to the given time.
+func (txn *Transaction) HistoricalSnapshotAtTime(ctx context.Context, t time.Time) (HistoricalSnapshot, error) {
+	state, err := txn.readCurrentState(ctx)
+	if err != nil {
+		return HistoricalSnapshot{}, err
+	}
+
+	var index LogIndex
+	found := false
+	for _, entry := range state.history {
+		if entry.index > txn.snapshot.ReadIndex || entry.entry.GetAppliedAt().AsTime().After(t) {
+			break
+		}
+
+		index = entry.index
+		found = true
+	}
+
+	if !found {
+		// The state prior to the oldest retained log entry is only known to have been valid at the given
+		// time if the oldest retained log entry is the first log entry. Otherwise the given time may predate
+		// log entries that have already been pruned from the history.
+		if len(state.history) == 0 || state.history[0].index != 1 {
+			return HistoricalSnapshot{}, ErrHistoryNotRetained
+		}
+	}
+
+	return txn.reconstructSnapshot(ctx, index, state)
+}
+
+// currentState is the current state of the repository's references along with the retained history.
+type currentState struct {
+	// defaultBranch is the reference the default branch points to.
+	defaultBranch git.ReferenceName
+	// references are the references in the repository.
+	references []git.Reference
+	// history contains the retained history entries in ascending order.
+	history []historyEntry
+}
+
+// readCurrentState reads the current state of the references and the retained history.
+func (txn *Transaction) readCurrentState(ctx context.Context) (currentState, error) {
+	// The references are read prior to the history. The history of a log entry is recorded before the log
+	// entry is applied, so the history we read afterwards covers every log entry that may be included in
+	// the references.
+	defaultBranch, err := txn.stagingRepository.HeadReference(ctx)
+	if err != nil {
+		return currentState{}, fmt.Errorf("head reference: %w", err)
+	}
+
+	references, err := txn.stagingRepository.GetReferences(ctx)
+	if err != nil {
+		return currentState{}, fmt.Errorf("get references: %w", err)
+	}
+
+	history, err := txn.readHistory()
+	if err != nil {
+		return currentState{}, fmt.Errorf("read history: %w", err)
+	}
+
+	return currentState{
+		defaultBranch: defaultBranch,
+		references:    references,
+		history:       history,
+	}, nil
+}
+
+// reconstructSnapshot reconstructs the state of the references at the given log index by reverting the changes
+// recorded in the history entries of the later log entries from the current state of the references.
+func (txn *Transaction) reconstructSnapshot(ctx context.Context, index LogIndex, state currentState) (HistoricalSnapshot, error) {
+	objectHash, err := txn.stagingRepository.ObjectHash(ctx)
+	if err != nil {
+		return HistoricalSnapshot{}, fmt.Errorf("object hash: %w", err)
+	}
+
+	snapshot := HistoricalSnapshot{
+		LogIndex:      index,
+		DefaultBranch: state.defaultBranch,
+	}
+
+	var laterEntries []historyEntry
+	for _, entry := range state.history {
+		if entry.index == index {
+			snapshot.AppliedAt = entry.entry.GetAppliedAt().AsTime()
+		}
+
+		if entry.index > index {
+			laterEntries = append(laterEntries, entry)
+		}
+	}
+
+	// Each log entry after the requested index must be present in the history as otherwise its changes
+	// can't be reverted. The history may include entries newer than the transaction's read index if they
+	// were applied concurrently. These have to be reverted as well as the references may include them.
+	expectedIndex := index + 1
+	for _, entry := range laterEntries {
+		if entry.index != expectedIndex {
+			return HistoricalSnapshot{}, ErrHistoryNotRetained
+		}
+
+		expectedIndex++
+	}
+
+	if index < txn.snapshot.ReadIndex && expectedIndex <= txn.snapshot.ReadIndex {
+		return HistoricalSnapshot{}, ErrHistoryNotRetained
+	}
+
+	tips := make(map[git.ReferenceName]git.ObjectID, len(state.references))
+	for _, reference := range state.references {
+		if reference.IsSymbolic {
+			continue
+		}
+
+		tips[reference.Name] = git.ObjectID(reference.Target)
+	}
+
+	// Revert the log entries from the newest to the oldest. Reverting a log entry that hasn't yet been applied
+	// is harmless as the old values it records are the current values of the references.
+	for i := len(laterEntries) - 1; i >= 0; i-- {
+		entry := laterEntries[i].entry
+
+		for _, change := range entry.GetReferenceChanges() {
+			referenceName := git.ReferenceName(change.GetReferenceName())
+			if oldOID := git.ObjectID(change.GetOldOid()); !objectHash.IsZeroOID(oldOID) {
+				tips[referenceName] = oldOID
+				continue
+			}
+
+			delete(tips, referenceName)
+		}
+
+		if len(entry.GetOldDefaultBranch()) > 0 {
+			snapshot.DefaultBranch = git.ReferenceName(entry.GetOldDefaultBranch())
+		}
+	}
+
+	snapshot.References = make([]git.Reference, 0, len(tips))
+	for referenceName, oid := range tips {
+		snapshot.References = append(snapshot.References, git.NewReference(referenceName, oid))
+	}
+
+	sort.Slice(snapshot.References, func(i, j int) bool {
+		return snapshot.References[i].Name < snapshot.References[j].Name
+	})
+
+	return snapshot, nil
+}
+
+// readHistory returns the retained history entries of the repository at the given relative path in ascending order.
+func (mgr *TransactionManager) readHistory(relativePath string) ([]historyEntry, error) {
+	var entries []historyEntry
+	if err := mgr.db.View(func(txn DatabaseTransaction) error {
+		var err error
+		entries, err = mgr.readHistoryEntries(txn, relativePath)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// readHistoryEntries reads the retained history entries of the repository at the given relative path in ascending
+// order in the given database transaction. The history is shared by the repositories of the partition so each entry
+// only retains the changes of the given repository. The entries up to the repository's latest deletion are left
+// out as they describe a repository that doesn't exist anymore.
+func (mgr *TransactionManager) readHistoryEntries(txn DatabaseTransaction, relativePath string) ([]historyEntry, error) {
+	historyPrefix := keyPrefixLogHistory(mgr.partitionID)
+
+	iterator := txn.NewIterator(IteratorOptions{Prefix: historyPrefix})
+	defer iterator.Close()
+
+	var entries []historyEntry
+	for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+		item := iterator.Item()
+
+		var entry gitalypb.LogHistoryEntry
+		if err := item.Value(func(value []byte) error { return proto.Unmarshal(value, &entry) }); err != nil {
+			return nil, fmt.Errorf("unmarshal: %w", err)
+		}
+
+		repositoryEntry := mgr.repositoryHistoryEntry(&entry, relativePath)
+		if repositoryEntry.GetRepositoryDeleted() {
+			entries = entries[:0]
+			continue
+		}
+
+		entries = append(entries, historyEntry{
+			index: LogIndex(binary.BigEndian.Uint64(bytes.TrimPrefix(item.Key(), historyPrefix))),
+			entry: repositoryEntry,
+		})
+	}
+
+	return entries, nil
+}
+
+// repositoryHistoryEntry returns the changes of the history entry that apply to the repository at the given relative
+// path.
+func (mgr *TransactionManager) repositoryHistoryEntry(entry *gitalypb.LogHistoryEntry, relativePath string) *gitalypb.LogHistoryEntry {
+	repositoryEntry := &gitalypb.LogHistoryEntry{
+		AppliedAt: entry.GetAppliedAt(),
+	}
+
+	if entry.GetRelativePath() == relativePath {
+		repositoryEntry.ReferenceChanges = entry.GetReferenceChanges()
+		repositoryEntry.OldDefaultBranch = entry.GetOldDefaultBranch()
+		repositoryEntry.NewDefaultBranch = entry.GetNewDefaultBranch()
+		repositoryEntry.CustomHooksUpdated = entry.GetCustomHooksUpdated()
+		repositoryEntry.RepositoryDeleted = entry.GetRepositoryDeleted()
+		return repositoryEntry
+	}
+
+	for _, changes := range entry.GetPartitionReferenceChanges() {
+		if changes.GetRelativePath() == relativePath {
+			repositoryEntry.ReferenceChanges = changes.GetReferenceChanges()
+		}
+	}
+
+	return repositoryEntry
+}
+
+// recordHistory records the history entry of the log entry at the given index if the history retention is
+// enabled. It must be called prior to applying the log entry as it records the state of the references the log
+// entry is about to change. If the history entry has already been recorded, for example by an earlier attempt
+// to apply the log entry that was interrupted, the existing entry is kept as is. The repository is the one the
+// log entry's repository-scoped changes apply to. The reference changes in the other repositories of the partition
+// are recorded as well.
+func (mgr *TransactionManager) recordHistory(ctx context.Context, logIndex LogIndex, repository *localrepo.Repo, logEntry *gitalypb.LogEntry) error {
+	if mgr.historyRetention == 0 {
+		return nil
+	}
+
+	if err := mgr.readKey(keyLogHistory(mgr.partitionID, logIndex), &gitalypb.LogHistoryEntry{}); err == nil {
+		return nil
+	} else if !errors.Is(err, ErrKeyNotFound) {
+		return fmt.Errorf("read history entry: %w", err)
+	}
+
+	historyEntry := &gitalypb.LogHistoryEntry{
+		AppliedAt:         timestamppb.New(mgr.now()),
+		RelativePath:      logEntry.RelativePath,
+		RepositoryDeleted: logEntry.RepositoryDeletion != nil,
+	}
+
+	// The repository-scoped changes of a deletion are not applied as the repository is removed.
+	if logEntry.RepositoryDeletion == nil {
+		var err error
+		historyEntry.ReferenceChanges, err = recordReferenceChanges(ctx, repository, logEntry.ReferenceUpdates)
+		if err != nil {
+			return fmt.Errorf("record reference changes: %w", err)
+		}
+
+		if logEntry.DefaultBranchUpdate != nil {
+			oldDefaultBranch, err := repository.HeadReference(ctx)
+			if err != nil {
+				return fmt.Errorf("head reference: %w", err)
+			}
+
+			historyEntry.OldDefaultBranch = []byte(oldDefaultBranch)
+			historyEntry.NewDefaultBranch = logEntry.DefaultBranchUpdate.ReferenceName
+		}
+
+		historyEntry.CustomHooksUpdated = logEntry.CustomHooksUpdate != nil
+	}
+
+	for _, repositoryUpdates := range logEntry.PartitionReferenceUpdates {
+		referenceChanges, err := recordReferenceChanges(ctx, mgr.repositoryFactory.Build(repositoryUpdates.RelativePath), repositoryUpdates.ReferenceUpdates)
+		if err != nil {
+			return fmt.Errorf("record partition reference changes: %q: %w", repositoryUpdates.RelativePath, err)
+		}
+
+		historyEntry.PartitionReferenceChanges = append(historyEntry.PartitionReferenceChanges, &gitalypb.LogHistoryEntry_RepositoryReferenceChanges{
+			RelativePath:     repositoryUpdates.RelativePath,
+			ReferenceChanges: referenceChanges,
+		})
+	}
+
+	if err := mgr.setKey(keyLogHistory(mgr.partitionID, logIndex), historyEntry); err != nil {
+		return fmt.Errorf("set history entry: %w", err)
+	}
+
+	return nil
+}
+
+// recordReferenceChanges returns the changes the reference updates perform in the repository. The old values of
+// the references are read from the repository so it must be called prior to applying the updates.
+func recordReferenceChanges(ctx context.Context, repository *localrepo.Repo, updates []*gitalypb.LogEntry_ReferenceUpdate) ([]*gitalypb.LogHistoryEntry_ReferenceChange, error) {
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	objectHash, err := repository.ObjectHash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("object hash: %w", err)
+	}
+
+	patterns := make([]string, 0, len(updates))
+	for _, update := range updates {
+		patterns = append(patterns, string(update.ReferenceName))
+	}
+
+	// The patterns also match references nested under the updated references. The old values
+	// are thus picked by the exact reference names.
+	references, err := repository.GetReferences(ctx, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("get references: %w", err)
+	}
+
+	oldTips := make(map[git.ReferenceName]git.ObjectID, len(references))
+	for _, reference := range references {
+		oldTips[reference.Name] = git.ObjectID(reference.Target)
+	}
+
+	changes := make([]*gitalypb.LogHistoryEntry_ReferenceChange, 0, len(updates))
+	for _, update := range updates {
+		oldOID, ok := oldTips[git.ReferenceName(update.ReferenceName)]
+		if !ok {
+			oldOID = objectHash.ZeroOID
+		}
+
+		changes = append(changes, &gitalypb.LogHistoryEntry_ReferenceChange{
+			ReferenceName: update.ReferenceName,
+			OldOid:        []byte(oldOID),
+			NewOid:        update.NewOid,
+		})
+	}
+
+	return changes, nil
+}
+
+// pruneHistory deletes the history entries that fall outside of the history retention after the log entry at the
+// given index has been applied. If the repository was deleted, the entire history is deleted as the references it
+// records don't exist anymore.
+func (mgr *TransactionManager) pruneHistory(appliedIndex LogIndex, repositoryDeleted bool) error {
+	var retainedFrom LogIndex
+	if !repositoryDeleted {
+		if uint64(appliedIndex) < mgr.historyRetention {
+			return nil
+		}
+
+		retainedFrom = appliedIndex - LogIndex(mgr.historyRetention) + 1
+	}
+
+	return mgr.db.Update(func(txn DatabaseTransaction) error {
+		historyPrefix := keyPrefixLogHistory(mgr.partitionID)
+
+		iterator := txn.NewIterator(IteratorOptions{Prefix: historyPrefix})
+		defer iterator.Close()
+
+		var keys [][]byte
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			key := iterator.Item().KeyCopy(nil)
+			if !repositoryDeleted && LogIndex(binary.BigEndian.Uint64(bytes.TrimPrefix(key, historyPrefix))) >= retainedFrom {
+				break
+			}
+
+			keys = append(keys, key)
+		}
+
+		for _, key := range keys {
+			if err := txn.Delete(key); err != nil {
+				return fmt.Errorf("delete: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// keyLogHistory returns the database key storing the history of a partition's log entry at a given index.
+func keyLogHistory(ptnID partitionID, index LogIndex) []byte {
+	marshaledIndex := make([]byte, binary.Size(index))
+	binary.BigEndian.PutUint64(marshaledIndex, uint64(index))
+	return []byte(fmt.Sprintf("%s%s", keyPrefixLogHistory(ptnID), marshaledIndex))
+}
+
+// keyPrefixLogHistory returns the key prefix holding the history of a partition's applied log entries.
+func keyPrefixLogHistory(ptnID partitionID) []byte {
+	return []byte(fmt.Sprintf("partition/%s/log/history/", ptnID.MarshalBinary()))
+}
@@ -0,0 +1,598 @@
+package storagemgr
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/backchannel"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTransactionManager_history(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+	secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(firstCommit))
+
+	cmdFactory := gittest.NewCommandFactory(t, cfg)
+	cache := catfile.NewCache(cfg)
+	defer cache.Stop()
+
+	database, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, database)
+
+	txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+	housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+	repositoryFactory, err := localrepo.NewFactory(
+		config.NewLocator(cfg), cmdFactory, cache,
+	).ScopeByStorage(cfg.Storages[0].Name)
+	require.NoError(t, err)
+
+	objectHash, err := repositoryFactory.Build(repo.RelativePath).ObjectHash(ctx)
+	require.NoError(t, err)
+
+	manager := NewTransactionManager(testPartitionID, database, cfg.Storages[0].Path, repo.RelativePath, t.TempDir(), t.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
+	manager.historyRetention = 2
+
+	// Each log entry is applied a minute after the previous one.
+	epoch := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	appliedAt := func(index LogIndex) time.Time {
+		return epoch.Add(time.Duration(index) * time.Minute)
+	}
+
+	var appliedEntries LogIndex
+	manager.now = func() time.Time {
+		appliedEntries++
+		return appliedAt(appliedEntries)
+	}
+
+	managerErr := make(chan error)
+	go func() { managerErr <- manager.Run() }()
+	defer func() {
+		manager.Close()
+		assert.NoError(t, <-managerErr)
+	}()
+
+	commit := func(updates ReferenceUpdates, defaultBranch git.ReferenceName) {
+		t.Helper()
+
+		transaction, err := manager.Begin(ctx, TransactionOptions{})
+		require.NoError(t, err)
+
+		transaction.UpdateReferences(updates)
+		if defaultBranch != "" {
+			transaction.SetDefaultBranch(defaultBranch)
+		}
+
+		require.NoError(t, transaction.Commit(ctx))
+	}
+
+	// Log index 1 creates the main branch.
+	commit(ReferenceUpdates{
+		"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: firstCommit},
+	}, "")
+	// Log index 2 creates a feature branch and points the default branch to it.
+	commit(ReferenceUpdates{
+		"refs/heads/feature": {OldOID: objectHash.ZeroOID, NewOID: firstCommit},
+	}, "refs/heads/feature")
+	// Log index 3 updates the main branch and deletes the feature branch.
+	commit(ReferenceUpdates{
+		"refs/heads/main":    {OldOID: firstCommit, NewOID: secondCommit},
+		"refs/heads/feature": {OldOID: firstCommit, NewOID: objectHash.ZeroOID},
+	}, "refs/heads/main")
+
+	// The history of the first log entry has been pruned as only the two latest are retained.
+	RequireDatabase(t, ctx, database, DatabaseState{
+		string(keyAppliedLogIndex(testPartitionID)): LogIndex(3).toProto(),
+		string(keyLogHistory(testPartitionID, 2)): &gitalypb.LogHistoryEntry{
+			RelativePath: repo.RelativePath,
+			ReferenceChanges: []*gitalypb.LogHistoryEntry_ReferenceChange{
+				{
+					ReferenceName: []byte("refs/heads/feature"),
+					OldOid:        []byte(objectHash.ZeroOID),
+					NewOid:        []byte(firstCommit),
+				},
+			},
+			OldDefaultBranch: []byte(git.DefaultRef),
+			NewDefaultBranch: []byte("refs/heads/feature"),
+			AppliedAt:        timestamppb.New(appliedAt(2)),
+		},
+		string(keyLogHistory(testPartitionID, 3)): &gitalypb.LogHistoryEntry{
+			RelativePath: repo.RelativePath,
+			ReferenceChanges: []*gitalypb.LogHistoryEntry_ReferenceChange{
+				{
+					ReferenceName: []byte("refs/heads/feature"),
+					OldOid:        []byte(firstCommit),
+					NewOid:        []byte(objectHash.ZeroOID),
+				},
+				{
+					ReferenceName: []byte("refs/heads/main"),
+					OldOid:        []byte(firstCommit),
+					NewOid:        []byte(secondCommit),
+				},
+			},
+			OldDefaultBranch: []byte("refs/heads/feature"),
+			NewDefaultBranch: []byte("refs/heads/main"),
+			AppliedAt:        timestamppb.New(appliedAt(3)),
+		},
+	})
+
+	transaction, err := manager.Begin(ctx, TransactionOptions{ReadOnly: true})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, transaction.Rollback()) }()
+
+	snapshotAtIndex1 := HistoricalSnapshot{
+		LogIndex:      1,
+		DefaultBranch: git.DefaultRef,
+		References: []git.Reference{
+			git.NewReference("refs/heads/main", firstCommit),
+		},
+	}
+
+	snapshotAtIndex2 := HistoricalSnapshot{
+		LogIndex:      2,
+		AppliedAt:     appliedAt(2),
+		DefaultBranch: "refs/heads/feature",
+		References: []git.Reference{
+			git.NewReference("refs/heads/feature", firstCommit),
+			git.NewReference("refs/heads/main", firstCommit),
+		},
+	}
+
+	snapshotAtIndex3 := HistoricalSnapshot{
+		LogIndex:      3,
+		AppliedAt:     appliedAt(3),
+		DefaultBranch: "refs/heads/main",
+		References: []git.Reference{
+			git.NewReference("refs/heads/main", secondCommit),
+		},
+	}
+
+	t.Run("at log index", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc             string
+			index            LogIndex
+			expectedSnapshot HistoricalSnapshot
+			expectedErr      error
+		}{
+			{
+				desc:        "pruned log index",
+				index:       0,
+				expectedErr: ErrHistoryNotRetained,
+			},
+			{
+				desc:             "oldest reconstructable log index",
+				index:            1,
+				expectedSnapshot: snapshotAtIndex1,
+			},
+			{
+				desc:             "retained log index",
+				index:            2,
+				expectedSnapshot: snapshotAtIndex2,
+			},
+			{
+				desc:             "read index",
+				index:            3,
+				expectedSnapshot: snapshotAtIndex3,
+			},
+			{
+				desc:  "log index newer than read index",
+				index: 4,
+				expectedErr: structerr.NewInvalidArgument("log index is newer than the read index").
+					WithMetadata("log_index", LogIndex(4)).
+					WithMetadata("read_index", LogIndex(3)),
+			},
+		} {
+			tc := tc
+			t.Run(tc.desc, func(t *testing.T) {
+				snapshot, err := transaction.HistoricalSnapshotAtLogIndex(ctx, tc.index)
+				require.Equal(t, tc.expectedErr, err)
+				require.Equal(t, tc.expectedSnapshot, snapshot)
+			})
+		}
+	})
+
+	t.Run("at time", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc             string
+			time             time.Time
+			expectedSnapshot HistoricalSnapshot
+			expectedErr      error
+		}{
+			{
+				desc:        "before retained history",
+				time:        appliedAt(2).Add(-time.Second),
+				expectedErr: ErrHistoryNotRetained,
+			},
+			{
+				desc:             "exactly at log entry",
+				time:             appliedAt(2),
+				expectedSnapshot: snapshotAtIndex2,
+			},
+			{
+				desc:             "between log entries",
+				time:             appliedAt(3).Add(-time.Second),
+				expectedSnapshot: snapshotAtIndex2,
+			},
+			{
+				desc:             "after latest log entry",
+				time:             appliedAt(10),
+				expectedSnapshot: snapshotAtIndex3,
+			},
+		} {
+			tc := tc
+			t.Run(tc.desc, func(t *testing.T) {
+				snapshot, err := transaction.HistoricalSnapshotAtTime(ctx, tc.time)
+				require.Equal(t, tc.expectedErr, err)
+				require.Equal(t, tc.expectedSnapshot, snapshot)
+			})
+		}
+	})
+}
+
+func TestTransactionManager_historyDeletedWithRepository(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+
+	cmdFactory := gittest.NewCommandFactory(t, cfg)
+	cache := catfile.NewCache(cfg)
+	defer cache.Stop()
+
+	database, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, database)
+
+	txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+	housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+	repositoryFactory, err := localrepo.NewFactory(
+		config.NewLocator(cfg), cmdFactory, cache,
+	).ScopeByStorage(cfg.Storages[0].Name)
+	require.NoError(t, err)
+
+	objectHash, err := repositoryFactory.Build(repo.RelativePath).ObjectHash(ctx)
+	require.NoError(t, err)
+
+	manager := NewTransactionManager(testPartitionID, database, cfg.Storages[0].Path, repo.RelativePath, t.TempDir(), t.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
+	manager.historyRetention = 10
+
+	managerErr := make(chan error)
+	go func() { managerErr <- manager.Run() }()
+	defer func() {
+		manager.Close()
+		assert.NoError(t, <-managerErr)
+	}()
+
+	transaction, err := manager.Begin(ctx, TransactionOptions{})
+	require.NoError(t, err)
+	transaction.UpdateReferences(ReferenceUpdates{
+		"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: commitID},
+	})
+	require.NoError(t, transaction.Commit(ctx))
+
+	transaction, err = manager.Begin(ctx, TransactionOptions{})
+	require.NoError(t, err)
+	transaction.DeleteRepository()
+	require.NoError(t, transaction.Commit(ctx))
+
+	RequireDatabase(t, ctx, database, DatabaseState{
+		string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
+	})
+}
+
+func TestTransactionManager_historyPartition(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	pool, poolPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	fork, forkPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	// Link the fork to the pool so the commits are available in both.
+	alternateRelativePath, err := filepath.Rel(filepath.Join(forkPath, "objects"), filepath.Join(poolPath, "objects"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(forkPath, "objects", "info", "alternates"), []byte(alternateRelativePath), fs.ModePerm))
+
+	firstCommit := gittest.WriteCommit(t, cfg, poolPath, gittest.WithParents())
+	secondCommit := gittest.WriteCommit(t, cfg, poolPath, gittest.WithParents(firstCommit))
+
+	cmdFactory := gittest.NewCommandFactory(t, cfg)
+	cache := catfile.NewCache(cfg)
+	defer cache.Stop()
+
+	database, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, database)
+
+	txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+	housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+	repositoryFactory, err := localrepo.NewFactory(
+		config.NewLocator(cfg), cmdFactory, cache,
+	).ScopeByStorage(cfg.Storages[0].Name)
+	require.NoError(t, err)
+
+	objectHash, err := repositoryFactory.Build(pool.RelativePath).ObjectHash(ctx)
+	require.NoError(t, err)
+
+	// The partition's TransactionManager is started for the pool.
+	manager := NewTransactionManager(testPartitionID, database, cfg.Storages[0].Path, pool.RelativePath, t.TempDir(), t.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
+	manager.historyRetention = 10
+
+	epoch := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	appliedAt := func(index LogIndex) time.Time {
+		return epoch.Add(time.Duration(index) * time.Minute)
+	}
+
+	var appliedEntries LogIndex
+	manager.now = func() time.Time {
+		appliedEntries++
+		return appliedAt(appliedEntries)
+	}
+
+	managerErr := make(chan error)
+	go func() { managerErr <- manager.Run() }()
+	defer func() {
+		manager.Close()
+		assert.NoError(t, <-managerErr)
+	}()
+
+	// Log index 1 creates the main branch in both of the repositories.
+	transaction, err := manager.Begin(ctx, TransactionOptions{AdditionalRepositories: []string{fork.RelativePath}})
+	require.NoError(t, err)
+	transaction.UpdateReferences(ReferenceUpdates{
+		"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: firstCommit},
+	})
+	transaction.UpdateRepositoryReferences(fork.RelativePath, ReferenceUpdates{
+		"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: firstCommit},
+	})
+	require.NoError(t, transaction.Commit(ctx))
+
+	// Log index 2 creates a feature branch in the fork and points its default branch to it.
+	transaction, err = manager.Begin(ctx, TransactionOptions{RelativePath: fork.RelativePath})
+	require.NoError(t, err)
+	transaction.UpdateReferences(ReferenceUpdates{
+		"refs/heads/feature": {OldOID: objectHash.ZeroOID, NewOID: firstCommit},
+	})
+	transaction.SetDefaultBranch("refs/heads/feature")
+	require.NoError(t, transaction.Commit(ctx))
+
+	// Log index 3 updates the main branch in the pool.
+	transaction, err = manager.Begin(ctx, TransactionOptions{})
+	require.NoError(t, err)
+	transaction.UpdateReferences(ReferenceUpdates{
+		"refs/heads/main": {OldOID: firstCommit, NewOID: secondCommit},
+	})
+	require.NoError(t, transaction.Commit(ctx))
+
+	RequireDatabase(t, ctx, database, DatabaseState{
+		string(keyAppliedLogIndex(testPartitionID)): LogIndex(3).toProto(),
+		string(keyLogHistory(testPartitionID, 1)): &gitalypb.LogHistoryEntry{
+			RelativePath: pool.RelativePath,
+			ReferenceChanges: []*gitalypb.LogHistoryEntry_ReferenceChange{
+				{
+					ReferenceName: []byte("refs/heads/main"),
+					OldOid:        []byte(objectHash.ZeroOID),
+					NewOid:        []byte(firstCommit),
+				},
+			},
+			PartitionReferenceChanges: []*gitalypb.LogHistoryEntry_RepositoryReferenceChanges{
+				{
+					RelativePath: fork.RelativePath,
+					ReferenceChanges: []*gitalypb.LogHistoryEntry_ReferenceChange{
+						{
+							ReferenceName: []byte("refs/heads/main"),
+							OldOid:        []byte(objectHash.ZeroOID),
+							NewOid:        []byte(firstCommit),
+						},
+					},
+				},
+			},
+			AppliedAt: timestamppb.New(appliedAt(1)),
+		},
+		string(keyLogHistory(testPartitionID, 2)): &gitalypb.LogHistoryEntry{
+			RelativePath: fork.RelativePath,
+			ReferenceChanges: []*gitalypb.LogHistoryEntry_ReferenceChange{
+				{
+					ReferenceName: []byte("refs/heads/feature"),
+					OldOid:        []byte(objectHash.ZeroOID),
+					NewOid:        []byte(firstCommit),
+				},
+			},
+			OldDefaultBranch: []byte(git.DefaultRef),
+			NewDefaultBranch: []byte("refs/heads/feature"),
+			AppliedAt:        timestamppb.New(appliedAt(2)),
+		},
+		string(keyLogHistory(testPartitionID, 3)): &gitalypb.LogHistoryEntry{
+			RelativePath: pool.RelativePath,
+			ReferenceChanges: []*gitalypb.LogHistoryEntry_ReferenceChange{
+				{
+					ReferenceName: []byte("refs/heads/main"),
+					OldOid:        []byte(firstCommit),
+					NewOid:        []byte(secondCommit),
+				},
+			},
+			AppliedAt: timestamppb.New(appliedAt(3)),
+		},
+	})
+
+	requireSnapshots := func(t *testing.T, manager *TransactionManager, relativePath string, expectedSnapshots []HistoricalSnapshot) {
+		t.Helper()
+
+		transaction, err := manager.Begin(ctx, TransactionOptions{RelativePath: relativePath, ReadOnly: true})
+		require.NoError(t, err)
+		defer func() { require.NoError(t, transaction.Rollback()) }()
+
+		for _, expectedSnapshot := range expectedSnapshots {
+			snapshot, err := transaction.HistoricalSnapshotAtLogIndex(ctx, expectedSnapshot.LogIndex)
+			require.NoError(t, err)
+			require.Equal(t, expectedSnapshot, snapshot)
+		}
+	}
+
+	t.Run("pool", func(t *testing.T) {
+		requireSnapshots(t, manager, pool.RelativePath, []HistoricalSnapshot{
+			{
+				LogIndex:      0,
+				DefaultBranch: git.DefaultRef,
+				References:    []git.Reference{},
+			},
+			{
+				LogIndex:      1,
+				AppliedAt:     appliedAt(1),
+				DefaultBranch: git.DefaultRef,
+				References:    []git.Reference{git.NewReference("refs/heads/main", firstCommit)},
+			},
+			{
+				LogIndex:      2,
+				AppliedAt:     appliedAt(2),
+				DefaultBranch: git.DefaultRef,
+				References:    []git.Reference{git.NewReference("refs/heads/main", firstCommit)},
+			},
+			{
+				LogIndex:      3,
+				AppliedAt:     appliedAt(3),
+				DefaultBranch: git.DefaultRef,
+				References:    []git.Reference{git.NewReference("refs/heads/main", secondCommit)},
+			},
+		})
+	})
+
+	expectedForkSnapshots := []HistoricalSnapshot{
+		{
+			LogIndex:      0,
+			DefaultBranch: git.DefaultRef,
+			References:    []git.Reference{},
+		},
+		{
+			LogIndex:      1,
+			AppliedAt:     appliedAt(1),
+			DefaultBranch: git.DefaultRef,
+			References:    []git.Reference{git.NewReference("refs/heads/main", firstCommit)},
+		},
+		{
+			LogIndex:      2,
+			AppliedAt:     appliedAt(2),
+			DefaultBranch: "refs/heads/feature",
+			References: []git.Reference{
+				git.NewReference("refs/heads/feature", firstCommit),
+				git.NewReference("refs/heads/main", firstCommit),
+			},
+		},
+		{
+			LogIndex:      3,
+			AppliedAt:     appliedAt(3),
+			DefaultBranch: "refs/heads/feature",
+			References: []git.Reference{
+				git.NewReference("refs/heads/feature", firstCommit),
+				git.NewReference("refs/heads/main", firstCommit),
+			},
+		},
+	}
+
+	t.Run("fork", func(t *testing.T) {
+		requireSnapshots(t, manager, fork.RelativePath, expectedForkSnapshots)
+	})
+
+	t.Run("partition restarted by the fork", func(t *testing.T) {
+		// The log entries are attributed to the repositories they were committed for even if the
+		// partition's TransactionManager is started for another repository of the partition.
+		forkManager := NewTransactionManager(testPartitionID, database, cfg.Storages[0].Path, fork.RelativePath, t.TempDir(), t.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
+		forkManager.historyRetention = 10
+
+		forkManagerErr := make(chan error)
+		go func() { forkManagerErr <- forkManager.Run() }()
+		defer func() {
+			forkManager.Close()
+			assert.NoError(t, <-forkManagerErr)
+		}()
+
+		requireSnapshots(t, forkManager, fork.RelativePath, expectedForkSnapshots)
+		requireSnapshots(t, forkManager, pool.RelativePath, []HistoricalSnapshot{
+			{
+				LogIndex:      2,
+				AppliedAt:     appliedAt(2),
+				DefaultBranch: git.DefaultRef,
+				References:    []git.Reference{git.NewReference("refs/heads/main", firstCommit)},
+			},
+		})
+	})
+
+	t.Run("recreated fork", func(t *testing.T) {
+		// Log index 4 deletes the fork.
+		transaction, err := manager.Begin(ctx, TransactionOptions{RelativePath: fork.RelativePath})
+		require.NoError(t, err)
+		transaction.DeleteRepository()
+		require.NoError(t, transaction.Commit(ctx))
+
+		require.NoDirExists(t, forkPath)
+
+		gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+			RelativePath:           fork.RelativePath,
+		})
+
+		transaction, err = manager.Begin(ctx, TransactionOptions{RelativePath: fork.RelativePath, ReadOnly: true})
+		require.NoError(t, err)
+		defer func() { require.NoError(t, transaction.Rollback()) }()
+
+		// The history of the deleted fork doesn't apply to the repository created in its place.
+		_, err = transaction.HistoricalSnapshotAtLogIndex(ctx, 3)
+		require.Equal(t, ErrHistoryNotRetained, err)
+
+		snapshot, err := transaction.HistoricalSnapshotAtLogIndex(ctx, 4)
+		require.NoError(t, err)
+		require.Equal(t, HistoricalSnapshot{
+			LogIndex:      4,
+			DefaultBranch: git.DefaultRef,
+			References:    []git.Reference{},
+		}, snapshot)
+
+		// The deletion of the fork doesn't affect the pool's history.
+		requireSnapshots(t, manager, pool.RelativePath, []HistoricalSnapshot{
+			{
+				LogIndex:      1,
+				AppliedAt:     appliedAt(1),
+				DefaultBranch: git.DefaultRef,
+				References:    []git.Reference{git.NewReference("refs/heads/main", firstCommit)},
+			},
+		})
+	})
+}
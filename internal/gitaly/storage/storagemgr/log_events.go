@@ -0,0 +1,172 @@
+package storagemgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReferenceChange describes a change to a single reference.
+type ReferenceChange struct {
+	// ReferenceName is the fully qualified name of the changed reference.
+	ReferenceName git.ReferenceName
+	// OldOID is the OID the reference pointed to before the change. It's the zero OID if the
+	// reference was created.
+	OldOID git.ObjectID
+	// NewOID is the OID the reference points to after the change. It's the zero OID if the
+	// reference was deleted.
+	NewOID git.ObjectID
+}
+
+// LogEvent describes the changes committed to a repository by a single log entry.
+type LogEvent struct {
+	// LogIndex is the index of the log entry.
+	LogIndex LogIndex
+	// AppliedAt is the time the log entry was applied to the repository.
+	AppliedAt time.Time
+	// ReferenceChanges contains the reference changes of the log entry sorted by reference name.
+	ReferenceChanges []ReferenceChange
+	// DefaultBranch is the reference the default branch was updated to. It's empty if the log
+	// entry didn't update the default branch.
+	DefaultBranch git.ReferenceName
+	// CustomHooksUpdated is set if the log entry updated the custom hooks.
+	CustomHooksUpdated bool
+}
+
+// readLogEvents returns the events of the repository at the given relative path from the log entries applied after
+// the given log index along with the index of the last applied log entry. Log entries that didn't change the
+// repository don't yield events. ErrHistoryNotRetained is returned if the history doesn't reach back to the given
+// log index.
+func (mgr *TransactionManager) readLogEvents(ctx context.Context, relativePath string, afterIndex LogIndex) ([]LogEvent, LogIndex, error) {
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	case <-mgr.initialized:
+		if !mgr.initializationSuccessful {
+			return nil, 0, errInitializationFailed
+		}
+	}
+
+	if mgr.historyRetention == 0 {
+		return nil, 0, ErrHistoryNotRetained
+	}
+
+	if relativePath == mgr.relativePath {
+		mgr.mutex.Lock()
+		repositoryExists := mgr.repositoryExists
+		mgr.mutex.Unlock()
+
+		if !repositoryExists {
+			return nil, 0, ErrRepositoryNotFound
+		}
+	} else if _, err := mgr.repositoryFactory.Build(relativePath).Path(); err != nil {
+		if errors.Is(err, storage.ErrRepositoryNotFound) {
+			return nil, 0, ErrRepositoryNotFound
+		}
+
+		return nil, 0, fmt.Errorf("repository path: %w", err)
+	}
+
+	appliedIndex, history, err := mgr.readAppliedHistory(relativePath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read applied history: %w", err)
+	}
+
+	if afterIndex >= appliedIndex {
+		return nil, appliedIndex, nil
+	}
+
+	events, err := logEvents(afterIndex, appliedIndex, history)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, appliedIndex, nil
+}
+
+// readAppliedHistory reads the index of the last applied log entry along with the retained history of the repository
+// at the given relative path. Both are read in the same database transaction so the history covers every applied log
+// entry still retained.
+func (mgr *TransactionManager) readAppliedHistory(relativePath string) (LogIndex, []historyEntry, error) {
+	var appliedIndex LogIndex
+	var history []historyEntry
+	if err := mgr.db.View(func(txn DatabaseTransaction) error {
+		item, err := txn.Get(keyAppliedLogIndex(mgr.partitionID))
+		if err != nil && !errors.Is(err, ErrKeyNotFound) {
+			return fmt.Errorf("get applied log index: %w", err)
+		}
+
+		if item != nil {
+			var index gitalypb.LogIndex
+			if err := item.Value(func(value []byte) error { return proto.Unmarshal(value, &index) }); err != nil {
+				return fmt.Errorf("unmarshal applied log index: %w", err)
+			}
+
+			appliedIndex = LogIndex(index.LogIndex)
+		}
+
+		history, err = mgr.readHistoryEntries(txn, relativePath)
+		return err
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	return appliedIndex, history, nil
+}
+
+// logEvents converts the history entries of the log entries after afterIndex up to and including appliedIndex
+// into events. ErrHistoryNotRetained is returned if any of the history entries is missing.
+func logEvents(afterIndex, appliedIndex LogIndex, history []historyEntry) ([]LogEvent, error) {
+	var events []LogEvent
+	expectedIndex := afterIndex + 1
+	for _, entry := range history {
+		if entry.index <= afterIndex {
+			continue
+		}
+
+		if entry.index > appliedIndex {
+			// The history of a log entry is recorded before it is applied. Leave the not yet applied
+			// log entries for the next round.
+			break
+		}
+
+		if entry.index != expectedIndex {
+			return nil, ErrHistoryNotRetained
+		}
+
+		event := LogEvent{
+			LogIndex:           entry.index,
+			AppliedAt:          entry.entry.GetAppliedAt().AsTime(),
+			DefaultBranch:      git.ReferenceName(entry.entry.GetNewDefaultBranch()),
+			CustomHooksUpdated: entry.entry.GetCustomHooksUpdated(),
+		}
+
+		for _, change := range entry.entry.GetReferenceChanges() {
+			event.ReferenceChanges = append(event.ReferenceChanges, ReferenceChange{
+				ReferenceName: git.ReferenceName(change.GetReferenceName()),
+				OldOID:        git.ObjectID(change.GetOldOid()),
+				NewOID:        git.ObjectID(change.GetNewOid()),
+			})
+		}
+
+		expectedIndex++
+		if len(event.ReferenceChanges) == 0 && event.DefaultBranch == "" && !event.CustomHooksUpdated {
+			// The log entry changed only the other repositories of the partition.
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	if expectedIndex <= appliedIndex {
+		return nil, ErrHistoryNotRetained
+	}
+
+	return events, nil
+}
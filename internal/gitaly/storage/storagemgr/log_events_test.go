@@ -0,0 +1,342 @@
+package storagemgr
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/backchannel"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestPartitionManager_WatchLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	objectHash := gittest.DefaultObjectHash
+
+	epoch := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	appliedAt := func(index LogIndex) time.Time {
+		return epoch.Add(time.Duration(index) * time.Minute)
+	}
+
+	type setupData struct {
+		cfg              config.Cfg
+		partitionManager *PartitionManager
+		repo             *gitalypb.Repository
+		repoPath         string
+		firstCommit      git.ObjectID
+		secondCommit     git.ObjectID
+	}
+
+	setup := func(t *testing.T, historyRetention uint64) setupData {
+		t.Helper()
+
+		cfg := testcfg.Build(t)
+
+		cmdFactory := gittest.NewCommandFactory(t, cfg)
+		catfileCache := catfile.NewCache(cfg)
+		t.Cleanup(catfileCache.Stop)
+
+		localRepoFactory := localrepo.NewFactory(config.NewLocator(cfg), cmdFactory, catfileCache)
+
+		txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+		housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+		partitionManager, err := NewPartitionManager(
+			cfg.Storages, cmdFactory, housekeepingManager, localRepoFactory, testhelper.SharedLogger(t),
+			WithHistoryRetention(historyRetention),
+			WithDatabaseOpener(openTestDatabase),
+		)
+		require.NoError(t, err)
+		t.Cleanup(partitionManager.Close)
+
+		// The partition's TransactionManager is started anew for each transaction so the count of applied
+		// log entries is kept across them.
+		var appliedEntriesMu sync.Mutex
+		var appliedEntries LogIndex
+		newTransactionManager := partitionManager.transactionManagerFactory
+		partitionManager.transactionManagerFactory = func(
+			ptnID partitionID,
+			storageMgr *storageManager,
+			cmdFactory git.CommandFactory,
+			housekeepingManager housekeeping.Manager,
+			relativePath, absoluteStateDir, stagingDir string,
+		) *TransactionManager {
+			mgr := newTransactionManager(ptnID, storageMgr, cmdFactory, housekeepingManager, relativePath, absoluteStateDir, stagingDir)
+			mgr.now = func() time.Time {
+				appliedEntriesMu.Lock()
+				defer appliedEntriesMu.Unlock()
+
+				appliedEntries++
+				return appliedAt(appliedEntries)
+			}
+
+			return mgr
+		}
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+
+		firstCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+		secondCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(firstCommit))
+
+		return setupData{
+			cfg:              cfg,
+			partitionManager: partitionManager,
+			repo:             repo,
+			repoPath:         repoPath,
+			firstCommit:      firstCommit,
+			secondCommit:     secondCommit,
+		}
+	}
+
+	requirePartitionsClosed := func(t *testing.T, setup setupData) {
+		t.Helper()
+
+		storageMgr := setup.partitionManager.storages[setup.cfg.Storages[0].Name]
+		require.Eventually(t, func() bool {
+			storageMgr.mu.Lock()
+			defer storageMgr.mu.Unlock()
+			return len(storageMgr.partitions) == 0
+		}, 10*time.Second, time.Millisecond)
+	}
+
+	type commitOptions struct {
+		additionalRepository string
+		additionalUpdates    ReferenceUpdates
+		defaultBranch        git.ReferenceName
+	}
+
+	commit := func(t *testing.T, partitionManager *PartitionManager, repo storage.Repository, updates ReferenceUpdates, opts commitOptions) {
+		t.Helper()
+
+		var additionalRepositories []string
+		if opts.additionalRepository != "" {
+			additionalRepositories = []string{opts.additionalRepository}
+		}
+
+		txn, err := partitionManager.Begin(ctx, repo, TransactionOptions{AdditionalRepositories: additionalRepositories})
+		require.NoError(t, err)
+
+		txn.UpdateReferences(updates)
+		if opts.additionalUpdates != nil {
+			txn.UpdateRepositoryReferences(opts.additionalRepository, opts.additionalUpdates)
+		}
+
+		if opts.defaultBranch != "" {
+			txn.SetDefaultBranch(opts.defaultBranch)
+		}
+
+		require.NoError(t, txn.Commit(ctx))
+	}
+
+	t.Run("history retention disabled", func(t *testing.T) {
+		setup := setup(t, 0)
+
+		require.Equal(t, ErrHistoryNotRetained, setup.partitionManager.WatchLog(ctx, setup.repo, 0, func([]LogEvent) error {
+			t.Fatal("no events were expected")
+			return nil
+		}))
+	})
+
+	t.Run("history not retained", func(t *testing.T) {
+		setup := setup(t, 1)
+
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+		}, commitOptions{})
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main": {OldOID: setup.firstCommit, NewOID: setup.secondCommit},
+		}, commitOptions{})
+
+		require.Equal(t, ErrHistoryNotRetained, setup.partitionManager.WatchLog(ctx, setup.repo, 0, func([]LogEvent) error {
+			t.Fatal("no events were expected")
+			return nil
+		}))
+	})
+
+	t.Run("events are streamed", func(t *testing.T) {
+		setup := setup(t, 10)
+
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+		}, commitOptions{})
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/feature": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+		}, commitOptions{defaultBranch: "refs/heads/feature"})
+
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+
+		events := make(chan []LogEvent)
+		watchErr := make(chan error, 1)
+		go func() {
+			// Start after the first log entry to exercise resuming from a cursor.
+			watchErr <- setup.partitionManager.WatchLog(watchCtx, setup.repo, 1, func(batch []LogEvent) error {
+				events <- batch
+				return nil
+			})
+		}()
+
+		require.Equal(t, []LogEvent{
+			{
+				LogIndex:  2,
+				AppliedAt: appliedAt(2),
+				ReferenceChanges: []ReferenceChange{
+					{ReferenceName: "refs/heads/feature", OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+				},
+				DefaultBranch: "refs/heads/feature",
+			},
+		}, <-events)
+
+		// The partition is not kept open while waiting for new log entries.
+		requirePartitionsClosed(t, setup)
+
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main":    {OldOID: setup.firstCommit, NewOID: setup.secondCommit},
+			"refs/heads/feature": {OldOID: setup.firstCommit, NewOID: objectHash.ZeroOID},
+		}, commitOptions{})
+
+		require.Equal(t, []LogEvent{
+			{
+				LogIndex:  3,
+				AppliedAt: appliedAt(3),
+				ReferenceChanges: []ReferenceChange{
+					{ReferenceName: "refs/heads/feature", OldOID: setup.firstCommit, NewOID: objectHash.ZeroOID},
+					{ReferenceName: "refs/heads/main", OldOID: setup.firstCommit, NewOID: setup.secondCommit},
+				},
+			},
+		}, <-events)
+
+		requirePartitionsClosed(t, setup)
+
+		cancelWatch()
+		require.Equal(t, context.Canceled, <-watchErr)
+	})
+
+	t.Run("events of the partition's other repository", func(t *testing.T) {
+		setup := setup(t, 10)
+
+		fork, forkPath := gittest.CreateRepository(t, ctx, setup.cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+
+		// Link the fork to the repository so they are assigned into the same partition.
+		alternateRelativePath, err := filepath.Rel(filepath.Join(forkPath, "objects"), filepath.Join(setup.repoPath, "objects"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(forkPath, "objects", "info", "alternates"), []byte(alternateRelativePath), fs.ModePerm))
+
+		// Log index 1 updates both of the repositories.
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+		}, commitOptions{
+			additionalRepository: fork.GetRelativePath(),
+			additionalUpdates: ReferenceUpdates{
+				"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+			},
+		})
+		// Log index 2 only updates the repository.
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main": {OldOID: setup.firstCommit, NewOID: setup.secondCommit},
+		}, commitOptions{})
+		// Log index 3 only updates the fork.
+		commit(t, setup.partitionManager, fork, ReferenceUpdates{
+			"refs/heads/feature": {OldOID: objectHash.ZeroOID, NewOID: setup.secondCommit},
+		}, commitOptions{defaultBranch: "refs/heads/feature"})
+
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+
+		events := make(chan []LogEvent)
+		watchErr := make(chan error, 1)
+		go func() {
+			watchErr <- setup.partitionManager.WatchLog(watchCtx, fork, 0, func(batch []LogEvent) error {
+				events <- batch
+				return nil
+			})
+		}()
+
+		// The log entry that only updated the other repository doesn't yield an event.
+		require.Equal(t, []LogEvent{
+			{
+				LogIndex:  1,
+				AppliedAt: appliedAt(1),
+				ReferenceChanges: []ReferenceChange{
+					{ReferenceName: "refs/heads/main", OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+				},
+			},
+			{
+				LogIndex:  3,
+				AppliedAt: appliedAt(3),
+				ReferenceChanges: []ReferenceChange{
+					{ReferenceName: "refs/heads/feature", OldOID: objectHash.ZeroOID, NewOID: setup.secondCommit},
+				},
+				DefaultBranch: "refs/heads/feature",
+			},
+		}, <-events)
+
+		// Log index 4 only updates the repository and is not passed to the handler while watching.
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/feature": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+		}, commitOptions{})
+		// Log index 5 only updates the fork.
+		commit(t, setup.partitionManager, fork, ReferenceUpdates{
+			"refs/heads/feature": {OldOID: setup.secondCommit, NewOID: setup.firstCommit},
+		}, commitOptions{})
+
+		require.Equal(t, []LogEvent{
+			{
+				LogIndex:  5,
+				AppliedAt: appliedAt(5),
+				ReferenceChanges: []ReferenceChange{
+					{ReferenceName: "refs/heads/feature", OldOID: setup.secondCommit, NewOID: setup.firstCommit},
+				},
+			},
+		}, <-events)
+
+		cancelWatch()
+		require.Equal(t, context.Canceled, <-watchErr)
+	})
+
+	t.Run("partition manager closes", func(t *testing.T) {
+		setup := setup(t, 10)
+
+		commit(t, setup.partitionManager, setup.repo, ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: setup.firstCommit},
+		}, commitOptions{})
+
+		events := make(chan []LogEvent)
+		watchErr := make(chan error, 1)
+		go func() {
+			watchErr <- setup.partitionManager.WatchLog(ctx, setup.repo, 0, func(batch []LogEvent) error {
+				events <- batch
+				return nil
+			})
+		}()
+
+		// Receiving the first batch ensures the watcher is waiting for further log entries when
+		// the partition manager closes.
+		require.Len(t, <-events, 1)
+
+		requirePartitionsClosed(t, setup)
+		setup.partitionManager.Close()
+		require.Equal(t, ErrPartitionManagerClosed, <-watchErr)
+	})
+}
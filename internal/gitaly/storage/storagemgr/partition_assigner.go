@@ -10,7 +10,6 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/dgraph-io/badger/v4"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 )
@@ -33,7 +32,12 @@ var (
 	errAlternateHasAlternate = errors.New("repository's alternate has an alternate itself")
 )
 
-const prefixPartitionAssignment = "partition_assignment/"
+const (
+	prefixPartitionAssignment = "partition_assignment/"
+	// keyPartitionIDSequence is the key storing the next partition ID to allocate. The key and its
+	// encoding are compatible with the Badger sequence that was previously used to allocate the IDs.
+	keyPartitionIDSequence = "partition_id_seq"
+)
 
 // partitionID uniquely identifies a partition.
 type partitionID uint64
@@ -53,9 +57,9 @@ func (id partitionID) String() string {
 }
 
 // partitionAssignmentTable records which partitions repositories are assigned into.
-type partitionAssignmentTable struct{ db *badger.DB }
+type partitionAssignmentTable struct{ db Database }
 
-func newPartitionAssignmentTable(db *badger.DB) *partitionAssignmentTable {
+func newPartitionAssignmentTable(db Database) *partitionAssignmentTable {
 	return &partitionAssignmentTable{db: db}
 }
 
@@ -65,10 +69,10 @@ func (pt *partitionAssignmentTable) key(relativePath string) []byte {
 
 func (pt *partitionAssignmentTable) getPartitionID(relativePath string) (partitionID, error) {
 	var id partitionID
-	if err := pt.db.View(func(txn *badger.Txn) error {
+	if err := pt.db.View(func(txn DatabaseTransaction) error {
 		item, err := txn.Get(pt.key(relativePath))
 		if err != nil {
-			if errors.Is(err, badger.ErrKeyNotFound) {
+			if errors.Is(err, ErrKeyNotFound) {
 				return errPartitionAssignmentNotFound
 			}
 
@@ -106,8 +110,10 @@ type partitionAssigner struct {
 	// repositoryLocks holds per-repository locks. The key is a relative path and the
 	// channel closing signals the lock being released.
 	repositoryLocks map[string]chan struct{}
-	// idSequence is the sequence used to mint partition IDs.
-	idSequence *badger.Sequence
+	// idMutex serializes partition ID allocations so concurrent allocations don't conflict.
+	idMutex sync.Mutex
+	// db is the database the partition ID sequence is stored in.
+	db Database
 	// partitionAssignmentTable contains the partition assignment records.
 	partitionAssignmentTable *partitionAssignmentTable
 	// storagePath is the path to the root directory of the storage the relative
@@ -115,39 +121,54 @@ type partitionAssigner struct {
 	storagePath string
 }
 
-// newPartitionAssigner returns a new partitionAssigner. Close must be called on the
-// returned instance to release acquired resources.
-func newPartitionAssigner(db *badger.DB, storagePath string) (*partitionAssigner, error) {
-	seq, err := db.GetSequence([]byte("partition_id_seq"), 100)
-	if err != nil {
-		return nil, fmt.Errorf("get sequence: %w", err)
-	}
-
+// newPartitionAssigner returns a new partitionAssigner.
+func newPartitionAssigner(db Database, storagePath string) *partitionAssigner {
 	return &partitionAssigner{
 		repositoryLocks:          make(map[string]chan struct{}),
-		idSequence:               seq,
+		db:                       db,
 		partitionAssignmentTable: newPartitionAssignmentTable(db),
 		storagePath:              storagePath,
-	}, nil
-}
-
-func (pa *partitionAssigner) Close() error {
-	return pa.idSequence.Release()
+	}
 }
 
+// allocatePartitionID mints a new partition ID. The next ID to allocate is stored big-endian encoded
+// in the partition ID sequence key.
 func (pa *partitionAssigner) allocatePartitionID() (partitionID, error) {
-	// Start partition IDs from 1 so the default value refers to an invalid
-	// partition.
-	var id uint64
-	for id == 0 {
-		var err error
-		id, err = pa.idSequence.Next()
-		if err != nil {
-			return 0, fmt.Errorf("next: %w", err)
+	pa.idMutex.Lock()
+	defer pa.idMutex.Unlock()
+
+	var id partitionID
+	if err := pa.db.Update(func(txn DatabaseTransaction) error {
+		item, err := txn.Get([]byte(keyPartitionIDSequence))
+		if err != nil && !errors.Is(err, ErrKeyNotFound) {
+			return fmt.Errorf("get: %w", err)
 		}
+
+		if item != nil {
+			if err := item.Value(func(value []byte) error {
+				id.UnmarshalBinary(value)
+				return nil
+			}); err != nil {
+				return fmt.Errorf("value: %w", err)
+			}
+		}
+
+		// Start partition IDs from 1 so the default value refers to an invalid
+		// partition.
+		if id == 0 {
+			id = 1
+		}
+
+		if err := txn.Set([]byte(keyPartitionIDSequence), (id + 1).MarshalBinary()); err != nil {
+			return fmt.Errorf("set: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("update: %w", err)
 	}
 
-	return partitionID(id), nil
+	return id, nil
 }
 
 // getPartitionID returns the partition ID of the repository. If the repository wasn't yet assigned into
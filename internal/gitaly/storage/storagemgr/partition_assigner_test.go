@@ -20,22 +20,22 @@ import (
 
 type partitionAssignments map[string]partitionID
 
-func getPartitionAssignments(tb testing.TB, db *badger.DB) partitionAssignments {
+func getPartitionAssignments(tb testing.TB, db Database) partitionAssignments {
 	tb.Helper()
 
 	state := partitionAssignments{}
-	require.NoError(tb, db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.IteratorOptions{
+	require.NoError(tb, db.View(func(txn DatabaseTransaction) error {
+		it := txn.NewIterator(IteratorOptions{
 			Prefix: []byte(prefixPartitionAssignment),
 		})
 		defer it.Close()
 
 		for it.Rewind(); it.Valid(); it.Next() {
-			value, err := it.Item().ValueCopy(nil)
-			require.NoError(tb, err)
-
 			var ptnID partitionID
-			ptnID.UnmarshalBinary(value)
+			require.NoError(tb, it.Item().Value(func(value []byte) error {
+				ptnID.UnmarshalBinary(value)
+				return nil
+			}))
 
 			relativePath := strings.TrimPrefix(string(it.Item().Key()), prefixPartitionAssignment)
 			state[relativePath] = ptnID
@@ -48,14 +48,12 @@ func getPartitionAssignments(tb testing.TB, db *badger.DB) partitionAssignments
 }
 
 func TestPartitionAssigner(t *testing.T) {
-	db, err := OpenDatabase(testhelper.SharedLogger(t), t.TempDir())
+	db, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
 	require.NoError(t, err)
 	defer testhelper.MustClose(t, db)
 
 	cfg := testcfg.Build(t)
-	pa, err := newPartitionAssigner(db, cfg.Storages[0].Path)
-	require.NoError(t, err)
-	defer testhelper.MustClose(t, pa)
+	pa := newPartitionAssigner(db, cfg.Storages[0].Path)
 
 	ctx := testhelper.Context(t)
 
@@ -175,13 +173,11 @@ func TestPartitionAssigner_alternates(t *testing.T) {
 				writeAlternatesFile(t, memberPath, tc.memberAlternatesContent)
 			}
 
-			db, err := OpenDatabase(testhelper.NewLogger(t), t.TempDir())
+			db, err := openTestDatabase(testhelper.NewLogger(t), t.TempDir())
 			require.NoError(t, err)
 			defer testhelper.MustClose(t, db)
 
-			pa, err := newPartitionAssigner(db, cfg.Storages[0].Path)
-			require.NoError(t, err)
-			defer testhelper.MustClose(t, pa)
+			pa := newPartitionAssigner(db, cfg.Storages[0].Path)
 
 			expectedPartitionAssignments := tc.expectedPartitionAssignments
 			if expectedPartitionAssignments == nil {
@@ -204,33 +200,99 @@ func TestPartitionAssigner_alternates(t *testing.T) {
 	}
 }
 
-func TestPartitionAssigner_close(t *testing.T) {
+func TestPartitionAssigner_idSequence(t *testing.T) {
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
 	dbDir := t.TempDir()
 
-	db, err := OpenDatabase(testhelper.SharedLogger(t), dbDir)
+	db, err := openTestDatabase(testhelper.SharedLogger(t), dbDir)
 	require.NoError(t, err)
 
-	cfg := testcfg.Build(t)
-
-	pa, err := newPartitionAssigner(db, cfg.Storages[0].Path)
+	ptnID, err := newPartitionAssigner(db, cfg.Storages[0].Path).getPartitionID(ctx, "relative-path-1")
 	require.NoError(t, err)
-	testhelper.MustClose(t, pa)
+	require.EqualValues(t, 1, ptnID)
+
 	testhelper.MustClose(t, db)
 
-	db, err = OpenDatabase(testhelper.SharedLogger(t), dbDir)
+	// The sequence is persisted so the IDs are not reused after reopening the database.
+	db, err = openTestDatabase(testhelper.SharedLogger(t), dbDir)
 	require.NoError(t, err)
 	defer testhelper.MustClose(t, db)
 
-	pa, err = newPartitionAssigner(db, cfg.Storages[0].Path)
-	require.NoError(t, err)
-	defer testhelper.MustClose(t, pa)
+	pa := newPartitionAssigner(db, cfg.Storages[0].Path)
 
-	// A block of ID is loaded into memory when the partitionAssigner is initialized.
-	// Closing the partitionAssigner is expected to return the unused IDs in the block
-	// back to the database.
-	ptnID, err := pa.getPartitionID(testhelper.Context(t), "relative-path")
+	ptnID, err = pa.getPartitionID(ctx, "relative-path-2")
 	require.NoError(t, err)
-	require.EqualValues(t, 1, ptnID)
+	require.EqualValues(t, 2, ptnID)
+}
+
+func TestPartitionAssigner_badgerSequence(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		// release determines whether the sequence is released before closing the database. A
+		// sequence that isn't released leaves the end of the leased block of IDs in the database.
+		release        bool
+		expectedNextID partitionID
+	}{
+		{
+			desc:           "released sequence",
+			release:        true,
+			expectedNextID: 3,
+		},
+		{
+			desc:           "leased block",
+			expectedNextID: 100,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+			cfg := testcfg.Build(t)
+			dbDir := t.TempDir()
+
+			// Allocate partition IDs 1 and 2 the way they were allocated before the ID sequence
+			// was stored as a plain key. Badger's sequence starts from 0 which was skipped as an
+			// invalid partition ID.
+			badgerDB, err := badger.Open(badger.DefaultOptions(dbDir).WithLogger(nil))
+			require.NoError(t, err)
+
+			seq, err := badgerDB.GetSequence([]byte(keyPartitionIDSequence), 100)
+			require.NoError(t, err)
+
+			for expectedID := uint64(0); expectedID <= 2; expectedID++ {
+				id, err := seq.Next()
+				require.NoError(t, err)
+				require.Equal(t, expectedID, id)
+			}
+
+			if tc.release {
+				require.NoError(t, seq.Release())
+			}
+
+			require.NoError(t, badgerDB.Close())
+
+			db, err := OpenDatabase(testhelper.SharedLogger(t), dbDir)
+			require.NoError(t, err)
+			defer testhelper.MustClose(t, db)
+
+			pa := newPartitionAssigner(db, cfg.Storages[0].Path)
+
+			// The allocation continues after the IDs handed out by the sequence.
+			ptnID, err := pa.getPartitionID(ctx, "relative-path-1")
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNextID, ptnID)
+
+			ptnID, err = pa.getPartitionID(ctx, "relative-path-2")
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNextID+1, ptnID)
+		})
+	}
 }
 
 func TestPartitionAssigner_concurrentAccess(t *testing.T) {
@@ -252,15 +314,13 @@ func TestPartitionAssigner_concurrentAccess(t *testing.T) {
 		t.Run(tc.desc, func(t *testing.T) {
 			t.Parallel()
 
-			db, err := OpenDatabase(testhelper.SharedLogger(t), t.TempDir())
+			db, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
 			require.NoError(t, err)
 			defer testhelper.MustClose(t, db)
 
 			cfg := testcfg.Build(t)
 
-			pa, err := newPartitionAssigner(db, cfg.Storages[0].Path)
-			require.NoError(t, err)
-			defer testhelper.MustClose(t, pa)
+			pa := newPartitionAssigner(db, cfg.Storages[0].Path)
 
 			// Access 10 repositories concurrently.
 			repositoryCount := 10
@@ -11,7 +11,6 @@ import (
 	"path/filepath"
 	"sync"
 
-	"github.com/dgraph-io/badger/v4"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
@@ -27,6 +26,7 @@ import (
 var ErrPartitionManagerClosed = errors.New("partition manager closed")
 
 type transactionManagerFactory func(
+	ptnID partitionID,
 	storageMgr *storageManager,
 	cmdFactory git.CommandFactory,
 	housekeepingManager housekeeping.Manager,
@@ -44,6 +44,33 @@ type PartitionManager struct {
 	// transactionManagerFactory is a factory to create TransactionManagers. This shouldn't ever be changed
 	// during normal operation, but can be used to adjust the transaction manager's behaviour in tests.
 	transactionManagerFactory transactionManagerFactory
+	// historyRetention is the number of applied log entries whose history the TransactionManagers retain.
+	historyRetention uint64
+	// openDatabase opens the key-value stores of the storages.
+	openDatabase DatabaseOpener
+}
+
+// DatabaseOpener opens a Database in the given directory.
+type DatabaseOpener func(logger log.Logger, databasePath string) (Database, error)
+
+// PartitionManagerOption configures a PartitionManager.
+type PartitionManagerOption func(*PartitionManager)
+
+// WithHistoryRetention configures the TransactionManagers to retain the history of the given number of latest
+// applied log entries. The retained history allows for reading the past states of the repositories. History is
+// not retained by default.
+func WithHistoryRetention(entries uint64) PartitionManagerOption {
+	return func(pm *PartitionManager) {
+		pm.historyRetention = entries
+	}
+}
+
+// WithDatabaseOpener configures the PartitionManager to open the storages' databases with the given opener.
+// Badger is used by default.
+func WithDatabaseOpener(openDatabase DatabaseOpener) PartitionManagerOption {
+	return func(pm *PartitionManager) {
+		pm.openDatabase = openDatabase
+	}
 }
 
 // storageManager represents a single storage.
@@ -63,13 +90,49 @@ type storageManager struct {
 	// no new transactions are allowed to begin.
 	closed bool
 	// db is the handle to the key-value store used for storing the storage's database state.
-	database *badger.DB
+	database Database
 	// partitionAssigner manages partition assignments of repositories.
 	partitionAssigner *partitionAssigner
 	// partitions contains all the active partitions. Each repository can have up to one partition.
 	partitions map[partitionID]*partition
 	// activePartitions keeps track of active partitions.
 	activePartitions sync.WaitGroup
+	// appliedNotificationsMu guards access to appliedNotifications.
+	appliedNotificationsMu sync.Mutex
+	// appliedNotifications contains channels that are closed once the next log entry of a partition is
+	// applied. They outlive the partitions' TransactionManagers so that log watchers don't need to keep the
+	// partitions running while waiting for new log entries.
+	appliedNotifications map[partitionID]chan struct{}
+}
+
+// appliedNotification returns a channel that is closed once the next log entry of the partition is applied or the
+// storage is closed.
+func (sm *storageManager) appliedNotification(id partitionID) <-chan struct{} {
+	sm.appliedNotificationsMu.Lock()
+	defer sm.appliedNotificationsMu.Unlock()
+
+	if sm.appliedNotifications == nil {
+		sm.appliedNotifications = map[partitionID]chan struct{}{}
+	}
+
+	notification, ok := sm.appliedNotifications[id]
+	if !ok {
+		notification = make(chan struct{})
+		sm.appliedNotifications[id] = notification
+	}
+
+	return notification
+}
+
+// notifyApplied wakes up the log watchers waiting for the next log entry of the partition to be applied.
+func (sm *storageManager) notifyApplied(id partitionID) {
+	sm.appliedNotificationsMu.Lock()
+	defer sm.appliedNotificationsMu.Unlock()
+
+	if notification, ok := sm.appliedNotifications[id]; ok {
+		close(notification)
+		delete(sm.appliedNotifications, id)
+	}
 }
 
 func (sm *storageManager) close() {
@@ -78,17 +141,24 @@ func (sm *storageManager) close() {
 	// also means no more partitions are spawned.
 	sm.closed = true
 	for _, ptn := range sm.partitions {
-		// Close all partitions.
-		ptn.close()
+		// Close all partitions. The partitions that have no pending transactions left may
+		// already be closing while their TransactionManager is still stopping.
+		if !ptn.isClosing() {
+			ptn.close()
+		}
 	}
 	sm.mu.Unlock()
 
 	// Wait for all partitions to finish.
 	sm.activePartitions.Wait()
 
-	if err := sm.partitionAssigner.Close(); err != nil {
-		sm.logger.WithError(err).Error("failed closing partition assigner")
+	// Wake up the log watchers so they notice the storage has been closed.
+	sm.appliedNotificationsMu.Lock()
+	for id, notification := range sm.appliedNotifications {
+		close(notification)
+		delete(sm.appliedNotifications, id)
 	}
+	sm.appliedNotificationsMu.Unlock()
 
 	if err := sm.database.Close(); err != nil {
 		sm.logger.WithError(err).Error("failed closing storage's database")
@@ -181,7 +251,18 @@ func NewPartitionManager(
 	housekeepingManager housekeeping.Manager,
 	localRepoFactory localrepo.Factory,
 	logger log.Logger,
+	opts ...PartitionManagerOption,
 ) (*PartitionManager, error) {
+	pm := &PartitionManager{
+		commandFactory:      cmdFactory,
+		housekeepingManager: housekeepingManager,
+		openDatabase:        OpenDatabase,
+	}
+
+	for _, opt := range opts {
+		opt(pm)
+	}
+
 	storages := make(map[string]*storageManager, len(configuredStorages))
 	for _, storage := range configuredStorages {
 		repoFactory, err := localRepoFactory.ScopeByStorage(storage.Name)
@@ -210,49 +291,48 @@ func NewPartitionManager(
 		}
 
 		storageLogger := logger.WithField("storage", storage.Name)
-		db, err := OpenDatabase(storageLogger.WithField("component", "database"), databaseDir)
+		db, err := pm.openDatabase(storageLogger.WithField("component", "database"), databaseDir)
 		if err != nil {
 			return nil, fmt.Errorf("create storage's database directory: %w", err)
 		}
 
-		pa, err := newPartitionAssigner(db, storage.Path)
-		if err != nil {
-			return nil, fmt.Errorf("new partition assigner: %w", err)
-		}
-
 		storages[storage.Name] = &storageManager{
 			logger:            storageLogger,
 			path:              storage.Path,
 			repoFactory:       repoFactory,
 			stagingDirectory:  stagingDir,
 			database:          db,
-			partitionAssigner: pa,
+			partitionAssigner: newPartitionAssigner(db, storage.Path),
 			partitions:        map[partitionID]*partition{},
 		}
 	}
 
-	return &PartitionManager{
-		storages:            storages,
-		commandFactory:      cmdFactory,
-		housekeepingManager: housekeepingManager,
-		transactionManagerFactory: func(
-			storageMgr *storageManager,
-			cmdFactory git.CommandFactory,
-			housekeepingManager housekeeping.Manager,
-			relativePath, absoluteStateDir, stagingDir string,
-		) *TransactionManager {
-			return NewTransactionManager(
-				storageMgr.database,
-				storageMgr.path,
-				relativePath,
-				absoluteStateDir,
-				stagingDir,
-				cmdFactory,
-				housekeepingManager,
-				storageMgr.repoFactory,
-			)
-		},
-	}, nil
+	pm.storages = storages
+
+	pm.transactionManagerFactory = func(
+		ptnID partitionID,
+		storageMgr *storageManager,
+		cmdFactory git.CommandFactory,
+		housekeepingManager housekeeping.Manager,
+		relativePath, absoluteStateDir, stagingDir string,
+	) *TransactionManager {
+		mgr := NewTransactionManager(
+			ptnID,
+			storageMgr.database,
+			storageMgr.path,
+			relativePath,
+			absoluteStateDir,
+			stagingDir,
+			cmdFactory,
+			housekeepingManager,
+			storageMgr.repoFactory,
+		)
+		mgr.historyRetention = pm.historyRetention
+
+		return mgr
+	}
+
+	return pm, nil
 }
 
 func stagingDirectoryPath(storagePath string) string {
@@ -261,29 +341,142 @@ func stagingDirectoryPath(storagePath string) string {
 
 // Begin gets the TransactionManager for the specified repository and starts a transaction. If a
 // TransactionManager is not already running, a new one is created and used. The partition tracks
-// the number of pending transactions and this counter gets incremented when Begin is invoked.
+// the number of pending transactions and this counter gets incremented when Begin is invoked. The
+// repositories in the options' AdditionalRepositories must be in the same partition as the repository.
 func (pm *PartitionManager) Begin(ctx context.Context, repo storage.Repository, opts TransactionOptions) (*finalizableTransaction, error) {
+	storageMgr, relativePath, partitionID, err := pm.resolvePartition(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// The additional repositories of the transaction must be in the same partition as the transaction is
+	// processed by the partition's TransactionManager.
+	additionalRepositories := make([]string, 0, len(opts.AdditionalRepositories))
+	for _, additionalRepository := range opts.AdditionalRepositories {
+		additionalRelativePath, err := storage.ValidateRelativePath(storageMgr.path, additionalRepository)
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("validate additional relative path: %w", err)
+		}
+
+		additionalPartitionID, err := storageMgr.partitionAssigner.getPartitionID(ctx, additionalRelativePath)
+		if err != nil {
+			if errors.Is(err, ErrDatabaseClosed) {
+				return nil, ErrPartitionManagerClosed
+			}
+
+			return nil, fmt.Errorf("get additional repository's partition: %w", err)
+		}
+
+		if additionalPartitionID != partitionID {
+			return nil, structerr.NewInvalidArgument("additional repository is in a different partition").
+				WithMetadata("relative_path", relativePath).
+				WithMetadata("additional_relative_path", additionalRelativePath)
+		}
+
+		additionalRepositories = append(additionalRepositories, additionalRelativePath)
+	}
+	opts.AdditionalRepositories = additionalRepositories
+	// The partition's TransactionManager may have been started for another repository of the partition.
+	opts.RelativePath = relativePath
+
+	ptn, err := pm.acquirePartition(ctx, storageMgr, relativePath, partitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := ptn.transactionManager.Begin(ctx, opts)
+	if err != nil {
+		// The pending transaction count needs to be decremented since the transaction is no longer
+		// inflight. A transaction failing does not necessarily mean the transaction manager has
+		// stopped running. Consequently, if there are no other pending transactions the partition
+		// should be closed.
+		storageMgr.finalizeTransaction(ptn)
+
+		return nil, err
+	}
+
+	return storageMgr.newFinalizableTransaction(ptn, transaction), nil
+}
+
+// WatchLog watches the write-ahead log of the specified repository's partition by calling handleEvents with
+// the repository's events of the log entries applied after the given log index. The events are passed in
+// ascending log index order. Once the events of the already applied log entries have been handled, WatchLog
+// waits for new log entries to be applied and passes their events on as well. WatchLog keeps watching until
+// the context is canceled, handleEvents returns an error, or the PartitionManager is closed.
+//
+// The partition is only kept open while the log is read so that watching the log doesn't keep the partition
+// running while waiting for new log entries. The events are read from the history of the log so history
+// retention must be enabled. ErrHistoryNotRetained is returned if the history doesn't reach back to the given
+// log index.
+func (pm *PartitionManager) WatchLog(ctx context.Context, repo storage.Repository, afterIndex LogIndex, handleEvents func([]LogEvent) error) error {
+	storageMgr, relativePath, partitionID, err := pm.resolvePartition(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// The notification channel is taken prior to reading the log so log entries applied while
+		// reading still wake us up.
+		applied := storageMgr.appliedNotification(partitionID)
+
+		ptn, err := pm.acquirePartition(ctx, storageMgr, relativePath, partitionID)
+		if err != nil {
+			return err
+		}
+
+		events, appliedIndex, err := ptn.transactionManager.readLogEvents(ctx, relativePath, afterIndex)
+		storageMgr.finalizeTransaction(ptn)
+		if err != nil {
+			return err
+		}
+
+		if len(events) > 0 {
+			if err := handleEvents(events); err != nil {
+				return fmt.Errorf("handle events: %w", err)
+			}
+		}
+
+		afterIndex = appliedIndex
+
+		select {
+		case <-applied:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// resolvePartition returns the storage the repository is in along with the repository's cleaned relative path
+// and the ID of the partition the repository is assigned to.
+func (pm *PartitionManager) resolvePartition(ctx context.Context, repo storage.Repository) (*storageManager, string, partitionID, error) {
 	storageMgr, ok := pm.storages[repo.GetStorageName()]
 	if !ok {
-		return nil, structerr.NewNotFound("unknown storage: %q", repo.GetStorageName())
+		return nil, "", 0, structerr.NewNotFound("unknown storage: %q", repo.GetStorageName())
 	}
 
 	relativePath, err := storage.ValidateRelativePath(storageMgr.path, repo.GetRelativePath())
 	if err != nil {
-		return nil, structerr.NewInvalidArgument("validate relative path: %w", err)
+		return nil, "", 0, structerr.NewInvalidArgument("validate relative path: %w", err)
 	}
 
 	partitionID, err := storageMgr.partitionAssigner.getPartitionID(ctx, relativePath)
 	if err != nil {
-		if errors.Is(err, badger.ErrDBClosed) {
+		if errors.Is(err, ErrDatabaseClosed) {
 			// The database is closed when PartitionManager is closing. Return a more
 			// descriptive error of what happened.
-			return nil, ErrPartitionManagerClosed
+			return nil, "", 0, ErrPartitionManagerClosed
 		}
 
-		return nil, fmt.Errorf("get partition: %w", err)
+		return nil, "", 0, fmt.Errorf("get partition: %w", err)
 	}
 
+	return storageMgr, relativePath, partitionID, nil
+}
+
+// acquirePartition returns the partition with the given ID. If the partition's TransactionManager is not already
+// running, a new one is created and started. The partition's pending transaction count is incremented and the
+// caller must decrement it with finalizeTransaction once done with the partition.
+func (pm *PartitionManager) acquirePartition(ctx context.Context, storageMgr *storageManager, relativePath string, partitionID partitionID) (*partition, error) {
 	relativeStateDir := deriveStateDirectory(partitionID)
 	absoluteStateDir := filepath.Join(storageMgr.path, relativeStateDir)
 	if err := os.MkdirAll(filepath.Dir(absoluteStateDir), perm.PrivateDir); err != nil {
@@ -314,7 +507,8 @@ func (pm *PartitionManager) Begin(ctx context.Context, repo storage.Repository,
 				return nil, fmt.Errorf("create staging directory: %w", err)
 			}
 
-			mgr := pm.transactionManagerFactory(storageMgr, pm.commandFactory, pm.housekeepingManager, relativePath, absoluteStateDir, stagingDir)
+			mgr := pm.transactionManagerFactory(partitionID, storageMgr, pm.commandFactory, pm.housekeepingManager, relativePath, absoluteStateDir, stagingDir)
+			mgr.notifyApplied = func() { storageMgr.notifyApplied(partitionID) }
 
 			ptn.transactionManager = mgr
 
@@ -372,18 +566,7 @@ func (pm *PartitionManager) Begin(ctx context.Context, repo storage.Repository,
 		ptn.pendingTransactionCount++
 		storageMgr.mu.Unlock()
 
-		transaction, err := ptn.transactionManager.Begin(ctx, opts)
-		if err != nil {
-			// The pending transaction count needs to be decremented since the transaction is no longer
-			// inflight. A transaction failing does not necessarily mean the transaction manager has
-			// stopped running. Consequently, if there are no other pending transactions the partition
-			// should be closed.
-			storageMgr.finalizeTransaction(ptn)
-
-			return nil, err
-		}
-
-		return storageMgr.newFinalizableTransaction(ptn, transaction), nil
+		return ptn, nil
 	}
 }
 
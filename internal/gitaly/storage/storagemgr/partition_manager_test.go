@@ -18,6 +18,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/backchannel"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
@@ -365,12 +366,14 @@ func TestPartitionManager(t *testing.T) {
 						},
 					},
 					transactionManagerFactory: func(
+						ptnID partitionID,
 						storageMgr *storageManager,
 						commandFactory git.CommandFactory,
 						housekeepingManager housekeeping.Manager,
 						relativePath, absoluteStateDir, stagingDir string,
 					) *TransactionManager {
 						txMgr := NewTransactionManager(
+							ptnID,
 							storageMgr.database,
 							storageMgr.path,
 							relativePath,
@@ -415,12 +418,14 @@ func TestPartitionManager(t *testing.T) {
 						},
 					},
 					transactionManagerFactory: func(
+						ptnID partitionID,
 						storageMgr *storageManager,
 						commandFactory git.CommandFactory,
 						housekeepingManager housekeeping.Manager,
 						relativePath, absoluteStateDir, stagingDir string,
 					) *TransactionManager {
 						txMgr := NewTransactionManager(
+							ptnID,
 							storageMgr.database,
 							storageMgr.path,
 							relativePath,
@@ -667,7 +672,7 @@ func TestPartitionManager(t *testing.T) {
 			txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
 			housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
 
-			partitionManager, err := NewPartitionManager(cfg.Storages, cmdFactory, housekeepingManager, localRepoFactory, testhelper.SharedLogger(t))
+			partitionManager, err := NewPartitionManager(cfg.Storages, cmdFactory, housekeepingManager, localRepoFactory, testhelper.SharedLogger(t), WithDatabaseOpener(openTestDatabase))
 			require.NoError(t, err)
 
 			if setup.transactionManagerFactory != nil {
@@ -778,3 +783,173 @@ func TestPartitionManager(t *testing.T) {
 		})
 	}
 }
+
+func TestPartitionManager_additionalRepositories(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	cmdFactory := gittest.NewCommandFactory(t, cfg)
+	catfileCache := catfile.NewCache(cfg)
+	t.Cleanup(catfileCache.Stop)
+
+	localRepoFactory := localrepo.NewFactory(config.NewLocator(cfg), cmdFactory, catfileCache)
+
+	txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+	housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+	partitionManager, err := NewPartitionManager(cfg.Storages, cmdFactory, housekeepingManager, localRepoFactory, testhelper.SharedLogger(t), WithDatabaseOpener(openTestDatabase))
+	require.NoError(t, err)
+	defer partitionManager.Close()
+
+	createRepository := func(t *testing.T) (*gitalypb.Repository, string) {
+		t.Helper()
+
+		return gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+	}
+
+	pool, poolPath := createRepository(t)
+	fork, forkPath := createRepository(t)
+	unrelated, _ := createRepository(t)
+
+	// Link the fork to the pool so they are assigned into the same partition.
+	alternateRelativePath, err := filepath.Rel(
+		filepath.Join(forkPath, "objects"),
+		filepath.Join(poolPath, "objects"),
+	)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(forkPath, "objects", "info", "alternates"), []byte(alternateRelativePath), fs.ModePerm))
+
+	commitID := gittest.WriteCommit(t, cfg, poolPath, gittest.WithParents())
+
+	t.Run("additional repository in a different partition", func(t *testing.T) {
+		_, err := partitionManager.Begin(ctx, pool, TransactionOptions{
+			AdditionalRepositories: []string{unrelated.GetRelativePath()},
+		})
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument("additional repository is in a different partition").
+			WithMetadata("relative_path", pool.GetRelativePath()).
+			WithMetadata("additional_relative_path", unrelated.GetRelativePath()), err)
+	})
+
+	t.Run("invalid additional relative path", func(t *testing.T) {
+		_, err := partitionManager.Begin(ctx, pool, TransactionOptions{
+			AdditionalRepositories: []string{"../../outside"},
+		})
+		require.Equal(t, structerr.NewInvalidArgument("validate additional relative path: %w", storage.ErrRelativePathEscapesRoot), err)
+	})
+
+	t.Run("references updated in the same partition", func(t *testing.T) {
+		txn, err := partitionManager.Begin(ctx, pool, TransactionOptions{
+			AdditionalRepositories: []string{filepath.Join(fork.GetRelativePath(), "child-dir", "..")},
+		})
+		require.NoError(t, err)
+
+		txn.UpdateReferences(ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+		txn.UpdateRepositoryReferences(fork.GetRelativePath(), ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+		require.NoError(t, txn.Commit(ctx))
+
+		expectedReferences := []git.Reference{git.NewReference("refs/heads/main", commitID)}
+		require.Equal(t, expectedReferences, gittest.GetReferences(t, cfg, poolPath))
+		require.Equal(t, expectedReferences, gittest.GetReferences(t, cfg, forkPath))
+	})
+
+	t.Run("transaction on a repository the partition wasn't started for", func(t *testing.T) {
+		// Keep a transaction open on the pool so the partition's TransactionManager keeps running with
+		// the pool as the repository it was started for.
+		poolTxn, err := partitionManager.Begin(ctx, pool, TransactionOptions{ReadOnly: true})
+		require.NoError(t, err)
+		defer func() { require.NoError(t, poolTxn.Rollback()) }()
+
+		txn, err := partitionManager.Begin(ctx, fork, TransactionOptions{
+			AdditionalRepositories: []string{pool.GetRelativePath()},
+		})
+		require.NoError(t, err)
+
+		txn.UpdateReferences(ReferenceUpdates{
+			"refs/heads/fork": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+		txn.UpdateRepositoryReferences(pool.GetRelativePath(), ReferenceUpdates{
+			"refs/heads/pool": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+		})
+		require.NoError(t, txn.Commit(ctx))
+
+		txn, err = partitionManager.Begin(ctx, fork, TransactionOptions{})
+		require.NoError(t, err)
+
+		txn.UpdateReferences(ReferenceUpdates{
+			"refs/heads/main": {OldOID: commitID, NewOID: gittest.DefaultObjectHash.ZeroOID},
+		})
+		txn.SetDefaultBranch("refs/heads/fork")
+		require.NoError(t, txn.Commit(ctx))
+
+		require.Equal(t, []git.Reference{
+			git.NewReference("refs/heads/main", commitID),
+			git.NewReference("refs/heads/pool", commitID),
+		}, gittest.GetReferences(t, cfg, poolPath))
+		require.Equal(t, []git.Reference{
+			git.NewReference("refs/heads/fork", commitID),
+		}, gittest.GetReferences(t, cfg, forkPath))
+
+		require.Equal(t, "refs/heads/main", text.ChompBytes(gittest.Exec(t, cfg, "-C", poolPath, "symbolic-ref", "HEAD")))
+		require.Equal(t, "refs/heads/fork", text.ChompBytes(gittest.Exec(t, cfg, "-C", forkPath, "symbolic-ref", "HEAD")))
+	})
+
+	t.Run("partition restarted by another repository", func(t *testing.T) {
+		// The partition's TransactionManager is closed once it has no transactions left. The fork
+		// starts it again, and must continue from the log entries committed through the pool.
+		txn, err := partitionManager.Begin(ctx, fork, TransactionOptions{ReadOnly: true})
+		require.NoError(t, err)
+		defer func() { require.NoError(t, txn.Rollback()) }()
+
+		require.Equal(t, LogIndex(3), txn.snapshot.ReadIndex)
+	})
+}
+
+func TestPartitionManager_databaseOpener(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	cmdFactory := gittest.NewCommandFactory(t, cfg)
+	catfileCache := catfile.NewCache(cfg)
+	t.Cleanup(catfileCache.Stop)
+
+	localRepoFactory := localrepo.NewFactory(config.NewLocator(cfg), cmdFactory, catfileCache)
+
+	txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+	housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+	partitionManager, err := NewPartitionManager(
+		cfg.Storages, cmdFactory, housekeepingManager, localRepoFactory, testhelper.SharedLogger(t),
+		WithDatabaseOpener(OpenBoltDatabase),
+	)
+	require.NoError(t, err)
+	defer partitionManager.Close()
+
+	require.FileExists(t, filepath.Join(cfg.Storages[0].Path, "database", boltDatabaseFileName))
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+
+	txn, err := partitionManager.Begin(ctx, repo, TransactionOptions{})
+	require.NoError(t, err)
+
+	txn.UpdateReferences(ReferenceUpdates{
+		"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitID},
+	})
+	require.NoError(t, txn.Commit(ctx))
+
+	require.Equal(t, []git.Reference{
+		git.NewReference("refs/heads/main", commitID),
+	}, gittest.GetReferences(t, cfg, repoPath))
+}
@@ -8,7 +8,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/dgraph-io/badger/v4"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
@@ -16,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/repoutil"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"google.golang.org/protobuf/proto"
 )
@@ -24,6 +24,20 @@ func TestMain(m *testing.M) {
 	testhelper.Run(m)
 }
 
+// testPartitionID is the partition ID the TransactionManagers constructed directly in the tests are managing.
+const testPartitionID = partitionID(1)
+
+// openTestDatabase opens a Database in the given directory. The database configured for the testing run
+// with GITALY_TEST_WAL_DATABASE is used so the tests can be run against all of the supported databases.
+func openTestDatabase(logger log.Logger, databasePath string) (Database, error) {
+	openDatabase, err := NewDatabaseOpener(testhelper.WALDatabase())
+	if err != nil {
+		return nil, err
+	}
+
+	return openDatabase(logger, databasePath)
+}
+
 // RepositoryState describes the full asserted state of a repository.
 type RepositoryState struct {
 	// NotFound when set asserts the repository should not exist. When set
@@ -97,7 +111,7 @@ type DatabaseState map[string]proto.Message
 
 // RequireDatabase asserts the actual database state matches the expected database state. The actual values in the
 // database are unmarshaled to the same type the values have in the expected database state.
-func RequireDatabase(tb testing.TB, ctx context.Context, database *badger.DB, expectedState DatabaseState) {
+func RequireDatabase(tb testing.TB, ctx context.Context, database Database, expectedState DatabaseState) {
 	tb.Helper()
 
 	if expectedState == nil {
@@ -106,8 +120,8 @@ func RequireDatabase(tb testing.TB, ctx context.Context, database *badger.DB, ex
 
 	actualState := DatabaseState{}
 	unexpectedKeys := []string{}
-	require.NoError(tb, database.View(func(txn *badger.Txn) error {
-		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+	require.NoError(tb, database.View(func(txn DatabaseTransaction) error {
+		iterator := txn.NewIterator(IteratorOptions{})
 		defer iterator.Close()
 
 		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
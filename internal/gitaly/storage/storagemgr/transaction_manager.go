@@ -16,13 +16,14 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/dgraph-io/badger/v4"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/repoutil"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/safe"
@@ -47,6 +48,9 @@ var (
 	errInitializationFailed = errors.New("initializing transaction processing failed")
 	// errNotDirectory is returned when the repository's path doesn't point to a directory
 	errNotDirectory = errors.New("repository's path didn't point to a directory")
+	// ErrRepositoryNotInTransaction is returned when references are updated in a repository that was not
+	// included in the transaction when it began.
+	ErrRepositoryNotInTransaction = errors.New("repository not included in the transaction")
 )
 
 // InvalidReferenceFormatError is returned when a reference name was invalid.
@@ -149,6 +153,8 @@ type Transaction struct {
 	state transactionState
 	// commit commits the Transaction through the TransactionManager.
 	commit func(context.Context, *Transaction) error
+	// readHistory reads the history entries retained by the TransactionManager.
+	readHistory func() ([]historyEntry, error)
 	// result is where the outcome of the transaction is sent ot by TransactionManager once it
 	// has been determined.
 	result chan error
@@ -174,6 +180,8 @@ type Transaction struct {
 	// packPrefix contains the prefix (`pack-<digest>`) of the transaction's pack if the transaction
 	// had objects to log.
 	packPrefix string
+	// relativePath is the relative path of the repository the transaction targets.
+	relativePath string
 	// stagingRepository is a repository that is used to stage the transaction. If there are quarantined
 	// objects, it has the quarantine applied so the objects are available for verification and packing.
 	stagingRepository *localrepo.Repo
@@ -187,6 +195,13 @@ type Transaction struct {
 	customHooksUpdate        *CustomHooksUpdate
 	deleteRepository         bool
 	includedObjects          map[git.ObjectID]struct{}
+
+	// additionalRepositories contains the other repositories of the partition included in the transaction.
+	// They're keyed by their relative paths.
+	additionalRepositories map[string]*localrepo.Repo
+	// partitionReferenceUpdates contains the reference updates to perform in the additional repositories.
+	// They're keyed by the relative paths of the repositories.
+	partitionReferenceUpdates map[string]ReferenceUpdates
 }
 
 // TransactionOptions configures transaction options when beginning a transaction.
@@ -194,6 +209,14 @@ type TransactionOptions struct {
 	// ReadOnly indicates whether this is a read-only transaction. Read-only transactions are not
 	// configured with a quarantine directory and do not commit a log entry.
 	ReadOnly bool
+	// RelativePath is the relative path of the repository the transaction targets. It defaults to
+	// the repository the TransactionManager was started for. Other repositories in the partition
+	// can be targeted as they share the partition's log.
+	RelativePath string
+	// AdditionalRepositories contains the relative paths of other repositories in the same partition
+	// the transaction may update references in. The reference updates in all of the repositories are
+	// committed atomically.
+	AdditionalRepositories []string
 }
 
 // Begin opens a new transaction. The caller must call either Commit or Rollback to release
@@ -213,18 +236,35 @@ func (mgr *TransactionManager) Begin(ctx context.Context, opts TransactionOption
 		}
 	}
 
+	targetRelativePath := opts.RelativePath
+	if targetRelativePath == "" {
+		targetRelativePath = mgr.relativePath
+	}
+
+	additionalRepositories := make(map[string]*localrepo.Repo, len(opts.AdditionalRepositories))
+	for _, relativePath := range opts.AdditionalRepositories {
+		if relativePath == targetRelativePath {
+			return nil, fmt.Errorf("additional repository is the transaction's repository: %q", relativePath)
+		}
+
+		additionalRepositories[relativePath] = mgr.repositoryFactory.Build(relativePath)
+	}
+
 	mgr.mutex.Lock()
 
 	txn := &Transaction{
-		readOnly: opts.ReadOnly,
-		commit:   mgr.commit,
+		readOnly:     opts.ReadOnly,
+		relativePath: targetRelativePath,
+		commit:       mgr.commit,
+		readHistory:  func() ([]historyEntry, error) { return mgr.readHistory(targetRelativePath) },
 		snapshot: Snapshot{
 			ReadIndex:       mgr.appendedLogIndex,
 			CustomHookIndex: mgr.customHookIndex,
 			CustomHookPath:  customHookPathForLogIndex(mgr.repositoryPath, mgr.customHookIndex),
 		},
-		admitted: make(chan struct{}),
-		finished: make(chan struct{}),
+		admitted:               make(chan struct{}),
+		finished:               make(chan struct{}),
+		additionalRepositories: additionalRepositories,
 	}
 
 	// If there are no custom hooks stored through the WAL yet, then default to the custom hooks
@@ -233,6 +273,13 @@ func (mgr *TransactionManager) Begin(ctx context.Context, opts TransactionOption
 		txn.snapshot.CustomHookPath = filepath.Join(mgr.repositoryPath, repoutil.CustomHooksDir)
 	}
 
+	// The WAL only stores the custom hooks of the repository the TransactionManager was started for.
+	// The custom hooks of the other repositories in the partition are only written into the repositories.
+	if txn.relativePath != mgr.relativePath {
+		txn.snapshot.CustomHookIndex = 0
+		txn.snapshot.CustomHookPath = filepath.Join(mgr.storagePath, txn.relativePath, repoutil.CustomHooksDir)
+	}
+
 	openTransactionElement := mgr.openTransactions.PushBack(txn)
 
 	readReady := mgr.applyNotifications[txn.snapshot.ReadIndex]
@@ -280,7 +327,7 @@ func (mgr *TransactionManager) Begin(ctx context.Context, opts TransactionOption
 			return nil, fmt.Errorf("mkdir temp: %w", err)
 		}
 
-		txn.stagingRepository = mgr.repositoryFactory.Build(mgr.relativePath)
+		txn.stagingRepository = mgr.repositoryFactory.Build(txn.relativePath)
 		if !txn.readOnly {
 			txn.quarantineDirectory = filepath.Join(txn.stagingDirectory, "quarantine")
 			if err := os.MkdirAll(filepath.Join(txn.quarantineDirectory, "pack"), perm.PrivateDir); err != nil {
@@ -328,6 +375,9 @@ var (
 	errReadOnlyCustomHooksUpdate   = errors.New("custom hooks update staged in a read-only transaction")
 	errReadOnlyRepositoryDeletion  = errors.New("repository deletion staged in a read-only transaction")
 	errReadOnlyObjectsIncluded     = errors.New("objects staged in a read-only transaction")
+	// errReadOnlyPartitionReferenceUpdates is returned when reference updates to other repositories
+	// have been staged in a read-only transaction.
+	errReadOnlyPartitionReferenceUpdates = errors.New("partition reference updates staged in a read-only transaction")
 )
 
 // Commit performs the changes. If no error is returned, the transaction was successful and the changes
@@ -358,6 +408,8 @@ func (txn *Transaction) Commit(ctx context.Context) (returnedErr error) {
 			return errReadOnlyRepositoryDeletion
 		case txn.includedObjects != nil:
 			return errReadOnlyObjectsIncluded
+		case txn.partitionReferenceUpdates != nil:
+			return errReadOnlyPartitionReferenceUpdates
 		default:
 			return nil
 		}
@@ -409,6 +461,19 @@ func (txn *Transaction) UpdateReferences(updates ReferenceUpdates) {
 	txn.referenceUpdates = updates
 }
 
+// UpdateRepositoryReferences updates the given references in another repository of the partition as part of
+// the transaction. The repository must have been included in the transaction's AdditionalRepositories when the
+// transaction began. The new tips must already exist in the repository as the transaction's objects are only
+// logged into the transaction's own repository. If UpdateRepositoryReferences is called multiple times for the
+// same repository, only the changes from the latest invocation take place.
+func (txn *Transaction) UpdateRepositoryReferences(relativePath string, updates ReferenceUpdates) {
+	if txn.partitionReferenceUpdates == nil {
+		txn.partitionReferenceUpdates = map[string]ReferenceUpdates{}
+	}
+
+	txn.partitionReferenceUpdates[relativePath] = updates
+}
+
 // DeleteRepository deletes the repository when the transaction is committed.
 func (txn *Transaction) DeleteRepository() {
 	txn.deleteRepository = true
@@ -482,6 +547,12 @@ func (txn *Transaction) walFilesPath() string {
 //     and the log index keeps monotonically increasing from there on without gaps. The write-ahead log
 //     entries are processed in ascending order.
 //
+// - `repository/<repository_id:string>/log/history/<log_index:uint64>`
+//   - These keys hold the history of the applied log entries if history retention is enabled. The history
+//     records the state of the references prior to applying each log entry, which allows for reconstructing
+//     the past states of the repository. Only the history of the latest applied log entries within the
+//     retention is kept.
+//
 // The values in the database are marshaled protocol buffer messages. Numbers in the keys are encoded as big
 // endian to preserve the sort order of the numbers also in lexicographical order.
 type TransactionManager struct {
@@ -516,10 +587,15 @@ type TransactionManager struct {
 	repository *localrepo.Repo
 	// repositoryPath is the path to the repository this TransactionManager is acting on.
 	repositoryPath string
+	// storagePath is the absolute path to the root of the storage the repository is in.
+	storagePath string
 	// relativePath is the repository's relative path inside the storage.
 	relativePath string
+	// partitionID is the ID of the partition this TransactionManager is managing. The partition's write-ahead
+	// log state is keyed by it as any of the partition's repositories may start the TransactionManager.
+	partitionID partitionID
 	// db is the handle to the key-value store used for storing the write-ahead log related state.
-	db database
+	db Database
 	// admissionQueue is where the incoming writes are waiting to be admitted to the transaction
 	// manager.
 	admissionQueue chan *Transaction
@@ -539,6 +615,9 @@ type TransactionManager struct {
 	// applyNotifications stores channels that are closed when a log entry is applied. These
 	// are used to block transactions from beginning before their snapshot is ready.
 	applyNotifications map[LogIndex]chan struct{}
+	// notifyApplied is called whenever a log entry is applied if set. It's used to wake up the log
+	// watchers of the partition.
+	notifyApplied func()
 	// appendedLogIndex holds the index of the last log entry appended to the log.
 	appendedLogIndex LogIndex
 	// appliedLogIndex holds the index of the last log entry applied to the repository
@@ -547,6 +626,11 @@ type TransactionManager struct {
 	customHookIndex LogIndex
 	// housekeepingManager access to the housekeeping.Manager.
 	housekeepingManager housekeeping.Manager
+	// historyRetention is the number of applied log entries whose history is retained. History is not
+	// retained if it is zero.
+	historyRetention uint64
+	// now returns the current time. It's used to timestamp the history entries.
+	now func() time.Time
 
 	// awaitingTransactions contains transactions waiting for their log entry to be applied to
 	// the repository. It's keyed by the log index the transaction is waiting to be applied and the
@@ -554,9 +638,10 @@ type TransactionManager struct {
 	awaitingTransactions map[LogIndex]resultChannel
 }
 
-// NewTransactionManager returns a new TransactionManager for the given repository.
+// NewTransactionManager returns a new TransactionManager for the given repository's partition.
 func NewTransactionManager(
-	db *badger.DB,
+	ptnID partitionID,
+	db Database,
 	storagePath,
 	relativePath,
 	stateDir,
@@ -575,8 +660,10 @@ func NewTransactionManager(
 		repositoryFactory:    repositoryFactory,
 		repository:           repositoryFactory.Build(relativePath),
 		repositoryPath:       filepath.Join(storagePath, relativePath),
+		storagePath:          storagePath,
 		relativePath:         relativePath,
-		db:                   newDatabaseAdapter(db),
+		partitionID:          ptnID,
+		db:                   db,
 		admissionQueue:       make(chan *Transaction),
 		openTransactions:     list.New(),
 		initialized:          make(chan struct{}),
@@ -584,6 +671,7 @@ func NewTransactionManager(
 		stateDirectory:       stateDir,
 		stagingDirectory:     stagingDir,
 		housekeepingManager:  housekeepingManager,
+		now:                  time.Now,
 		awaitingTransactions: make(map[LogIndex]resultChannel),
 	}
 }
@@ -865,11 +953,11 @@ func (mgr *TransactionManager) processTransaction() (returnedErr error) {
 	}
 
 	if err := func() (commitErr error) {
-		if !mgr.repositoryExists {
-			return ErrRepositoryNotFound
+		if err := mgr.verifyRepositoryExists(transaction); err != nil {
+			return err
 		}
 
-		logEntry := &gitalypb.LogEntry{}
+		logEntry := &gitalypb.LogEntry{RelativePath: transaction.relativePath}
 
 		var err error
 		logEntry.ReferenceUpdates, err = mgr.verifyReferences(mgr.ctx, transaction)
@@ -877,6 +965,11 @@ func (mgr *TransactionManager) processTransaction() (returnedErr error) {
 			return fmt.Errorf("verify references: %w", err)
 		}
 
+		logEntry.PartitionReferenceUpdates, err = mgr.verifyPartitionReferences(mgr.ctx, transaction)
+		if err != nil {
+			return fmt.Errorf("verify partition references: %w", err)
+		}
+
 		if transaction.defaultBranchUpdate != nil {
 			if err := mgr.verifyDefaultBranchUpdate(mgr.ctx, transaction); err != nil {
 				return fmt.Errorf("verify default branch update: %w", err)
@@ -931,6 +1024,29 @@ func (mgr *TransactionManager) processTransaction() (returnedErr error) {
 	return nil
 }
 
+// verifyRepositoryExists returns ErrRepositoryNotFound if the repository the transaction targets doesn't exist.
+func (mgr *TransactionManager) verifyRepositoryExists(transaction *Transaction) error {
+	if transaction.relativePath == mgr.relativePath {
+		if !mgr.repositoryExists {
+			return ErrRepositoryNotFound
+		}
+
+		return nil
+	}
+
+	// The existence of the other repositories in the partition is not tracked by the TransactionManager.
+	// Their deletions are applied before later transactions are processed so the disk is up to date.
+	if _, err := transaction.stagingRepository.Path(); err != nil {
+		if errors.Is(err, storage.ErrRepositoryNotFound) {
+			return ErrRepositoryNotFound
+		}
+
+		return fmt.Errorf("repository path: %w", err)
+	}
+
+	return nil
+}
+
 // Close stops the transaction processing causing Run to return.
 func (mgr *TransactionManager) Close() { mgr.close() }
 
@@ -950,7 +1066,7 @@ func (mgr *TransactionManager) initialize(ctx context.Context) error {
 	defer close(mgr.initialized)
 
 	var appliedLogIndex gitalypb.LogIndex
-	if err := mgr.readKey(keyAppliedLogIndex(mgr.relativePath), &appliedLogIndex); err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+	if err := mgr.readKey(keyAppliedLogIndex(mgr.partitionID), &appliedLogIndex); err != nil && !errors.Is(err, ErrKeyNotFound) {
 		return fmt.Errorf("read applied log index: %w", err)
 	}
 
@@ -963,17 +1079,15 @@ func (mgr *TransactionManager) initialize(ctx context.Context) error {
 	//
 	// As the log indexes in the keys are encoded in big endian, the latest log entry can be found by taking
 	// the first key when iterating the log entry key space in reverse.
-	if err := mgr.db.View(func(txn databaseTransaction) error {
-		logPrefix := keyPrefixLogEntries(mgr.relativePath)
+	if err := mgr.db.View(func(txn DatabaseTransaction) error {
+		logPrefix := keyPrefixLogEntries(mgr.partitionID)
 
-		iterator := txn.NewIterator(badger.IteratorOptions{Reverse: true, Prefix: logPrefix})
+		iterator := txn.NewIterator(IteratorOptions{Reverse: true, Prefix: logPrefix})
 		defer iterator.Close()
 
 		mgr.appendedLogIndex = mgr.appliedLogIndex
 
-		// The iterator seeks to a key that is greater than or equal than seeked key. Since we are doing a reverse
-		// seek, we need to add 0xff to the prefix so the first iterated key is the latest log entry.
-		if iterator.Seek(append(logPrefix, 0xff)); iterator.Valid() {
+		if iterator.Rewind(); iterator.Valid() {
 			mgr.appendedLogIndex = LogIndex(binary.BigEndian.Uint64(bytes.TrimPrefix(iterator.Item().Key(), logPrefix)))
 		}
 
@@ -1041,7 +1155,7 @@ func (mgr *TransactionManager) determineRepositoryExistence() error {
 			return fmt.Errorf("read log entry: %w", err)
 		}
 
-		if logEntry.RepositoryDeletion != nil {
+		if logEntry.RepositoryDeletion != nil && logEntry.RelativePath == mgr.relativePath {
 			mgr.repositoryExists = false
 		}
 	}
@@ -1070,7 +1184,7 @@ func (mgr *TransactionManager) determineCustomHookIndex(ctx context.Context, app
 			return 0, fmt.Errorf("read log entry: %w", err)
 		}
 
-		if logEntry.CustomHooksUpdate != nil {
+		if logEntry.CustomHooksUpdate != nil && logEntry.RelativePath == mgr.relativePath {
 			return i, nil
 		}
 	}
@@ -1120,12 +1234,12 @@ func (mgr *TransactionManager) createStateDirectory() error {
 	return nil
 }
 
-// removePackedRefsLocks removes any packed-refs.lock and packed-refs.new files present in the manager's
+// removePackedRefsLocks removes any packed-refs.lock and packed-refs.new files present in the given
 // repository. No grace period for the locks is given as any lockfiles present must be stale and can be
 // safely removed immediately.
-func (mgr *TransactionManager) removePackedRefsLocks(ctx context.Context) error {
+func (mgr *TransactionManager) removePackedRefsLocks(ctx context.Context, repositoryPath string) error {
 	for _, lock := range []string{".new", ".lock"} {
-		lockPath := filepath.Join(mgr.repositoryPath, "packed-refs"+lock)
+		lockPath := filepath.Join(repositoryPath, "packed-refs"+lock)
 
 		// We deliberately do not fsync this deletion. Should a crash occur before this is persisted
 		// to disk, the restarted transaction manager will simply remove them again.
@@ -1212,20 +1326,68 @@ func packFilePath(walFiles string) string {
 // reference changes. The old tips in the transaction are verified against the current actual tips.
 // It returns the write-ahead log entry for the transaction if it was successfully verified.
 func (mgr *TransactionManager) verifyReferences(ctx context.Context, transaction *Transaction) ([]*gitalypb.LogEntry_ReferenceUpdate, error) {
-	if len(transaction.referenceUpdates) == 0 {
+	return mgr.verifyReferenceUpdates(ctx, transaction.stagingRepository, transaction.referenceUpdates, transaction.skipVerificationFailures)
+}
+
+// verifyPartitionReferences verifies the reference updates the transaction performs in the other repositories of the
+// partition. It returns the updates to log sorted by the relative paths of the repositories.
+func (mgr *TransactionManager) verifyPartitionReferences(ctx context.Context, transaction *Transaction) ([]*gitalypb.LogEntry_RepositoryReferenceUpdates, error) {
+	relativePaths := make([]string, 0, len(transaction.partitionReferenceUpdates))
+	for relativePath := range transaction.partitionReferenceUpdates {
+		relativePaths = append(relativePaths, relativePath)
+	}
+	sort.Strings(relativePaths)
+
+	var repositoryUpdates []*gitalypb.LogEntry_RepositoryReferenceUpdates
+	for _, relativePath := range relativePaths {
+		repository, ok := transaction.additionalRepositories[relativePath]
+		if !ok {
+			return nil, fmt.Errorf("%q: %w", relativePath, ErrRepositoryNotInTransaction)
+		}
+
+		if _, err := repository.Path(); err != nil {
+			if errors.Is(err, storage.ErrRepositoryNotFound) {
+				return nil, fmt.Errorf("%q: %w", relativePath, ErrRepositoryNotFound)
+			}
+
+			return nil, fmt.Errorf("repository path: %w", err)
+		}
+
+		referenceUpdates, err := mgr.verifyReferenceUpdates(ctx, repository, transaction.partitionReferenceUpdates[relativePath], transaction.skipVerificationFailures)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", relativePath, err)
+		}
+
+		if len(referenceUpdates) == 0 {
+			continue
+		}
+
+		repositoryUpdates = append(repositoryUpdates, &gitalypb.LogEntry_RepositoryReferenceUpdates{
+			RelativePath:     relativePath,
+			ReferenceUpdates: referenceUpdates,
+		})
+	}
+
+	return repositoryUpdates, nil
+}
+
+// verifyReferenceUpdates verifies the reference updates against the current state of the references in the given
+// repository. It returns the reference updates to log.
+func (mgr *TransactionManager) verifyReferenceUpdates(ctx context.Context, repository *localrepo.Repo, updates ReferenceUpdates, skipVerificationFailures bool) ([]*gitalypb.LogEntry_ReferenceUpdate, error) {
+	if len(updates) == 0 {
 		return nil, nil
 	}
 
 	var referenceUpdates []*gitalypb.LogEntry_ReferenceUpdate
-	for referenceName, update := range transaction.referenceUpdates {
+	for referenceName, update := range updates {
 		if err := git.ValidateReference(string(referenceName)); err != nil {
 			return nil, InvalidReferenceFormatError{ReferenceName: referenceName}
 		}
 
 		if !update.Force {
-			actualOldTip, err := transaction.stagingRepository.ResolveRevision(ctx, referenceName.Revision())
+			actualOldTip, err := repository.ResolveRevision(ctx, referenceName.Revision())
 			if errors.Is(err, git.ErrReferenceNotFound) {
-				objectHash, err := transaction.stagingRepository.ObjectHash(ctx)
+				objectHash, err := repository.ObjectHash(ctx)
 				if err != nil {
 					return nil, fmt.Errorf("object hash: %w", err)
 				}
@@ -1236,7 +1398,7 @@ func (mgr *TransactionManager) verifyReferences(ctx context.Context, transaction
 			}
 
 			if update.OldOID != actualOldTip {
-				if transaction.skipVerificationFailures {
+				if skipVerificationFailures {
 					continue
 				}
 
@@ -1262,7 +1424,7 @@ func (mgr *TransactionManager) verifyReferences(ctx context.Context, transaction
 		) == -1
 	})
 
-	if err := mgr.verifyReferencesWithGit(ctx, referenceUpdates, transaction.stagingRepository); err != nil {
+	if err := mgr.verifyReferencesWithGit(ctx, referenceUpdates, repository); err != nil {
 		return nil, fmt.Errorf("verify references with git: %w", err)
 	}
 
@@ -1297,13 +1459,13 @@ func (mgr *TransactionManager) verifyDefaultBranchUpdate(ctx context.Context, tr
 }
 
 // applyDefaultBranchUpdate applies the default branch update to the repository from the log entry.
-func (mgr *TransactionManager) applyDefaultBranchUpdate(ctx context.Context, defaultBranch *gitalypb.LogEntry_DefaultBranchUpdate) error {
+func (mgr *TransactionManager) applyDefaultBranchUpdate(ctx context.Context, repository *localrepo.Repo, defaultBranch *gitalypb.LogEntry_DefaultBranchUpdate) error {
 	if defaultBranch == nil {
 		return nil
 	}
 
 	var stderr bytes.Buffer
-	if err := mgr.repository.ExecAndWait(ctx, git.Command{
+	if err := repository.ExecAndWait(ctx, git.Command{
 		Name: "symbolic-ref",
 		Args: []string{"HEAD", string(defaultBranch.ReferenceName)},
 	}, git.WithStderr(&stderr), git.WithDisabledHooks()); err != nil {
@@ -1354,7 +1516,12 @@ func (mgr *TransactionManager) prepareReferenceTransaction(ctx context.Context,
 	if errors.Is(err, updateref.ErrPackedRefsLocked) || errors.As(err, &updateref.AlreadyLockedError{}) {
 		// Before clearing stale reference locks, we add should ensure that housekeeping doesn't
 		// run git-pack-refs(1), which could create new reference locks. So we add an inhibitor.
-		success, cleanup, err := mgr.housekeepingManager.AddPackRefsInhibitor(ctx, mgr.repositoryPath)
+		repositoryPath, err := repository.Path()
+		if err != nil {
+			return nil, fmt.Errorf("repository path: %w", err)
+		}
+
+		success, cleanup, err := mgr.housekeepingManager.AddPackRefsInhibitor(ctx, repositoryPath)
 		if !success {
 			return nil, fmt.Errorf("add pack-refs inhibitor: %w", err)
 		}
@@ -1363,12 +1530,12 @@ func (mgr *TransactionManager) prepareReferenceTransaction(ctx context.Context,
 		// We ask housekeeping to cleanup stale reference locks. We don't add a grace period, because
 		// transaction manager is the only process which writes into the repository, so it is safe
 		// to delete these locks.
-		if err := mgr.housekeepingManager.CleanStaleData(ctx, log.FromContext(ctx), mgr.repository, housekeeping.OnlyStaleReferenceLockCleanup(0)); err != nil {
+		if err := mgr.housekeepingManager.CleanStaleData(ctx, log.FromContext(ctx), mgr.repositoryFactory.Build(repository.GetRelativePath()), housekeeping.OnlyStaleReferenceLockCleanup(0)); err != nil {
 			return nil, fmt.Errorf("running reflock cleanup: %w", err)
 		}
 
 		// Remove possible locks and temporary files covering `packed-refs`.
-		if err := mgr.removePackedRefsLocks(mgr.ctx); err != nil {
+		if err := mgr.removePackedRefsLocks(mgr.ctx, repositoryPath); err != nil {
 			return nil, fmt.Errorf("remove stale packed-refs locks: %w", err)
 		}
 
@@ -1393,11 +1560,11 @@ func (mgr *TransactionManager) appendLogEntry(nextLogIndex LogIndex, logEntry *g
 
 	mgr.mutex.Lock()
 	mgr.appendedLogIndex = nextLogIndex
-	if logEntry.CustomHooksUpdate != nil {
+	if logEntry.CustomHooksUpdate != nil && logEntry.RelativePath == mgr.relativePath {
 		mgr.customHookIndex = nextLogIndex
 	}
 	mgr.applyNotifications[nextLogIndex] = make(chan struct{})
-	if logEntry.RepositoryDeletion != nil {
+	if logEntry.RepositoryDeletion != nil && logEntry.RelativePath == mgr.relativePath {
 		mgr.repositoryExists = false
 		mgr.customHookIndex = 0
 	}
@@ -1413,29 +1580,44 @@ func (mgr *TransactionManager) applyLogEntry(ctx context.Context, logIndex LogIn
 		return fmt.Errorf("read log entry: %w", err)
 	}
 
+	repository, repositoryPath := mgr.logEntryRepository(logEntry)
+
+	// The history is recorded prior to applying any of the changes as it records the state they replace.
+	// Deletions are recorded as well so the history of a deleted repository isn't mixed with the history
+	// of a repository later created in its place.
+	if err := mgr.recordHistory(ctx, logIndex, repository, logEntry); err != nil {
+		return fmt.Errorf("record history: %w", err)
+	}
+
+	// The reference updates to the other repositories are applied regardless of whether the transaction's
+	// own repository is being deleted as the deletion doesn't affect them.
+	if err := mgr.applyPartitionReferenceUpdates(ctx, logEntry.PartitionReferenceUpdates); err != nil {
+		return fmt.Errorf("apply partition reference updates: %w", err)
+	}
+
 	if logEntry.RepositoryDeletion != nil {
 		// If the repository is being deleted, just delete it without any other changes given
 		// they'd all be removed anyway. Reapplying the other changes after a crash would also
 		// not work if the repository was successfully deleted before the crash.
-		if err := mgr.applyRepositoryDeletion(ctx, logIndex); err != nil {
+		if err := mgr.applyRepositoryDeletion(ctx, logIndex, repositoryPath); err != nil {
 			return fmt.Errorf("apply repository deletion: %w", err)
 		}
 	} else {
 		if logEntry.PackPrefix != "" {
-			if err := mgr.applyPackFile(ctx, logEntry.PackPrefix, logIndex); err != nil {
+			if err := mgr.applyPackFile(ctx, repositoryPath, logEntry.PackPrefix, logIndex); err != nil {
 				return fmt.Errorf("apply pack file: %w", err)
 			}
 		}
 
-		if err := mgr.applyReferenceUpdates(ctx, logEntry.ReferenceUpdates); err != nil {
+		if err := mgr.applyReferenceUpdates(ctx, repository, logEntry.ReferenceUpdates); err != nil {
 			return fmt.Errorf("apply reference updates: %w", err)
 		}
 
-		if err := mgr.applyDefaultBranchUpdate(ctx, logEntry.DefaultBranchUpdate); err != nil {
+		if err := mgr.applyDefaultBranchUpdate(ctx, repository, logEntry.DefaultBranchUpdate); err != nil {
 			return fmt.Errorf("writing default branch: %w", err)
 		}
 
-		if err := mgr.applyCustomHooks(ctx, logIndex, logEntry.CustomHooksUpdate); err != nil {
+		if err := mgr.applyCustomHooks(ctx, logIndex, repositoryPath, logEntry.RelativePath == mgr.relativePath, logEntry.CustomHooksUpdate); err != nil {
 			return fmt.Errorf("apply custom hooks: %w", err)
 		}
 	}
@@ -1448,6 +1630,10 @@ func (mgr *TransactionManager) applyLogEntry(ctx context.Context, logIndex LogIn
 		return fmt.Errorf("deleting log entry: %w", err)
 	}
 
+	if err := mgr.pruneHistory(logIndex, logEntry.RepositoryDeletion != nil && logEntry.RelativePath == mgr.relativePath); err != nil {
+		return fmt.Errorf("prune history: %w", err)
+	}
+
 	mgr.appliedLogIndex = logIndex
 
 	// Notify the transactions waiting for this log entry to be applied prior to beginning.
@@ -1462,6 +1648,10 @@ func (mgr *TransactionManager) applyLogEntry(ctx context.Context, logIndex LogIn
 	delete(mgr.applyNotifications, logIndex)
 	close(notificationCh)
 
+	if mgr.notifyApplied != nil {
+		mgr.notifyApplied()
+	}
+
 	// There is no awaiter for a transaction if the transaction manager is recovering
 	// transactions from the log after starting up.
 	if resultChan, ok := mgr.awaitingTransactions[logIndex]; ok {
@@ -1472,13 +1662,23 @@ func (mgr *TransactionManager) applyLogEntry(ctx context.Context, logIndex LogIn
 	return nil
 }
 
+// logEntryRepository returns the repository and its absolute path the repository-scoped changes of the
+// log entry apply to.
+func (mgr *TransactionManager) logEntryRepository(logEntry *gitalypb.LogEntry) (*localrepo.Repo, string) {
+	if logEntry.RelativePath == mgr.relativePath {
+		return mgr.repository, mgr.repositoryPath
+	}
+
+	return mgr.repositoryFactory.Build(logEntry.RelativePath), filepath.Join(mgr.storagePath, logEntry.RelativePath)
+}
+
 // applyReferenceUpdates applies the applies the given reference updates to the repository.
-func (mgr *TransactionManager) applyReferenceUpdates(ctx context.Context, updates []*gitalypb.LogEntry_ReferenceUpdate) error {
+func (mgr *TransactionManager) applyReferenceUpdates(ctx context.Context, repository *localrepo.Repo, updates []*gitalypb.LogEntry_ReferenceUpdate) error {
 	if len(updates) == 0 {
 		return nil
 	}
 
-	updater, err := mgr.prepareReferenceTransaction(ctx, updates, mgr.repository)
+	updater, err := mgr.prepareReferenceTransaction(ctx, updates, repository)
 	if err != nil {
 		return fmt.Errorf("prepare reference transaction: %w", err)
 	}
@@ -1490,6 +1690,28 @@ func (mgr *TransactionManager) applyReferenceUpdates(ctx context.Context, update
 	return nil
 }
 
+// applyPartitionReferenceUpdates applies the reference updates to the other repositories of the partition.
+func (mgr *TransactionManager) applyPartitionReferenceUpdates(ctx context.Context, repositoryUpdates []*gitalypb.LogEntry_RepositoryReferenceUpdates) error {
+	for _, repositoryUpdate := range repositoryUpdates {
+		repository := mgr.repositoryFactory.Build(repositoryUpdate.RelativePath)
+		if _, err := repository.Path(); err != nil {
+			if errors.Is(err, storage.ErrRepositoryNotFound) {
+				// The repository was verified to exist when the log entry was committed. If it doesn't exist
+				// anymore, it has been deleted since and there are no references left to update.
+				continue
+			}
+
+			return fmt.Errorf("repository path: %w", err)
+		}
+
+		if err := mgr.applyReferenceUpdates(ctx, repository, repositoryUpdate.ReferenceUpdates); err != nil {
+			return fmt.Errorf("apply reference updates to %q: %w", repositoryUpdate.RelativePath, err)
+		}
+	}
+
+	return nil
+}
+
 // applyRepositoryDeletion deletes the repository.
 //
 // Given how the repositories are laid out in the storage, we currently can't support MVCC for them.
@@ -1497,7 +1719,7 @@ func (mgr *TransactionManager) applyReferenceUpdates(ctx context.Context, update
 // of the readers to finish before we can delete the repository as otherwise the readers could fail in
 // unexpected ways and it would be an isolation violation. Repository deletions thus block before all
 // transaction with an older read snapshot are done with the repository.
-func (mgr *TransactionManager) applyRepositoryDeletion(ctx context.Context, index LogIndex) error {
+func (mgr *TransactionManager) applyRepositoryDeletion(ctx context.Context, index LogIndex, repositoryPath string) error {
 	for {
 		mgr.mutex.Lock()
 		oldestElement := mgr.openTransactions.Front()
@@ -1546,11 +1768,11 @@ func (mgr *TransactionManager) applyRepositoryDeletion(ctx context.Context, inde
 		}
 	}
 
-	if err := os.RemoveAll(mgr.repositoryPath); err != nil {
+	if err := os.RemoveAll(repositoryPath); err != nil {
 		return fmt.Errorf("remove repository: %w", err)
 	}
 
-	if err := safe.NewSyncer().Sync(filepath.Dir(mgr.repositoryPath)); err != nil {
+	if err := safe.NewSyncer().Sync(filepath.Dir(repositoryPath)); err != nil {
 		return fmt.Errorf("sync: %w", err)
 	}
 
@@ -1560,8 +1782,8 @@ func (mgr *TransactionManager) applyRepositoryDeletion(ctx context.Context, inde
 // applyPackFile unpacks the objects from the pack file into the repository if the log entry
 // has an associated pack file. This is done by hard linking the pack and index from the
 // log into the repository's object directory.
-func (mgr *TransactionManager) applyPackFile(ctx context.Context, packPrefix string, logIndex LogIndex) error {
-	packDirectory := filepath.Join(mgr.repositoryPath, "objects", "pack")
+func (mgr *TransactionManager) applyPackFile(ctx context.Context, repositoryPath, packPrefix string, logIndex LogIndex) error {
+	packDirectory := filepath.Join(repositoryPath, "objects", "pack")
 	for _, fileExtension := range []string{
 		".pack",
 		".idx",
@@ -1595,21 +1817,14 @@ func (mgr *TransactionManager) applyPackFile(ctx context.Context, packPrefix str
 // The hooks are also extracted at `<repo>/custom_hooks`. This is done for backwards compatibility, as we want
 // the hooks to be present even if the WAL logic is disabled. This ensures we don't lose data if we have to
 // disable the WAL logic after rollout.
-func (mgr *TransactionManager) applyCustomHooks(ctx context.Context, logIndex LogIndex, update *gitalypb.LogEntry_CustomHooksUpdate) error {
+//
+// The WAL only stores the custom hooks of the repository the TransactionManager was started for. The hooks of the
+// other repositories in the partition are only extracted at `<repo>/custom_hooks`.
+func (mgr *TransactionManager) applyCustomHooks(ctx context.Context, logIndex LogIndex, repositoryPath string, storeInWAL bool, update *gitalypb.LogEntry_CustomHooksUpdate) error {
 	if update == nil {
 		return nil
 	}
 
-	targetDirectory := customHookPathForLogIndex(mgr.stateDirectory, logIndex)
-	if err := os.Mkdir(targetDirectory, fs.ModePerm); err != nil {
-		// The target directory may exist if we previously tried to extract the
-		// custom hooks there. TAR overwrites existing files and the custom hooks
-		// files are guaranteed to be the same as this is the same log entry.
-		if !errors.Is(err, fs.ErrExist) {
-			return fmt.Errorf("create directory: %w", err)
-		}
-	}
-
 	syncer := safe.NewSyncer()
 	extractHooks := func(destinationDir string) error {
 		if err := repoutil.ExtractHooks(ctx, bytes.NewReader(update.CustomHooksTar), destinationDir, true); err != nil {
@@ -1624,13 +1839,25 @@ func (mgr *TransactionManager) applyCustomHooks(ctx context.Context, logIndex Lo
 		return nil
 	}
 
-	if err := extractHooks(targetDirectory); err != nil {
-		return fmt.Errorf("extract hooks: %w", err)
-	}
+	if storeInWAL {
+		targetDirectory := customHookPathForLogIndex(mgr.stateDirectory, logIndex)
+		if err := os.Mkdir(targetDirectory, fs.ModePerm); err != nil {
+			// The target directory may exist if we previously tried to extract the
+			// custom hooks there. TAR overwrites existing files and the custom hooks
+			// files are guaranteed to be the same as this is the same log entry.
+			if !errors.Is(err, fs.ErrExist) {
+				return fmt.Errorf("create directory: %w", err)
+			}
+		}
+
+		if err := extractHooks(targetDirectory); err != nil {
+			return fmt.Errorf("extract hooks: %w", err)
+		}
 
-	// Sync the parent directory as well.
-	if err := syncer.SyncParent(targetDirectory); err != nil {
-		return fmt.Errorf("sync hook directory: %w", err)
+		// Sync the parent directory as well.
+		if err := syncer.SyncParent(targetDirectory); err != nil {
+			return fmt.Errorf("sync hook directory: %w", err)
+		}
 	}
 
 	// Extract another copy that we can move to `<repo>/custom_hooks` where the hooks exist without the WAL enabled.
@@ -1645,7 +1872,7 @@ func (mgr *TransactionManager) applyCustomHooks(ctx context.Context, logIndex Lo
 		return fmt.Errorf("extract legacy hooks: %w", err)
 	}
 
-	legacyHooksPath := filepath.Join(mgr.repositoryPath, repoutil.CustomHooksDir)
+	legacyHooksPath := filepath.Join(repositoryPath, repoutil.CustomHooksDir)
 	// The hooks are lost if we perform this removal but fail to perform the remaining operations and the
 	// WAL is disabled before succeeding. This is an existing issue already with SetCustomHooks RPC.
 	if err := os.RemoveAll(legacyHooksPath); err != nil {
@@ -1671,13 +1898,13 @@ func customHookPathForLogIndex(stateDir string, logIndex LogIndex) string {
 
 // deleteLogEntry deletes the log entry at the given index from the log.
 func (mgr *TransactionManager) deleteLogEntry(index LogIndex) error {
-	return mgr.deleteKey(keyLogEntry(mgr.relativePath, index))
+	return mgr.deleteKey(keyLogEntry(mgr.partitionID, index))
 }
 
 // readLogEntry returns the log entry from the given position in the log.
 func (mgr *TransactionManager) readLogEntry(index LogIndex) (*gitalypb.LogEntry, error) {
 	var logEntry gitalypb.LogEntry
-	key := keyLogEntry(mgr.relativePath, index)
+	key := keyLogEntry(mgr.partitionID, index)
 
 	if err := mgr.readKey(key, &logEntry); err != nil {
 		return nil, fmt.Errorf("read key: %w", err)
@@ -1688,12 +1915,12 @@ func (mgr *TransactionManager) readLogEntry(index LogIndex) (*gitalypb.LogEntry,
 
 // storeLogEntry stores the log entry in the repository's write-ahead log at the given index.
 func (mgr *TransactionManager) storeLogEntry(index LogIndex, entry *gitalypb.LogEntry) error {
-	return mgr.setKey(keyLogEntry(mgr.relativePath, index), entry)
+	return mgr.setKey(keyLogEntry(mgr.partitionID, index), entry)
 }
 
 // storeAppliedLogIndex stores the repository's applied log index in the database.
 func (mgr *TransactionManager) storeAppliedLogIndex(index LogIndex) error {
-	return mgr.setKey(keyAppliedLogIndex(mgr.relativePath), index.toProto())
+	return mgr.setKey(keyAppliedLogIndex(mgr.partitionID), index.toProto())
 }
 
 // setKey marshals and stores a given protocol buffer message into the database under the given key.
@@ -1716,7 +1943,7 @@ func (mgr *TransactionManager) setKey(key []byte, value proto.Message) error {
 // readKey reads a key from the database and unmarshals its value in to the destination protocol
 // buffer message.
 func (mgr *TransactionManager) readKey(key []byte, destination proto.Message) error {
-	return mgr.db.View(func(txn databaseTransaction) error {
+	return mgr.db.View(func(txn DatabaseTransaction) error {
 		item, err := txn.Get(key)
 		if err != nil {
 			return fmt.Errorf("get: %w", err)
@@ -1728,7 +1955,7 @@ func (mgr *TransactionManager) readKey(key []byte, destination proto.Message) er
 
 // deleteKey deletes a key from the database.
 func (mgr *TransactionManager) deleteKey(key []byte) error {
-	return mgr.db.Update(func(txn databaseTransaction) error {
+	return mgr.db.Update(func(txn DatabaseTransaction) error {
 		if err := txn.Delete(key); err != nil {
 			return fmt.Errorf("delete: %w", err)
 		}
@@ -1738,18 +1965,18 @@ func (mgr *TransactionManager) deleteKey(key []byte) error {
 }
 
 // keyAppliedLogIndex returns the database key storing a repository's last applied log entry's index.
-func keyAppliedLogIndex(repositoryID string) []byte {
-	return []byte(fmt.Sprintf("repository/%s/log/index/applied", repositoryID))
+func keyAppliedLogIndex(ptnID partitionID) []byte {
+	return []byte(fmt.Sprintf("partition/%s/log/index/applied", ptnID.MarshalBinary()))
 }
 
-// keyLogEntry returns the database key storing a repository's log entry at a given index.
-func keyLogEntry(repositoryID string, index LogIndex) []byte {
+// keyLogEntry returns the database key storing a partition's log entry at a given index.
+func keyLogEntry(ptnID partitionID, index LogIndex) []byte {
 	marshaledIndex := make([]byte, binary.Size(index))
 	binary.BigEndian.PutUint64(marshaledIndex, uint64(index))
-	return []byte(fmt.Sprintf("%s%s", keyPrefixLogEntries(repositoryID), marshaledIndex))
+	return []byte(fmt.Sprintf("%s%s", keyPrefixLogEntries(ptnID), marshaledIndex))
 }
 
-// keyPrefixLogEntries returns the key prefix holding repository's write-ahead log entries.
-func keyPrefixLogEntries(repositoryID string) []byte {
-	return []byte(fmt.Sprintf("repository/%s/log/entry/", repositoryID))
+// keyPrefixLogEntries returns the key prefix holding partition's write-ahead log entries.
+func keyPrefixLogEntries(ptnID partitionID) []byte {
+	return []byte(fmt.Sprintf("partition/%s/log/entry/", ptnID.MarshalBinary()))
 }
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/dgraph-io/badger/v4"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +18,7 @@ type hookContext struct {
 	// closeManager calls the calls Close on the TransactionManager.
 	closeManager func()
 	// database provides access to the database for the hook handler.
-	database *badger.DB
+	database Database
 	tb       testing.TB
 }
 
@@ -40,7 +39,7 @@ type hooks struct {
 }
 
 // installHooks installs the configured hooks into the transactionManager.
-func installHooks(tb testing.TB, transactionManager *TransactionManager, database *badger.DB, hooks hooks) {
+func installHooks(tb testing.TB, transactionManager *TransactionManager, database Database, hooks hooks) {
 	hookContext := hookContext{closeManager: transactionManager.close, database: database, tb: &testingHook{TB: tb}}
 
 	transactionManager.close = func() {
@@ -60,81 +59,81 @@ func installHooks(tb testing.TB, transactionManager *TransactionManager, databas
 	}
 
 	transactionManager.db = databaseHook{
-		database:    newDatabaseAdapter(database),
+		Database:    database,
 		hooks:       hooks,
 		hookContext: hookContext,
 	}
 }
 
 type databaseHook struct {
-	database
+	Database
 	hookContext
 	hooks
 }
 
-func (hook databaseHook) View(handler func(databaseTransaction) error) error {
-	return hook.database.View(func(transaction databaseTransaction) error {
-		return handler(databaseTransactionHook{
-			databaseTransaction: transaction,
+func (hook databaseHook) View(handler func(DatabaseTransaction) error) error {
+	return hook.Database.View(func(transaction DatabaseTransaction) error {
+		return handler(DatabaseTransactionHook{
+			DatabaseTransaction: transaction,
 			hookContext:         hook.hookContext,
 			hooks:               hook.hooks,
 		})
 	})
 }
 
-func (hook databaseHook) Update(handler func(databaseTransaction) error) error {
-	return hook.database.Update(func(transaction databaseTransaction) error {
-		return handler(databaseTransactionHook{
-			databaseTransaction: transaction,
+func (hook databaseHook) Update(handler func(DatabaseTransaction) error) error {
+	return hook.Database.Update(func(transaction DatabaseTransaction) error {
+		return handler(DatabaseTransactionHook{
+			DatabaseTransaction: transaction,
 			hookContext:         hook.hookContext,
 			hooks:               hook.hooks,
 		})
 	})
 }
 
-func (hook databaseHook) NewWriteBatch() writeBatch {
+func (hook databaseHook) NewWriteBatch() WriteBatch {
 	return writeBatchHook{
-		writeBatch:  hook.database.NewWriteBatch(),
+		WriteBatch:  hook.Database.NewWriteBatch(),
 		hookContext: hook.hookContext,
 		hooks:       hook.hooks,
 	}
 }
 
-type databaseTransactionHook struct {
-	databaseTransaction
+type DatabaseTransactionHook struct {
+	DatabaseTransaction
 	hookContext
 	hooks
 }
 
 var (
-	regexLogEntry = regexp.MustCompile("repository/.+/log/entry/")
-	regexLogIndex = regexp.MustCompile("repository/.+/log/index/applied")
+	regexLogEntry = regexp.MustCompile("(?s)partition/.+/log/entry/")
+	regexLogIndex = regexp.MustCompile("(?s)partition/.+/log/index/applied")
 )
 
-func (hook databaseTransactionHook) Get(key []byte) (*badger.Item, error) {
+func (hook DatabaseTransactionHook) Get(key []byte) (Item, error) {
 	if regexLogEntry.Match(key) {
 		if hook.hooks.beforeReadLogEntry != nil {
 			hook.hooks.beforeReadLogEntry(hook.hookContext)
 		}
 	}
 
-	return hook.databaseTransaction.Get(key)
+	return hook.DatabaseTransaction.Get(key)
 }
 
-func (hook databaseTransactionHook) NewIterator(options badger.IteratorOptions) *badger.Iterator {
-	return hook.databaseTransaction.NewIterator(options)
+func (hook DatabaseTransactionHook) NewIterator(options IteratorOptions) Iterator {
+	return hook.DatabaseTransaction.NewIterator(options)
 }
 
-func (hook databaseTransactionHook) Delete(key []byte) error {
+func (hook DatabaseTransactionHook) Delete(key []byte) error {
 	if regexLogEntry.Match(key) && hook.beforeDeleteLogEntry != nil {
 		hook.beforeDeleteLogEntry(hook.hookContext)
 	}
 
-	return hook.databaseTransaction.Delete(key)
+	return hook.DatabaseTransaction.Delete(key)
 }
 
 type writeBatchHook struct {
-	writeBatch
+	WriteBatch
 	hookContext
 	hooks
 }
@@ -148,12 +147,12 @@ func (hook writeBatchHook) Set(key []byte, value []byte) error {
 		hook.hooks.beforeStoreLogEntry(hook.hookContext)
 	}
 
-	return hook.writeBatch.Set(key, value)
+	return hook.WriteBatch.Set(key, value)
 }
 
-func (hook writeBatchHook) Flush() error { return hook.writeBatch.Flush() }
+func (hook writeBatchHook) Flush() error { return hook.WriteBatch.Flush() }
 
-func (hook writeBatchHook) Cancel() { hook.writeBatch.Cancel() }
+func (hook writeBatchHook) Cancel() { hook.WriteBatch.Cancel() }
 
 type testingHook struct {
 	testing.TB
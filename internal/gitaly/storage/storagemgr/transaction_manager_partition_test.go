@@ -0,0 +1,173 @@
+package storagemgr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/backchannel"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+)
+
+func TestTransactionManager_partitionReferenceUpdates(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	otherRepo, otherRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+	otherCommitID := gittest.WriteCommit(t, cfg, otherRepoPath, gittest.WithParents())
+
+	cmdFactory := gittest.NewCommandFactory(t, cfg)
+	cache := catfile.NewCache(cfg)
+	defer cache.Stop()
+
+	database, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, database)
+
+	txManager := transaction.NewManager(cfg, backchannel.NewRegistry())
+	housekeepingManager := housekeeping.NewManager(cfg.Prometheus, txManager)
+
+	repositoryFactory, err := localrepo.NewFactory(
+		config.NewLocator(cfg), cmdFactory, cache,
+	).ScopeByStorage(cfg.Storages[0].Name)
+	require.NoError(t, err)
+
+	objectHash, err := repositoryFactory.Build(repo.RelativePath).ObjectHash(ctx)
+	require.NoError(t, err)
+
+	manager := NewTransactionManager(testPartitionID, database, cfg.Storages[0].Path, repo.RelativePath, t.TempDir(), t.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
+
+	managerErr := make(chan error)
+	go func() { managerErr <- manager.Run() }()
+	defer func() {
+		manager.Close()
+		assert.NoError(t, <-managerErr)
+	}()
+
+	requireReferences := func(t *testing.T, repoPath string, expected []git.Reference) {
+		t.Helper()
+		require.Equal(t, expected, gittest.GetReferences(t, cfg, repoPath))
+	}
+
+	t.Run("additional repository is the transaction's repository", func(t *testing.T) {
+		_, err := manager.Begin(ctx, TransactionOptions{AdditionalRepositories: []string{repo.RelativePath}})
+		require.EqualError(t, err, `additional repository is the transaction's repository: "`+repo.RelativePath+`"`)
+	})
+
+	t.Run("read-only transaction", func(t *testing.T) {
+		transaction, err := manager.Begin(ctx, TransactionOptions{
+			ReadOnly:               true,
+			AdditionalRepositories: []string{otherRepo.RelativePath},
+		})
+		require.NoError(t, err)
+
+		transaction.UpdateRepositoryReferences(otherRepo.RelativePath, ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: otherCommitID},
+		})
+		require.Equal(t, errReadOnlyPartitionReferenceUpdates, transaction.Commit(ctx))
+	})
+
+	t.Run("repository not included in the transaction", func(t *testing.T) {
+		transaction, err := manager.Begin(ctx, TransactionOptions{})
+		require.NoError(t, err)
+
+		transaction.UpdateReferences(ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: commitID},
+		})
+		transaction.UpdateRepositoryReferences(otherRepo.RelativePath, ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: otherCommitID},
+		})
+		require.ErrorIs(t, transaction.Commit(ctx), ErrRepositoryNotInTransaction)
+
+		requireReferences(t, repoPath, nil)
+		requireReferences(t, otherRepoPath, nil)
+	})
+
+	t.Run("additional repository doesn't exist", func(t *testing.T) {
+		transaction, err := manager.Begin(ctx, TransactionOptions{AdditionalRepositories: []string{"non-existent"}})
+		require.NoError(t, err)
+
+		transaction.UpdateRepositoryReferences("non-existent", ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: otherCommitID},
+		})
+		require.ErrorIs(t, transaction.Commit(ctx), ErrRepositoryNotFound)
+	})
+
+	t.Run("verification failure in additional repository aborts the transaction", func(t *testing.T) {
+		transaction, err := manager.Begin(ctx, TransactionOptions{AdditionalRepositories: []string{otherRepo.RelativePath}})
+		require.NoError(t, err)
+
+		transaction.UpdateReferences(ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: commitID},
+		})
+		transaction.UpdateRepositoryReferences(otherRepo.RelativePath, ReferenceUpdates{
+			"refs/heads/main": {OldOID: otherCommitID, NewOID: objectHash.ZeroOID},
+		})
+
+		var verificationErr ReferenceVerificationError
+		require.ErrorAs(t, transaction.Commit(ctx), &verificationErr)
+		require.Equal(t, ReferenceVerificationError{
+			ReferenceName: "refs/heads/main",
+			ExpectedOID:   otherCommitID,
+			ActualOID:     objectHash.ZeroOID,
+		}, verificationErr)
+
+		requireReferences(t, repoPath, nil)
+		requireReferences(t, otherRepoPath, nil)
+	})
+
+	t.Run("references updated in both repositories", func(t *testing.T) {
+		transaction, err := manager.Begin(ctx, TransactionOptions{AdditionalRepositories: []string{otherRepo.RelativePath}})
+		require.NoError(t, err)
+
+		transaction.UpdateReferences(ReferenceUpdates{
+			"refs/heads/main": {OldOID: objectHash.ZeroOID, NewOID: commitID},
+		})
+		transaction.UpdateRepositoryReferences(otherRepo.RelativePath, ReferenceUpdates{
+			"refs/heads/main":    {OldOID: objectHash.ZeroOID, NewOID: otherCommitID},
+			"refs/heads/feature": {OldOID: objectHash.ZeroOID, NewOID: otherCommitID},
+		})
+		require.NoError(t, transaction.Commit(ctx))
+
+		requireReferences(t, repoPath, []git.Reference{
+			git.NewReference("refs/heads/main", commitID),
+		})
+		requireReferences(t, otherRepoPath, []git.Reference{
+			git.NewReference("refs/heads/feature", otherCommitID),
+			git.NewReference("refs/heads/main", otherCommitID),
+		})
+	})
+
+	t.Run("skipped verification failures in additional repository", func(t *testing.T) {
+		transaction, err := manager.Begin(ctx, TransactionOptions{AdditionalRepositories: []string{otherRepo.RelativePath}})
+		require.NoError(t, err)
+
+		transaction.SkipVerificationFailures()
+		transaction.UpdateRepositoryReferences(otherRepo.RelativePath, ReferenceUpdates{
+			"refs/heads/main":    {OldOID: objectHash.ZeroOID, NewOID: objectHash.ZeroOID},
+			"refs/heads/feature": {OldOID: otherCommitID, NewOID: objectHash.ZeroOID},
+		})
+		require.NoError(t, transaction.Commit(ctx))
+
+		requireReferences(t, otherRepoPath, []git.Reference{
+			git.NewReference("refs/heads/main", otherCommitID),
+		})
+	})
+}
@@ -438,7 +438,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -459,7 +459,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -485,7 +485,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -536,7 +536,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 			},
 		},
@@ -583,7 +583,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 			},
 		},
@@ -619,7 +619,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					References: []git.Reference{{Name: "refs/heads/parent", Target: setup.Commits.First.OID.String()}},
@@ -694,7 +694,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					References: []git.Reference{{Name: "refs/heads/parent/child", Target: setup.Commits.First.OID.String()}},
@@ -750,7 +750,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					References: []git.Reference{{Name: "refs/tags/v1.0.0", Target: setup.ObjectHash.EmptyTreeOID.String()}},
@@ -803,7 +803,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -848,7 +848,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -889,7 +889,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -925,7 +925,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -964,7 +964,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1010,7 +1010,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1066,7 +1066,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1102,7 +1102,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 			},
 		},
@@ -1126,7 +1126,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 			},
 		},
@@ -1165,7 +1165,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					References: []git.Reference{
@@ -1209,7 +1209,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					References: []git.Reference{
@@ -1252,7 +1252,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1295,7 +1295,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1336,7 +1336,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 			},
 		},
@@ -1367,7 +1367,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":        {Mode: fs.ModeDir | perm.PrivateDir},
@@ -1435,7 +1435,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":        {Mode: fs.ModeDir | perm.PrivateDir},
@@ -1520,7 +1520,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":        {Mode: fs.ModeDir | perm.PrivateDir},
@@ -1572,7 +1572,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1611,7 +1611,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1651,7 +1651,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1699,7 +1699,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1744,7 +1744,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1794,7 +1794,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -1854,7 +1854,7 @@ func TestTransactionManager(t *testing.T) {
 				},
 				expectedState: StateAssertion{
 					Database: DatabaseState{
-						string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+						string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 					},
 					Repository: RepositoryState{
 						DefaultBranch: "refs/heads/main",
@@ -1902,7 +1902,8 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyLogEntry(relativePath, 1)): &gitalypb.LogEntry{
+					string(keyLogEntry(testPartitionID, 1)): &gitalypb.LogEntry{
+						RelativePath: relativePath,
 						ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
 							{
 								ReferenceName: []byte("refs/heads/main"),
@@ -1942,7 +1943,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/branch2",
@@ -1985,7 +1986,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/branch2",
@@ -2030,7 +2031,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/non-existent",
@@ -2083,7 +2084,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/branch2",
@@ -2125,7 +2126,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/branch2",
@@ -2180,7 +2181,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/branch2",
@@ -2342,7 +2343,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(3).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(3).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":        {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2399,7 +2400,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2500,7 +2501,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(3).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(3).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2572,7 +2573,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2656,7 +2657,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2701,7 +2702,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":      {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2730,7 +2731,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2774,7 +2775,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2856,7 +2857,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -2934,7 +2935,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Directory: testhelper.DirectoryState{
 					"/":                  {Mode: fs.ModeDir | perm.PrivateDir},
@@ -3006,7 +3007,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3034,7 +3035,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3062,7 +3063,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3092,7 +3093,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3122,7 +3123,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3170,7 +3171,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3218,7 +3219,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3280,7 +3281,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3321,7 +3322,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					NotFound: true,
@@ -3367,7 +3368,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -3403,7 +3404,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 				Repository: RepositoryState{
 					DefaultBranch: "refs/heads/main",
@@ -3439,7 +3440,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(2).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(2).toProto(),
 				},
 			},
 		},
@@ -3466,7 +3467,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 			},
 		},
@@ -3482,7 +3483,7 @@ func TestTransactionManager(t *testing.T) {
 			},
 			expectedState: StateAssertion{
 				Database: DatabaseState{
-					string(keyAppliedLogIndex(relativePath)): LogIndex(1).toProto(),
+					string(keyAppliedLogIndex(testPartitionID)): LogIndex(1).toProto(),
 				},
 			},
 		},
@@ -3684,7 +3685,7 @@ func TestTransactionManager(t *testing.T) {
 			repoPath, err := repo.Path()
 			require.NoError(t, err)
 
-			database, err := OpenDatabase(testhelper.SharedLogger(t), t.TempDir())
+			database, err := openTestDatabase(testhelper.SharedLogger(t), t.TempDir())
 			require.NoError(t, err)
 			defer testhelper.MustClose(t, database)
 
@@ -3700,7 +3701,7 @@ func TestTransactionManager(t *testing.T) {
 				// managerRunning tracks whether the manager is running or closed.
 				managerRunning bool
 				// transactionManager is the current TransactionManager instance.
-				transactionManager = NewTransactionManager(database, storagePath, relativePath, stateDir, stagingDir, setup.CommandFactory, housekeepingManager, storageScopedFactory)
+				transactionManager = NewTransactionManager(testPartitionID, database, storagePath, relativePath, stateDir, stagingDir, setup.CommandFactory, housekeepingManager, storageScopedFactory)
 				// managerErr is used for synchronizing manager closing and returning
 				// the error from Run.
 				managerErr chan error
@@ -3747,7 +3748,7 @@ func TestTransactionManager(t *testing.T) {
 					require.NoError(t, os.RemoveAll(stagingDir))
 					require.NoError(t, os.Mkdir(stagingDir, perm.PrivateDir))
 
-					transactionManager = NewTransactionManager(database, storagePath, relativePath, stateDir, stagingDir, setup.CommandFactory, housekeepingManager, storageScopedFactory)
+					transactionManager = NewTransactionManager(testPartitionID, database, storagePath, relativePath, stateDir, stagingDir, setup.CommandFactory, housekeepingManager, storageScopedFactory)
 					installHooks(t, transactionManager, database, hooks{
 						beforeReadLogEntry:  step.Hooks.BeforeApplyLogEntry,
 						beforeStoreLogEntry: step.Hooks.BeforeAppendLogEntry,
@@ -3971,6 +3972,7 @@ func checkManagerError(t *testing.T, ctx context.Context, managerErrChannel chan
 	t.Helper()
 
 	testTransaction := &Transaction{
+		relativePath:     mgr.relativePath,
 		referenceUpdates: ReferenceUpdates{"sentinel": {}},
 		result:           make(chan error, 1),
 		finish:           func() error { return nil },
@@ -4079,7 +4081,7 @@ func BenchmarkTransactionManager(b *testing.B) {
 			cache := catfile.NewCache(cfg)
 			defer cache.Stop()
 
-			database, err := OpenDatabase(testhelper.SharedLogger(b), b.TempDir())
+			database, err := openTestDatabase(testhelper.SharedLogger(b), b.TempDir())
 			require.NoError(b, err)
 			defer testhelper.MustClose(b, database)
 
@@ -4127,7 +4129,7 @@ func BenchmarkTransactionManager(b *testing.B) {
 				commit1 = gittest.WriteCommit(b, cfg, repoPath, gittest.WithParents())
 				commit2 = gittest.WriteCommit(b, cfg, repoPath, gittest.WithParents(commit1))
 
-				manager := NewTransactionManager(database, cfg.Storages[0].Path, repo.RelativePath, b.TempDir(), b.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
+				manager := NewTransactionManager(testPartitionID, database, cfg.Storages[0].Path, repo.RelativePath, b.TempDir(), b.TempDir(), cmdFactory, housekeepingManager, repositoryFactory)
 
 				managers = append(managers, manager)
 
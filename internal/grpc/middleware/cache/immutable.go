@@ -0,0 +1,300 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	diskcache "gitlab.com/gitlab-org/gitaly/v16/internal/cache"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/protoregistry"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamImmutableCache serves responses of streaming RPCs whose result is fully determined by their
+// request from the cache. Responses of requests that are not cached yet are stored in the cache. Only
+// server-streaming RPCs annotated with `immutable_result` are considered.
+func StreamImmutableCache(streamer diskcache.ImmutableStreamer, reg *protoregistry.Registry, logger log.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if shouldIgnore(reg, info.FullMethod) || info.IsClientStream {
+			return handler(srv, ss)
+		}
+
+		mInfo, err := reg.LookupMethod(info.FullMethod)
+		if err != nil || !mInfo.ImmutableResult() {
+			return handler(srv, ss)
+		}
+
+		errLogger := methodErrLogger(logger, info.FullMethod)
+
+		// The request needs to be known up front to decide whether the response can be served from the
+		// cache, so we receive it on behalf of the handler and replay it.
+		req := mInfo.NewRequest()
+		stream := &immutableStream{
+			ServerStream: ss,
+			req:          req,
+			recvErr:      ss.RecvMsg(req),
+		}
+		if stream.recvErr != nil {
+			return handler(srv, stream)
+		}
+
+		repo, ok := immutableTarget(mInfo, req, errLogger)
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		ctx := ss.Context()
+
+		cached, err := streamer.GetImmutableStream(ctx, repo, req)
+		switch {
+		case err == nil:
+			defer cached.Close()
+
+			return replayResponses(cached, mInfo, ss.SendMsg)
+		case errors.Is(err, diskcache.ErrReqNotFound):
+		default:
+			errLogger(err)
+		}
+
+		recorder := newResponseRecorder(ctx, streamer, repo, req, errLogger)
+		stream.onSend = recorder.record
+
+		err = handler(srv, stream)
+		recorder.finish(err)
+
+		return err
+	}
+}
+
+// UnaryImmutableCache serves responses of unary RPCs whose result is fully determined by their request
+// from the cache. Responses of requests that are not cached yet are stored in the cache. Only RPCs
+// annotated with `immutable_result` are considered.
+func UnaryImmutableCache(streamer diskcache.ImmutableStreamer, reg *protoregistry.Registry, logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if shouldIgnore(reg, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		mInfo, err := reg.LookupMethod(info.FullMethod)
+		if err != nil || !mInfo.ImmutableResult() {
+			return handler(ctx, req)
+		}
+
+		errLogger := methodErrLogger(logger, info.FullMethod)
+
+		pbReq, ok := req.(proto.Message)
+		if !ok {
+			errLogger(fmt.Errorf("expected protobuf message but got %T", req))
+			return handler(ctx, req)
+		}
+
+		repo, ok := immutableTarget(mInfo, pbReq, errLogger)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		cached, err := streamer.GetImmutableStream(ctx, repo, pbReq)
+		switch {
+		case err == nil:
+			defer cached.Close()
+
+			resp := mInfo.NewResponse()
+			if err := protodelim.UnmarshalFrom(bufio.NewReader(cached), resp); err != nil {
+				// Nothing has been sent yet, so we can still fall back to computing the response.
+				errLogger(fmt.Errorf("reading cached response: %w", err))
+				return handler(ctx, req)
+			}
+
+			return resp, nil
+		case errors.Is(err, diskcache.ErrReqNotFound):
+		default:
+			errLogger(err)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		var encodedResp bytes.Buffer
+		if _, err := protodelim.MarshalTo(&encodedResp, resp.(proto.Message)); err != nil {
+			errLogger(fmt.Errorf("encoding response: %w", err))
+			return resp, nil
+		}
+
+		if err := streamer.PutImmutableStream(ctx, repo, pbReq, &encodedResp); err != nil {
+			errLogger(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// immutableTarget returns the target repository of the request in case its response is fully determined
+// by the request.
+func immutableTarget(mInfo protoregistry.MethodInfo, req proto.Message, errLogger func(error)) (*gitalypb.Repository, bool) {
+	immutable, err := mInfo.ImmutableRequest(req)
+	if err != nil {
+		errLogger(err)
+		return nil, false
+	}
+
+	if !immutable {
+		return nil, false
+	}
+
+	repo, err := mInfo.TargetRepo(req)
+	if err != nil {
+		// Requests without a repository are rejected by the handler anyway.
+		return nil, false
+	}
+
+	return repo, true
+}
+
+// replayResponses sends the length-delimited responses read from the cached stream.
+func replayResponses(cached io.Reader, mInfo protoregistry.MethodInfo, send func(interface{}) error) error {
+	reader := bufio.NewReader(cached)
+
+	for {
+		resp := mInfo.NewResponse()
+		if err := protodelim.UnmarshalFrom(reader, resp); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return structerr.NewInternal("reading cached response: %w", err)
+		}
+
+		if err := send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// immutableStream replays the request that has already been received by the interceptor and passes any
+// sent responses to the onSend callback.
+type immutableStream struct {
+	grpc.ServerStream
+
+	req      proto.Message
+	recvErr  error
+	received bool
+	onSend   func(proto.Message)
+}
+
+// RecvMsg returns the request received by the interceptor on the first invocation.
+func (s *immutableStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return s.ServerStream.RecvMsg(m)
+	}
+	s.received = true
+
+	if s.recvErr != nil {
+		return s.recvErr
+	}
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return fmt.Errorf("expected protobuf message but got %T", m)
+	}
+
+	proto.Merge(msg, s.req)
+	return nil
+}
+
+// SendMsg sends the response and passes it to the onSend callback.
+func (s *immutableStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	if s.onSend != nil {
+		if msg, ok := m.(proto.Message); ok {
+			s.onSend(msg)
+		}
+	}
+
+	return nil
+}
+
+// responseRecorder writes sent responses to the cache. The responses are only persisted in case the
+// RPC succeeds.
+type responseRecorder struct {
+	writer    *io.PipeWriter
+	errLogger func(error)
+	failed    bool
+	wg        sync.WaitGroup
+}
+
+func newResponseRecorder(
+	ctx context.Context,
+	streamer diskcache.ImmutableStreamer,
+	repo *gitalypb.Repository,
+	req proto.Message,
+	errLogger func(error),
+) *responseRecorder {
+	pr, pw := io.Pipe()
+
+	recorder := &responseRecorder{
+		writer:    pw,
+		errLogger: errLogger,
+	}
+
+	recorder.wg.Add(1)
+	go func() {
+		defer recorder.wg.Done()
+
+		if err := streamer.PutImmutableStream(ctx, repo, req, pr); err != nil {
+			// Aborting the recording has already been handled by the recorder.
+			if !errors.Is(err, errRecordingAborted) {
+				errLogger(err)
+			}
+
+			// Discard the remaining responses so that the recorder does not block.
+			_, _ = io.Copy(io.Discard, pr)
+		}
+	}()
+
+	return recorder
+}
+
+// errRecordingAborted aborts storing the responses, either because the RPC has failed or because the
+// responses couldn't be recorded.
+var errRecordingAborted = errors.New("recording aborted")
+
+// record writes the response length-delimited so that it can be replayed.
+func (r *responseRecorder) record(msg proto.Message) {
+	if r.failed {
+		return
+	}
+
+	if _, err := protodelim.MarshalTo(r.writer, msg); err != nil {
+		r.failed = true
+		r.errLogger(fmt.Errorf("recording response: %w", err))
+		_ = r.writer.CloseWithError(errRecordingAborted) // always returns nil
+	}
+}
+
+// finish persists the recorded responses in case the RPC has succeeded and waits for the cache to be
+// written.
+func (r *responseRecorder) finish(rpcErr error) {
+	if !r.failed {
+		if rpcErr != nil {
+			_ = r.writer.CloseWithError(errRecordingAborted) // always returns nil
+		} else {
+			_ = r.writer.Close() // always returns nil
+		}
+	}
+
+	r.wg.Wait()
+}
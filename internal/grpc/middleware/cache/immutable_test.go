@@ -0,0 +1,267 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	diskcache "gitlab.com/gitlab-org/gitaly/v16/internal/cache"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/protoregistry"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestImmutableCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	repo := &gitalypb.Repository{
+		StorageName:  "default",
+		RelativePath: "repo.git",
+	}
+	commitID := strings.Repeat("1", 40)
+
+	setup := func(t *testing.T) (*immutableTestService, *mockImmutableStreamer, *grpc.ClientConn) {
+		streamer := &mockImmutableStreamer{streams: map[string][]byte{}}
+		service := &immutableTestService{}
+
+		server := grpc.NewServer(
+			grpc.StreamInterceptor(StreamImmutableCache(streamer, protoregistry.GitalyProtoPreregistered, testhelper.SharedLogger(t))),
+			grpc.UnaryInterceptor(UnaryImmutableCache(streamer, protoregistry.GitalyProtoPreregistered, testhelper.SharedLogger(t))),
+		)
+		gitalypb.RegisterDiffServiceServer(server, service)
+		gitalypb.RegisterCommitServiceServer(server, service)
+
+		listener, err := net.Listen("tcp", ":0")
+		require.NoError(t, err)
+		go func() {
+			testhelper.MustServe(t, server, listener)
+		}()
+		t.Cleanup(server.Stop)
+
+		conn, err := grpc.DialContext(
+			ctx,
+			listener.Addr().String(),
+			grpc.WithBlock(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { testhelper.MustClose(t, conn) })
+
+		return service, streamer, conn
+	}
+
+	commitDiff := func(t *testing.T, conn *grpc.ClientConn, request *gitalypb.CommitDiffRequest) ([]*gitalypb.CommitDiffResponse, error) {
+		stream, err := gitalypb.NewDiffServiceClient(conn).CommitDiff(ctx, request)
+		require.NoError(t, err)
+
+		var responses []*gitalypb.CommitDiffResponse
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return responses, nil
+				}
+
+				return responses, err
+			}
+
+			responses = append(responses, response)
+		}
+	}
+
+	expectedDiffs := []*gitalypb.CommitDiffResponse{
+		{FromPath: []byte("a"), ToPath: []byte("a")},
+		{FromPath: []byte("b"), ToPath: []byte("b"), EndOfPatch: true},
+	}
+
+	t.Run("streaming RPC with object IDs is cached", func(t *testing.T) {
+		t.Parallel()
+
+		service, streamer, conn := setup(t)
+
+		request := &gitalypb.CommitDiffRequest{Repository: repo, LeftCommitId: commitID, RightCommitId: commitID}
+
+		for i := 0; i < 2; i++ {
+			responses, err := commitDiff(t, conn, request)
+			require.NoError(t, err)
+			testhelper.ProtoEqual(t, expectedDiffs, responses)
+		}
+
+		require.Equal(t, 1, service.invocations())
+		require.Equal(t, 1, streamer.count())
+	})
+
+	t.Run("streaming RPC with revisions is not cached", func(t *testing.T) {
+		t.Parallel()
+
+		service, streamer, conn := setup(t)
+
+		request := &gitalypb.CommitDiffRequest{Repository: repo, LeftCommitId: commitID, RightCommitId: "main"}
+
+		for i := 0; i < 2; i++ {
+			responses, err := commitDiff(t, conn, request)
+			require.NoError(t, err)
+			testhelper.ProtoEqual(t, expectedDiffs, responses)
+		}
+
+		require.Equal(t, 2, service.invocations())
+		require.Zero(t, streamer.count())
+	})
+
+	t.Run("failing streaming RPC is not cached", func(t *testing.T) {
+		t.Parallel()
+
+		service, streamer, conn := setup(t)
+		service.err = structerr.NewNotFound("commit not found")
+
+		request := &gitalypb.CommitDiffRequest{Repository: repo, LeftCommitId: commitID, RightCommitId: commitID}
+
+		for i := 0; i < 2; i++ {
+			_, err := commitDiff(t, conn, request)
+			testhelper.RequireGrpcError(t, structerr.NewNotFound("commit not found"), err)
+		}
+
+		require.Equal(t, 2, service.invocations())
+		require.Zero(t, streamer.count())
+	})
+
+	t.Run("unary RPC with object IDs is cached", func(t *testing.T) {
+		t.Parallel()
+
+		service, streamer, conn := setup(t)
+
+		request := &gitalypb.CommitLanguagesRequest{Repository: repo, Revision: []byte(commitID)}
+
+		for i := 0; i < 2; i++ {
+			response, err := gitalypb.NewCommitServiceClient(conn).CommitLanguages(ctx, request)
+			require.NoError(t, err)
+			testhelper.ProtoEqual(t, &gitalypb.CommitLanguagesResponse{
+				Languages: []*gitalypb.CommitLanguagesResponse_Language{
+					{Name: "Go", Share: 100, Bytes: 42},
+				},
+			}, response)
+		}
+
+		require.Equal(t, 1, service.invocations())
+		require.Equal(t, 1, streamer.count())
+	})
+
+	t.Run("unary RPC without revision is not cached", func(t *testing.T) {
+		t.Parallel()
+
+		service, streamer, conn := setup(t)
+
+		request := &gitalypb.CommitLanguagesRequest{Repository: repo}
+
+		for i := 0; i < 2; i++ {
+			_, err := gitalypb.NewCommitServiceClient(conn).CommitLanguages(ctx, request)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 2, service.invocations())
+		require.Zero(t, streamer.count())
+	})
+}
+
+// mockImmutableStreamer stores streams in memory keyed by the request.
+type mockImmutableStreamer struct {
+	sync.Mutex
+	streams map[string][]byte
+}
+
+func (m *mockImmutableStreamer) count() int {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.streams)
+}
+
+func (m *mockImmutableStreamer) key(req proto.Message) string {
+	key, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(key)
+}
+
+func (m *mockImmutableStreamer) GetImmutableStream(_ context.Context, _ *gitalypb.Repository, req proto.Message) (io.ReadCloser, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	stream, ok := m.streams[m.key(req)]
+	if !ok {
+		return nil, diskcache.ErrReqNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(stream)), nil
+}
+
+func (m *mockImmutableStreamer) PutImmutableStream(_ context.Context, _ *gitalypb.Repository, req proto.Message, src io.Reader) error {
+	stream, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.streams[m.key(req)] = stream
+	return nil
+}
+
+type immutableTestService struct {
+	gitalypb.UnimplementedDiffServiceServer
+	gitalypb.UnimplementedCommitServiceServer
+
+	mutex sync.Mutex
+	calls int
+	err   error
+}
+
+func (s *immutableTestService) invocations() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.calls
+}
+
+func (s *immutableTestService) invoke() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.calls++
+}
+
+func (s *immutableTestService) CommitDiff(_ *gitalypb.CommitDiffRequest, stream gitalypb.DiffService_CommitDiffServer) error {
+	s.invoke()
+
+	if err := stream.Send(&gitalypb.CommitDiffResponse{FromPath: []byte("a"), ToPath: []byte("a")}); err != nil {
+		return err
+	}
+
+	if s.err != nil {
+		return s.err
+	}
+
+	return stream.Send(&gitalypb.CommitDiffResponse{FromPath: []byte("b"), ToPath: []byte("b"), EndOfPatch: true})
+}
+
+func (s *immutableTestService) CommitLanguages(context.Context, *gitalypb.CommitLanguagesRequest) (*gitalypb.CommitLanguagesResponse, error) {
+	s.invoke()
+
+	return &gitalypb.CommitLanguagesResponse{
+		Languages: []*gitalypb.CommitLanguagesResponse_Language{
+			{Name: "Go", Share: 100, Bytes: 42},
+		},
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/middleware/metadatahandler"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
 	"google.golang.org/grpc"
@@ -32,12 +33,57 @@ func LimitConcurrencyByRepo(ctx context.Context) string {
 	return ""
 }
 
+// tenantFromTag returns a limiter.TenantFunc that identifies tenants by the value of the given
+// tag. The tags are populated by the metadatahandler.
+func tenantFromTag(key string) limiter.TenantFunc {
+	return func(ctx context.Context) string {
+		value, ok := grpcmwtags.Extract(ctx).Values()[key].(string)
+		if !ok {
+			return ""
+		}
+		return value
+	}
+}
+
+// tenantFairnessOptions returns the limiter options required to set up per-tenant fairness as
+// configured. It returns no options if fairness is disabled.
+func tenantFairnessOptions(limit config.Concurrency) []limiter.ConcurrencyLimiterOption {
+	switch limit.Fairness {
+	case config.FairnessClientName:
+		return []limiter.ConcurrencyLimiterOption{
+			limiter.WithTenantFairness(tenantFromTag(metadatahandler.ClientNameKey), limit.FairnessWeights),
+		}
+	case config.FairnessUserID:
+		return []limiter.ConcurrencyLimiterOption{
+			limiter.WithTenantFairness(tenantFromTag(metadatahandler.UserIDKey), limit.FairnessWeights),
+		}
+	default:
+		return nil
+	}
+}
+
+// tenantLabel maps a tenant to the value of the tenant label of per-tenant metrics. To bound the
+// cardinality of these metrics, only tenants with an explicitly configured weight are reported by
+// name. All other tenants are aggregated.
+func tenantLabel(weights map[string]int) func(string) string {
+	return func(tenant string) string {
+		if tenant == "" {
+			return "unknown"
+		}
+		if _, ok := weights[tenant]; !ok {
+			return "other"
+		}
+		return tenant
+	}
+}
+
 // LimiterMiddleware contains rate limiter state
 type LimiterMiddleware struct {
 	methodLimiters        map[string]limiter.Limiter
 	getLockKey            GetLockKey
 	requestsDroppedMetric *prometheus.CounterVec
 	collect               func(metrics chan<- prometheus.Metric)
+	adaptiveLimits        []limiter.AdaptiveLimiter
 }
 
 // New creates a new middleware that limits requests. SetupFunc sets up the
@@ -77,6 +123,12 @@ func (c *LimiterMiddleware) Collect(metrics chan<- prometheus.Metric) {
 	}
 }
 
+// AdaptiveLimits returns the adaptive limits of the limiters set up by the middleware. The limits
+// need to be calibrated by a limiter.AdaptiveCalculator to adapt to the load of the node.
+func (c *LimiterMiddleware) AdaptiveLimits() []limiter.AdaptiveLimiter {
+	return c.adaptiveLimits
+}
+
 // UnaryInterceptor returns a Unary Interceptor
 func (c *LimiterMiddleware) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -163,9 +215,32 @@ func (w *wrappedStream) RecvMsg(m interface{}) error {
 	}
 }
 
+// adaptiveBackoffFactor is the factor adaptive concurrency limits are multiplied with when the
+// node is saturated.
+const adaptiveBackoffFactor = 0.5
+
 // WithConcurrencyLimiters sets up middleware to limit the concurrency of
 // requests based on RPC and repository
 func WithConcurrencyLimiters(cfg config.Cfg, middleware *LimiterMiddleware) {
+	withConcurrencyLimiters(cfg, middleware, nil)
+}
+
+// WithMonitoredConcurrencyLimiters sets up the same limiters as WithConcurrencyLimiters, but wraps
+// the monitor of each limiter with wrapMonitor. This allows watchers of the adaptive limiter to
+// observe the queues of the limiters.
+func WithMonitoredConcurrencyLimiters(wrapMonitor func(limiter.ConcurrencyMonitor) limiter.ConcurrencyMonitor) SetupFunc {
+	return func(cfg config.Cfg, middleware *LimiterMiddleware) {
+		withConcurrencyLimiters(cfg, middleware, wrapMonitor)
+	}
+}
+
+func withConcurrencyLimiters(cfg config.Cfg, middleware *LimiterMiddleware, wrapMonitor func(limiter.ConcurrencyMonitor) limiter.ConcurrencyMonitor) {
+	if wrapMonitor == nil {
+		wrapMonitor = func(monitor limiter.ConcurrencyMonitor) limiter.ConcurrencyMonitor {
+			return monitor
+		}
+	}
+
 	acquiringSecondsMetric := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "gitaly",
@@ -195,24 +270,68 @@ func WithConcurrencyLimiters(cfg config.Cfg, middleware *LimiterMiddleware) {
 		[]string{"system", "grpc_service", "grpc_method"},
 	)
 
+	tenantQueuedMetric := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gitaly",
+			Subsystem: "concurrency_limiting",
+			Name:      "tenant_queued",
+			Help:      "Gauge of number of queued calls per tenant",
+		},
+		[]string{"system", "grpc_service", "grpc_method", "tenant"},
+	)
+	tenantDroppedMetric := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gitaly",
+			Subsystem: "concurrency_limiting",
+			Name:      "tenant_dropped_total",
+			Help:      "Number of requests dropped from the queue per tenant",
+		},
+		[]string{"system", "grpc_service", "grpc_method", "tenant", "reason"},
+	)
+
 	middleware.collect = func(metrics chan<- prometheus.Metric) {
 		acquiringSecondsMetric.Collect(metrics)
 		inProgressMetric.Collect(metrics)
 		queuedMetric.Collect(metrics)
+		tenantQueuedMetric.Collect(metrics)
+		tenantDroppedMetric.Collect(metrics)
 	}
 
 	result := make(map[string]limiter.Limiter)
 	for _, limit := range cfg.Concurrency {
 		limit := limit
 
+		var monitor limiter.ConcurrencyMonitor = limiter.NewPerRPCPromMonitor(
+			"gitaly", limit.RPC,
+			queuedMetric, inProgressMetric, acquiringSecondsMetric, middleware.requestsDroppedMetric,
+		)
+
+		fairnessOpts := tenantFairnessOptions(limit)
+		if len(fairnessOpts) > 0 {
+			monitor = limiter.NewPerTenantPromMonitor(
+				monitor, "gitaly", limit.RPC,
+				tenantQueuedMetric, tenantDroppedMetric, tenantLabel(limit.FairnessWeights),
+			)
+		}
+
+		opts := fairnessOpts
+		if limit.Adaptive {
+			adaptiveLimit := limiter.NewAdaptiveLimit(limit.RPC, limiter.AdaptiveSetting{
+				Initial:       limit.InitialLimit,
+				Max:           limit.MaxLimit,
+				Min:           limit.MinLimit,
+				BackoffFactor: adaptiveBackoffFactor,
+			})
+			middleware.adaptiveLimits = append(middleware.adaptiveLimits, adaptiveLimit)
+			opts = append(opts, limiter.WithAdaptiveLimit(adaptiveLimit))
+		}
+
 		result[limit.RPC] = limiter.NewConcurrencyLimiter(
 			limit.MaxPerRepo,
 			limit.MaxQueueSize,
 			limit.MaxQueueWait.Duration(),
-			limiter.NewPerRPCPromMonitor(
-				"gitaly", limit.RPC,
-				queuedMetric, inProgressMetric, acquiringSecondsMetric, middleware.requestsDroppedMetric,
-			),
+			wrapMonitor(monitor),
+			opts...,
 		)
 	}
 
@@ -223,10 +342,10 @@ func WithConcurrencyLimiters(cfg config.Cfg, middleware *LimiterMiddleware) {
 			1,
 			0,
 			0,
-			limiter.NewPerRPCPromMonitor(
+			wrapMonitor(limiter.NewPerRPCPromMonitor(
 				"gitaly", replicateRepositoryFullMethod,
 				queuedMetric, inProgressMetric, acquiringSecondsMetric, middleware.requestsDroppedMetric,
-			),
+			)),
 		)
 	}
 
@@ -9,11 +9,13 @@ import (
 	"testing"
 	"time"
 
+	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	promtest "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/middleware/limithandler"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/middleware/metadatahandler"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
@@ -23,6 +25,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/interop/grpc_testing"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -683,6 +686,171 @@ gitaly_requests_dropped_total{grpc_method="UnaryCall",grpc_service="grpc.testing
 	<-respCh
 }
 
+func TestConcurrencyLimitHandler_tenantFairness(t *testing.T) {
+	t.Parallel()
+
+	s := &queueTestServer{reqArrivedCh: make(chan struct{})}
+	s.blockCh = make(chan struct{})
+
+	methodName := "/grpc.testing.TestService/UnaryCall"
+	cfg := config.Cfg{
+		Concurrency: []config.Concurrency{
+			{
+				RPC:             methodName,
+				MaxPerRepo:      1,
+				MaxQueueSize:    2,
+				Fairness:        config.FairnessClientName,
+				FairnessWeights: map[string]int{"gitlab-web": 2},
+			},
+		},
+	}
+
+	lh := limithandler.New(cfg, fixedLockKey, limithandler.WithConcurrencyLimiters)
+	srv, serverSocketPath := runServer(t, s, grpc.ChainUnaryInterceptor(
+		grpcmwtags.UnaryServerInterceptor(),
+		metadatahandler.UnaryInterceptor,
+		lh.UnaryInterceptor(),
+	))
+	defer srv.Stop()
+
+	client, conn := newClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx := testhelper.Context(t)
+	webCtx := metadata.AppendToOutgoingContext(ctx, "client_name", "gitlab-web")
+	ciCtx := metadata.AppendToOutgoingContext(ctx, "client_name", "gitlab-ci")
+
+	var wg sync.WaitGroup
+	call := func(ctx context.Context) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+			assert.NoError(t, err)
+		}()
+	}
+
+	// Occupy the only slot and queue up one call per tenant.
+	call(webCtx)
+	<-s.reqArrivedCh
+	call(webCtx)
+	call(ciCtx)
+
+	require.Eventually(t, func() bool {
+		return promtest.CollectAndCompare(lh, bytes.NewBufferString(`# HELP gitaly_concurrency_limiting_tenant_queued Gauge of number of queued calls per tenant
+# TYPE gitaly_concurrency_limiting_tenant_queued gauge
+gitaly_concurrency_limiting_tenant_queued{grpc_method="UnaryCall",grpc_service="grpc.testing.TestService",system="gitaly",tenant="gitlab-web"} 1
+gitaly_concurrency_limiting_tenant_queued{grpc_method="UnaryCall",grpc_service="grpc.testing.TestService",system="gitaly",tenant="other"} 1
+`), "gitaly_concurrency_limiting_tenant_queued") == nil
+	}, 10*time.Second, time.Millisecond)
+
+	// The queue is full now, so the next call is dropped.
+	_, err := client.UnaryCall(ciCtx, &grpc_testing.SimpleRequest{})
+	testhelper.RequireGrpcCode(t, err, codes.ResourceExhausted)
+
+	require.NoError(t, promtest.CollectAndCompare(lh, bytes.NewBufferString(`# HELP gitaly_concurrency_limiting_tenant_dropped_total Number of requests dropped from the queue per tenant
+# TYPE gitaly_concurrency_limiting_tenant_dropped_total counter
+gitaly_concurrency_limiting_tenant_dropped_total{grpc_method="UnaryCall",grpc_service="grpc.testing.TestService",reason="max_size",system="gitaly",tenant="other"} 1
+`), "gitaly_concurrency_limiting_tenant_dropped_total"))
+
+	close(s.blockCh)
+	for i := 0; i < 2; i++ {
+		<-s.reqArrivedCh
+	}
+	wg.Wait()
+}
+
+// enterCountingMonitor is a ConcurrencyMonitor that counts the calls which entered the limiter.
+type enterCountingMonitor struct {
+	limiter.ConcurrencyMonitor
+	mu      sync.Mutex
+	entered int
+}
+
+func (m *enterCountingMonitor) Enter(ctx context.Context, inProgress int, acquireTime time.Duration) {
+	m.mu.Lock()
+	m.entered++
+	m.mu.Unlock()
+	m.ConcurrencyMonitor.Enter(ctx, inProgress, acquireTime)
+}
+
+func TestConcurrencyLimitHandler_adaptive(t *testing.T) {
+	t.Parallel()
+
+	s := &queueTestServer{reqArrivedCh: make(chan struct{})}
+	s.blockCh = make(chan struct{})
+
+	methodName := "/grpc.testing.TestService/UnaryCall"
+	cfg := config.Cfg{
+		Concurrency: []config.Concurrency{
+			{
+				RPC:          methodName,
+				MaxPerRepo:   10,
+				Adaptive:     true,
+				MinLimit:     1,
+				InitialLimit: 1,
+				MaxLimit:     2,
+			},
+		},
+	}
+
+	monitor := &enterCountingMonitor{}
+	lh := limithandler.New(cfg, fixedLockKey, limithandler.WithMonitoredConcurrencyLimiters(
+		func(wrapped limiter.ConcurrencyMonitor) limiter.ConcurrencyMonitor {
+			if monitor.ConcurrencyMonitor == nil {
+				monitor.ConcurrencyMonitor = wrapped
+				return monitor
+			}
+			return wrapped
+		},
+	))
+
+	require.Len(t, lh.AdaptiveLimits(), 1)
+	adaptiveLimit := lh.AdaptiveLimits()[0]
+	require.Equal(t, methodName, adaptiveLimit.Name())
+	require.Equal(t, limiter.AdaptiveSetting{Initial: 1, Max: 2, Min: 1, BackoffFactor: 0.5}, adaptiveLimit.Setting())
+
+	srv, serverSocketPath := runServer(t, s, grpc.UnaryInterceptor(lh.UnaryInterceptor()))
+	defer srv.Stop()
+
+	client, conn := newClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx := testhelper.Context(t)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+			assert.NoError(t, err)
+		}()
+	}
+
+	// The adaptive limit starts at one, so only one of the calls is admitted even though
+	// MaxPerRepo is higher.
+	<-s.reqArrivedCh
+	select {
+	case <-s.reqArrivedCh:
+		require.FailNow(t, "received unexpected second request")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Raising the adaptive limit admits the queued call.
+	adaptiveLimit.Update(2)
+	<-s.reqArrivedCh
+
+	close(s.blockCh)
+	wg.Wait()
+
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	require.Equal(t, 2, monitor.entered)
+}
+
 func TestRateLimitHandler(t *testing.T) {
 	t.Parallel()
 
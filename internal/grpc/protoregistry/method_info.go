@@ -69,6 +69,13 @@ type MethodInfo struct {
 	// requestType is the RPC's request type.
 	requestType    protoreflect.MessageType
 	fullMethodName string
+	// responseType is the RPC's response type.
+	responseType protoreflect.MessageType
+	// clientStreaming is set if the client streams the requests of the RPC.
+	clientStreaming bool
+	// immutableResult is set if the RPC's response is fully determined by its request in case all of
+	// its revisions are full object IDs.
+	immutableResult bool
 }
 
 // TargetRepo returns the target repository for a protobuf message if it exists
@@ -87,6 +94,103 @@ func (mi MethodInfo) FullMethodName() string {
 	return mi.fullMethodName
 }
 
+// ClientStreaming returns true if the client streams the requests of the RPC.
+func (mi MethodInfo) ClientStreaming() bool {
+	return mi.clientStreaming
+}
+
+// ImmutableResult returns true if the RPC has been annotated with `immutable_result`. The response of such
+// RPCs is fully determined by their request in case all of its revisions are full object IDs.
+func (mi MethodInfo) ImmutableResult() bool {
+	return mi.immutableResult
+}
+
+// ImmutableRequest returns true if the response of the RPC is fully determined by the given request. This is
+// the case when the RPC has been annotated with `immutable_result` and all fields of the request that are
+// annotated with `immutable_revision` contain full object IDs.
+func (mi MethodInfo) ImmutableRequest(msg proto.Message) (bool, error) {
+	if !mi.immutableResult {
+		return false, nil
+	}
+
+	if mi.requestName != string(proto.MessageName(msg)) {
+		return false, fmt.Errorf(
+			"proto message %s does not match expected RPC request message %s",
+			proto.MessageName(msg), mi.requestName,
+		)
+	}
+
+	return hasOnlyObjectIDRevisions(msg.ProtoReflect()), nil
+}
+
+// hasOnlyObjectIDRevisions recursively verifies that all revision fields of the message are set to full
+// object IDs. Singular revision fields need to be set, as an unset revision typically refers to HEAD.
+// Repeated revision fields may be empty.
+func hasOnlyObjectIDRevisions(msg protoreflect.Message) bool {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		opts := field.Options().(*descriptorpb.FieldOptions)
+		if proto.HasExtension(opts, gitalypb.E_ImmutableRevision) {
+			if field.IsList() {
+				list := msg.Get(field).List()
+				for j := 0; j < list.Len(); j++ {
+					if !isFullObjectID(list.Get(j), field.Kind()) {
+						return false
+					}
+				}
+			} else if !isFullObjectID(msg.Get(field), field.Kind()) {
+				return false
+			}
+
+			continue
+		}
+
+		if field.Kind() != protoreflect.MessageKind || field.IsMap() {
+			continue
+		}
+
+		if field.IsList() {
+			list := msg.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				if !hasOnlyObjectIDRevisions(list.Get(j).Message()) {
+					return false
+				}
+			}
+		} else if msg.Has(field) && !hasOnlyObjectIDRevisions(msg.Get(field).Message()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isFullObjectID determines whether the value is a full hex-encoded SHA1 or SHA256 object ID.
+func isFullObjectID(value protoreflect.Value, kind protoreflect.Kind) bool {
+	var revision string
+	switch kind {
+	case protoreflect.StringKind:
+		revision = value.String()
+	case protoreflect.BytesKind:
+		revision = string(value.Bytes())
+	default:
+		return false
+	}
+
+	if len(revision) != 40 && len(revision) != 64 {
+		return false
+	}
+
+	for _, c := range revision {
+		if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ErrRepositoryFieldNotFound indicates that the repository field could not be found.
 var ErrRepositoryFieldNotFound = errors.New("repository field not found")
 
@@ -187,6 +291,11 @@ func (mi MethodInfo) NewRequest() proto.Message {
 	return mi.requestType.New().Interface()
 }
 
+// NewResponse instantiates a new instance of the method's response type.
+func (mi MethodInfo) NewResponse() proto.Message {
+	return mi.responseType.New().Interface()
+}
+
 func parseMethodInfo(
 	p *descriptorpb.FileDescriptorProto,
 	methodDesc *descriptorpb.MethodDescriptorProto,
@@ -220,17 +329,36 @@ func parseMethodInfo(
 		return MethodInfo{}, fmt.Errorf("no message type found for %w", err)
 	}
 
+	responseName := strings.TrimLeft(methodDesc.GetOutputType(), ".")
+
+	responseType, err := protoreg.GlobalTypes.FindMessageByName(protoreflect.FullName(responseName))
+	if err != nil {
+		return MethodInfo{}, fmt.Errorf("no message type found for %w", err)
+	}
+
 	scope, ok := protoScope[opMsg.GetScopeLevel()]
 	if !ok {
 		return MethodInfo{}, fmt.Errorf("encountered unknown method scope %d", opMsg.GetScopeLevel())
 	}
 
+	immutableResult, err := protoutil.IsImmutableResultMethod(methodDesc)
+	if err != nil {
+		return MethodInfo{}, fmt.Errorf("is immutable result method: %w", err)
+	}
+
+	if immutableResult && opCode != OpAccessor {
+		return MethodInfo{}, fmt.Errorf("method %s with immutable result is not an accessor", fullMethodName)
+	}
+
 	mi := MethodInfo{
-		Operation:      opCode,
-		Scope:          scope,
-		requestName:    requestName,
-		requestType:    requestType,
-		fullMethodName: fullMethodName,
+		Operation:       opCode,
+		Scope:           scope,
+		requestName:     requestName,
+		requestType:     requestType,
+		responseType:    responseType,
+		fullMethodName:  fullMethodName,
+		clientStreaming: methodDesc.GetClientStreaming(),
+		immutableResult: immutableResult,
 	}
 
 	return mi, nil
@@ -3,6 +3,7 @@ package protoregistry
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -289,6 +290,211 @@ func TestMethodInfoScope(t *testing.T) {
 	}
 }
 
+func TestMethodInfo_ClientStreaming(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		method          string
+		clientStreaming bool
+	}{
+		{
+			method:          "/gitaly.RepositoryService/RepositoryExists",
+			clientStreaming: false,
+		},
+		{
+			method:          "/gitaly.CommitService/ListCommitsByOid",
+			clientStreaming: false,
+		},
+		{
+			method:          "/gitaly.BlobService/GetBlobs",
+			clientStreaming: false,
+		},
+		{
+			method:          "/gitaly.CommitService/CheckObjectsExist",
+			clientStreaming: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.method, func(t *testing.T) {
+			t.Parallel()
+
+			mInfo, err := GitalyProtoPreregistered.LookupMethod(tc.method)
+			require.NoError(t, err)
+			require.Equal(t, tc.clientStreaming, mInfo.ClientStreaming())
+		})
+	}
+}
+
+func TestMethodInfo_ImmutableResult(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		method          string
+		immutableResult bool
+	}{
+		{
+			method:          "/gitaly.CommitService/FindCommit",
+			immutableResult: false,
+		},
+		{
+			method:          "/gitaly.CommitService/CommitLanguages",
+			immutableResult: true,
+		},
+		{
+			method:          "/gitaly.CommitService/ListLastCommitsForTree",
+			immutableResult: true,
+		},
+		{
+			method:          "/gitaly.CommitService/RawBlame",
+			immutableResult: true,
+		},
+		{
+			method:          "/gitaly.CommitService/Blame",
+			immutableResult: true,
+		},
+		{
+			method:          "/gitaly.DiffService/CommitDiff",
+			immutableResult: true,
+		},
+		{
+			method:          "/gitaly.DiffService/FindChangedPaths",
+			immutableResult: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.method, func(t *testing.T) {
+			t.Parallel()
+
+			mInfo, err := GitalyProtoPreregistered.LookupMethod(tc.method)
+			require.NoError(t, err)
+			require.Equal(t, tc.immutableResult, mInfo.ImmutableResult())
+		})
+	}
+}
+
+func TestMethodInfo_ImmutableRequest(t *testing.T) {
+	t.Parallel()
+
+	sha1 := "1e292f8fedd741b75372e19097c76d327140c312"
+	sha256 := "a5b1c3d4e5f60718293a4b5c6d7e8f90112233445566778899aabbccddeeff00"
+
+	for _, tc := range []struct {
+		desc            string
+		method          string
+		request         proto.Message
+		expectImmutable bool
+		expectedErr     error
+	}{
+		{
+			desc:    "method without annotation",
+			method:  "/gitaly.CommitService/FindCommit",
+			request: &gitalypb.FindCommitRequest{Revision: []byte(sha1)},
+		},
+		{
+			desc:            "string object IDs",
+			method:          "/gitaly.DiffService/CommitDiff",
+			request:         &gitalypb.CommitDiffRequest{LeftCommitId: sha1, RightCommitId: sha1},
+			expectImmutable: true,
+		},
+		{
+			desc:            "SHA256 object IDs",
+			method:          "/gitaly.DiffService/CommitDiff",
+			request:         &gitalypb.CommitDiffRequest{LeftCommitId: sha256, RightCommitId: sha256},
+			expectImmutable: true,
+		},
+		{
+			desc:    "branch name",
+			method:  "/gitaly.DiffService/CommitDiff",
+			request: &gitalypb.CommitDiffRequest{LeftCommitId: sha1, RightCommitId: "main"},
+		},
+		{
+			desc:    "abbreviated object ID",
+			method:  "/gitaly.DiffService/CommitDiff",
+			request: &gitalypb.CommitDiffRequest{LeftCommitId: sha1, RightCommitId: sha1[:10]},
+		},
+		{
+			desc:    "uppercase object ID",
+			method:  "/gitaly.DiffService/CommitDiff",
+			request: &gitalypb.CommitDiffRequest{LeftCommitId: sha1, RightCommitId: strings.ToUpper(sha1)},
+		},
+		{
+			desc:            "bytes object ID",
+			method:          "/gitaly.CommitService/RawBlame",
+			request:         &gitalypb.RawBlameRequest{Revision: []byte(sha1), Path: []byte("README")},
+			expectImmutable: true,
+		},
+		{
+			desc:    "unset revision",
+			method:  "/gitaly.CommitService/CommitLanguages",
+			request: &gitalypb.CommitLanguagesRequest{},
+		},
+		{
+			desc:   "nested object IDs",
+			method: "/gitaly.DiffService/FindChangedPaths",
+			request: &gitalypb.FindChangedPathsRequest{
+				Requests: []*gitalypb.FindChangedPathsRequest_Request{
+					{
+						Type: &gitalypb.FindChangedPathsRequest_Request_CommitRequest_{
+							CommitRequest: &gitalypb.FindChangedPathsRequest_Request_CommitRequest{
+								CommitRevision:        sha1,
+								ParentCommitRevisions: []string{sha1, sha1},
+							},
+						},
+					},
+					{
+						Type: &gitalypb.FindChangedPathsRequest_Request_TreeRequest_{
+							TreeRequest: &gitalypb.FindChangedPathsRequest_Request_TreeRequest{
+								LeftTreeRevision:  sha1,
+								RightTreeRevision: sha1,
+							},
+						},
+					},
+				},
+			},
+			expectImmutable: true,
+		},
+		{
+			desc:   "nested revision",
+			method: "/gitaly.DiffService/FindChangedPaths",
+			request: &gitalypb.FindChangedPathsRequest{
+				Requests: []*gitalypb.FindChangedPathsRequest_Request{
+					{
+						Type: &gitalypb.FindChangedPathsRequest_Request_CommitRequest_{
+							CommitRequest: &gitalypb.FindChangedPathsRequest_Request_CommitRequest{
+								CommitRevision:        sha1,
+								ParentCommitRevisions: []string{sha1, "HEAD~"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc:   "mismatching request",
+			method: "/gitaly.DiffService/CommitDiff",
+			request: &gitalypb.RawBlameRequest{
+				Revision: []byte(sha1),
+			},
+			expectedErr: errors.New("proto message gitaly.RawBlameRequest does not match expected RPC request message gitaly.CommitDiffRequest"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			mInfo, err := GitalyProtoPreregistered.LookupMethod(tc.method)
+			require.NoError(t, err)
+
+			immutable, err := mInfo.ImmutableRequest(tc.request)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectImmutable, immutable)
+		})
+	}
+}
+
 func TestFindFieldsByExtension(t *testing.T) {
 	t.Parallel()
 
@@ -121,8 +121,10 @@ func TestNewProtoRegistry(t *testing.T) {
 			"RepositoryExists":             OpAccessor,
 			"RepositorySize":               OpAccessor,
 			"RestoreCustomHooks":           OpMutator,
+			"SearchCode":                   OpAccessor,
 			"SearchFilesByContent":         OpAccessor,
 			"SearchFilesByName":            OpAccessor,
+			"UpdateCodeSearchIndex":        OpMaintenance,
 			"WriteRef":                     OpMutator,
 		},
 		"SmartHTTPService": {
@@ -31,6 +31,7 @@ type StreamParameters struct {
 	reqFinalizer func() error
 	callOptions  []grpc.CallOption
 	secondaries  []Destination
+	hedge        *Hedge
 }
 
 // Destination contains a client connection as well as a rewritten protobuf message
@@ -58,6 +59,17 @@ func NewStreamParameters(primary Destination, secondaries []Destination, reqFina
 	}
 }
 
+// NewHedgedStreamParameters returns a new instance of StreamParameters for a request that is hedged as configured
+// if the primary destination is slow to respond.
+func NewHedgedStreamParameters(primary Destination, hedge Hedge, reqFinalizer func() error, callOpts []grpc.CallOption) *StreamParameters {
+	return &StreamParameters{
+		primary:      primary,
+		reqFinalizer: reqFinalizer,
+		callOptions:  callOpts,
+		hedge:        &hedge,
+	}
+}
+
 //nolint:revive // This is unintentionally missing documentation.
 func (s *StreamParameters) Primary() Destination {
 	return s.primary
@@ -68,6 +80,11 @@ func (s *StreamParameters) Secondaries() []Destination {
 	return s.secondaries
 }
 
+// Hedge returns the hedging configuration of the request. It returns nil if the request is not hedged.
+func (s *StreamParameters) Hedge() *Hedge {
+	return s.hedge
+}
+
 // RequestFinalizer calls the request finalizer
 func (s *StreamParameters) RequestFinalizer() error {
 	if s.reqFinalizer != nil {
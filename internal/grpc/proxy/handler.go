@@ -107,7 +107,8 @@ func failDestinationsWithError(params *StreamParameters, err error) {
 }
 
 // HandleStream proxies the RPC stream to the destination storages. Only responses from the primary
-// are sent back to the client.
+// are sent back to the client. If the request is hedged, the responses of the destination that
+// responds first are sent back to the client.
 func HandleStream(serverStream grpc.ServerStream, fullMethodName string, params *StreamParameters) (finalErr error) {
 	defer func() {
 		err := params.RequestFinalizer()
@@ -116,6 +117,10 @@ func HandleStream(serverStream grpc.ServerStream, fullMethodName string, params
 		}
 	}()
 
+	if params.Hedge() != nil {
+		return handleHedgedStream(serverStream, fullMethodName, params)
+	}
+
 	clientCtx, clientCancel := context.WithCancel(params.Primary().Ctx)
 	defer clientCancel()
 	// TODO(mwitkow): Add a `forwarded` header to metadata, https://en.wikipedia.org/wiki/X-Forwarded-For.
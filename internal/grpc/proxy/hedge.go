@@ -0,0 +1,218 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/middleware/sentryhandler"
+	"google.golang.org/grpc"
+)
+
+// Hedge configures the hedging of a request. If the primary destination hasn't responded with its first message
+// within the delay, the request is sent to a second destination as well. The destination that responds first serves
+// the request and the request to the other destination is canceled. Only requests that consist of a single message
+// can be hedged, as the request is replayed from the destination's rewritten message.
+type Hedge struct {
+	// Delay is the time to wait for the primary's first response message before hedging the request.
+	Delay time.Duration
+	// Destination returns the destination to send the hedged request to. It's only called once the delay
+	// has passed. The request is not hedged if it returns an error or if Destination is not set.
+	Destination func() (Destination, error)
+	// Report is called with the outcome of the request once a destination has responded successfully.
+	// It's optional.
+	Report func(HedgeResult)
+}
+
+// HedgeResult describes the outcome of a request that was eligible for hedging.
+type HedgeResult struct {
+	// Hedged is set if the request was sent to the hedge destination.
+	Hedged bool
+	// HedgeWon is set if the hedge destination responded first.
+	HedgeWon bool
+	// PrimaryLatency is the time it took the primary destination to respond with its first message. If the
+	// hedge destination responded first, the request to the primary is canceled before it has responded. The
+	// latency is then the time the primary had been pending for, which is a lower bound of its actual latency.
+	//
+	// The latency is always measured for the primary as the winner's latency would be biased towards the
+	// faster of both destinations.
+	PrimaryLatency time.Duration
+}
+
+// hedgeAttempt is a request sent to one of the destinations of a hedged request.
+type hedgeAttempt struct {
+	destination Destination
+	cancel      func()
+	hedge       bool
+	// stream is the stream to the destination. It's only safe to access after the attempt's
+	// response has been received.
+	stream grpc.ClientStream
+}
+
+// hedgeResponse is the first response of a destination.
+type hedgeResponse struct {
+	attempt *hedgeAttempt
+	frame   *frame
+	// err is io.EOF if the destination finished the stream without sending a message.
+	err error
+}
+
+// startHedgeAttempt sends the destination's rewritten message to the destination and sends its first
+// response to the responses channel.
+func startHedgeAttempt(fullMethodName string, params *StreamParameters, destination Destination, hedge bool, responses chan<- hedgeResponse) *hedgeAttempt {
+	ctx, cancel := context.WithCancel(destination.Ctx)
+	attempt := &hedgeAttempt{
+		destination: destination,
+		cancel:      cancel,
+		hedge:       hedge,
+	}
+
+	go func() {
+		response := hedgeResponse{attempt: attempt, frame: &frame{}}
+		defer func() { responses <- response }()
+
+		stream, err := grpc.NewClientStream(ctx, clientStreamDescForProxying, destination.Conn, fullMethodName, params.CallOptions()...)
+		if err != nil {
+			response.err = fmt.Errorf("initiate stream: %w", err)
+			return
+		}
+		attempt.stream = stream
+
+		// SendMsg returns io.EOF if the stream was aborted. The actual error is then returned by RecvMsg.
+		if err := stream.SendMsg(&frame{payload: destination.Msg}); err != nil && !errors.Is(err, io.EOF) {
+			response.err = err
+			return
+		}
+
+		if err := stream.CloseSend(); err != nil {
+			response.err = err
+			return
+		}
+
+		response.err = stream.RecvMsg(response.frame)
+	}()
+
+	return attempt
+}
+
+// handleHedgedStream proxies a request that may be hedged. The first destination to respond with a message serves
+// the request. A destination that fails is only picked if the other destination fails as well.
+func handleHedgedStream(serverStream grpc.ServerStream, fullMethodName string, params *StreamParameters) error {
+	hedge := params.hedge
+	started := time.Now()
+
+	// The channel is buffered for both attempts so the attempts don't block once a winner has been picked.
+	responses := make(chan hedgeResponse, 2)
+	attempts := []*hedgeAttempt{startHedgeAttempt(fullMethodName, params, params.Primary(), false, responses)}
+	defer func() {
+		for _, attempt := range attempts {
+			attempt.cancel()
+		}
+	}()
+
+	// A nil channel never fires, so the request is not hedged if there is no destination to hedge to.
+	var hedgeTimer <-chan time.Time
+	if hedge.Destination != nil {
+		timer := time.NewTimer(hedge.Delay)
+		defer timer.Stop()
+		hedgeTimer = timer.C
+	}
+
+	var winner hedgeResponse
+	var primaryLatency time.Duration
+	for pending := len(attempts); ; {
+		select {
+		case <-hedgeTimer:
+			destination, err := hedge.Destination()
+			if err != nil {
+				continue
+			}
+
+			attempts = append(attempts, startHedgeAttempt(fullMethodName, params, destination, true, responses))
+			pending++
+			continue
+		case winner = <-responses:
+			pending--
+			if !winner.attempt.hedge {
+				primaryLatency = time.Since(started)
+			}
+		}
+
+		if winner.err != nil && !errors.Is(winner.err, io.EOF) && pending > 0 {
+			continue
+		}
+
+		break
+	}
+
+	if primaryLatency == 0 {
+		primaryLatency = time.Since(started)
+	}
+
+	// Cancel the losing attempt so it doesn't keep using resources on its destination.
+	for _, attempt := range attempts {
+		if attempt != winner.attempt {
+			attempt.cancel()
+		}
+	}
+
+	if (winner.err == nil || errors.Is(winner.err, io.EOF)) && hedge.Report != nil {
+		hedge.Report(HedgeResult{
+			Hedged:         len(attempts) > 1,
+			HedgeWon:       winner.attempt.hedge,
+			PrimaryLatency: primaryLatency,
+		})
+	}
+
+	err := winner.err
+	if err == nil {
+		err = forwardHedgeWinnerToClient(winner, serverStream)
+	}
+
+	if winner.attempt.stream != nil {
+		serverStream.SetTrailer(winner.attempt.stream.Trailer())
+	}
+
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	if winner.attempt.destination.ErrHandler != nil {
+		err = winner.attempt.destination.ErrHandler(err)
+	}
+
+	if err != nil {
+		// we must not propagate Gitaly errors into Sentry
+		sentryhandler.MarkToSkip(serverStream.Context())
+	}
+
+	return err
+}
+
+// forwardHedgeWinnerToClient forwards the winning destination's headers and messages to the client. It returns
+// io.EOF once the destination has finished the stream.
+func forwardHedgeWinnerToClient(winner hedgeResponse, dst grpc.ServerStream) error {
+	src := winner.attempt.stream
+
+	md, err := src.Header()
+	if err != nil {
+		return err
+	}
+
+	if err := dst.SendHeader(md); err != nil {
+		return err
+	}
+
+	f := winner.frame
+	for {
+		if err := dst.SendMsg(f); err != nil {
+			return err
+		}
+
+		if err := src.RecvMsg(f); err != nil {
+			return err
+		}
+	}
+}
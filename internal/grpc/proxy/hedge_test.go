@@ -0,0 +1,276 @@
+package proxy_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/metadata"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/proxy"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/interop/grpc_testing"
+	grpc_metadata "google.golang.org/grpc/metadata"
+)
+
+func TestHandler_hedging(t *testing.T) {
+	t.Parallel()
+
+	respond := func(body string) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+		return func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+			return &grpc_testing.SimpleResponse{Payload: &grpc_testing.Payload{Body: []byte(body)}}, nil
+		}
+	}
+
+	fail := func(message string) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+		return func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+			return nil, structerr.NewInternal("%s", message)
+		}
+	}
+
+	// block blocks until the request is canceled and signals the cancellation on the canceled channel.
+	block := func(canceled chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+		return func(ctx context.Context, _ *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+			<-ctx.Done()
+			close(canceled)
+			return nil, ctx.Err()
+		}
+	}
+
+	for _, tc := range []struct {
+		desc                string
+		delay               time.Duration
+		primary             func(canceled chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error)
+		hedge               func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error)
+		hedgeUnavailable    bool
+		hedgeUnset          bool
+		expectedResponse    string
+		expectedErr         error
+		expectedResult      *proxy.HedgeResult
+		expectPrimaryCancel bool
+	}{
+		{
+			desc:  "primary responds before the delay",
+			delay: time.Hour,
+			primary: func(chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+				return respond("primary")
+			},
+			hedge:            respond("hedge"),
+			expectedResponse: "primary",
+			expectedResult:   &proxy.HedgeResult{},
+		},
+		{
+			desc:  "primary fails before the delay",
+			delay: time.Hour,
+			primary: func(chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+				return fail("primary failed")
+			},
+			hedge:       respond("hedge"),
+			expectedErr: structerr.NewInternal("primary failed"),
+		},
+		{
+			desc:                "hedge responds first",
+			delay:               10 * time.Millisecond,
+			primary:             block,
+			hedge:               respond("hedge"),
+			expectedResponse:    "hedge",
+			expectedResult:      &proxy.HedgeResult{Hedged: true, HedgeWon: true},
+			expectPrimaryCancel: true,
+		},
+		{
+			desc: "hedge fails",
+			primary: func(chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+				return func(ctx context.Context, request *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+					// Respond only after the hedge has had the time to fail.
+					time.Sleep(50 * time.Millisecond)
+					return respond("primary")(ctx, request)
+				}
+			},
+			hedge:            fail("hedge failed"),
+			expectedResponse: "primary",
+			expectedResult:   &proxy.HedgeResult{Hedged: true},
+		},
+		{
+			desc: "both fail",
+			primary: func(chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+				return func(ctx context.Context, request *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+					time.Sleep(50 * time.Millisecond)
+					return fail("primary failed")(ctx, request)
+				}
+			},
+			hedge:       fail("hedge failed"),
+			expectedErr: structerr.NewInternal("primary failed"),
+		},
+		{
+			desc: "no hedge destination",
+			primary: func(chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+				return func(ctx context.Context, request *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+					time.Sleep(10 * time.Millisecond)
+					return respond("primary")(ctx, request)
+				}
+			},
+			hedgeUnavailable: true,
+			expectedResponse: "primary",
+			expectedResult:   &proxy.HedgeResult{},
+		},
+		{
+			desc: "hedge destination unset",
+			primary: func(chan<- struct{}) func(context.Context, *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+				return respond("primary")
+			},
+			hedge:            respond("hedge"),
+			hedgeUnset:       true,
+			expectedResponse: "primary",
+			expectedResult:   &proxy.HedgeResult{},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+
+			primaryCanceled := make(chan struct{})
+			primaryConn, primary := newBackendPinger(t, ctx)
+			primary.unaryCall = tc.primary(primaryCanceled)
+
+			hedgeConn, hedge := newBackendPinger(t, ctx)
+			hedge.unaryCall = tc.hedge
+
+			results := make(chan proxy.HedgeResult, 1)
+			director := func(ctx context.Context, _ string, peeker proxy.StreamPeeker) (*proxy.StreamParameters, error) {
+				payload, err := peeker.Peek()
+				if err != nil {
+					return nil, err
+				}
+
+				hedge := proxy.Hedge{
+					Delay: tc.delay,
+					Destination: func() (proxy.Destination, error) {
+						if tc.hedgeUnavailable {
+							return proxy.Destination{}, errors.New("no hedge destination")
+						}
+
+						return proxy.Destination{
+							Ctx:  metadata.IncomingToOutgoing(ctx),
+							Conn: hedgeConn,
+							Msg:  payload,
+						}, nil
+					},
+					Report: func(result proxy.HedgeResult) {
+						results <- result
+					},
+				}
+				if tc.hedgeUnset {
+					hedge.Destination = nil
+				}
+
+				return proxy.NewHedgedStreamParameters(proxy.Destination{
+					Ctx:  metadata.IncomingToOutgoing(ctx),
+					Conn: primaryConn,
+					Msg:  payload,
+				}, hedge, nil, nil), nil
+			}
+
+			client := grpc_testing.NewTestServiceClient(newProxy(t, ctx, director, "grpc.testing.TestService", "UnaryCall"))
+
+			response, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+			if tc.expectedErr != nil {
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResponse, string(response.GetPayload().GetBody()))
+			}
+
+			if tc.expectedResult != nil {
+				result := <-results
+				require.NotZero(t, result.PrimaryLatency)
+				if result.HedgeWon {
+					// The primary's latency is recorded even if it was canceled, in which case
+					// it's at least the delay after which the request was hedged.
+					require.GreaterOrEqual(t, result.PrimaryLatency, tc.delay)
+				}
+				result.PrimaryLatency = 0
+				require.Equal(t, *tc.expectedResult, result)
+			} else {
+				require.Empty(t, results)
+			}
+
+			if tc.expectPrimaryCancel {
+				<-primaryCanceled
+			}
+		})
+	}
+}
+
+func TestHandler_hedgingStreamedResponses(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	primaryConn, primary := newBackendPinger(t, ctx)
+	primary.streamingOutputCall = func(_ *grpc_testing.StreamingOutputCallRequest, stream grpc_testing.TestService_StreamingOutputCallServer) error {
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	}
+
+	hedgeConn, hedge := newBackendPinger(t, ctx)
+	hedge.streamingOutputCall = func(_ *grpc_testing.StreamingOutputCallRequest, stream grpc_testing.TestService_StreamingOutputCallServer) error {
+		require.NoError(t, grpc.SetTrailer(stream.Context(), grpc_metadata.Pairs("hedge-trailer", "value")))
+
+		for _, body := range []string{"first", "second", "third"} {
+			if err := stream.Send(&grpc_testing.StreamingOutputCallResponse{
+				Payload: &grpc_testing.Payload{Body: []byte(body)},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	director := func(ctx context.Context, _ string, peeker proxy.StreamPeeker) (*proxy.StreamParameters, error) {
+		payload, err := peeker.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		return proxy.NewHedgedStreamParameters(proxy.Destination{
+			Ctx:  metadata.IncomingToOutgoing(ctx),
+			Conn: primaryConn,
+			Msg:  payload,
+		}, proxy.Hedge{
+			Destination: func() (proxy.Destination, error) {
+				return proxy.Destination{
+					Ctx:  metadata.IncomingToOutgoing(ctx),
+					Conn: hedgeConn,
+					Msg:  payload,
+				}, nil
+			},
+		}, nil, nil), nil
+	}
+
+	client := grpc_testing.NewTestServiceClient(newProxy(t, ctx, director, "grpc.testing.TestService", "StreamingOutputCall"))
+
+	stream, err := client.StreamingOutputCall(ctx, &grpc_testing.StreamingOutputCallRequest{})
+	require.NoError(t, err)
+
+	var bodies []string
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		bodies = append(bodies, string(response.GetPayload().GetBody()))
+	}
+
+	require.Equal(t, []string{"first", "second", "third"}, bodies)
+	require.Equal(t, grpc_metadata.Pairs("hedge-trailer", "value"), stream.Trailer())
+}
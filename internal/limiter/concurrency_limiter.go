@@ -97,6 +97,9 @@ type keyedConcurrencyLimiter struct {
 	// concurrencyTokens is the counting semaphore to control available concurrency tokens, where every token
 	// allows one concurrent call to the concurrency-limited function.
 	concurrencyTokens semaphorer
+	// fairConcurrencyTokens is used instead of concurrencyTokens when per-tenant fairness is enabled. It hands out
+	// the concurrency tokens fairly across the tenants waiting for them.
+	fairConcurrencyTokens *fairSemaphore
 	// queueTokens is the counting semaphore to control available queue tokens, where every token allows one
 	// concurrent call to be admitted to the queue.
 	queueTokens semaphorer
@@ -104,7 +107,7 @@ type keyedConcurrencyLimiter struct {
 
 // acquire tries to acquire the semaphore. It may fail if the admission queue is full or if the max
 // queue-time ticker ticks before acquiring a concurrency token.
-func (sem *keyedConcurrencyLimiter) acquire(ctx context.Context, limitingKey string) (returnedErr error) {
+func (sem *keyedConcurrencyLimiter) acquire(ctx context.Context, limitingKey, tenant string) (returnedErr error) {
 	if sem.queueTokens != nil {
 		// Try to acquire the queueing token. The queueing token is used to control how many
 		// callers may wait for the concurrency token at the same time. If there are no more
@@ -149,15 +152,32 @@ func (sem *keyedConcurrencyLimiter) acquire(ctx context.Context, limitingKey str
 	}
 
 	// Try to acquire the concurrency token now that we're in the queue.
-	return sem.concurrencyTokens.Acquire(ctx)
+	if err := sem.tokens(tenant).Acquire(ctx); err != nil {
+		// The resizable semaphore used for adaptive limits reports the context's error as is.
+		if errors.Is(err, context.DeadlineExceeded) {
+			return ErrMaxQueueTime
+		}
+		return err
+	}
+
+	return nil
 }
 
 // release releases the acquired tokens.
-func (sem *keyedConcurrencyLimiter) release() {
+func (sem *keyedConcurrencyLimiter) release(tenant string) {
 	if sem.queueTokens != nil {
 		sem.queueTokens.Release()
 	}
-	sem.concurrencyTokens.Release()
+	sem.tokens(tenant).Release()
+}
+
+// tokens returns the concurrency tokens to be used by the given tenant. The tenant is ignored unless per-tenant
+// fairness is enabled.
+func (sem *keyedConcurrencyLimiter) tokens(tenant string) semaphorer {
+	if sem.fairConcurrencyTokens != nil {
+		return sem.fairConcurrencyTokens.forTenant(tenant)
+	}
+	return sem.concurrencyTokens
 }
 
 // queueLength returns the length of the queue waiting for tokens.
@@ -165,12 +185,25 @@ func (sem *keyedConcurrencyLimiter) queueLength() int {
 	if sem.queueTokens == nil {
 		return 0
 	}
-	return sem.queueTokens.Count() - sem.concurrencyTokens.Count()
+	return sem.queueTokens.Count() - sem.inProgress()
+}
+
+// resize changes the number of concurrency tokens. Only the tokens of adaptive limiters can be resized.
+func (sem *keyedConcurrencyLimiter) resize(limit int) {
+	if sem.fairConcurrencyTokens != nil {
+		sem.fairConcurrencyTokens.resize(limit)
+		return
+	}
+
+	if tokens, ok := sem.concurrencyTokens.(*resizableSemaphore); ok {
+		tokens.Resize(uint(limit))
+	}
 }
 
 // inProgress returns the number of in-progress tokens.
 func (sem *keyedConcurrencyLimiter) inProgress() int {
-	return sem.concurrencyTokens.Count()
+	// The count of concurrency tokens is shared by all tenants, so it doesn't matter which tenant we ask for.
+	return sem.tokens("").Count()
 }
 
 // ConcurrencyLimiter contains rate limiter state.
@@ -192,26 +225,103 @@ type ConcurrencyLimiter struct {
 	// calls.
 	monitor ConcurrencyMonitor
 
+	// tenantFunc determines the tenant of a call. If set, concurrency tokens are handed out fairly across
+	// tenants instead of in FIFO order.
+	tenantFunc TenantFunc
+	// tenantWeights maps tenants to their weight when handing out concurrency tokens. Tenants without an
+	// explicit weight have a weight of 1.
+	tenantWeights map[string]int
+	// adaptiveLimit is the limit the concurrency limit follows if set. maxConcurrencyLimit is then the
+	// maximum of the adaptive limit.
+	adaptiveLimit AdaptiveLimiter
+
 	m sync.RWMutex
+	// currentLimit is the current value of the adaptive limit. It is updated along with the limits of all
+	// keys whenever the adaptive limit changes.
+	currentLimit int
 	// limitsByKey tracks all concurrency limits per key. Its per-key entries are lazily created
 	// and will get evicted once there are no concurrency-limited calls for any such key
 	// anymore.
 	limitsByKey map[string]*keyedConcurrencyLimiter
 }
 
+// TenantFunc determines the tenant a call is made on behalf of.
+type TenantFunc func(context.Context) string
+
+type tenantKey struct{}
+
+// contextWithTenant injects the tenant into the context.
+func contextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant a concurrency-limited call is made on behalf of. The tenant is only known if
+// per-tenant fairness is enabled for the limiter.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// ConcurrencyLimiterOption is an option for the ConcurrencyLimiter.
+type ConcurrencyLimiterOption func(*ConcurrencyLimiter)
+
+// WithTenantFairness enables per-tenant fairness. Instead of handing out concurrency tokens of a limiting key in FIFO
+// order, every tenant as determined by tenantFunc gets a share of the tokens proportional to its weight. Tenants
+// without an explicit weight have a weight of 1. The tenant of a call is injected into the context passed to the
+// monitor and can be retrieved via TenantFromContext.
+func WithTenantFairness(tenantFunc TenantFunc, weights map[string]int) ConcurrencyLimiterOption {
+	return func(c *ConcurrencyLimiter) {
+		c.tenantFunc = tenantFunc
+		c.tenantWeights = weights
+	}
+}
+
+// WithAdaptiveLimit makes the concurrency limit of every key follow the given adaptive limit instead of being fixed.
+// The limits of all keys are resized whenever the adaptive limit is updated. Calls already holding a concurrency token
+// keep it when the limit shrinks, but no further calls are admitted until the number of calls in progress falls below
+// the new limit. The maxConcurrencyLimit passed to NewConcurrencyLimiter is ignored in favor of the limit's maximum.
+func WithAdaptiveLimit(limit AdaptiveLimiter) ConcurrencyLimiterOption {
+	return func(c *ConcurrencyLimiter) {
+		c.adaptiveLimit = limit
+	}
+}
+
 // NewConcurrencyLimiter creates a new concurrency rate limiter.
-func NewConcurrencyLimiter(maxConcurrencyLimit, maxQueueLength int, maxQueueWait time.Duration, monitor ConcurrencyMonitor) *ConcurrencyLimiter {
+func NewConcurrencyLimiter(maxConcurrencyLimit, maxQueueLength int, maxQueueWait time.Duration, monitor ConcurrencyMonitor, opts ...ConcurrencyLimiterOption) *ConcurrencyLimiter {
 	if monitor == nil {
 		monitor = NewNoopConcurrencyMonitor()
 	}
 
-	return &ConcurrencyLimiter{
+	limiter := &ConcurrencyLimiter{
 		maxConcurrencyLimit: maxConcurrencyLimit,
 		maxQueueLength:      maxQueueLength,
 		maxQueueWait:        maxQueueWait,
 		monitor:             monitor,
 		limitsByKey:         make(map[string]*keyedConcurrencyLimiter),
 	}
+
+	for _, opt := range opts {
+		opt(limiter)
+	}
+
+	if limiter.adaptiveLimit != nil {
+		limiter.maxConcurrencyLimit = limiter.adaptiveLimit.Setting().Max
+		limiter.currentLimit = limiter.adaptiveLimit.Current()
+		limiter.adaptiveLimit.AfterUpdate(limiter.resize)
+	}
+
+	return limiter
+}
+
+// resize updates the concurrency limits of all keys to the new value of the adaptive limit.
+func (c *ConcurrencyLimiter) resize(limit int) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.currentLimit = limit
+	for _, keyedLimiter := range c.limitsByKey {
+		keyedLimiter.resize(limit)
+	}
 }
 
 // Limit will limit the concurrency of the limited function f. There are two distinct mechanisms
@@ -235,12 +345,19 @@ func (c *ConcurrencyLimiter) Limit(ctx context.Context, limitingKey string, f Li
 		return f()
 	}
 
+	var tenant string
+	if c.tenantFunc != nil {
+		tenant = c.tenantFunc(ctx)
+		ctx = contextWithTenant(ctx, tenant)
+		span.SetTag("tenant", tenant)
+	}
+
 	sem := c.getConcurrencyLimit(limitingKey)
 	defer c.putConcurrencyLimit(limitingKey)
 
 	start := time.Now()
 
-	if err := sem.acquire(ctx, limitingKey); err != nil {
+	if err := sem.acquire(ctx, limitingKey, tenant); err != nil {
 		queueTime := time.Since(start)
 		switch err {
 		case ErrMaxQueueSize:
@@ -260,7 +377,7 @@ func (c *ConcurrencyLimiter) Limit(ctx context.Context, limitingKey string, f Li
 			return nil, fmt.Errorf("unexpected error when dequeueing request: %w", err)
 		}
 	}
-	defer sem.release()
+	defer sem.release(tenant)
 
 	c.monitor.Enter(ctx, sem.inProgress(), time.Since(start))
 	defer c.monitor.Exit(ctx)
@@ -282,13 +399,27 @@ func (c *ConcurrencyLimiter) getConcurrencyLimit(limitingKey string) *keyedConcu
 			queueTokens = newStaticSemaphore(c.maxConcurrencyLimit + c.maxQueueLength)
 		}
 
-		c.limitsByKey[limitingKey] = &keyedConcurrencyLimiter{
+		keyedLimiter := &keyedConcurrencyLimiter{
 			monitor:               c.monitor,
 			maxQueueWait:          c.maxQueueWait,
 			setWaitTimeoutContext: c.SetWaitTimeoutContext,
-			concurrencyTokens:     newStaticSemaphore(c.maxConcurrencyLimit),
 			queueTokens:           queueTokens,
 		}
+		limit := c.maxConcurrencyLimit
+		if c.adaptiveLimit != nil {
+			limit = c.currentLimit
+		}
+
+		switch {
+		case c.tenantFunc != nil:
+			keyedLimiter.fairConcurrencyTokens = newFairSemaphore(limit, c.tenantWeights)
+		case c.adaptiveLimit != nil:
+			keyedLimiter.concurrencyTokens = NewResizableSemaphore(uint(limit))
+		default:
+			keyedLimiter.concurrencyTokens = newStaticSemaphore(limit)
+		}
+
+		c.limitsByKey[limitingKey] = keyedLimiter
 	}
 
 	c.limitsByKey[limitingKey].refcount++
@@ -430,3 +430,192 @@ func TestLimitConcurrency_queueWaitTimeRealTimeout(t *testing.T) {
 
 	close(release)
 }
+
+type tenantKeyForTest struct{}
+
+type tenantRecordingCounter struct {
+	counter
+
+	tenantsMu sync.Mutex
+	tenants   []string
+}
+
+func (c *tenantRecordingCounter) Enter(ctx context.Context, inProgress int, acquireTime time.Duration) {
+	c.counter.Enter(ctx, inProgress, acquireTime)
+
+	tenant, _ := TenantFromContext(ctx)
+
+	c.tenantsMu.Lock()
+	defer c.tenantsMu.Unlock()
+	c.tenants = append(c.tenants, tenant)
+}
+
+func TestConcurrencyLimiter_tenantFairness(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	monitor := &tenantRecordingCounter{}
+	limiter := NewConcurrencyLimiter(1, 10, 0, monitor, WithTenantFairness(func(ctx context.Context) string {
+		tenant, _ := ctx.Value(tenantKeyForTest{}).(string)
+		return tenant
+	}, nil))
+
+	// waitForWaiters blocks until the given number of calls is waiting for a concurrency token.
+	waitForWaiters := func(t *testing.T, expected int) {
+		require.Eventually(t, func() bool {
+			limiter.m.RLock()
+			defer limiter.m.RUnlock()
+
+			keyed := limiter.limitsByKey["key"]
+			if keyed == nil {
+				return false
+			}
+
+			keyed.fairConcurrencyTokens.mu.Lock()
+			defer keyed.fairConcurrencyTokens.mu.Unlock()
+
+			var waiters int
+			for _, tenantWaiters := range keyed.fairConcurrencyTokens.waiters {
+				waiters += len(tenantWaiters)
+			}
+			return waiters == expected
+		}, 10*time.Second, time.Millisecond)
+	}
+
+	heavyCtx := context.WithValue(ctx, tenantKeyForTest{}, "heavy")
+	lightCtx := context.WithValue(ctx, tenantKeyForTest{}, "light")
+
+	// Occupy the only concurrency token on behalf of the heavy tenant.
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := limiter.Limit(heavyCtx, "key", func() (interface{}, error) {
+			close(entered)
+			<-release
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	}()
+	<-entered
+
+	// Queue up calls of the heavy tenant before the light tenant queues a single call.
+	for i, tenantCtx := range []context.Context{heavyCtx, heavyCtx, heavyCtx, lightCtx} {
+		tenantCtx := tenantCtx
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := limiter.Limit(tenantCtx, "key", func() (interface{}, error) {
+				return nil, nil
+			})
+			assert.NoError(t, err)
+		}()
+		waitForWaiters(t, i+1)
+	}
+
+	close(release)
+	wg.Wait()
+
+	// The light tenant overtakes the queued calls of the heavy tenant.
+	require.Equal(t, []string{"heavy", "light", "heavy", "heavy", "heavy"}, monitor.tenants)
+	require.Equal(t, 0, limiter.countSemaphores())
+}
+
+func TestConcurrencyLimiter_adaptiveLimit(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		opts []ConcurrencyLimiterOption
+	}{
+		{
+			desc: "without tenant fairness",
+		},
+		{
+			desc: "with tenant fairness",
+			opts: []ConcurrencyLimiterOption{
+				WithTenantFairness(func(context.Context) string { return "tenant" }, nil),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+			monitor := &counter{}
+			adaptiveLimit := NewAdaptiveLimit("limit", AdaptiveSetting{Initial: 2, Max: 3, Min: 1, BackoffFactor: 0.5})
+			limiter := NewConcurrencyLimiter(0, 0, 0, monitor, append(tc.opts, WithAdaptiveLimit(adaptiveLimit))...)
+
+			entered := func() int {
+				monitor.Lock()
+				defer monitor.Unlock()
+				return monitor.enter
+			}
+
+			var wg sync.WaitGroup
+			defer wg.Wait()
+
+			// call starts a call that holds its concurrency token until the returned channel is closed.
+			call := func() chan struct{} {
+				release := make(chan struct{})
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := limiter.Limit(ctx, "key", func() (interface{}, error) {
+						<-release
+						return nil, nil
+					})
+					assert.NoError(t, err)
+				}()
+
+				return release
+			}
+
+			waitForEntered := func(expected int) {
+				require.Eventually(t, func() bool { return entered() == expected }, 10*time.Second, time.Millisecond)
+			}
+
+			waitForQueued := func(expected int) {
+				require.Eventually(t, func() bool {
+					monitor.Lock()
+					defer monitor.Unlock()
+					return monitor.queued == expected
+				}, 10*time.Second, time.Millisecond)
+			}
+
+			// Only two of the calls fit into the initial limit.
+			releases := []chan struct{}{call(), call(), call()}
+			waitForQueued(3)
+			waitForEntered(2)
+
+			// Raising the limit admits the waiting call.
+			adaptiveLimit.Update(3)
+			waitForEntered(3)
+
+			// Lowering the limit keeps the calls in progress, but no further call is admitted until the
+			// number of calls in progress falls below the new limit.
+			adaptiveLimit.Update(1)
+			releases = append(releases, call())
+			waitForQueued(4)
+
+			close(releases[0])
+			close(releases[1])
+			require.Eventually(t, func() bool {
+				monitor.Lock()
+				defer monitor.Unlock()
+				return monitor.exit == 2
+			}, 10*time.Second, time.Millisecond)
+			require.Equal(t, 3, entered())
+
+			close(releases[2])
+			waitForEntered(4)
+			close(releases[3])
+		})
+	}
+}
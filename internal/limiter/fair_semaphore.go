@@ -0,0 +1,265 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// fairSemaphore is a counting semaphore that hands out its slots fairly across tenants. Every tenant has its own FIFO
+// queue of waiters. The semaphore implements start-time fair queueing: every tenant has a virtual time that advances
+// by 1/weight whenever the tenant is handed a slot, and a released slot is handed to the waiting tenant with the
+// smallest virtual time. Ties are broken in favor of the tenant whose first waiter has been queued the longest. A
+// single tenant may thus still use all slots as long as nobody else is waiting, but as soon as others queue up the
+// slots are spread across tenants according to their weights.
+//
+// The semaphore itself doesn't know about the tenant of a call. Callers get a tenant-specific view of it via
+// `forTenant()`, which implements the semaphorer interface.
+type fairSemaphore struct {
+	mu sync.Mutex
+	// size is the maximum number of slots that may be held at the same time.
+	size int
+	// current is the number of slots currently held.
+	current int
+	// weights maps tenants to their weights. Tenants not contained in the map have a weight of 1.
+	weights map[string]int
+	// inProgress tracks the number of slots held per tenant.
+	inProgress map[string]int
+	// virtualTimes tracks the virtual time per active tenant. Tenants are active as long as they hold a slot or
+	// wait for one.
+	virtualTimes map[string]float64
+	// virtualTime is the virtual time of the semaphore, which is the start time of the most recent grant. Tenants
+	// becoming active start at this time so that they can't build up credit while being idle.
+	virtualTime float64
+	// waiters tracks the FIFO queue of waiters per tenant.
+	waiters map[string][]*fairWaiter
+	// sequence is a monotonically increasing counter used to order waiters across tenants.
+	sequence uint64
+}
+
+// fairWaiter is a caller waiting for a slot of the fairSemaphore. Its ready channel is closed as soon as a slot has
+// been handed to it.
+type fairWaiter struct {
+	sequence uint64
+	ready    chan struct{}
+	granted  bool
+}
+
+// newFairSemaphore creates a new fairSemaphore with the given number of slots and per-tenant weights.
+func newFairSemaphore(size int, weights map[string]int) *fairSemaphore {
+	return &fairSemaphore{
+		size:         size,
+		weights:      weights,
+		inProgress:   make(map[string]int),
+		virtualTimes: make(map[string]float64),
+		waiters:      make(map[string][]*fairWaiter),
+	}
+}
+
+// forTenant returns a view of the semaphore that acquires and releases slots on behalf of the given tenant.
+func (s *fairSemaphore) forTenant(tenant string) semaphorer {
+	return &tenantSemaphore{fairSemaphore: s, tenant: tenant}
+}
+
+// acquire acquires a slot for the tenant. The caller is blocked until a slot has been handed to it or until the
+// context is cancelled.
+func (s *fairSemaphore) acquire(ctx context.Context, tenant string) error {
+	s.mu.Lock()
+	if s.current < s.size {
+		s.grantLocked(tenant)
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.sequence++
+	w := &fairWaiter{sequence: s.sequence, ready: make(chan struct{})}
+	s.waiters[tenant] = append(s.waiters[tenant], w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		if w.granted {
+			// We have been handed a slot concurrently to the context being cancelled. As we report an error to
+			// the caller we need to hand the slot on to the next waiter.
+			s.releaseLocked(tenant)
+		} else {
+			s.removeWaiterLocked(tenant, w)
+		}
+		s.mu.Unlock()
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return ErrMaxQueueTime
+		}
+		return ctx.Err()
+	}
+}
+
+// tryAcquire acquires a slot for the tenant if one is available. Otherwise, it returns ErrMaxQueueSize.
+func (s *fairSemaphore) tryAcquire(tenant string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current >= s.size {
+		return ErrMaxQueueSize
+	}
+
+	s.grantLocked(tenant)
+	return nil
+}
+
+// release releases a slot held by the tenant and hands it to the next waiter, if any.
+func (s *fairSemaphore) release(tenant string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.releaseLocked(tenant)
+}
+
+// count returns the number of slots currently held.
+func (s *fairSemaphore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.current
+}
+
+func (s *fairSemaphore) grantLocked(tenant string) {
+	s.current++
+	s.inProgress[tenant]++
+
+	start := s.tenantVirtualTimeLocked(tenant)
+	s.virtualTimes[tenant] = start + 1/float64(s.weight(tenant))
+	s.virtualTime = start
+}
+
+func (s *fairSemaphore) releaseLocked(tenant string) {
+	s.current--
+	if s.inProgress[tenant]--; s.inProgress[tenant] <= 0 {
+		delete(s.inProgress, tenant)
+		s.forgetIfIdleLocked(tenant)
+	}
+
+	s.notifyWaitersLocked()
+}
+
+// resize changes the number of slots that may be held at the same time. If the semaphore shrinks below the number of
+// slots currently held, the holders keep their slots but no further slots are handed out until enough of them have
+// been released.
+func (s *fairSemaphore) resize(size int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.size = size
+	s.notifyWaitersLocked()
+}
+
+// notifyWaitersLocked hands the free slots to the waiters.
+func (s *fairSemaphore) notifyWaitersLocked() {
+	for s.current < s.size {
+		next, ok := s.nextTenantLocked()
+		if !ok {
+			return
+		}
+
+		// Grant the slot before removing the waiter so that the tenant's state isn't forgotten in case this was
+		// its last waiter.
+		w := s.waiters[next][0]
+		s.grantLocked(next)
+		s.removeWaiterLocked(next, w)
+		w.granted = true
+		close(w.ready)
+	}
+}
+
+// nextTenantLocked picks the waiting tenant which should be handed the next slot.
+func (s *fairSemaphore) nextTenantLocked() (string, bool) {
+	var next string
+	var found bool
+
+	for tenant, waiters := range s.waiters {
+		if !found {
+			next, found = tenant, true
+			continue
+		}
+
+		candidateTime := s.tenantVirtualTimeLocked(tenant)
+		nextTime := s.tenantVirtualTimeLocked(next)
+
+		if candidateTime < nextTime || (candidateTime == nextTime && waiters[0].sequence < s.waiters[next][0].sequence) {
+			next = tenant
+		}
+	}
+
+	return next, found
+}
+
+func (s *fairSemaphore) removeWaiterLocked(tenant string, w *fairWaiter) {
+	waiters := s.waiters[tenant]
+	for i, candidate := range waiters {
+		if candidate == w {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+
+	if len(waiters) == 0 {
+		delete(s.waiters, tenant)
+		s.forgetIfIdleLocked(tenant)
+		return
+	}
+	s.waiters[tenant] = waiters
+}
+
+// tenantVirtualTimeLocked returns the virtual time of the tenant. Tenants which have fallen behind the virtual time of
+// the semaphore, which includes tenants that have just become active, are moved up to it.
+func (s *fairSemaphore) tenantVirtualTimeLocked(tenant string) float64 {
+	if virtualTime := s.virtualTimes[tenant]; virtualTime > s.virtualTime {
+		return virtualTime
+	}
+	return s.virtualTime
+}
+
+// forgetIfIdleLocked drops the state kept for the tenant if it neither holds nor waits for a slot anymore.
+func (s *fairSemaphore) forgetIfIdleLocked(tenant string) {
+	if s.inProgress[tenant] > 0 || len(s.waiters[tenant]) > 0 {
+		return
+	}
+	delete(s.virtualTimes, tenant)
+}
+
+func (s *fairSemaphore) weight(tenant string) int {
+	if weight, ok := s.weights[tenant]; ok && weight > 0 {
+		return weight
+	}
+	return 1
+}
+
+// tenantSemaphore implements the semaphorer interface on top of a fairSemaphore for a single tenant. Count returns the
+// number of slots held across all tenants.
+type tenantSemaphore struct {
+	*fairSemaphore
+	tenant string
+}
+
+// Acquire acquires a slot for the tenant.
+func (s *tenantSemaphore) Acquire(ctx context.Context) error {
+	return s.acquire(ctx, s.tenant)
+}
+
+// TryAcquire tries to acquire a slot for the tenant without blocking.
+func (s *tenantSemaphore) TryAcquire() error {
+	return s.tryAcquire(s.tenant)
+}
+
+// Release releases a slot held by the tenant.
+func (s *tenantSemaphore) Release() {
+	s.release(s.tenant)
+}
+
+// Count returns the number of slots currently held across all tenants.
+func (s *tenantSemaphore) Count() int {
+	return s.count()
+}
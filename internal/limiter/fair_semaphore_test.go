@@ -0,0 +1,151 @@
+package limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestFairSemaphore(t *testing.T) {
+	t.Parallel()
+
+	// waitForWaiters blocks until the given number of waiters is queued across all tenants.
+	waitForWaiters := func(t *testing.T, s *fairSemaphore, expected int) {
+		require.Eventually(t, func() bool {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			var waiters int
+			for _, tenantWaiters := range s.waiters {
+				waiters += len(tenantWaiters)
+			}
+			return waiters == expected
+		}, 10*time.Second, time.Millisecond)
+	}
+
+	// enqueue starts to acquire the semaphore on behalf of the tenant in a separate goroutine. The tenant is
+	// written to the returned channel once the semaphore has been acquired.
+	enqueue := func(t *testing.T, ctx context.Context, s *fairSemaphore, tenant string, acquired chan<- string) {
+		go func() {
+			if err := s.forTenant(tenant).Acquire(ctx); err == nil {
+				acquired <- tenant
+			}
+		}()
+	}
+
+	t.Run("acquires without waiting when slots are available", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+		s := newFairSemaphore(2, nil)
+
+		require.NoError(t, s.forTenant("a").Acquire(ctx))
+		require.NoError(t, s.forTenant("a").TryAcquire())
+		require.Equal(t, 2, s.forTenant("b").Count())
+		require.Equal(t, ErrMaxQueueSize, s.forTenant("b").TryAcquire())
+
+		s.forTenant("a").Release()
+		require.Equal(t, 1, s.forTenant("b").Count())
+		require.NoError(t, s.forTenant("b").TryAcquire())
+	})
+
+	t.Run("hands out slots round-robin across tenants", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+		s := newFairSemaphore(1, nil)
+		require.NoError(t, s.forTenant("heavy").Acquire(ctx))
+
+		acquired := make(chan string)
+
+		// The heavy tenant queues up lots of calls before the light tenant queues up a single one. As FIFO
+		// ordering would hand all slots to the heavy tenant first, we expect the light tenant to overtake.
+		for i := 0; i < 3; i++ {
+			enqueue(t, ctx, s, "heavy", acquired)
+			waitForWaiters(t, s, i+1)
+		}
+		enqueue(t, ctx, s, "light", acquired)
+		waitForWaiters(t, s, 4)
+
+		var order []string
+		tenant := "heavy"
+		for i := 0; i < 4; i++ {
+			s.forTenant(tenant).Release()
+			tenant = <-acquired
+			order = append(order, tenant)
+		}
+		s.forTenant(tenant).Release()
+
+		require.Equal(t, []string{"light", "heavy", "heavy", "heavy"}, order)
+		require.Equal(t, 0, s.count())
+	})
+
+	t.Run("hands out slots according to weights", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+		s := newFairSemaphore(4, map[string]int{"important": 3})
+
+		// Fill up all slots by tenants with waiters so that every released slot gets handed out anew.
+		for i := 0; i < 4; i++ {
+			require.NoError(t, s.forTenant("filler").Acquire(ctx))
+		}
+
+		acquired := make(chan string)
+		for i := 0; i < 4; i++ {
+			enqueue(t, ctx, s, "important", acquired)
+			waitForWaiters(t, s, 2*i+1)
+			enqueue(t, ctx, s, "regular", acquired)
+			waitForWaiters(t, s, 2*i+2)
+		}
+
+		counts := map[string]int{}
+		for i := 0; i < 4; i++ {
+			s.forTenant("filler").Release()
+			counts[<-acquired]++
+		}
+
+		// With a weight of 3, the important tenant gets three times as many slots as the regular one.
+		require.Equal(t, map[string]int{"important": 3, "regular": 1}, counts)
+	})
+
+	t.Run("cancelled waiter is removed from the queue", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+		s := newFairSemaphore(1, nil)
+		require.NoError(t, s.forTenant("a").Acquire(ctx))
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		errCh := make(chan error)
+		go func() {
+			errCh <- s.forTenant("b").Acquire(cancelledCtx)
+		}()
+		waitForWaiters(t, s, 1)
+
+		cancel()
+		require.Equal(t, context.Canceled, <-errCh)
+		waitForWaiters(t, s, 0)
+
+		s.forTenant("a").Release()
+		require.Equal(t, 0, s.count())
+		require.NoError(t, s.forTenant("c").TryAcquire())
+	})
+
+	t.Run("deadline exceeded returns max queue time error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+		s := newFairSemaphore(1, nil)
+		require.NoError(t, s.forTenant("a").Acquire(ctx))
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
+		defer cancel()
+
+		require.Equal(t, ErrMaxQueueTime, s.forTenant("b").Acquire(timeoutCtx))
+		require.Equal(t, 1, s.count())
+	})
+}
@@ -114,6 +114,73 @@ func (p *PromMonitor) Dropped(ctx context.Context, key string, queueLength int,
 	p.requestsDroppedMetric.WithLabelValues(reason).Inc()
 }
 
+// tenantPromMonitor wraps a ConcurrencyMonitor and additionally tracks queued and dropped calls per tenant.
+type tenantPromMonitor struct {
+	ConcurrencyMonitor
+	queuedMetric          *prometheus.GaugeVec
+	requestsDroppedMetric *prometheus.CounterVec
+	tenantLabel           func(string) string
+}
+
+// NewPerTenantPromMonitor wraps the given monitor so that queued and dropped calls are additionally tracked per
+// tenant. The tenant is retrieved from the context via TenantFromContext, so this monitor is only useful for limiters
+// with per-tenant fairness enabled. The tenantLabel function maps tenants to the value of the "tenant" label, which
+// allows the caller to bound the cardinality of the metrics.
+func NewPerTenantPromMonitor(
+	monitor ConcurrencyMonitor,
+	system, fullMethod string,
+	queuedMetric *prometheus.GaugeVec,
+	requestsDroppedMetric *prometheus.CounterVec,
+	tenantLabel func(string) string,
+) ConcurrencyMonitor {
+	serviceName, methodName := splitMethodName(fullMethod)
+	labels := prometheus.Labels{
+		"system":       system,
+		"grpc_service": serviceName,
+		"grpc_method":  methodName,
+	}
+
+	return &tenantPromMonitor{
+		ConcurrencyMonitor:    monitor,
+		queuedMetric:          queuedMetric.MustCurryWith(labels),
+		requestsDroppedMetric: requestsDroppedMetric.MustCurryWith(labels),
+		tenantLabel:           tenantLabel,
+	}
+}
+
+// Queued is called when a request has been queued.
+func (p *tenantPromMonitor) Queued(ctx context.Context, key string, queueLength int) {
+	p.ConcurrencyMonitor.Queued(ctx, key, queueLength)
+
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if stats := log.CustomFieldsFromContext(ctx); stats != nil {
+		stats.RecordMetadata("limit.tenant", tenant)
+	}
+	p.queuedMetric.WithLabelValues(p.tenantLabel(tenant)).Inc()
+}
+
+// Dequeued is called when a request has been dequeued.
+func (p *tenantPromMonitor) Dequeued(ctx context.Context) {
+	p.ConcurrencyMonitor.Dequeued(ctx)
+
+	if tenant, ok := TenantFromContext(ctx); ok {
+		p.queuedMetric.WithLabelValues(p.tenantLabel(tenant)).Dec()
+	}
+}
+
+// Dropped is called when a request is dropped.
+func (p *tenantPromMonitor) Dropped(ctx context.Context, key string, queueLength int, inProgress int, acquireTime time.Duration, reason string) {
+	p.ConcurrencyMonitor.Dropped(ctx, key, queueLength, inProgress, acquireTime, reason)
+
+	if tenant, ok := TenantFromContext(ctx); ok {
+		p.requestsDroppedMetric.WithLabelValues(p.tenantLabel(tenant), reason).Inc()
+	}
+}
+
 func newPromMonitor(
 	limitingType string,
 	queuedVec, inProgressVec prometheus.Gauge,
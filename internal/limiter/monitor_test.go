@@ -426,3 +426,59 @@ gitaly_pack_objects_queued 0
 		}, stats.Fields())
 	})
 }
+
+func TestNewPerTenantPromMonitor(t *testing.T) {
+	t.Parallel()
+
+	queuedMetric := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_queued",
+			Help: "number of queued requests per tenant",
+		},
+		[]string{"system", "grpc_service", "grpc_method", "tenant"},
+	)
+	droppedMetric := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_dropped",
+			Help: "number of dropped requests per tenant",
+		},
+		[]string{"system", "grpc_service", "grpc_method", "tenant", "reason"},
+	)
+
+	monitor := NewPerTenantPromMonitor(
+		NewNoopConcurrencyMonitor(), "gitaly", "/gitaly.RepositoryService/FetchRemote",
+		queuedMetric, droppedMetric,
+		func(tenant string) string {
+			if tenant == "gitlab-web" {
+				return tenant
+			}
+			return "other"
+		},
+	)
+
+	ctx := log.InitContextCustomFields(testhelper.Context(t))
+	webCtx := contextWithTenant(ctx, "gitlab-web")
+	ciCtx := contextWithTenant(ctx, "gitlab-ci")
+
+	monitor.Queued(webCtx, "key", 1)
+	monitor.Queued(webCtx, "key", 2)
+	monitor.Queued(ciCtx, "key", 3)
+	monitor.Dequeued(webCtx)
+	monitor.Dropped(ciCtx, "key", 3, 1, time.Second, "max_time")
+
+	// Calls without a tenant are not tracked.
+	monitor.Queued(ctx, "key", 4)
+	monitor.Dropped(ctx, "key", 4, 1, time.Second, "max_size")
+
+	require.NoError(t, testutil.CollectAndCompare(queuedMetric, bytes.NewBufferString(`# HELP tenant_queued number of queued requests per tenant
+# TYPE tenant_queued gauge
+tenant_queued{grpc_method="FetchRemote",grpc_service="gitaly.RepositoryService",system="gitaly",tenant="gitlab-web"} 1
+tenant_queued{grpc_method="FetchRemote",grpc_service="gitaly.RepositoryService",system="gitaly",tenant="other"} 1
+`)))
+	require.NoError(t, testutil.CollectAndCompare(droppedMetric, bytes.NewBufferString(`# HELP tenant_dropped number of dropped requests per tenant
+# TYPE tenant_dropped counter
+tenant_dropped{grpc_method="FetchRemote",grpc_service="gitaly.RepositoryService",reason="max_time",system="gitaly",tenant="other"} 1
+`)))
+
+	require.Equal(t, "gitlab-ci", log.CustomFieldsFromContext(ctx).Fields()["limit.tenant"])
+}
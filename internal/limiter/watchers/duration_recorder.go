@@ -0,0 +1,86 @@
+package watchers
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+)
+
+const (
+	// maxRecordedDurations bounds the number of durations kept between two polls. When more durations are
+	// observed, a uniformly distributed sample of them is kept.
+	maxRecordedDurations = 1024
+	// minimumObservations is the number of durations that must be observed between two polls before a watcher
+	// considers to back off. Percentiles of a handful of observations are dominated by outliers.
+	minimumObservations = 20
+	// durationPercentile is the percentile of the observed durations that is compared with the thresholds.
+	durationPercentile = 0.99
+)
+
+// durationRecorder records the durations observed between two polls of a watcher. It is safe for concurrent use.
+type durationRecorder struct {
+	mu        sync.Mutex
+	observed  int
+	durations []time.Duration
+}
+
+// observe records a duration. Once maxRecordedDurations durations are recorded, reservoir sampling is used to
+// decide whether the duration replaces a recorded one.
+func (r *durationRecorder) observe(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.observed++
+	if len(r.durations) < maxRecordedDurations {
+		r.durations = append(r.durations, d)
+		return
+	}
+
+	if i := rand.Intn(r.observed); i < maxRecordedDurations {
+		r.durations[i] = d
+	}
+}
+
+// flush returns the durationPercentile of the durations recorded since the last flush along with the number of
+// observed durations, and resets the recorder.
+func (r *durationRecorder) flush() (time.Duration, int) {
+	r.mu.Lock()
+	durations, observed := r.durations, r.observed
+	r.durations, r.observed = nil, 0
+	r.mu.Unlock()
+
+	if len(durations) == 0 {
+		return 0, 0
+	}
+
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+
+	index := int(math.Ceil(durationPercentile*float64(len(durations)))) - 1
+	if index < 0 {
+		index = 0
+	}
+
+	return durations[index], observed
+}
+
+// poll flushes the recorder and returns a backoff event if the percentile of the recorded durations exceeds
+// threshold. signal describes the durations in the reason of the event.
+func (r *durationRecorder) poll(watcherName, signal string, threshold time.Duration) *limiter.BackoffEvent {
+	percentile, observed := r.flush()
+	if observed < minimumObservations || percentile <= threshold {
+		return &limiter.BackoffEvent{WatcherName: watcherName, ShouldBackoff: false}
+	}
+
+	return &limiter.BackoffEvent{
+		WatcherName:   watcherName,
+		ShouldBackoff: true,
+		Reason:        fmt.Sprintf("p99 %s exceeds threshold: %s/%s", signal, percentile, threshold),
+	}
+}
@@ -0,0 +1,76 @@
+package watchers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+)
+
+func TestDurationRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		var recorder durationRecorder
+		percentile, observed := recorder.flush()
+		require.Zero(t, percentile)
+		require.Zero(t, observed)
+	})
+
+	t.Run("percentile", func(t *testing.T) {
+		t.Parallel()
+
+		var recorder durationRecorder
+		for i := 100; i > 0; i-- {
+			recorder.observe(time.Duration(i) * time.Millisecond)
+		}
+
+		percentile, observed := recorder.flush()
+		require.Equal(t, 99*time.Millisecond, percentile)
+		require.Equal(t, 100, observed)
+
+		// Flushing resets the recorder.
+		percentile, observed = recorder.flush()
+		require.Zero(t, percentile)
+		require.Zero(t, observed)
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		t.Parallel()
+
+		var recorder durationRecorder
+		for i := 0; i < 10*maxRecordedDurations; i++ {
+			recorder.observe(time.Second)
+		}
+		require.Len(t, recorder.durations, maxRecordedDurations)
+
+		percentile, observed := recorder.flush()
+		require.Equal(t, time.Second, percentile)
+		require.Equal(t, 10*maxRecordedDurations, observed)
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		t.Parallel()
+
+		var recorder durationRecorder
+		for i := 0; i < minimumObservations-1; i++ {
+			recorder.observe(time.Second)
+		}
+		require.Equal(t, &limiter.BackoffEvent{
+			WatcherName:   "Test",
+			ShouldBackoff: false,
+		}, recorder.poll("Test", "test", time.Millisecond), "too few observations")
+
+		for i := 0; i < minimumObservations; i++ {
+			recorder.observe(time.Second)
+		}
+		require.Equal(t, &limiter.BackoffEvent{
+			WatcherName:   "Test",
+			ShouldBackoff: true,
+			Reason:        "p99 test exceeds threshold: 1s/1ms",
+		}, recorder.poll("Test", "test", time.Millisecond))
+	})
+}
@@ -0,0 +1,96 @@
+package watchers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+	"google.golang.org/grpc"
+)
+
+const latencyWatcherName = "Latency"
+
+// LatencyWatcher implements ResourceWatcher interface for watching the latency of RPCs. As the latencies of different
+// RPCs vary by orders of magnitude, each RPC is compared with its own threshold and RPCs without a threshold are not
+// watched. The latencies are collected by the interceptors returned by UnaryServerInterceptor and
+// StreamServerInterceptor. If the 99th percentile of the latencies of any RPC observed since the last poll exceeds
+// its threshold, it returns a backoff event.
+type LatencyWatcher struct {
+	thresholds map[string]time.Duration
+	recorders  map[string]*durationRecorder
+}
+
+// NewLatencyWatcher is the initializer of LatencyWatcher. thresholds maps full method names of RPCs, for example
+// "/gitaly.CommitService/FindCommit", to their latency threshold.
+func NewLatencyWatcher(thresholds map[string]time.Duration) *LatencyWatcher {
+	recorders := make(map[string]*durationRecorder, len(thresholds))
+	for fullMethod := range thresholds {
+		recorders[fullMethod] = &durationRecorder{}
+	}
+
+	return &LatencyWatcher{
+		thresholds: thresholds,
+		recorders:  recorders,
+	}
+}
+
+// Name returns the name of LatencyWatcher
+func (w *LatencyWatcher) Name() string {
+	return latencyWatcherName
+}
+
+// Observe records the latency of an RPC. Latencies of RPCs without a threshold are discarded.
+func (w *LatencyWatcher) Observe(fullMethod string, latency time.Duration) {
+	if recorder, ok := w.recorders[fullMethod]; ok {
+		recorder.observe(latency)
+	}
+}
+
+// UnaryServerInterceptor returns a unary interceptor that records the latency of RPCs.
+func (w *LatencyWatcher) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		defer func() {
+			w.Observe(info.FullMethod, time.Since(start))
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor that records the latency of RPCs.
+func (w *LatencyWatcher) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		defer func() {
+			w.Observe(info.FullMethod, time.Since(start))
+		}()
+
+		return handler(srv, stream)
+	}
+}
+
+// Poll returns a backoff event if the 99th percentile of the latencies of any RPC observed since the last poll
+// exceeds its threshold.
+func (w *LatencyWatcher) Poll(context.Context) (*limiter.BackoffEvent, error) {
+	fullMethods := make([]string, 0, len(w.recorders))
+	for fullMethod := range w.recorders {
+		fullMethods = append(fullMethods, fullMethod)
+	}
+	sort.Strings(fullMethods)
+
+	// All recorders are flushed so that stale latencies don't leak into the next poll.
+	var backoffEvent *limiter.BackoffEvent
+	for _, fullMethod := range fullMethods {
+		event := w.recorders[fullMethod].poll(w.Name(), fullMethod+" latency", w.thresholds[fullMethod])
+		if event.ShouldBackoff && backoffEvent == nil {
+			backoffEvent = event
+		}
+	}
+
+	if backoffEvent != nil {
+		return backoffEvent, nil
+	}
+	return &limiter.BackoffEvent{WatcherName: w.Name(), ShouldBackoff: false}, nil
+}
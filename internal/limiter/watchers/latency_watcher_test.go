@@ -0,0 +1,134 @@
+package watchers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"google.golang.org/grpc"
+)
+
+func TestLatencyWatcher_Name(t *testing.T) {
+	t.Parallel()
+
+	watcher := NewLatencyWatcher(nil)
+	require.Equal(t, latencyWatcherName, watcher.Name())
+}
+
+func TestLatencyWatcher_Poll(t *testing.T) {
+	t.Parallel()
+
+	const (
+		findCommit = "/gitaly.CommitService/FindCommit"
+		listRefs   = "/gitaly.RefService/ListRefs"
+		postUpload = "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel"
+	)
+
+	for _, tc := range []struct {
+		desc          string
+		latencies     map[string][]time.Duration
+		expectedEvent *limiter.BackoffEvent
+	}{
+		{
+			desc: "no requests",
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   latencyWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc: "latencies below thresholds",
+			latencies: map[string][]time.Duration{
+				findCommit: repeatDuration(10*time.Millisecond, 100),
+				listRefs:   repeatDuration(500*time.Millisecond, 100),
+			},
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   latencyWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc: "unwatched RPC is slow",
+			latencies: map[string][]time.Duration{
+				postUpload: repeatDuration(time.Minute, 100),
+			},
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   latencyWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc: "one RPC above its threshold",
+			latencies: map[string][]time.Duration{
+				findCommit: repeatDuration(10*time.Millisecond, 100),
+				listRefs:   repeatDuration(2*time.Second, 100),
+			},
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   latencyWatcherName,
+				ShouldBackoff: true,
+				Reason:        "p99 /gitaly.RefService/ListRefs latency exceeds threshold: 2s/1s",
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			watcher := NewLatencyWatcher(map[string]time.Duration{
+				findCommit: 100 * time.Millisecond,
+				listRefs:   time.Second,
+			})
+
+			for fullMethod, latencies := range tc.latencies {
+				for _, latency := range latencies {
+					watcher.Observe(fullMethod, latency)
+				}
+			}
+
+			event, err := watcher.Poll(testhelper.Context(t))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedEvent, event)
+		})
+	}
+}
+
+func TestLatencyWatcher_interceptors(t *testing.T) {
+	t.Parallel()
+
+	const (
+		unaryMethod  = "/gitaly.CommitService/FindCommit"
+		streamMethod = "/gitaly.RefService/ListRefs"
+	)
+
+	ctx := testhelper.Context(t)
+	watcher := NewLatencyWatcher(map[string]time.Duration{
+		unaryMethod:  time.Nanosecond,
+		streamMethod: time.Nanosecond,
+	})
+
+	unary := watcher.UnaryServerInterceptor()
+	stream := watcher.StreamServerInterceptor()
+	for i := 0; i < minimumObservations; i++ {
+		_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: unaryMethod}, func(context.Context, interface{}) (interface{}, error) {
+			time.Sleep(time.Microsecond)
+			return nil, nil
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, stream(nil, nil, &grpc.StreamServerInfo{FullMethod: streamMethod}, func(interface{}, grpc.ServerStream) error {
+			time.Sleep(time.Microsecond)
+			return nil
+		}))
+	}
+
+	require.Equal(t, minimumObservations, len(watcher.recorders[unaryMethod].durations))
+	require.Equal(t, minimumObservations, len(watcher.recorders[streamMethod].durations))
+
+	event, err := watcher.Poll(ctx)
+	require.NoError(t, err)
+	require.True(t, event.ShouldBackoff)
+}
@@ -0,0 +1,63 @@
+package watchers
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+)
+
+const queueTimeWatcherName = "QueueTime"
+
+// QueueTimeWatcher implements ResourceWatcher interface for watching the time requests wait in the queues of
+// concurrency limiters. The wait times are collected by the ConcurrencyMonitor returned by Monitor. If the 99th
+// percentile of the wait times observed since the last poll exceeds the threshold, it returns a backoff event. Long
+// queues are an early sign of a saturated node, regardless of whether cgroups are configured.
+type QueueTimeWatcher struct {
+	threshold time.Duration
+	recorder  durationRecorder
+}
+
+// NewQueueTimeWatcher is the initializer of QueueTimeWatcher
+func NewQueueTimeWatcher(threshold time.Duration) *QueueTimeWatcher {
+	return &QueueTimeWatcher{
+		threshold: threshold,
+	}
+}
+
+// Name returns the name of QueueTimeWatcher
+func (w *QueueTimeWatcher) Name() string {
+	return queueTimeWatcherName
+}
+
+// Monitor wraps the given ConcurrencyMonitor such that the time requests waited in the queue of the limiter is
+// recorded by the watcher. All calls are passed on to the wrapped monitor.
+func (w *QueueTimeWatcher) Monitor(monitor limiter.ConcurrencyMonitor) limiter.ConcurrencyMonitor {
+	return &queueTimeMonitor{
+		ConcurrencyMonitor: monitor,
+		recorder:           &w.recorder,
+	}
+}
+
+// Poll returns a backoff event if the 99th percentile of the queue times observed since the last poll exceeds the
+// threshold.
+func (w *QueueTimeWatcher) Poll(context.Context) (*limiter.BackoffEvent, error) {
+	return w.recorder.poll(w.Name(), "queue time", w.threshold), nil
+}
+
+type queueTimeMonitor struct {
+	limiter.ConcurrencyMonitor
+	recorder *durationRecorder
+}
+
+// Enter records the time the request waited before it is passed on to the wrapped monitor.
+func (m *queueTimeMonitor) Enter(ctx context.Context, inProgress int, acquireTime time.Duration) {
+	m.recorder.observe(acquireTime)
+	m.ConcurrencyMonitor.Enter(ctx, inProgress, acquireTime)
+}
+
+// Dropped records the time the request waited before it is passed on to the wrapped monitor.
+func (m *queueTimeMonitor) Dropped(ctx context.Context, key string, queueLength int, inProgress int, acquireTime time.Duration, message string) {
+	m.recorder.observe(acquireTime)
+	m.ConcurrencyMonitor.Dropped(ctx, key, queueLength, inProgress, acquireTime, message)
+}
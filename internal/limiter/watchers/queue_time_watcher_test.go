@@ -0,0 +1,123 @@
+package watchers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+type countingMonitor struct {
+	limiter.ConcurrencyMonitor
+	entered, dropped int
+}
+
+func (m *countingMonitor) Enter(ctx context.Context, inProgress int, acquireTime time.Duration) {
+	m.entered++
+}
+
+func (m *countingMonitor) Dropped(ctx context.Context, key string, queueLength int, inProgress int, acquireTime time.Duration, message string) {
+	m.dropped++
+}
+
+func TestQueueTimeWatcher_Name(t *testing.T) {
+	t.Parallel()
+
+	watcher := NewQueueTimeWatcher(time.Second)
+	require.Equal(t, queueTimeWatcherName, watcher.Name())
+}
+
+func TestQueueTimeWatcher_Poll(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc          string
+		enterTimes    []time.Duration
+		dropTimes     []time.Duration
+		expectedEvent *limiter.BackoffEvent
+	}{
+		{
+			desc: "no requests",
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   queueTimeWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc:       "queue times below threshold",
+			enterTimes: repeatDuration(100*time.Millisecond, 100),
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   queueTimeWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc:       "few slow requests",
+			enterTimes: append(repeatDuration(100*time.Millisecond, 99), 5*time.Second),
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   queueTimeWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc:       "queue times above threshold",
+			enterTimes: append(repeatDuration(100*time.Millisecond, 90), repeatDuration(2*time.Second, 10)...),
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   queueTimeWatcherName,
+				ShouldBackoff: true,
+				Reason:        "p99 queue time exceeds threshold: 2s/1s",
+			},
+		},
+		{
+			desc:       "dropped requests",
+			enterTimes: repeatDuration(100*time.Millisecond, 50),
+			dropTimes:  repeatDuration(3*time.Second, 50),
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   queueTimeWatcherName,
+				ShouldBackoff: true,
+				Reason:        "p99 queue time exceeds threshold: 3s/1s",
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+
+			watcher := NewQueueTimeWatcher(time.Second)
+			wrapped := &countingMonitor{}
+			monitor := watcher.Monitor(wrapped)
+
+			for _, acquireTime := range tc.enterTimes {
+				monitor.Enter(ctx, 1, acquireTime)
+			}
+			for _, acquireTime := range tc.dropTimes {
+				monitor.Dropped(ctx, "key", 1, 1, acquireTime, "max_time")
+			}
+			require.Equal(t, len(tc.enterTimes), wrapped.entered)
+			require.Equal(t, len(tc.dropTimes), wrapped.dropped)
+
+			event, err := watcher.Poll(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedEvent, event)
+
+			// Observations are reset after each poll.
+			event, err = watcher.Poll(ctx)
+			require.NoError(t, err)
+			require.False(t, event.ShouldBackoff)
+		})
+	}
+}
+
+func repeatDuration(d time.Duration, n int) []time.Duration {
+	durations := make([]time.Duration, n)
+	for i := range durations {
+		durations[i] = d
+	}
+	return durations
+}
@@ -0,0 +1,43 @@
+package watchers
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+)
+
+const spawnTokenWatcherName = "SpawnToken"
+
+// SpawnTokenWatcher implements ResourceWatcher interface for watching the time spent waiting for tokens to spawn
+// processes. Spawning processes slows down when the node is saturated, so the waiting times are a good indicator of
+// the load of the node. The waiting times are passed to Observe, which is usually registered with
+// command.AddSpawnTokenWaitingTimeObserver. If the 99th percentile of the waiting times observed since the
+// last poll exceeds the threshold, it returns a backoff event.
+type SpawnTokenWatcher struct {
+	threshold time.Duration
+	recorder  durationRecorder
+}
+
+// NewSpawnTokenWatcher is the initializer of SpawnTokenWatcher
+func NewSpawnTokenWatcher(threshold time.Duration) *SpawnTokenWatcher {
+	return &SpawnTokenWatcher{
+		threshold: threshold,
+	}
+}
+
+// Name returns the name of SpawnTokenWatcher
+func (w *SpawnTokenWatcher) Name() string {
+	return spawnTokenWatcherName
+}
+
+// Observe records the time spent waiting for a spawn token.
+func (w *SpawnTokenWatcher) Observe(wait time.Duration) {
+	w.recorder.observe(wait)
+}
+
+// Poll returns a backoff event if the 99th percentile of the waiting times observed since the last poll exceeds the
+// threshold.
+func (w *SpawnTokenWatcher) Poll(context.Context) (*limiter.BackoffEvent, error) {
+	return w.recorder.poll(w.Name(), "spawn token wait", w.threshold), nil
+}
@@ -0,0 +1,90 @@
+package watchers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/limiter"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestSpawnTokenWatcher_Name(t *testing.T) {
+	t.Parallel()
+
+	watcher := NewSpawnTokenWatcher(time.Second)
+	require.Equal(t, spawnTokenWatcherName, watcher.Name())
+}
+
+func TestSpawnTokenWatcher_Poll(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc          string
+		waits         []time.Duration
+		expectedEvent *limiter.BackoffEvent
+	}{
+		{
+			desc: "no spawns",
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   spawnTokenWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc:  "waits below threshold",
+			waits: repeatDuration(time.Millisecond, 100),
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   spawnTokenWatcherName,
+				ShouldBackoff: false,
+			},
+		},
+		{
+			desc:  "waits above threshold",
+			waits: repeatDuration(500*time.Millisecond, 100),
+			expectedEvent: &limiter.BackoffEvent{
+				WatcherName:   spawnTokenWatcherName,
+				ShouldBackoff: true,
+				Reason:        "p99 spawn token wait exceeds threshold: 500ms/100ms",
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			watcher := NewSpawnTokenWatcher(100 * time.Millisecond)
+			for _, wait := range tc.waits {
+				watcher.Observe(wait)
+			}
+
+			event, err := watcher.Poll(testhelper.Context(t))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedEvent, event)
+		})
+	}
+}
+
+func TestSpawnTokenWatcher_spawnTokenManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	manager := command.NewSpawnTokenManager(command.SpawnConfig{
+		Timeout:     time.Second,
+		MaxParallel: 1,
+	})
+	watcher := NewSpawnTokenWatcher(time.Hour)
+	manager.AddWaitingTimeObserver(watcher.Observe)
+
+	for i := 0; i < minimumObservations; i++ {
+		putToken, err := manager.GetSpawnToken(ctx)
+		require.NoError(t, err)
+		putToken()
+	}
+
+	_, observed := watcher.recorder.flush()
+	require.Equal(t, minimumObservations, observed)
+}
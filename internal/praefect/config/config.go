@@ -48,6 +48,27 @@ const (
 	minimalSyncRunInterval   = time.Minute
 )
 
+// ReadDistribution is a strategy for distributing reads across the up-to-date replicas of a repository.
+type ReadDistribution string
+
+// validate validates the read distribution is a valid one.
+func (rd ReadDistribution) validate() error {
+	switch rd {
+	case "", ReadDistributionRandom, ReadDistributionLeastLoaded:
+		return nil
+	default:
+		return fmt.Errorf("invalid read distribution: %q", rd)
+	}
+}
+
+const (
+	// ReadDistributionRandom distributes reads uniformly at random. This is the default.
+	ReadDistributionRandom ReadDistribution = "random"
+	// ReadDistributionLeastLoaded prefers the replica with the least load, which is derived from the latency
+	// and the number of in-flight requests observed for each node.
+	ReadDistributionLeastLoaded ReadDistribution = "least_loaded"
+)
+
 // Failover contains configuration for the mechanism that tracks healthiness of the cluster nodes.
 type Failover struct {
 	// Enabled is a trigger used to check if failover is enabled or not.
@@ -128,6 +149,10 @@ type BackgroundVerification struct {
 	// DeleteInvalidRecords controls whether the background verifier will actually delete the metadata
 	// records that point to non-existent replicas.
 	DeleteInvalidRecords bool `toml:"delete_invalid_records" json:"delete_invalid_records"`
+	// VerifyChecksums controls whether the background verifier additionally compares the checksums of
+	// the replicas on the repository's latest generation. Replicas whose checksums differ from the
+	// majority's are repaired from a replica with the majority's checksum.
+	VerifyChecksums bool `toml:"verify_checksums,omitempty" json:"verify_checksums"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
@@ -176,6 +201,101 @@ func DefaultReconciliationConfig() Reconciliation {
 	}
 }
 
+// Rebalancing contains configuration options of the replica rebalancer.
+type Rebalancing struct {
+	// SchedulingInterval is the interval between each rebalancing run. If set to 0, rebalancing is
+	// disabled. Rebalancing only moves replicas of virtual storages that have a rebalancing plan.
+	SchedulingInterval duration.Duration `toml:"scheduling_interval,omitempty" json:"scheduling_interval"`
+	// MaxConcurrentMoves is the maximum number of replicas being moved at the same time in a
+	// virtual storage.
+	MaxConcurrentMoves int `toml:"max_concurrent_moves,omitempty" json:"max_concurrent_moves"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (r Rebalancing) Validate() error {
+	errs := cfgerror.New().
+		Append(cfgerror.Comparable(r.SchedulingInterval.Duration()).GreaterOrEqual(0), "scheduling_interval")
+
+	if r.SchedulingInterval != 0 {
+		errs = errs.Append(cfgerror.Comparable(r.MaxConcurrentMoves).GreaterOrEqual(1), "max_concurrent_moves")
+	}
+
+	return errs.AsError()
+}
+
+// DefaultRebalancingConfig returns the default values for rebalancing configuration.
+func DefaultRebalancingConfig() Rebalancing {
+	return Rebalancing{
+		SchedulingInterval: duration.Duration(time.Minute),
+		MaxConcurrentMoves: 10,
+	}
+}
+
+// Hedging contains configuration options of request hedging. Hedging sends a second copy of a repository
+// accessor request to another up to date replica if the first replica is slow to respond.
+type Hedging struct {
+	// Enabled enables hedging of repository accessor requests.
+	Enabled bool `toml:"enabled,omitempty" json:"enabled"`
+	// Percentile is the percentile of the method's observed first response latency after which the
+	// request is hedged.
+	Percentile float64 `toml:"percentile,omitempty" json:"percentile"`
+	// MinDelay is the minimum time to wait for a response before hedging a request.
+	MinDelay duration.Duration `toml:"min_delay,omitempty" json:"min_delay"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (h Hedging) Validate() error {
+	if !h.Enabled {
+		return nil
+	}
+
+	return cfgerror.New().
+		Append(cfgerror.InRange(0, 100, h.Percentile), "percentile").
+		Append(cfgerror.Comparable(h.MinDelay.Duration()).GreaterOrEqual(0), "min_delay").
+		AsError()
+}
+
+// DefaultHedgingConfig returns the default values for hedging configuration.
+func DefaultHedgingConfig() Hedging {
+	return Hedging{
+		Percentile: 95,
+		MinDelay:   duration.Duration(10 * time.Millisecond),
+	}
+}
+
+// StorageUsage contains configuration options of storage usage aware placement of new repositories.
+// Praefect polls the disk statistics of the storages and prefers to place new repositories on storages
+// with more free capacity.
+type StorageUsage struct {
+	// Enabled enables storage usage aware placement of new repositories.
+	Enabled bool `toml:"enabled,omitempty" json:"enabled"`
+	// PollInterval is the interval at which the disk statistics of the storages are polled.
+	PollInterval duration.Duration `toml:"poll_interval,omitempty" json:"poll_interval"`
+	// FillThreshold is the percentage of a storage's disk space in use at which no more new
+	// repositories are created on the storage.
+	FillThreshold float64 `toml:"fill_threshold,omitempty" json:"fill_threshold"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (s StorageUsage) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	return cfgerror.New().
+		Append(cfgerror.Comparable(s.PollInterval.Duration()).GreaterThan(0), "poll_interval").
+		Append(cfgerror.InRange(0, 100, s.FillThreshold, cfgerror.InRangeOptIncludeMax), "fill_threshold").
+		AsError()
+}
+
+// DefaultStorageUsageConfig returns the default values for storage usage configuration.
+func DefaultStorageUsageConfig() StorageUsage {
+	return StorageUsage{
+		PollInterval:  duration.Duration(time.Minute),
+		FillThreshold: 95,
+	}
+}
+
 // Replication contains replication specific configuration options.
 type Replication struct {
 	// BatchSize controls how many replication jobs to dequeue and lock
@@ -209,6 +329,9 @@ type Config struct {
 	AllowLegacyElectors    bool                   `toml:"i_understand_my_election_strategy_is_unsupported_and_will_be_removed_without_warning,omitempty" json:"i_understand_my_election_strategy_is_unsupported_and_will_be_removed_without_warning"`
 	BackgroundVerification BackgroundVerification `toml:"background_verification,omitempty" json:"background_verification"`
 	Reconciliation         Reconciliation         `toml:"reconciliation,omitempty" json:"reconciliation"`
+	Rebalancing            Rebalancing            `toml:"rebalancing,omitempty" json:"rebalancing"`
+	Hedging                Hedging                `toml:"hedging,omitempty" json:"hedging"`
+	StorageUsage           StorageUsage           `toml:"storage_usage,omitempty" json:"storage_usage"`
 	Replication            Replication            `toml:"replication,omitempty" json:"replication"`
 	ListenAddr             string                 `toml:"listen_addr,omitempty" json:"listen_addr"`
 	TLSListenAddr          string                 `toml:"tls_listen_addr,omitempty" json:"tls_listen_addr"`
@@ -279,6 +402,9 @@ type VirtualStorage struct {
 	// host assignments, falling back to the behavior of replicating to every configured
 	// storage
 	DefaultReplicationFactor int `toml:"default_replication_factor,omitempty" json:"default_replication_factor"`
+	// ReadDistribution is the strategy used to distribute reads across the up-to-date replicas of a
+	// repository. Reads are distributed at random if unset.
+	ReadDistribution ReadDistribution `toml:"read_distribution,omitempty" json:"read_distribution,omitempty"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
@@ -291,6 +417,10 @@ func (vs VirtualStorage) Validate() error {
 		errs = errs.Append(node.Validate(), "node", fmt.Sprintf("[%d]", i))
 	}
 
+	if vs.ReadDistribution != "" {
+		errs = errs.Append(cfgerror.IsSupportedValue(vs.ReadDistribution, ReadDistributionRandom, ReadDistributionLeastLoaded), "read_distribution")
+	}
+
 	return errs.AsError()
 }
 
@@ -309,6 +439,9 @@ func FromReader(reader io.Reader) (Config, error) {
 	conf := &Config{
 		BackgroundVerification: DefaultBackgroundVerificationConfig(),
 		Reconciliation:         DefaultReconciliationConfig(),
+		Rebalancing:            DefaultRebalancingConfig(),
+		Hedging:                DefaultHedgingConfig(),
+		StorageUsage:           DefaultStorageUsageConfig(),
 		Replication:            DefaultReplicationConfig(),
 		Prometheus:             prometheus.DefaultConfig(),
 		// Sets the default Failover, to be overwritten when deserializing the TOML
@@ -408,6 +541,10 @@ func (c *Config) Validate() error {
 				virtualStorage.Name, virtualStorage.DefaultReplicationFactor, len(virtualStorage.Nodes),
 			)
 		}
+
+		if err := virtualStorage.ReadDistribution.validate(); err != nil {
+			return fmt.Errorf("virtual storage %q: %w", virtualStorage.Name, err)
+		}
 	}
 
 	if c.RepositoriesCleanup.RunInterval.Duration() > 0 {
@@ -439,6 +576,9 @@ func (c *Config) ValidateV2() error {
 		}()).
 		Append(c.BackgroundVerification.Validate(), "background_verification").
 		Append(c.Reconciliation.Validate(), "reconciliation").
+		Append(c.Rebalancing.Validate(), "rebalancing").
+		Append(c.Hedging.Validate(), "hedging").
+		Append(c.StorageUsage.Validate(), "storage_usage").
 		Append(c.Replication.Validate(), "replication").
 		Append(c.Prometheus.Validate(), "prometheus").
 		Append(c.TLS.Validate(), "tls").
@@ -500,6 +640,24 @@ func (c *Config) StorageNames() map[string][]string {
 	return storages
 }
 
+// StorageZones returns the zones of the storages by virtual storage. Storages without a configured zone are
+// omitted.
+func (c *Config) StorageZones() map[string]map[string]string {
+	zones := make(map[string]map[string]string, len(c.VirtualStorages))
+	for _, vs := range c.VirtualStorages {
+		storageZones := make(map[string]string, len(vs.Nodes))
+		for _, n := range vs.Nodes {
+			if n.Zone != "" {
+				storageZones[n.Storage] = n.Zone
+			}
+		}
+
+		zones[vs.Name] = storageZones
+	}
+
+	return zones
+}
+
 // DefaultReplicationFactors returns a map with the default replication factors of
 // the virtual storages.
 func (c Config) DefaultReplicationFactors() map[string]int {
@@ -511,6 +669,16 @@ func (c Config) DefaultReplicationFactors() map[string]int {
 	return replicationFactors
 }
 
+// ReadDistributions returns a map with the read distribution strategies of the virtual storages.
+func (c Config) ReadDistributions() map[string]ReadDistribution {
+	readDistributions := make(map[string]ReadDistribution, len(c.VirtualStorages))
+	for _, vs := range c.VirtualStorages {
+		readDistributions[vs.Name] = vs.ReadDistribution
+	}
+
+	return readDistributions
+}
+
 // DBConnection holds Postgres client configuration data.
 type DBConnection struct {
 	Host        string `toml:"host,omitempty" json:"host"`
@@ -197,6 +197,13 @@ func TestConfigValidation(t *testing.T) {
 			},
 			errMsg: `virtual storage "default" has a default replication factor (2) which is higher than the number of storages (1)`,
 		},
+		{
+			desc: "invalid read distribution",
+			changeConfig: func(cfg *Config) {
+				cfg.VirtualStorages[0].ReadDistribution = "round_robin"
+			},
+			errMsg: `virtual storage "default": invalid read distribution: "round_robin"`,
+		},
 		{
 			desc: "repositories_cleanup minimal duration is too low",
 			changeConfig: func(cfg *Config) {
@@ -283,6 +290,7 @@ func TestConfigParsing(t *testing.T) {
 					{
 						Name:                     "praefect",
 						DefaultReplicationFactor: 2,
+						ReadDistribution:         ReadDistributionLeastLoaded,
 						Nodes: []*Node{
 							{
 								Address: "tcp://gitaly-internal-1.example.com",
@@ -331,6 +339,20 @@ func TestConfigParsing(t *testing.T) {
 					SchedulingInterval: duration.Duration(time.Minute),
 					HistogramBuckets:   []float64{1, 2, 3, 4, 5},
 				},
+				Rebalancing: Rebalancing{
+					SchedulingInterval: duration.Duration(30 * time.Second),
+					MaxConcurrentMoves: 5,
+				},
+				Hedging: Hedging{
+					Enabled:    true,
+					Percentile: 99,
+					MinDelay:   duration.Duration(20 * time.Millisecond),
+				},
+				StorageUsage: StorageUsage{
+					Enabled:       true,
+					PollInterval:  duration.Duration(30 * time.Second),
+					FillThreshold: 90,
+				},
 				Replication: Replication{BatchSize: 1, ParallelStorageProcessingWorkers: 2},
 				Failover: Failover{
 					Enabled:                  true,
@@ -349,6 +371,7 @@ func TestConfigParsing(t *testing.T) {
 				BackgroundVerification: BackgroundVerification{
 					VerificationInterval: duration.Duration(24 * time.Hour),
 					DeleteInvalidRecords: false,
+					VerifyChecksums:      true,
 				},
 				Yamux: Yamux{
 					MaximumStreamWindowSizeBytes: 1000,
@@ -365,8 +388,14 @@ func TestConfigParsing(t *testing.T) {
 					SchedulingInterval: 0,
 					HistogramBuckets:   []float64{1, 2, 3, 4, 5},
 				},
-				Prometheus:  prometheus.DefaultConfig(),
-				Replication: Replication{BatchSize: 1, ParallelStorageProcessingWorkers: 2},
+				Rebalancing: Rebalancing{
+					SchedulingInterval: 0,
+					MaxConcurrentMoves: 10,
+				},
+				Hedging:      DefaultHedgingConfig(),
+				StorageUsage: DefaultStorageUsageConfig(),
+				Prometheus:   prometheus.DefaultConfig(),
+				Replication:  Replication{BatchSize: 1, ParallelStorageProcessingWorkers: 2},
 				Failover: Failover{
 					Enabled:           false,
 					ElectionStrategy:  "local",
@@ -389,6 +418,9 @@ func TestConfigParsing(t *testing.T) {
 				GracefulStopTimeout: duration.Duration(time.Minute),
 				Prometheus:          prometheus.DefaultConfig(),
 				Reconciliation:      DefaultReconciliationConfig(),
+				Rebalancing:         DefaultRebalancingConfig(),
+				Hedging:             DefaultHedgingConfig(),
+				StorageUsage:        DefaultStorageUsageConfig(),
 				Replication:         DefaultReplicationConfig(),
 				Failover: Failover{
 					Enabled:           true,
@@ -471,6 +503,41 @@ func TestDefaultReplicationFactors(t *testing.T) {
 	}
 }
 
+func TestStorageZones(t *testing.T) {
+	conf := Config{VirtualStorages: []*VirtualStorage{
+		{
+			Name: "virtual-storage-1",
+			Nodes: []*Node{
+				{Storage: "storage-1", Zone: "zone-a"},
+				{Storage: "storage-2", Zone: "zone-b"},
+				{Storage: "storage-3"},
+			},
+		},
+		{
+			Name:  "virtual-storage-2",
+			Nodes: []*Node{{Storage: "storage-1"}},
+		},
+	}}
+
+	require.Equal(t, map[string]map[string]string{
+		"virtual-storage-1": {"storage-1": "zone-a", "storage-2": "zone-b"},
+		"virtual-storage-2": {},
+	}, conf.StorageZones())
+}
+
+func TestReadDistributions(t *testing.T) {
+	require.Equal(t,
+		map[string]ReadDistribution{
+			"virtual-storage-1": "",
+			"virtual-storage-2": ReadDistributionLeastLoaded,
+		},
+		Config{VirtualStorages: []*VirtualStorage{
+			{Name: "virtual-storage-1"},
+			{Name: "virtual-storage-2", ReadDistribution: ReadDistributionLeastLoaded},
+		}}.ReadDistributions(),
+	)
+}
+
 func TestNeedsSQL(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -723,6 +790,114 @@ func TestReconciliation_Validate(t *testing.T) {
 	}
 }
 
+func TestRebalancing_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name        string
+		rebalancing Rebalancing
+		expectedErr error
+	}{
+		{
+			name:        "empty is valid",
+			rebalancing: Rebalancing{},
+		},
+		{
+			name: "valid",
+			rebalancing: Rebalancing{
+				SchedulingInterval: duration.Duration(1),
+				MaxConcurrentMoves: 1,
+			},
+		},
+		{
+			name: "invalid",
+			rebalancing: Rebalancing{
+				SchedulingInterval: duration.Duration(-1),
+			},
+			expectedErr: cfgerror.ValidationErrors{{
+				Key:   []string{"scheduling_interval"},
+				Cause: fmt.Errorf("%w: -1ns is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+			}, {
+				Key:   []string{"max_concurrent_moves"},
+				Cause: fmt.Errorf("%w: 0 is not greater than or equal to 1", cfgerror.ErrNotInRange),
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rebalancing.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestHedging_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name        string
+		hedging     Hedging
+		expectedErr error
+	}{
+		{
+			name:    "disabled is valid",
+			hedging: Hedging{Percentile: -1},
+		},
+		{
+			name:    "valid",
+			hedging: Hedging{Enabled: true, Percentile: 95, MinDelay: duration.Duration(time.Millisecond)},
+		},
+		{
+			name: "invalid",
+			hedging: Hedging{
+				Enabled:  true,
+				MinDelay: duration.Duration(-1),
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(fmt.Errorf("%w: 0 out of (0, 100)", cfgerror.ErrNotInRange), "percentile"),
+				cfgerror.NewValidationError(fmt.Errorf("%w: -1ns is not greater than or equal to 0s", cfgerror.ErrNotInRange), "min_delay"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.hedging.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestStorageUsage_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name         string
+		storageUsage StorageUsage
+		expectedErr  error
+	}{
+		{
+			name:         "disabled is valid",
+			storageUsage: StorageUsage{FillThreshold: -1},
+		},
+		{
+			name:         "valid",
+			storageUsage: StorageUsage{Enabled: true, PollInterval: duration.Duration(time.Second), FillThreshold: 100},
+		},
+		{
+			name: "invalid",
+			storageUsage: StorageUsage{
+				Enabled:       true,
+				PollInterval:  duration.Duration(0),
+				FillThreshold: 101,
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(fmt.Errorf("%w: 0s is not greater than 0s", cfgerror.ErrNotInRange), "poll_interval"),
+				cfgerror.NewValidationError(fmt.Errorf("%w: 101 out of (0, 100]", cfgerror.ErrNotInRange), "fill_threshold"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.storageUsage.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestReplication_Validate(t *testing.T) {
 	t.Parallel()
 	for _, tc := range []struct {
@@ -786,6 +961,28 @@ func TestVirtualStorage_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid read distribution",
+			vs: VirtualStorage{
+				Name:             "vs",
+				Nodes:            []*Node{{Storage: "st", Address: "addr"}},
+				ReadDistribution: ReadDistributionLeastLoaded,
+			},
+		},
+		{
+			name: "invalid read distribution",
+			vs: VirtualStorage{
+				Name:             "vs",
+				Nodes:            []*Node{{Storage: "st", Address: "addr"}},
+				ReadDistribution: "round_robin",
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf(`%w: "round_robin"`, cfgerror.ErrUnsupportedValue),
+					"read_distribution",
+				),
+			},
+		},
 		{
 			name: "invalid",
 			vs:   VirtualStorage{},
@@ -938,6 +1135,18 @@ func TestConfig_ValidateV2(t *testing.T) {
 			Reconciliation: Reconciliation{
 				SchedulingInterval: duration.Duration(-1),
 			},
+			Rebalancing: Rebalancing{
+				SchedulingInterval: duration.Duration(-1),
+			},
+			Hedging: Hedging{
+				Enabled:    true,
+				Percentile: 100,
+			},
+			StorageUsage: StorageUsage{
+				Enabled:       true,
+				PollInterval:  duration.Duration(time.Minute),
+				FillThreshold: 0,
+			},
 			Replication: Replication{
 				BatchSize:                        0,
 				ParallelStorageProcessingWorkers: 1,
@@ -972,6 +1181,10 @@ func TestConfig_ValidateV2(t *testing.T) {
 			cfgerror.NewValidationError(errors.New(`none of "socket_path", "listen_addr" or "tls_listen_addr" is set`)),
 			cfgerror.NewValidationError(negativeDurationErr, "background_verification", "verification_interval"),
 			cfgerror.NewValidationError(negativeDurationErr, "reconciliation", "scheduling_interval"),
+			cfgerror.NewValidationError(negativeDurationErr, "rebalancing", "scheduling_interval"),
+			cfgerror.NewValidationError(fmt.Errorf("%w: 0 is not greater than or equal to 1", cfgerror.ErrNotInRange), "rebalancing", "max_concurrent_moves"),
+			cfgerror.NewValidationError(fmt.Errorf("%w: 100 out of (0, 100)", cfgerror.ErrNotInRange), "hedging", "percentile"),
+			cfgerror.NewValidationError(fmt.Errorf("%w: 0 out of (0, 100]", cfgerror.ErrNotInRange), "storage_usage", "fill_threshold"),
 			cfgerror.NewValidationError(fmt.Errorf("%w: 0 is not greater than or equal to 1", cfgerror.ErrNotInRange), "replication", "batch_size"),
 			cfgerror.NewValidationError(negativeDurationErr, "prometheus", "scrape_timeout"),
 			cfgerror.NewValidationError(fmt.Errorf(`%w: "/doesnt/exist"`, cfgerror.ErrDoesntExist), "tls", "certificate_path"),
@@ -14,11 +14,12 @@ func TestNode_MarshalJSON(t *testing.T) {
 		Storage: "storage",
 		Address: "address",
 		Token:   token,
+		Zone:    "zone",
 	}
 
 	b, err := json.Marshal(node)
 	require.NoError(t, err)
-	require.JSONEq(t, `{"storage":"storage","address":"address"}`, string(b))
+	require.JSONEq(t, `{"storage":"storage","address":"address","zone":"zone"}`, string(b))
 }
 
 func TestNode_Validate(t *testing.T) {
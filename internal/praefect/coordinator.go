@@ -20,6 +20,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/metrics"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/transactions"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/transaction/txinfo"
@@ -194,6 +195,21 @@ type Coordinator struct {
 	conf                     config.Config
 	votersMetric             *prometheus.HistogramVec
 	txReplicationCountMetric *prometheus.CounterVec
+	// hedger is only set if hedging of repository accessor requests is enabled.
+	hedger *hedger
+	// loads is only set if the latencies of the nodes are tracked to distribute reads.
+	loads tracker.LoadTracker
+}
+
+// CoordinatorOpt allows a coordinator to be configured with additional options
+type CoordinatorOpt func(*Coordinator)
+
+// WithLoadTracker is an option to record the first response latencies of repository accessor requests in the load
+// tracker. The latencies are measured by the proxy the same way as for hedging.
+func WithLoadTracker(loads tracker.LoadTracker) CoordinatorOpt {
+	return func(c *Coordinator) {
+		c.loads = loads
+	}
 }
 
 // NewCoordinator returns a new Coordinator that utilizes the provided logger
@@ -204,6 +220,7 @@ func NewCoordinator(
 	txMgr *transactions.Manager,
 	conf config.Config,
 	r *protoregistry.Registry,
+	opts ...CoordinatorOpt,
 ) *Coordinator {
 	maxVoters := 1
 	for _, storage := range conf.VirtualStorages {
@@ -236,6 +253,14 @@ func NewCoordinator(
 		),
 	}
 
+	if conf.Hedging.Enabled {
+		coordinator.hedger = newHedger(conf.Hedging)
+	}
+
+	for _, opt := range opts {
+		opt(coordinator)
+	}
+
 	return coordinator
 }
 
@@ -248,6 +273,9 @@ func (c *Coordinator) Describe(descs chan<- *prometheus.Desc) {
 func (c *Coordinator) Collect(metrics chan<- prometheus.Metric) {
 	c.votersMetric.Collect(metrics)
 	c.txReplicationCountMetric.Collect(metrics)
+	if c.hedger != nil {
+		c.hedger.Collect(metrics)
+	}
 }
 
 func (c *Coordinator) directRepositoryScopedMessage(ctx context.Context, call grpcCall) (*proxy.StreamParameters, error) {
@@ -300,9 +328,8 @@ func (c *Coordinator) accessorStreamParameters(ctx context.Context, call grpcCal
 	repoPath := call.targetRepo.GetRelativePath()
 	virtualStorage := call.targetRepo.StorageName
 
-	route, err := c.router.RouteRepositoryAccessor(
-		ctx, virtualStorage, repoPath, shouldRouteRepositoryAccessorToPrimary(ctx, call),
-	)
+	forcePrimary := shouldRouteRepositoryAccessorToPrimary(ctx, call)
+	route, err := c.router.RouteRepositoryAccessor(ctx, virtualStorage, repoPath, forcePrimary)
 	if err != nil {
 		return nil, fmt.Errorf("accessor call: route repository accessor: %w", err)
 	}
@@ -316,11 +343,21 @@ func (c *Coordinator) accessorStreamParameters(ctx context.Context, call grpcCal
 
 	metrics.ReadDistribution.WithLabelValues(virtualStorage, route.Node.Storage).Inc()
 
-	return proxy.NewStreamParameters(proxy.Destination{
+	destination := proxy.Destination{
 		Ctx:  streamParametersContext(ctx),
 		Conn: route.Node.Connection,
 		Msg:  b,
-	}, nil, nil, nil), nil
+	}
+
+	// The first response latency is only measured for requests proxied as hedged requests. Client-streaming
+	// requests can't be proxied that way as only their first message is known.
+	if (c.hedger == nil && c.loads == nil) || call.methodInfo.ClientStreaming() {
+		return proxy.NewStreamParameters(destination, nil, nil, nil), nil
+	}
+
+	// Requests routed to the primary must not be served by another replica. They are still proxied as hedged
+	// requests without a hedge destination to measure their latency.
+	return proxy.NewHedgedStreamParameters(destination, c.accessorHedge(ctx, call, route, !forcePrimary), nil, nil), nil
 }
 
 func (c *Coordinator) registerTransaction(ctx context.Context, primary RouterNode, secondaries []RouterNode) (transactions.Transaction, transactions.CancelFunc, error) {
@@ -414,6 +451,10 @@ func (c *Coordinator) mutatorStreamParameters(ctx context.Context, call grpcCall
 			route, err = c.router.RouteRepositoryMutator(ctx, virtualStorage, targetRepo.RelativePath, additionalRepoRelativePath)
 		}
 		if err != nil {
+			if errors.Is(err, ErrStoragesFull) {
+				return nil, err
+			}
+
 			return nil, fmt.Errorf("route repository creation: %w", err)
 		}
 	default:
@@ -1090,6 +1131,8 @@ func (c *Coordinator) newRequestFinalizer(
 			if err := c.rs.IncrementGeneration(ctx, repositoryID, primary, updatedSecondaries); err != nil {
 				return fmt.Errorf("increment generation: %w", err)
 			}
+
+			c.returnGeneration(originalCtx, ctx, repositoryID, primary)
 		case datastore.RenameRepo:
 			// Renaming a repository is not idempotent on Gitaly's side. This combined with a failure here results in a problematic state,
 			// where the client receives an error but can't retry the call as the repository has already been moved on the primary.
@@ -1123,6 +1166,8 @@ func (c *Coordinator) newRequestFinalizer(
 
 				return fmt.Errorf("create repository: %w", err)
 			}
+
+			c.returnGeneration(originalCtx, ctx, repositoryID, primary)
 			change = datastore.UpdateRepo
 		}
 
@@ -1168,6 +1213,28 @@ func (c *Coordinator) newRequestFinalizer(
 	}
 }
 
+// returnGeneration returns the primary's generation of the repository to the client in the RPC's trailer so the
+// client can read its own writes. Failing to do so is not fatal as the write itself succeeded.
+func (c *Coordinator) returnGeneration(rpcCtx, ctx context.Context, repositoryID int64, primary string) {
+	if c.conf.Failover.ElectionStrategy != config.ElectionStrategyPerRepository {
+		return
+	}
+
+	generation, err := c.rs.GetGeneration(ctx, repositoryID, primary)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("failed to get repository generation")
+		return
+	}
+
+	if generation == datastore.GenerationUnknown {
+		return
+	}
+
+	if err := setGenerationTrailer(rpcCtx, generation); err != nil {
+		log.FromContext(ctx).WithError(err).Error("failed to set repository generation trailer")
+	}
+}
+
 func (c *Coordinator) validateTargetRepo(repo *gitalypb.Repository) error {
 	if repo.GetStorageName() == "" && repo.GetRelativePath() == "" {
 		return storage.ErrRepositoryNotSet
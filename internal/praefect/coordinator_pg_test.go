@@ -220,6 +220,7 @@ func TestStreamDirectorMutator_Transaction(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				testhelper.SharedLogger(t),
 			)
 			require.NoError(t, err)
@@ -234,9 +235,12 @@ func TestStreamDirectorMutator_Transaction(t *testing.T) {
 					StaticHealthChecker(conf.StorageNames()),
 					NewLockedRandom(rand.New(rand.NewSource(0))),
 					rs,
-					datastore.NewAssignmentStore(tx, conf.StorageNames()),
+					datastore.NewAssignmentStore(tx, conf.StorageNames(), nil),
 					rs,
 					nil,
+					nil,
+					nil,
+					nil,
 				),
 				txMgr,
 				conf,
@@ -165,7 +165,7 @@ func TestStreamDirectorMutator(t *testing.T) {
 	db := testdb.New(t)
 	txMgr := transactions.NewManager(conf)
 
-	nodeSet, err := DialNodes(ctx, conf.VirtualStorages, protoregistry.GitalyProtoPreregistered, nil, nil, nil, testhelper.SharedLogger(t))
+	nodeSet, err := DialNodes(ctx, conf.VirtualStorages, protoregistry.GitalyProtoPreregistered, nil, nil, nil, nil, testhelper.SharedLogger(t))
 	require.NoError(t, err)
 	defer nodeSet.Close()
 
@@ -387,9 +387,12 @@ func TestStreamDirectorMutator(t *testing.T) {
 					StaticHealthChecker(conf.StorageNames()),
 					NewLockedRandom(rand.New(rand.NewSource(0))),
 					rs,
-					datastore.NewAssignmentStore(tx, conf.StorageNames()),
+					datastore.NewAssignmentStore(tx, conf.StorageNames(), nil),
 					rs,
 					nil,
+					nil,
+					nil,
+					nil,
 				),
 				txMgr,
 				conf,
@@ -767,7 +770,7 @@ func TestStreamDirector_maintenance(t *testing.T) {
 
 	ctx := testhelper.Context(t)
 
-	nodeSet, err := DialNodes(ctx, cfg.VirtualStorages, protoregistry.GitalyProtoPreregistered, nil, nil, nil, testhelper.SharedLogger(t))
+	nodeSet, err := DialNodes(ctx, cfg.VirtualStorages, protoregistry.GitalyProtoPreregistered, nil, nil, nil, nil, testhelper.SharedLogger(t))
 	require.NoError(t, err)
 	defer nodeSet.Close()
 
@@ -791,9 +794,12 @@ func TestStreamDirector_maintenance(t *testing.T) {
 			StaticHealthChecker(cfg.StorageNames()),
 			NewLockedRandom(rand.New(rand.NewSource(0))),
 			rs,
-			datastore.NewAssignmentStore(tx, cfg.StorageNames()),
+			datastore.NewAssignmentStore(tx, cfg.StorageNames(), nil),
 			rs,
 			nil,
+			nil,
+			nil,
+			nil,
 		),
 		nil,
 		cfg,
@@ -1554,6 +1560,9 @@ func TestStreamDirector_repo_creation(t *testing.T) {
 				nil,
 				repositoryStore,
 				conf.DefaultReplicationFactors(),
+				nil,
+				nil,
+				nil,
 			)
 
 			txMgr := transactions.NewManager(conf)
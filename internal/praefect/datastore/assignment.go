@@ -30,11 +30,14 @@ func newRepositoryNotFoundError(virtualStorage, relativePath string) error {
 type AssignmentStore struct {
 	db                 glsql.Querier
 	configuredStorages map[string][]string
+	storageZones       map[string]map[string]string
 }
 
-// NewAssignmentStore returns a new AssignmentStore using the passed in database.
-func NewAssignmentStore(db glsql.Querier, configuredStorages map[string][]string) AssignmentStore {
-	return AssignmentStore{db: db, configuredStorages: configuredStorages}
+// NewAssignmentStore returns a new AssignmentStore using the passed in database. storageZones contains the zones
+// of the storages by virtual storage. Assignments are spread across the zones when the replication factor is
+// changed. Storages without a zone are considered to be in the same zone.
+func NewAssignmentStore(db glsql.Querier, configuredStorages map[string][]string, storageZones map[string]map[string]string) AssignmentStore {
+	return AssignmentStore{db: db, configuredStorages: configuredStorages, storageZones: storageZones}
 }
 
 //nolint:revive // This is unintentionally missing documentation.
@@ -92,6 +95,11 @@ func (s AssignmentStore) SetReplicationFactor(ctx context.Context, virtualStorag
 		return nil, newUnattainableReplicationFactorError(replicationFactor, max)
 	}
 
+	candidateZones := make([]string, len(candidateStorages))
+	for i, storage := range candidateStorages {
+		candidateZones[i] = s.storageZones[virtualStorage][storage]
+	}
+
 	// The query works as follows:
 	//
 	// 1. `repository` CTE locks the repository's record for the duration of the update.
@@ -102,18 +110,24 @@ func (s AssignmentStore) SetReplicationFactor(ctx context.Context, virtualStorag
 	//    is being increased concurrently from two different nodes and they assign different
 	//    storages.
 	//
-	// 2. `existing_assignments` CTE gets the existing assignments for the repository. While
+	// 2. `configured_storages` CTE pairs the configured storages with their zones.
+	//
+	// 3. `existing_assignments` CTE gets the existing assignments for the repository. While
 	//    there may be assignments in the database for storage nodes that were removed from the
 	//    cluster, the query filters them out.
 	//
-	// 3. `created_assignments` CTE assigns new hosts to the repository if the replication
-	//    factor has been increased. Random storages which are not yet assigned to the repository
+	// 4. `created_assignments` CTE assigns new hosts to the repository if the replication
+	//    factor has been increased. Storages which are not yet assigned to the repository
 	//    are picked until the replication factor is met. The primary of a repository is always
-	//    assigned first.
+	//    assigned first. The rest are picked from the zones with the fewest assignments so the
+	//    assignments are spread across zones. Each candidate is ranked within its zone at random
+	//    and the number of assignments its zone would have if it was picked is what it is ordered
+	//    by. Ties are broken at random.
 	//
-	// 4. `removed_assignments` CTE removes host assignments if the replication factor has been
+	// 5. `removed_assignments` CTE removes host assignments if the replication factor has been
 	//    decreased. Primary is never removed as it needs a copy of the repository in order to
-	//    accept writes. Random hosts are removed until the replication factor is met.
+	//    accept writes. Hosts are removed from the zones with the most assignments until the
+	//    replication factor is met, using the same ranking as above in reverse.
 	//
 	// 6. Finally we return the current set of assignments. CTE updates are not visible in the
 	//    tables during the transaction. To account for that, we filter out removed assignments
@@ -129,20 +143,33 @@ WITH repository AS (
 	FOR UPDATE
 ),
 
+configured_storages AS (
+	SELECT storage, zone
+	FROM unnest($4::text[], $5::text[]) AS storages (storage, zone)
+),
+
 existing_assignments AS (
-	SELECT storage
+	SELECT storage, zone
 	FROM repository
 	JOIN repository_assignments USING (virtual_storage, relative_path)
-	WHERE storage = ANY($4::text[])
+	JOIN configured_storages USING (storage)
 ),
 
 created_assignments AS (
 	INSERT INTO repository_assignments
 	SELECT virtual_storage, relative_path, storage, repository_id
-	FROM repository
-	CROSS JOIN ( SELECT unnest($4::text[]) AS storage ) AS configured_storages
-	WHERE storage NOT IN ( SELECT storage FROM existing_assignments )
-	ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, random()
+	FROM (
+		SELECT virtual_storage, relative_path, storage, repository_id, "primary",
+			( SELECT COUNT(*) FROM existing_assignments WHERE existing_assignments.zone = candidates.zone ) +
+			ROW_NUMBER() OVER (
+				PARTITION BY zone
+				ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, random()
+			) AS zone_assignments
+		FROM repository
+		CROSS JOIN configured_storages AS candidates
+		WHERE storage NOT IN ( SELECT storage FROM existing_assignments )
+	) AS ranked_candidates
+	ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, zone_assignments, random()
 	LIMIT ( SELECT GREATEST(COUNT(*), $3) - COUNT(*) FROM existing_assignments )
 	RETURNING storage
 ),
@@ -151,9 +178,14 @@ removed_assignments AS (
 	DELETE FROM repository_assignments
 	USING (
 		SELECT virtual_storage, relative_path, storage
-		FROM repository, existing_assignments
-		WHERE storage != "primary"
-		ORDER BY random()
+		FROM (
+			SELECT virtual_storage, relative_path, storage,
+				( SELECT COUNT(*) FROM existing_assignments AS same_zone WHERE same_zone.zone = candidates.zone ) -
+				ROW_NUMBER() OVER ( PARTITION BY zone ORDER BY random() ) AS zone_assignments
+			FROM repository, existing_assignments AS candidates
+			WHERE storage != "primary"
+		) AS ranked_candidates
+		ORDER BY zone_assignments DESC, random()
 		LIMIT ( SELECT COUNT(*) - LEAST(COUNT(*), $3)  FROM existing_assignments )
 	) AS removals
 	WHERE repository_assignments.virtual_storage = removals.virtual_storage
@@ -169,7 +201,7 @@ UNION
 SELECT storage
 FROM created_assignments
 ORDER BY storage
-	`, virtualStorage, relativePath, replicationFactor, candidateStorages)
+	`, virtualStorage, relativePath, replicationFactor, candidateStorages, candidateZones)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
@@ -104,6 +104,7 @@ func TestAssignmentStore_GetHostAssignments(t *testing.T) {
 			actualAssignments, err := NewAssignmentStore(
 				db,
 				map[string][]string{"virtual-storage": configuredStorages},
+				nil,
 			).GetHostAssignments(ctx, tc.virtualStorage, repositoryID)
 			require.Equal(t, tc.error, err)
 			require.ElementsMatch(t, tc.expectedAssignments, actualAssignments)
@@ -226,7 +227,7 @@ func TestAssignmentStore_SetReplicationFactor(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			store := NewAssignmentStore(db, configuredStorages)
+			store := NewAssignmentStore(db, configuredStorages, nil)
 
 			setStorages, err := store.SetReplicationFactor(ctx, "virtual-storage", "relative-path", tc.replicationFactor)
 			require.Equal(t, tc.error, err)
@@ -252,3 +253,82 @@ func TestAssignmentStore_SetReplicationFactor(t *testing.T) {
 		})
 	}
 }
+
+func TestAssignmentStore_SetReplicationFactor_zones(t *testing.T) {
+	t.Parallel()
+
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"primary", "secondary-1", "secondary-2", "secondary-3"}}
+	storageZones := map[string]map[string]string{
+		"virtual-storage": {
+			"primary":     "zone-a",
+			"secondary-1": "zone-a",
+			"secondary-2": "zone-b",
+			"secondary-3": "zone-c",
+		},
+	}
+
+	for _, tc := range []struct {
+		desc                string
+		existingAssignments []string
+		replicationFactor   int
+		expectedStorages    [][]string
+	}{
+		{
+			desc:                "increasing replication factor prefers zones without assignments",
+			existingAssignments: []string{"primary"},
+			replicationFactor:   3,
+			expectedStorages:    [][]string{{"primary", "secondary-2", "secondary-3"}},
+		},
+		{
+			desc:                "increasing replication factor from scratch spreads across zones",
+			existingAssignments: nil,
+			replicationFactor:   2,
+			expectedStorages:    [][]string{{"primary", "secondary-2"}, {"primary", "secondary-3"}},
+		},
+		{
+			desc:                "increasing replication factor fills zones evenly",
+			existingAssignments: []string{"primary", "secondary-2", "secondary-3"},
+			replicationFactor:   4,
+			expectedStorages:    [][]string{{"primary", "secondary-1", "secondary-2", "secondary-3"}},
+		},
+		{
+			desc:                "decreasing replication factor removes from the zones with the most assignments",
+			existingAssignments: []string{"primary", "secondary-1", "secondary-2"},
+			replicationFactor:   2,
+			expectedStorages:    [][]string{{"primary", "secondary-2"}},
+		},
+		{
+			desc:                "decreasing replication factor keeps zones covered",
+			existingAssignments: []string{"primary", "secondary-1", "secondary-2", "secondary-3"},
+			replicationFactor:   3,
+			expectedStorages:    [][]string{{"primary", "secondary-2", "secondary-3"}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := testhelper.Context(t)
+
+			db.TruncateAll(t)
+
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO repositories (virtual_storage, relative_path, "primary", repository_id)
+				VALUES ('virtual-storage', 'relative-path', 'primary', 1)
+			`)
+			require.NoError(t, err)
+
+			for _, storage := range tc.existingAssignments {
+				_, err := db.ExecContext(ctx, `
+					INSERT INTO repository_assignments VALUES ('virtual-storage', 'relative-path', $1, 1)
+				`, storage)
+				require.NoError(t, err)
+			}
+
+			store := NewAssignmentStore(db, configuredStorages, storageZones)
+
+			setStorages, err := store.SetReplicationFactor(ctx, "virtual-storage", "relative-path", tc.replicationFactor)
+			require.NoError(t, err)
+			require.Contains(t, tc.expectedStorages, setStorages)
+		})
+	}
+}
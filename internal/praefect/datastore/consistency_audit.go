@@ -0,0 +1,173 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// ConsistencyDecision is the decision made about a replica whose checksum differs from the checksums of the other
+// replicas on the same generation.
+type ConsistencyDecision string
+
+const (
+	// ConsistencyDecisionRepair means the replica's checksum differed from the majority's and the replica was
+	// scheduled to be repaired from a replica with the majority's checksum.
+	ConsistencyDecisionRepair ConsistencyDecision = "repair"
+	// ConsistencyDecisionNoMajority means the replicas' checksums differed without a majority agreeing on a
+	// checksum. The replica was left in place as there's no way to tell which of the replicas is correct.
+	ConsistencyDecisionNoMajority ConsistencyDecision = "no_majority"
+	// ConsistencyDecisionPrimaryDeferred means the repository's primary replica's checksum differed from the
+	// majority's. The primary is not marked outdated right away as a write may have been in progress on it. It's
+	// repaired if its checksum still differs when the repository is verified the next time.
+	ConsistencyDecisionPrimaryDeferred ConsistencyDecision = "primary_deferred"
+)
+
+// ConsistencyAuditEntry is a record of a decision made about a replica's consistency.
+type ConsistencyAuditEntry struct {
+	// RepositoryID is the ID of the repository.
+	RepositoryID int64
+	// VirtualStorage is the virtual storage of the repository.
+	VirtualStorage string
+	// RelativePath is the relative path of the repository.
+	RelativePath string
+	// Storage is the storage of the replica the decision was made about.
+	Storage string
+	// Generation is the generation the replicas were on when their checksums were compared.
+	Generation int
+	// Checksum is the checksum of the replica.
+	Checksum string
+	// MajorityChecksum is the checksum the majority of the replicas agreed on. It's empty if there was
+	// no majority.
+	MajorityChecksum string
+	// SourceStorage is the storage the replica is repaired from. It's empty if the replica is not repaired.
+	SourceStorage string
+	// Decision is the decision made about the replica.
+	Decision ConsistencyDecision
+	// CreatedAt is the time the decision was made. It's set by the database.
+	CreatedAt time.Time
+}
+
+// ConsistencyAudit records the decisions made about the consistency of replicas.
+type ConsistencyAudit struct {
+	db glsql.Querier
+}
+
+// NewConsistencyAudit returns a new ConsistencyAudit.
+func NewConsistencyAudit(db glsql.Querier) *ConsistencyAudit {
+	return &ConsistencyAudit{db: db}
+}
+
+// Record records the entries in the audit log.
+func (ca *ConsistencyAudit) Record(ctx context.Context, entries []ConsistencyAuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var (
+		repositoryIDs     = make([]int64, len(entries))
+		virtualStorages   = make([]string, len(entries))
+		relativePaths     = make([]string, len(entries))
+		storages          = make([]string, len(entries))
+		generations       = make([]int64, len(entries))
+		checksums         = make([]string, len(entries))
+		majorityChecksums = make([]string, len(entries))
+		sourceStorages    = make([]string, len(entries))
+		decisions         = make([]string, len(entries))
+	)
+
+	for i, entry := range entries {
+		repositoryIDs[i] = entry.RepositoryID
+		virtualStorages[i] = entry.VirtualStorage
+		relativePaths[i] = entry.RelativePath
+		storages[i] = entry.Storage
+		generations[i] = int64(entry.Generation)
+		checksums[i] = entry.Checksum
+		majorityChecksums[i] = entry.MajorityChecksum
+		sourceStorages[i] = entry.SourceStorage
+		decisions[i] = string(entry.Decision)
+	}
+
+	if _, err := ca.db.ExecContext(ctx, `
+INSERT INTO consistency_audit (
+	repository_id, virtual_storage, relative_path, storage, generation,
+	checksum, majority_checksum, source_storage, decision
+)
+SELECT
+	repository_id, virtual_storage, relative_path, storage, generation,
+	checksum, NULLIF(majority_checksum, ''), NULLIF(source_storage, ''), decision
+FROM unnest($1::bigint[], $2::text[], $3::text[], $4::text[], $5::bigint[], $6::text[], $7::text[], $8::text[], $9::text[])
+	AS entries (repository_id, virtual_storage, relative_path, storage, generation, checksum, majority_checksum, source_storage, decision)
+	`,
+		repositoryIDs, virtualStorages, relativePaths, storages, generations,
+		checksums, majorityChecksums, sourceStorages, decisions,
+	); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// IsDeferred returns whether the latest decision made about the replica was to defer its repair, and whether the
+// replica was on the same generation with the same checksum at the time.
+func (ca *ConsistencyAudit) IsDeferred(ctx context.Context, repositoryID int64, storage string, generation int, checksum string) (bool, error) {
+	var deferred bool
+	if err := ca.db.QueryRowContext(ctx, `
+SELECT COALESCE((
+	SELECT decision = $5 AND generation = $3 AND checksum = $4
+	FROM consistency_audit
+	WHERE repository_id = $1
+	AND storage = $2
+	ORDER BY id DESC
+	LIMIT 1
+), false)
+	`, repositoryID, storage, generation, checksum, string(ConsistencyDecisionPrimaryDeferred)).Scan(&deferred); err != nil {
+		return false, fmt.Errorf("scan: %w", err)
+	}
+
+	return deferred, nil
+}
+
+// List returns the most recent entries in the audit log, newest first. The entries can be filtered by
+// virtual storage and relative path. Empty filters match every entry. At most limit entries are returned.
+func (ca *ConsistencyAudit) List(ctx context.Context, virtualStorage, relativePath string, limit int) ([]ConsistencyAuditEntry, error) {
+	rows, err := ca.db.QueryContext(ctx, `
+SELECT
+	repository_id, virtual_storage, relative_path, storage, generation, checksum,
+	COALESCE(majority_checksum, ''), COALESCE(source_storage, ''), decision, created_at
+FROM consistency_audit
+WHERE ($1 = '' OR virtual_storage = $1)
+AND ($2 = '' OR relative_path = $2)
+ORDER BY id DESC
+LIMIT $3
+	`, virtualStorage, relativePath, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []ConsistencyAuditEntry
+	for rows.Next() {
+		var entry ConsistencyAuditEntry
+		if err := rows.Scan(
+			&entry.RepositoryID,
+			&entry.VirtualStorage,
+			&entry.RelativePath,
+			&entry.Storage,
+			&entry.Generation,
+			&entry.Checksum,
+			&entry.MajorityChecksum,
+			&entry.SourceStorage,
+			&entry.Decision,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,122 @@
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestConsistencyAudit(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+	audit := NewConsistencyAudit(db)
+
+	entries, err := audit.List(ctx, "", "", 10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	repaired := ConsistencyAuditEntry{
+		RepositoryID:     1,
+		VirtualStorage:   "virtual-storage-1",
+		RelativePath:     "relative-path-1",
+		Storage:          "storage-1",
+		Generation:       2,
+		Checksum:         "divergent",
+		MajorityChecksum: "majority",
+		SourceStorage:    "storage-2",
+		Decision:         ConsistencyDecisionRepair,
+	}
+
+	noMajority := []ConsistencyAuditEntry{
+		{
+			RepositoryID:   2,
+			VirtualStorage: "virtual-storage-1",
+			RelativePath:   "relative-path-2",
+			Storage:        "storage-1",
+			Generation:     1,
+			Checksum:       "checksum-1",
+			Decision:       ConsistencyDecisionNoMajority,
+		},
+		{
+			RepositoryID:   2,
+			VirtualStorage: "virtual-storage-1",
+			RelativePath:   "relative-path-2",
+			Storage:        "storage-2",
+			Generation:     1,
+			Checksum:       "checksum-2",
+			Decision:       ConsistencyDecisionNoMajority,
+		},
+	}
+
+	otherVirtualStorage := ConsistencyAuditEntry{
+		RepositoryID:     3,
+		VirtualStorage:   "virtual-storage-2",
+		RelativePath:     "relative-path-1",
+		Storage:          "storage-1",
+		Checksum:         "divergent",
+		MajorityChecksum: "majority",
+		SourceStorage:    "storage-2",
+		Decision:         ConsistencyDecisionRepair,
+	}
+
+	require.NoError(t, audit.Record(ctx, nil))
+	require.NoError(t, audit.Record(ctx, []ConsistencyAuditEntry{repaired}))
+	require.NoError(t, audit.Record(ctx, noMajority))
+	require.NoError(t, audit.Record(ctx, []ConsistencyAuditEntry{otherVirtualStorage}))
+
+	list := func(t *testing.T, virtualStorage, relativePath string, limit int) []ConsistencyAuditEntry {
+		t.Helper()
+
+		entries, err := audit.List(ctx, virtualStorage, relativePath, limit)
+		require.NoError(t, err)
+		for i := range entries {
+			// The creation time is set by the database.
+			require.False(t, entries[i].CreatedAt.IsZero())
+			entries[i].CreatedAt = time.Time{}
+		}
+
+		return entries
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		virtualStorage string
+		relativePath   string
+		limit          int
+		expected       []ConsistencyAuditEntry
+	}{
+		{
+			desc:     "all entries newest first",
+			limit:    10,
+			expected: []ConsistencyAuditEntry{otherVirtualStorage, noMajority[1], noMajority[0], repaired},
+		},
+		{
+			desc:     "limited",
+			limit:    1,
+			expected: []ConsistencyAuditEntry{otherVirtualStorage},
+		},
+		{
+			desc:           "filtered by virtual storage",
+			virtualStorage: "virtual-storage-1",
+			limit:          10,
+			expected:       []ConsistencyAuditEntry{noMajority[1], noMajority[0], repaired},
+		},
+		{
+			desc:           "filtered by relative path",
+			virtualStorage: "virtual-storage-1",
+			relativePath:   "relative-path-1",
+			limit:          10,
+			expected:       []ConsistencyAuditEntry{repaired},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, list(t, tc.virtualStorage, tc.relativePath, tc.limit))
+		})
+	}
+}
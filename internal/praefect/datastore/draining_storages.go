@@ -0,0 +1,105 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// DrainingStorageStore manages the physical storages that are drained for maintenance. Draining storages
+// are not considered healthy, so no new requests are routed to them and they are not elected as primaries.
+type DrainingStorageStore struct {
+	db glsql.Querier
+}
+
+// NewDrainingStorageStore returns a new DrainingStorageStore.
+func NewDrainingStorageStore(db glsql.Querier) *DrainingStorageStore {
+	return &DrainingStorageStore{db: db}
+}
+
+// DrainStorage marks the storage as draining and elects a new primary for the repositories the storage is
+// the primary of. It returns the number of repositories that got a new primary and the number of repositories
+// that still have the storage as their primary as there was no other valid primary candidate.
+func (s *DrainingStorageStore) DrainStorage(ctx context.Context, virtualStorage, storage string) (int64, int64, error) {
+	// The storage is marked draining and the primaries are re-elected in a single statement so that both are
+	// applied atomically. The primaries are re-elected right away rather than lazily on the next access so the
+	// storage stops receiving writes as soon as possible. The statement's sub-queries don't see the storage
+	// being marked draining, so it's excluded from the valid primaries explicitly. The remaining primaries are
+	// counted from the snapshot before the update.
+	var reelected, remaining int64
+	if err := s.db.QueryRowContext(ctx, `
+WITH draining AS (
+	INSERT INTO draining_storages (virtual_storage, storage)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+),
+
+reelected AS (
+	UPDATE repositories
+	SET "primary" = (
+		SELECT storage
+		FROM valid_primaries
+		WHERE valid_primaries.repository_id = repositories.repository_id
+		AND valid_primaries.storage != $2
+		ORDER BY random()
+		LIMIT 1
+	)
+	WHERE virtual_storage = $1
+	AND "primary" = $2
+	AND EXISTS (
+		SELECT FROM valid_primaries
+		WHERE valid_primaries.repository_id = repositories.repository_id
+		AND valid_primaries.storage != $2
+	)
+	RETURNING repository_id
+)
+
+SELECT
+	(SELECT COUNT(*) FROM reelected),
+	(SELECT COUNT(*) FROM repositories WHERE virtual_storage = $1 AND "primary" = $2) - (SELECT COUNT(*) FROM reelected)
+	`, virtualStorage, storage).Scan(&reelected, &remaining); err != nil {
+		return 0, 0, fmt.Errorf("drain: %w", err)
+	}
+
+	return reelected, remaining, nil
+}
+
+// UndrainStorage removes the draining mark from the storage. The storage only serves reads and is
+// elected as a primary again for repositories once it has caught up with their replication.
+func (s *DrainingStorageStore) UndrainStorage(ctx context.Context, virtualStorage, storage string) error {
+	if _, err := s.db.ExecContext(ctx, `
+DELETE FROM draining_storages
+WHERE virtual_storage = $1
+AND storage = $2
+	`, virtualStorage, storage); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	return nil
+}
+
+// GetDrainingStorages returns the storages that are currently draining.
+func (s *DrainingStorageStore) GetDrainingStorages(ctx context.Context) ([]ClusterPath, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT virtual_storage, storage
+FROM draining_storages
+ORDER BY virtual_storage, storage
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var storages []ClusterPath
+	for rows.Next() {
+		var storage ClusterPath
+		if err := rows.Scan(&storage.VirtualStorage, &storage.Storage); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		storages = append(storages, storage)
+	}
+
+	return storages, rows.Err()
+}
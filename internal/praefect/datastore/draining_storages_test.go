@@ -0,0 +1,89 @@
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestDrainingStorageStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	const virtualStorage = "virtual-storage"
+	storages := []string{"primary", "secondary-1", "secondary-2"}
+
+	testdb.SetHealthyNodes(t, ctx, db, map[string]map[string][]string{
+		"praefect-0": {virtualStorage: storages},
+	})
+
+	rs := NewPostgresRepositoryStore(db, map[string][]string{virtualStorage: storages})
+	// The first repository has an up to date secondary that can take over as the primary.
+	require.NoError(t, rs.CreateRepository(ctx, 1, virtualStorage, "repository-1", "replica-path-1", "primary", []string{"secondary-1"}, nil, true, false))
+	// The second repository has no other replica to elect as the primary.
+	require.NoError(t, rs.CreateRepository(ctx, 2, virtualStorage, "repository-2", "replica-path-2", "primary", nil, nil, true, false))
+	// The third repository's primary is not drained.
+	require.NoError(t, rs.CreateRepository(ctx, 3, virtualStorage, "repository-3", "replica-path-3", "secondary-2", []string{"primary"}, nil, true, false))
+
+	getPrimary := func(t *testing.T, repositoryID int64) string {
+		t.Helper()
+		metadata, err := rs.GetRepositoryMetadata(ctx, repositoryID)
+		require.NoError(t, err)
+		return metadata.Primary
+	}
+
+	getHealthyStorages := func(t *testing.T) []string {
+		t.Helper()
+
+		rows, err := db.QueryContext(ctx, "SELECT storage FROM healthy_storages WHERE virtual_storage = $1", virtualStorage)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var healthy []string
+		for rows.Next() {
+			var storage string
+			require.NoError(t, rows.Scan(&storage))
+			healthy = append(healthy, storage)
+		}
+		require.NoError(t, rows.Err())
+
+		return healthy
+	}
+
+	store := NewDrainingStorageStore(db)
+
+	draining, err := store.GetDrainingStorages(ctx)
+	require.NoError(t, err)
+	require.Empty(t, draining)
+
+	reelected, remaining, err := store.DrainStorage(ctx, virtualStorage, "primary")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), reelected)
+	require.Equal(t, int64(1), remaining)
+
+	require.Equal(t, "secondary-1", getPrimary(t, 1))
+	require.Equal(t, "primary", getPrimary(t, 2))
+	require.Equal(t, "secondary-2", getPrimary(t, 3))
+	require.Equal(t, []string{"secondary-1", "secondary-2"}, getHealthyStorages(t))
+
+	draining, err = store.GetDrainingStorages(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []ClusterPath{{VirtualStorage: virtualStorage, Storage: "primary"}}, draining)
+
+	// Draining an already draining storage is a no-op.
+	reelected, remaining, err = store.DrainStorage(ctx, virtualStorage, "primary")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), reelected)
+	require.Equal(t, int64(1), remaining)
+
+	require.NoError(t, store.UndrainStorage(ctx, virtualStorage, "primary"))
+
+	draining, err = store.GetDrainingStorages(ctx)
+	require.NoError(t, err)
+	require.Empty(t, draining)
+	require.Equal(t, storages, getHealthyStorages(t))
+}
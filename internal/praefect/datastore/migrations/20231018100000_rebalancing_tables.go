@@ -0,0 +1,34 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20231018100000_rebalancing_tables",
+		Up: []string{
+			`
+CREATE TABLE rebalancing_plans (
+	virtual_storage TEXT PRIMARY KEY,
+	strategy TEXT NOT NULL,
+	drained_storages TEXT[] NOT NULL DEFAULT '{}',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)
+			`,
+			`
+CREATE TABLE rebalancing_moves (
+	repository_id BIGINT NOT NULL REFERENCES repositories ON DELETE CASCADE,
+	source_storage TEXT NOT NULL,
+	target_storage TEXT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (repository_id, source_storage)
+)
+			`,
+		},
+		Down: []string{
+			"DROP TABLE rebalancing_moves",
+			"DROP TABLE rebalancing_plans",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
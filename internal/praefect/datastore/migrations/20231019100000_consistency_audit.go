@@ -0,0 +1,32 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20231019100000_consistency_audit",
+		Up: []string{
+			`
+CREATE TABLE consistency_audit (
+	id BIGSERIAL PRIMARY KEY,
+	repository_id BIGINT NOT NULL,
+	virtual_storage TEXT NOT NULL,
+	relative_path TEXT NOT NULL,
+	storage TEXT NOT NULL,
+	generation BIGINT NOT NULL,
+	checksum TEXT NOT NULL,
+	majority_checksum TEXT,
+	source_storage TEXT,
+	decision TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)
+			`,
+			"CREATE INDEX consistency_audit_repository_index ON consistency_audit (virtual_storage, relative_path)",
+		},
+		Down: []string{
+			"DROP TABLE consistency_audit",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20231020100000_replication_queue_priority",
+		Up: []string{
+			// Jobs queued before priorities were introduced are treated as user writes.
+			"ALTER TABLE replication_queue ADD COLUMN priority SMALLINT NOT NULL DEFAULT 3",
+		},
+		Down: []string{
+			"ALTER TABLE replication_queue DROP COLUMN priority",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20231021100000_draining_storages",
+		Up: []string{
+			`
+CREATE TABLE draining_storages (
+	virtual_storage TEXT NOT NULL,
+	storage TEXT NOT NULL,
+	drained_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+	PRIMARY KEY (virtual_storage, storage)
+)`,
+			// Draining storages are not considered healthy so they are not elected as primaries.
+			`
+CREATE OR REPLACE VIEW healthy_storages AS
+	SELECT shard_name AS virtual_storage, node_name AS storage
+	FROM node_status AS ns
+	WHERE last_seen_active_at >= NOW() - INTERVAL '10 SECOND'
+	AND NOT EXISTS (
+		SELECT FROM draining_storages
+		WHERE draining_storages.virtual_storage = ns.shard_name
+		AND draining_storages.storage = ns.node_name
+	)
+	GROUP BY shard_name, node_name
+	HAVING COUNT(praefect_name) >= (
+		SELECT CEIL(COUNT(DISTINCT praefect_name) / 2.0) AS quorum_count
+		FROM node_status
+		WHERE shard_name = ns.shard_name
+		AND last_contact_attempt_at >= NOW() - INTERVAL '60 SECOND'
+	)
+	ORDER BY shard_name, node_name
+`,
+		},
+		Down: []string{
+			`
+CREATE OR REPLACE VIEW healthy_storages AS
+	SELECT shard_name AS virtual_storage, node_name AS storage
+	FROM node_status AS ns
+	WHERE last_seen_active_at >= NOW() - INTERVAL '10 SECOND'
+	GROUP BY shard_name, node_name
+	HAVING COUNT(praefect_name) >= (
+		SELECT CEIL(COUNT(DISTINCT praefect_name) / 2.0) AS quorum_count
+		FROM node_status
+		WHERE shard_name = ns.shard_name
+		AND last_contact_attempt_at >= NOW() - INTERVAL '60 SECOND'
+	)
+	ORDER BY shard_name, node_name
+`,
+			"DROP TABLE draining_storages",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
@@ -0,0 +1,71 @@
+package datastore
+
+import "time"
+
+// ReplicationOrigin describes what caused a replication job to be scheduled.
+type ReplicationOrigin string
+
+const (
+	// OriginUser is the origin of jobs replicating a user's write.
+	OriginUser ReplicationOrigin = "user"
+	// OriginReconciler is the origin of jobs scheduled by the reconciler to fix outdated or
+	// unnecessary replicas.
+	OriginReconciler ReplicationOrigin = "reconciler"
+	// OriginRepair is the origin of jobs repairing replicas whose checksum differs from the other
+	// replicas on the same generation.
+	OriginRepair ReplicationOrigin = "repair"
+	// OriginRebalance is the origin of jobs moving replicas between storages.
+	OriginRebalance ReplicationOrigin = "rebalance"
+)
+
+// OriginKey is the key of the job's origin in the event's meta data. Events without an origin
+// are considered to replicate a user's write.
+const OriginKey = "origin"
+
+// Priorities of the replication jobs. Jobs with a higher priority are dequeued first.
+const (
+	// PriorityRebalance is the priority of jobs moving replicas between storages.
+	PriorityRebalance = 0
+	// PriorityReconciler is the priority of jobs scheduled by the reconciler. There may be a
+	// large number of these after a storage has been unavailable.
+	PriorityReconciler = 1
+	// PriorityRepair is the priority of jobs repairing inconsistent replicas.
+	PriorityRepair = 2
+	// PriorityUser is the priority of jobs replicating a user's write.
+	PriorityUser = 3
+	// PriorityInteractive is the priority of jobs replicating a user's write that the user is
+	// likely to immediately act upon, such as creating or renaming a repository.
+	PriorityInteractive = 4
+)
+
+// PriorityAgingInterval is the time after which a queued job's priority is raised by one to prevent jobs
+// with a low priority from starving.
+const PriorityAgingInterval = 5 * time.Minute
+
+// Origin returns the origin of the event.
+func (event ReplicationEvent) Origin() ReplicationOrigin {
+	if origin, ok := event.Meta[OriginKey].(string); ok && origin != "" {
+		return ReplicationOrigin(origin)
+	}
+
+	return OriginUser
+}
+
+// ReplicationPriority returns the priority of a job with the given change type and origin.
+func ReplicationPriority(change ChangeType, origin ReplicationOrigin) int {
+	switch origin {
+	case OriginRebalance:
+		return PriorityRebalance
+	case OriginReconciler:
+		return PriorityReconciler
+	case OriginRepair:
+		return PriorityRepair
+	}
+
+	switch change {
+	case CreateRepo, RenameRepo:
+		return PriorityInteractive
+	default:
+		return PriorityUser
+	}
+}
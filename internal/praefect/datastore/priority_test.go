@@ -0,0 +1,42 @@
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationEvent_Origin(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, OriginUser, ReplicationEvent{}.Origin())
+	require.Equal(t, OriginUser, ReplicationEvent{Meta: Params{OriginKey: ""}}.Origin())
+	require.Equal(t, OriginUser, ReplicationEvent{Meta: Params{OriginKey: 1}}.Origin())
+	require.Equal(t, OriginRepair, ReplicationEvent{Meta: Params{OriginKey: "repair"}}.Origin())
+}
+
+func TestReplicationPriority(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc             string
+		change           ChangeType
+		origin           ReplicationOrigin
+		expectedPriority int
+	}{
+		{desc: "user update", change: UpdateRepo, origin: OriginUser, expectedPriority: PriorityUser},
+		{desc: "user deletion", change: DeleteReplica, origin: OriginUser, expectedPriority: PriorityUser},
+		{desc: "user creation", change: CreateRepo, origin: OriginUser, expectedPriority: PriorityInteractive},
+		{desc: "user rename", change: RenameRepo, origin: OriginUser, expectedPriority: PriorityInteractive},
+		{desc: "reconciler update", change: UpdateRepo, origin: OriginReconciler, expectedPriority: PriorityReconciler},
+		{desc: "repair update", change: UpdateRepo, origin: OriginRepair, expectedPriority: PriorityRepair},
+		{desc: "rebalance deletion", change: DeleteReplica, origin: OriginRebalance, expectedPriority: PriorityRebalance},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expectedPriority, ReplicationPriority(tc.change, tc.origin))
+		})
+	}
+}
@@ -231,8 +231,15 @@ type PostgresReplicationEventQueue struct {
 // When `Enqueue` method is called:
 //  1. Insertion of the new record into `replication_queue_lock` table, so we are ensured all events have
 //     a corresponding <lock>. If a record already exists it won't be inserted again.
-//  2. Insertion of the new record into the `replication_queue` table with the defaults listed above,
-//     the job, the meta and corresponding <lock> used in `replication_queue_lock` table for the `lock_id` column.
+//  2. Events for the same <lock> with the same change that are still `ready` are superseded by the new event.
+//     As `update` events replicate the latest state of the repository, `update` events in `failed` state are
+//     superseded as well. Superseded events are collapsed into the oldest `ready` event, which takes on the
+//     highest priority and the earliest creation time of the superseded events. If there is no `ready` event,
+//     the superseded events are removed and replaced by the new event.
+//  3. Insertion of the new record into the `replication_queue` table with the defaults listed above,
+//     the job, the meta, the priority derived from the job's change and the event's origin, and corresponding
+//     <lock> used in `replication_queue_lock` table for the `lock_id` column. The record is not inserted if
+//     the event was collapsed into an existing `ready` event.
 func (rq PostgresReplicationEventQueue) Enqueue(ctx context.Context, event ReplicationEvent) (ReplicationEvent, error) {
 	query := `
 		WITH insert_lock AS (
@@ -241,21 +248,47 @@ func (rq PostgresReplicationEventQueue) Enqueue(ctx context.Context, event Repli
 			ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
 			RETURNING id
 		)
-		INSERT INTO replication_queue(lock_id, job, meta)
+		, superseded AS (
+			SELECT queue.id, queue.state, queue.created_at, queue.priority
+			FROM replication_queue AS queue
+			JOIN insert_lock ON queue.lock_id = insert_lock.id
+			WHERE queue.job->>'change' = $4::json->>'change'
+			AND (
+				queue.state = 'ready'
+				OR (queue.state = 'failed' AND queue.job->>'change' = 'update')
+			)
+			FOR UPDATE OF queue
+		)
+		, collapse_into AS (
+			SELECT id
+			FROM superseded
+			WHERE state = 'ready'
+			ORDER BY created_at, id
+			LIMIT 1
+		)
+		, collapse AS (
+			UPDATE replication_queue AS queue
+			SET priority = GREATEST($6, (SELECT MAX(priority) FROM superseded))
+				, created_at = (SELECT MIN(created_at) FROM superseded)
+			FROM collapse_into
+			WHERE queue.id = collapse_into.id
+		)
+		, remove_superseded AS (
+			DELETE FROM replication_queue AS queue
+			USING superseded
+			WHERE queue.id = superseded.id
+			AND NOT EXISTS (SELECT FROM collapse_into WHERE collapse_into.id = queue.id)
+		)
+		INSERT INTO replication_queue(lock_id, job, meta, priority, created_at)
 		SELECT insert_lock.id, $4, $5
+			, GREATEST($6, (SELECT MAX(priority) FROM superseded))
+			, COALESCE((SELECT MIN(created_at) FROM superseded), NOW() AT TIME ZONE 'UTC')
 		FROM insert_lock
-		WHERE NOT EXISTS (
-			SELECT
-			FROM replication_queue AS q
-			WHERE q.state = 'ready'
-			AND q.job->>'virtual_storage' = $4::json->>'virtual_storage'
-			AND q.job->>'relative_path' = $4::json->>'relative_path'
-			AND q.job->>'target_node_storage' = $4::json->>'target_node_storage'
-			AND q.job->>'change' = $4::json->>'change'
-		)
+		WHERE NOT EXISTS (SELECT FROM collapse_into)
 		RETURNING id, state, created_at, updated_at, lock_id, attempt, job, meta`
 	// this will always return a single row result (because of lock uniqueness) or an error
-	rows, err := rq.qc.QueryContext(ctx, query, event.Job.VirtualStorage, event.Job.TargetNodeStorage, event.Job.RelativePath, event.Job, event.Meta)
+	rows, err := rq.qc.QueryContext(ctx, query, event.Job.VirtualStorage, event.Job.TargetNodeStorage, event.Job.RelativePath, event.Job, event.Meta,
+		ReplicationPriority(event.Job.Change, event.Origin()))
 	if err != nil {
 		return ReplicationEvent{}, fmt.Errorf("query: %w", err)
 	}
@@ -287,6 +320,9 @@ func (rq PostgresReplicationEventQueue) Enqueue(ctx context.Context, event Repli
 //  2. Events for repositories that are already locked by another Praefect instance are filtered out.
 //     Repository locks are stored in the `replication_queue_lock` table.
 //
+//     The remaining events are dequeued in the order of their priority, oldest first. An event's priority is raised
+//     by one for every `PriorityAgingInterval` it has spent in the queue so events with a low priority don't starve.
+//
 //  3. The events that still remain after filtering are dequeued. On dequeuing:
 //     - The event's attempts are decremented by 1.
 //     - The event's state is set to `in_progress`
@@ -321,7 +357,7 @@ func (rq PostgresReplicationEventQueue) Dequeue(ctx context.Context, virtualStor
 				WHERE queue.state IN ('ready', 'failed' )
 					AND NOT EXISTS (SELECT 1 FROM replication_queue_job_lock WHERE lock_id = queue.lock_id)
 			)
-			ORDER BY created_at
+			ORDER BY priority + FLOOR(EXTRACT(EPOCH FROM (NOW() AT TIME ZONE 'UTC' - created_at)) / $4) DESC, created_at
 			LIMIT $3
 			FOR UPDATE
 		)
@@ -349,7 +385,7 @@ func (rq PostgresReplicationEventQueue) Dequeue(ctx context.Context, virtualStor
 		SELECT id, state, created_at, updated_at, lock_id, attempt, job, meta
 		FROM job
 		ORDER BY id`
-	rows, err := rq.qc.QueryContext(ctx, query, virtualStorage, nodeStorage, count)
+	rows, err := rq.qc.QueryContext(ctx, query, virtualStorage, nodeStorage, count, PriorityAgingInterval.Seconds())
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
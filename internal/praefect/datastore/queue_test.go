@@ -1134,6 +1134,221 @@ func TestPostgresReplicationEventQueue_DuplicateEvent(t *testing.T) {
 	}
 }
 
+func TestPostgresReplicationEventQueue_DequeuePriority(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	newEvent := func(relativePath string, change ChangeType, origin ReplicationOrigin) ReplicationEvent {
+		return ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            change,
+				RelativePath:      relativePath,
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+			},
+			Meta: Params{OriginKey: string(origin)},
+		}
+	}
+
+	dequeueRelativePaths := func(t *testing.T, queue ReplicationEventQueue, count int) []string {
+		t.Helper()
+
+		events, err := queue.Dequeue(ctx, "praefect", "gitaly-1", count)
+		require.NoError(t, err)
+
+		relativePaths := make([]string, 0, len(events))
+		for _, event := range events {
+			relativePaths = append(relativePaths, event.Job.RelativePath)
+		}
+
+		return relativePaths
+	}
+
+	t.Run("higher priority first", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		for _, event := range []ReplicationEvent{
+			newEvent("rebalance", UpdateRepo, OriginRebalance),
+			newEvent("reconciler", UpdateRepo, OriginReconciler),
+			newEvent("repair", UpdateRepo, OriginRepair),
+			newEvent("user", UpdateRepo, OriginUser),
+			newEvent("interactive", CreateRepo, OriginUser),
+		} {
+			_, err := queue.Enqueue(ctx, event)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, []string{"interactive"}, dequeueRelativePaths(t, queue, 1))
+		require.Equal(t, []string{"user"}, dequeueRelativePaths(t, queue, 1))
+		require.Equal(t, []string{"repair"}, dequeueRelativePaths(t, queue, 1))
+		require.Equal(t, []string{"reconciler"}, dequeueRelativePaths(t, queue, 1))
+		require.Equal(t, []string{"rebalance"}, dequeueRelativePaths(t, queue, 1))
+	})
+
+	t.Run("same priority oldest first", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		for _, relativePath := range []string{"first", "second"} {
+			_, err := queue.Enqueue(ctx, newEvent(relativePath, UpdateRepo, OriginReconciler))
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, []string{"first"}, dequeueRelativePaths(t, queue, 1))
+		require.Equal(t, []string{"second"}, dequeueRelativePaths(t, queue, 1))
+	})
+
+	t.Run("aged jobs are not starved", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		rebalance, err := queue.Enqueue(ctx, newEvent("rebalance", UpdateRepo, OriginRebalance))
+		require.NoError(t, err)
+
+		_, err = queue.Enqueue(ctx, newEvent("user", UpdateRepo, OriginUser))
+		require.NoError(t, err)
+
+		// Age the rebalancing job so its priority surpasses the user's job.
+		_, err = db.ExecContext(ctx, `UPDATE replication_queue SET created_at = created_at - $2 * INTERVAL '1 second' WHERE id = $1`,
+			rebalance.ID, 4*PriorityAgingInterval.Seconds())
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"rebalance"}, dequeueRelativePaths(t, queue, 1))
+		require.Equal(t, []string{"user"}, dequeueRelativePaths(t, queue, 1))
+	})
+}
+
+func TestPostgresReplicationEventQueue_EnqueueSupersedes(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	event := ReplicationEvent{
+		Job: ReplicationJob{
+			Change:            UpdateRepo,
+			RelativePath:      "/project/path-1",
+			TargetNodeStorage: "gitaly-1",
+			SourceNodeStorage: "gitaly-0",
+			VirtualStorage:    "praefect",
+		},
+	}
+
+	type queuedJob struct {
+		id       uint64
+		state    JobState
+		priority int
+	}
+
+	requireQueuedJobs := func(t *testing.T, expected []queuedJob) {
+		t.Helper()
+
+		rows, err := db.QueryContext(ctx, `SELECT id, state, priority FROM replication_queue ORDER BY id`)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var actual []queuedJob
+		for rows.Next() {
+			var job queuedJob
+			require.NoError(t, rows.Scan(&job.id, &job.state, &job.priority))
+			actual = append(actual, job)
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, expected, actual)
+	}
+
+	t.Run("ready job takes on the higher priority", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		reconcilerEvent := event
+		reconcilerEvent.Meta = Params{OriginKey: string(OriginReconciler)}
+		queued, err := queue.Enqueue(ctx, reconcilerEvent)
+		require.NoError(t, err)
+
+		_, err = queue.Enqueue(ctx, event)
+		require.Equal(t, ReplicationEventExistsError{
+			virtualStorage:    event.Job.VirtualStorage,
+			targetNodeStorage: event.Job.TargetNodeStorage,
+			relativePath:      event.Job.RelativePath,
+		}, err)
+
+		requireQueuedJobs(t, []queuedJob{{id: queued.ID, state: JobStateReady, priority: PriorityUser}})
+	})
+
+	t.Run("failed update is replaced", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		failed, err := queue.Enqueue(ctx, event)
+		require.NoError(t, err)
+
+		dequeued, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
+		require.NoError(t, err)
+		require.Len(t, dequeued, 1)
+
+		_, err = queue.Acknowledge(ctx, JobStateFailed, []uint64{dequeued[0].ID})
+		require.NoError(t, err)
+
+		reconcilerEvent := event
+		reconcilerEvent.Meta = Params{OriginKey: string(OriginReconciler)}
+		replacement, err := queue.Enqueue(ctx, reconcilerEvent)
+		require.NoError(t, err)
+		require.Equal(t, failed.CreatedAt, replacement.CreatedAt)
+		require.Equal(t, JobStateReady, replacement.State)
+		require.Equal(t, 3, replacement.Attempt)
+
+		requireQueuedJobs(t, []queuedJob{{id: replacement.ID, state: JobStateReady, priority: PriorityUser}})
+	})
+
+	t.Run("in_progress update is not replaced", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		inProgress, err := queue.Enqueue(ctx, event)
+		require.NoError(t, err)
+
+		_, err = queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
+		require.NoError(t, err)
+
+		ready, err := queue.Enqueue(ctx, event)
+		require.NoError(t, err)
+
+		requireQueuedJobs(t, []queuedJob{
+			{id: inProgress.ID, state: JobStateInProgress, priority: PriorityUser},
+			{id: ready.ID, state: JobStateReady, priority: PriorityUser},
+		})
+	})
+
+	t.Run("failed job of another change is kept", func(t *testing.T) {
+		db.TruncateAll(t)
+		queue := NewPostgresReplicationEventQueue(db)
+
+		renameEvent := event
+		renameEvent.Job.Change = RenameRepo
+		renameEvent.Job.Params = Params{"RelativePath": "/project/path-1-renamed"}
+		failed, err := queue.Enqueue(ctx, renameEvent)
+		require.NoError(t, err)
+
+		dequeued, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
+		require.NoError(t, err)
+		require.Len(t, dequeued, 1)
+
+		_, err = queue.Acknowledge(ctx, JobStateFailed, []uint64{dequeued[0].ID})
+		require.NoError(t, err)
+
+		ready, err := queue.Enqueue(ctx, renameEvent)
+		require.NoError(t, err)
+
+		requireQueuedJobs(t, []queuedJob{
+			{id: failed.ID, state: JobStateFailed, priority: PriorityInteractive},
+			{id: ready.ID, state: JobStateReady, priority: PriorityInteractive},
+		})
+	})
+}
+
 func requireEvents(t *testing.T, ctx context.Context, db testdb.DB, expected []ReplicationEvent) {
 	t.Helper()
 
@@ -8,6 +8,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/transactions"
 )
 
@@ -26,5 +27,7 @@ type Dependencies struct {
 	Registry        *protoregistry.Registry
 	Conns           Connections
 	PrimaryGetter   PrimaryGetter
+	StorageDrainer  info.StorageDrainer
+	StorageUsage    info.StorageUsageGetter
 	Checks          []service.CheckFunc
 }
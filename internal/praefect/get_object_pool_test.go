@@ -58,7 +58,7 @@ func TestGetObjectPoolHandler(t *testing.T) {
 			},
 		}
 
-		nodeSet, err := DialNodes(ctx, cfg.VirtualStorages, nil, nil, nil, nil, testhelper.SharedLogger(t))
+		nodeSet, err := DialNodes(ctx, cfg.VirtualStorages, nil, nil, nil, nil, nil, testhelper.SharedLogger(t))
 		require.NoError(t, err)
 		t.Cleanup(nodeSet.Close)
 
@@ -83,9 +83,12 @@ func TestGetObjectPoolHandler(t *testing.T) {
 				StaticHealthChecker(cfg.StorageNames()),
 				NewLockedRandom(rand.New(rand.NewSource(0))),
 				repoStore,
-				datastore.NewAssignmentStore(db, cfg.StorageNames()),
+				datastore.NewAssignmentStore(db, cfg.StorageNames(), nil),
 				repoStore,
 				nil,
+				nil,
+				nil,
+				nil,
 			),
 			Registry: protoregistry.GitalyProtoPreregistered,
 			Conns:    nodeSet.Connections(),
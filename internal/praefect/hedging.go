@@ -0,0 +1,216 @@
+package praefect
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/proxy"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/metrics"
+)
+
+const (
+	// hedgingWindowSize is the number of latest first response latencies kept per method to estimate the hedging
+	// delay from.
+	hedgingWindowSize = 1000
+	// hedgingMinimumSamples is the number of latencies that need to be observed for a method before its requests
+	// are hedged.
+	hedgingMinimumSamples = 100
+	// hedgingRecomputeInterval is the number of latencies observed after which the hedging delay is recomputed.
+	hedgingRecomputeInterval = 50
+)
+
+// latencyWindow keeps the latest first response latencies of a method and the hedging delay computed from them.
+type latencyWindow struct {
+	samples []time.Duration
+	// next is the index in samples the next latency is written to once the window is full.
+	next int
+	// sinceRecompute is the number of latencies observed since the delay was last computed.
+	sinceRecompute int
+	delay          time.Duration
+}
+
+func (w *latencyWindow) observe(latency time.Duration, percentile float64) {
+	if len(w.samples) < hedgingWindowSize {
+		w.samples = append(w.samples, latency)
+	} else {
+		w.samples[w.next] = latency
+		w.next = (w.next + 1) % hedgingWindowSize
+	}
+
+	w.sinceRecompute++
+	if len(w.samples) < hedgingMinimumSamples {
+		return
+	}
+
+	if w.delay != 0 && w.sinceRecompute < hedgingRecomputeInterval {
+		return
+	}
+
+	sorted := make([]time.Duration, len(w.samples))
+	copy(sorted, w.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	index := int(math.Ceil(percentile/100*float64(len(sorted)))) - 1
+	if index < 0 {
+		index = 0
+	}
+
+	w.delay = sorted[index]
+	w.sinceRecompute = 0
+}
+
+// hedger estimates after which delay repository accessor requests are hedged. The delay is the configured percentile
+// of the method's first response latency, but at least the configured minimum delay.
+type hedger struct {
+	percentile float64
+	minDelay   time.Duration
+
+	m       sync.Mutex
+	windows map[string]*latencyWindow
+
+	eligibleRequestsTotal *prometheus.CounterVec
+	hedgedRequestsTotal   *prometheus.CounterVec
+	hedgeWinsTotal        *prometheus.CounterVec
+}
+
+func newHedger(cfg config.Hedging) *hedger {
+	return &hedger{
+		percentile: cfg.Percentile,
+		minDelay:   cfg.MinDelay.Duration(),
+		windows:    map[string]*latencyWindow{},
+		eligibleRequestsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_praefect_hedging_eligible_requests_total",
+				Help: "The number of repository accessor requests that were eligible for hedging",
+			},
+			[]string{"virtual_storage"},
+		),
+		hedgedRequestsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_praefect_hedged_requests_total",
+				Help: "The number of repository accessor requests that were sent to a second replica",
+			},
+			[]string{"virtual_storage"},
+		),
+		hedgeWinsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_praefect_hedged_request_wins_total",
+				Help: "The number of hedged repository accessor requests that were served by the second replica",
+			},
+			[]string{"virtual_storage"},
+		),
+	}
+}
+
+// delay returns the delay after which a request to the method should be hedged. It returns false if not enough
+// latencies have been observed for the method yet.
+func (h *hedger) delay(fullMethodName string) (time.Duration, bool) {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	window, ok := h.windows[fullMethodName]
+	if !ok || len(window.samples) < hedgingMinimumSamples {
+		return 0, false
+	}
+
+	if window.delay < h.minDelay {
+		return h.minDelay, true
+	}
+
+	return window.delay, true
+}
+
+// observe records the outcome of a request to the method.
+func (h *hedger) observe(virtualStorage, fullMethodName string, result proxy.HedgeResult) {
+	if result.HedgeWon {
+		h.hedgeWinsTotal.WithLabelValues(virtualStorage).Inc()
+	}
+
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	window, ok := h.windows[fullMethodName]
+	if !ok {
+		window = &latencyWindow{}
+		h.windows[fullMethodName] = window
+	}
+
+	// Only the primary's latency is recorded. The delay would otherwise decrease with every request the hedge
+	// has won, making hedging more and more aggressive. If the hedge won, the recorded latency is a lower bound
+	// of the primary's latency. It is still above the delay the request was hedged after, so the percentile the
+	// delay is computed from doesn't shift.
+	window.observe(result.PrimaryLatency, h.percentile)
+}
+
+// Describe describes the hedging metrics.
+func (h *hedger) Describe(descs chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(h, descs)
+}
+
+// Collect collects the hedging metrics.
+func (h *hedger) Collect(metrics chan<- prometheus.Metric) {
+	h.eligibleRequestsTotal.Collect(metrics)
+	h.hedgedRequestsTotal.Collect(metrics)
+	h.hedgeWinsTotal.Collect(metrics)
+}
+
+// accessorHedge returns the hedging configuration of a repository accessor request that was routed to the route's
+// node. The request is only hedged if hedging is enabled, the request may be hedged and enough latencies of the
+// method have been observed to estimate the delay. The primary's first response latency is reported to the load
+// tracker regardless of whether the request is hedged.
+func (c *Coordinator) accessorHedge(ctx context.Context, call grpcCall, route RepositoryAccessorRoute, hedgeable bool) proxy.Hedge {
+	virtualStorage := call.targetRepo.GetStorageName()
+	hedgeable = hedgeable && c.hedger != nil
+
+	hedge := proxy.Hedge{
+		Report: func(result proxy.HedgeResult) {
+			if c.loads != nil {
+				c.loads.ObserveLatency(route.Node.Storage, result.PrimaryLatency)
+			}
+
+			if hedgeable {
+				c.hedger.observe(virtualStorage, call.fullMethodName, result)
+			}
+		},
+	}
+
+	if !hedgeable {
+		return hedge
+	}
+
+	delay, ok := c.hedger.delay(call.fullMethodName)
+	if !ok {
+		return hedge
+	}
+
+	c.hedger.eligibleRequestsTotal.WithLabelValues(virtualStorage).Inc()
+
+	hedge.Delay = delay
+	hedge.Destination = func() (proxy.Destination, error) {
+		node, err := c.router.RouteRepositoryAccessorHedge(ctx, virtualStorage, call.targetRepo.GetRelativePath(), route)
+		if err != nil {
+			return proxy.Destination{}, err
+		}
+
+		b, err := rewrittenRepositoryMessage(call.methodInfo, call.msg, node.Storage, route.ReplicaPath, "")
+		if err != nil {
+			return proxy.Destination{}, err
+		}
+
+		metrics.ReadDistribution.WithLabelValues(virtualStorage, node.Storage).Inc()
+		c.hedger.hedgedRequestsTotal.WithLabelValues(virtualStorage).Inc()
+
+		return proxy.Destination{
+			Ctx:  streamParametersContext(ctx),
+			Conn: node.Connection,
+			Msg:  b,
+		}, nil
+	}
+
+	return hedge
+}
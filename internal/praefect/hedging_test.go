@@ -0,0 +1,270 @@
+package praefect
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/proxy"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestHedger(t *testing.T) {
+	t.Parallel()
+
+	const method = "/gitaly.CommitService/FindCommit"
+
+	h := newHedger(config.Hedging{
+		Enabled:    true,
+		Percentile: 95,
+		MinDelay:   duration.Duration(10 * time.Millisecond),
+	})
+
+	for i := 1; i < hedgingMinimumSamples; i++ {
+		h.observe("virtual-storage", method, proxy.HedgeResult{PrimaryLatency: time.Duration(i) * time.Millisecond})
+	}
+
+	_, ok := h.delay(method)
+	require.False(t, ok, "requests must not be hedged before enough latencies were observed")
+
+	h.observe("virtual-storage", method, proxy.HedgeResult{PrimaryLatency: hedgingMinimumSamples * time.Millisecond})
+
+	delay, ok := h.delay(method)
+	require.True(t, ok)
+	require.Equal(t, 95*time.Millisecond, delay)
+
+	_, ok = h.delay("/gitaly.CommitService/ListCommits")
+	require.False(t, ok, "latencies are tracked per method")
+
+	t.Run("delay is recomputed periodically", func(t *testing.T) {
+		for i := 0; i < hedgingWindowSize; i++ {
+			h.observe("virtual-storage", method, proxy.HedgeResult{PrimaryLatency: time.Second})
+		}
+
+		delay, ok := h.delay(method)
+		require.True(t, ok)
+		require.Equal(t, time.Second, delay)
+	})
+
+	t.Run("minimum delay", func(t *testing.T) {
+		for i := 0; i < hedgingWindowSize; i++ {
+			h.observe("virtual-storage", method, proxy.HedgeResult{PrimaryLatency: time.Millisecond})
+		}
+
+		delay, ok := h.delay(method)
+		require.True(t, ok)
+		require.Equal(t, 10*time.Millisecond, delay)
+	})
+
+	h.observe("virtual-storage", method, proxy.HedgeResult{Hedged: true, HedgeWon: true, PrimaryLatency: time.Millisecond})
+	h.observe("virtual-storage", method, proxy.HedgeResult{Hedged: true, PrimaryLatency: time.Millisecond})
+
+	require.NoError(t, testutil.CollectAndCompare(h, strings.NewReader(`
+# HELP gitaly_praefect_hedged_request_wins_total The number of hedged repository accessor requests that were served by the second replica
+# TYPE gitaly_praefect_hedged_request_wins_total counter
+gitaly_praefect_hedged_request_wins_total{virtual_storage="virtual-storage"} 1
+`), "gitaly_praefect_hedged_request_wins_total"))
+}
+
+// latencyRecordingLoads is a tracker.LoadTracker that records the observed latencies.
+type latencyRecordingLoads struct {
+	staticLoads
+	latencies map[string][]time.Duration
+}
+
+func (l latencyRecordingLoads) ObserveLatency(node string, latency time.Duration) {
+	l.latencies[node] = append(l.latencies[node], latency)
+}
+
+func TestCoordinator_accessorHedge(t *testing.T) {
+	t.Parallel()
+
+	const method = "/gitaly.CommitService/FindCommit"
+
+	ctx := testhelper.Context(t)
+	call := grpcCall{
+		fullMethodName: method,
+		targetRepo:     &gitalypb.Repository{StorageName: "virtual-storage", RelativePath: "repository"},
+	}
+	route := RepositoryAccessorRoute{Node: RouterNode{Storage: "primary"}}
+
+	hedgerWithDelay := func() *hedger {
+		h := newHedger(config.Hedging{Enabled: true, Percentile: 95})
+		for i := 0; i < hedgingMinimumSamples; i++ {
+			h.observe("virtual-storage", method, proxy.HedgeResult{PrimaryLatency: time.Millisecond})
+		}
+		return h
+	}
+
+	for _, tc := range []struct {
+		desc              string
+		hedger            bool
+		loads             bool
+		hedgeable         bool
+		expectHedge       bool
+		expectedLatencies map[string][]time.Duration
+		expectedSamples   int
+	}{
+		{
+			desc:              "load tracking without hedging",
+			loads:             true,
+			hedgeable:         true,
+			expectedLatencies: map[string][]time.Duration{"primary": {time.Second}},
+		},
+		{
+			desc:            "hedging without load tracking",
+			hedger:          true,
+			hedgeable:       true,
+			expectHedge:     true,
+			expectedSamples: hedgingMinimumSamples + 1,
+		},
+		{
+			desc:              "hedging and load tracking",
+			hedger:            true,
+			loads:             true,
+			hedgeable:         true,
+			expectHedge:       true,
+			expectedLatencies: map[string][]time.Duration{"primary": {time.Second}},
+			expectedSamples:   hedgingMinimumSamples + 1,
+		},
+		{
+			desc:              "request may not be hedged",
+			hedger:            true,
+			loads:             true,
+			expectedLatencies: map[string][]time.Duration{"primary": {time.Second}},
+			expectedSamples:   hedgingMinimumSamples,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			loads := latencyRecordingLoads{latencies: map[string][]time.Duration{}}
+
+			var coordinator Coordinator
+			if tc.hedger {
+				coordinator.hedger = hedgerWithDelay()
+			}
+			if tc.loads {
+				coordinator.loads = loads
+			}
+
+			hedge := coordinator.accessorHedge(ctx, call, route, tc.hedgeable)
+			require.Equal(t, tc.expectHedge, hedge.Destination != nil)
+
+			hedge.Report(proxy.HedgeResult{PrimaryLatency: time.Second})
+
+			if tc.expectedLatencies == nil {
+				tc.expectedLatencies = map[string][]time.Duration{}
+			}
+			require.Equal(t, tc.expectedLatencies, loads.latencies)
+
+			if coordinator.hedger != nil {
+				require.Len(t, coordinator.hedger.windows[method].samples, tc.expectedSamples)
+			}
+		})
+	}
+}
+
+func TestPerRepositoryRouter_RouteRepositoryAccessorHedge(t *testing.T) {
+	t.Parallel()
+
+	conns := Connections{
+		"virtual-storage": {
+			"primary":   &grpc.ClientConn{},
+			"storage-a": &grpc.ClientConn{},
+			"storage-b": &grpc.ClientConn{},
+		},
+	}
+
+	newRouter := func(healthy []string, consistent ...string) *PerRepositoryRouter {
+		return NewPerRepositoryRouter(
+			conns,
+			nil,
+			StaticHealthChecker{"virtual-storage": healthy},
+			mockRandom{intnFunc: func(int) int { return 0 }},
+			datastore.MockRepositoryStore{
+				GetConsistentStoragesFunc: func(context.Context, string, string) (string, *datastructure.Set[string], error) {
+					return "replica-path", datastructure.SetFromSlice(consistent), nil
+				},
+			},
+			nil,
+			datastore.MockRepositoryStore{},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	route := RepositoryAccessorRoute{
+		ReplicaPath: "replica-path",
+		Node:        RouterNode{Storage: "primary", Connection: conns["virtual-storage"]["primary"]},
+	}
+
+	for _, tc := range []struct {
+		desc            string
+		healthy         []string
+		consistent      []string
+		expectedStorage string
+		expectedErr     error
+	}{
+		{
+			desc:            "another consistent node",
+			healthy:         []string{"primary", "storage-a", "storage-b"},
+			consistent:      []string{"primary", "storage-b"},
+			expectedStorage: "storage-b",
+		},
+		{
+			desc:            "unhealthy nodes are skipped",
+			healthy:         []string{"primary", "storage-b"},
+			consistent:      []string{"primary", "storage-a", "storage-b"},
+			expectedStorage: "storage-b",
+		},
+		{
+			desc:        "no other consistent node",
+			healthy:     []string{"primary", "storage-a", "storage-b"},
+			consistent:  []string{"primary"},
+			expectedErr: ErrNoSuitableNode,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			node, err := newRouter(tc.healthy, tc.consistent...).RouteRepositoryAccessorHedge(
+				testhelper.Context(t), "virtual-storage", "relative-path", route,
+			)
+			require.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				require.Equal(t, RouterNode{Storage: tc.expectedStorage, Connection: conns["virtual-storage"][tc.expectedStorage]}, node)
+			}
+		})
+	}
+
+	t.Run("client zone is preferred", func(t *testing.T) {
+		t.Parallel()
+
+		router := newRouter([]string{"primary", "storage-a", "storage-b"}, "primary", "storage-a", "storage-b")
+		router.storageZones = map[string]map[string]string{
+			"virtual-storage": {"storage-b": "zone-b"},
+		}
+
+		ctx := metadata.NewIncomingContext(testhelper.Context(t), metadata.Pairs(clientZoneHeader, "zone-b"))
+		node, err := router.RouteRepositoryAccessorHedge(ctx, "virtual-storage", "relative-path", route)
+		require.NoError(t, err)
+		require.Equal(t, "storage-b", node.Storage)
+	})
+}
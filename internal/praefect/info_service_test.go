@@ -47,6 +47,7 @@ func TestInfoService_RepositoryReplicas(t *testing.T) {
 				deps.GetBackupSink(),
 				deps.GetBackupLocator(),
 				deps.GetRepositoryCounter(),
+				deps.GetPartitionManager(),
 			))
 		}, testserver.WithDisablePraefect())
 		cfgNodes = append(cfgNodes, &config.Node{
@@ -74,6 +75,7 @@ func TestInfoService_RepositoryReplicas(t *testing.T) {
 		conf.VirtualStorages,
 		protoregistry.GitalyProtoPreregistered,
 		nil,
+		nil,
 		backchannel.NewClientHandshaker(
 			logger,
 			NewBackchannelServerFactory(
@@ -110,9 +112,12 @@ func TestInfoService_RepositoryReplicas(t *testing.T) {
 			StaticHealthChecker{virtualStorage: storages},
 			NewLockedRandom(rand.New(rand.NewSource(0))),
 			rs,
-			datastore.NewAssignmentStore(db, conf.StorageNames()),
+			datastore.NewAssignmentStore(db, conf.StorageNames(), nil),
 			rs,
 			conf.DefaultReplicationFactors(),
+			nil,
+			nil,
+			nil,
 		),
 		WithPrimaryGetter: elector,
 		WithTxMgr:         txManager,
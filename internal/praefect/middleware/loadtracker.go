@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthCheckMethod is the full method name of health checks. Health checks are excluded from load tracking as
+// they are cheap and are sent regardless of the node's load.
+var healthCheckMethod = "/" + grpc_health_v1.Health_ServiceDesc.ServiceName + "/Check"
+
+// UnaryLoadTracker returns a client interceptor that tracks the requests in flight to internal gitaly nodes. The
+// latency of the node is not observed here but by the proxy, see tracker.LoadTracker.ObserveLatency.
+func UnaryLoadTracker(loadTracker tracker.LoadTracker, nodeStorage string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if method == healthCheckMethod {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		done := loadTracker.RequestStarted(nodeStorage)
+		defer done()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamLoadTracker returns a client interceptor that tracks the streams in flight to internal gitaly nodes. A
+// stream is considered to be finished as soon as receiving from it fails, which includes reaching the end of the
+// stream, or when its context is done.
+func StreamLoadTracker(loadTracker tracker.LoadTracker, nodeStorage string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if method == healthCheckMethod {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		done := loadTracker.RequestStarted(nodeStorage)
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done()
+			return nil, err
+		}
+
+		go func() {
+			<-stream.Context().Done()
+			done()
+		}()
+
+		return &loadTrackingStream{ClientStream: stream, done: done}, nil
+	}
+}
+
+// loadTrackingStream is a grpc.ClientStream which signals the load tracker when the stream has finished.
+type loadTrackingStream struct {
+	grpc.ClientStream
+	done func()
+}
+
+// RecvMsg proxies the receive and marks the stream as finished if receiving fails.
+func (s *loadTrackingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.done()
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type recordingLoadTracker struct {
+	m        sync.Mutex
+	started  map[string]int
+	finished map[string]int
+}
+
+func (r *recordingLoadTracker) RequestStarted(node string) func() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.started[node]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.m.Lock()
+			defer r.m.Unlock()
+			r.finished[node]++
+		})
+	}
+}
+
+func (r *recordingLoadTracker) ObserveLatency(string, time.Duration) {}
+
+func (r *recordingLoadTracker) Load(string) tracker.Load {
+	return tracker.Load{}
+}
+
+func (r *recordingLoadTracker) counts(node string) (int, int) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.started[node], r.finished[node]
+}
+
+func TestLoadTracker(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	internalSrv := grpc.NewServer()
+	gitalypb.RegisterRepositoryServiceServer(internalSrv, &repositoryService{})
+	grpc_health_v1.RegisterHealthServer(internalSrv, health.NewServer())
+
+	socketPath := testhelper.GetTemporaryGitalySocketFileName(t)
+	lis, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	go testhelper.MustServe(t, internalSrv, lis)
+	defer internalSrv.Stop()
+
+	loadTracker := &recordingLoadTracker{started: map[string]int{}, finished: map[string]int{}}
+	cc, err := grpc.Dial("unix://"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryLoadTracker(loadTracker, "node-1")),
+		grpc.WithStreamInterceptor(StreamLoadTracker(loadTracker, "node-1")),
+	)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, cc)
+
+	client := gitalypb.NewRepositoryServiceClient(cc)
+
+	_, err = client.RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{})
+	testhelper.RequireGrpcCode(t, err, codes.Internal)
+
+	started, finished := loadTracker.counts("node-1")
+	require.Equal(t, 1, started)
+	require.Equal(t, 1, finished)
+
+	stream, err := client.GetArchive(ctx, &gitalypb.GetArchiveRequest{})
+	require.NoError(t, err)
+
+	started, _ = loadTracker.counts("node-1")
+	require.Equal(t, 2, started)
+
+	_, err = stream.Recv()
+	testhelper.RequireGrpcCode(t, err, codes.Unimplemented)
+
+	started, finished = loadTracker.counts("node-1")
+	require.Equal(t, 2, started)
+	require.Equal(t, 2, finished)
+
+	// Health checks are not tracked.
+	_, err = grpc_health_v1.NewHealthClient(cc).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	started, finished = loadTracker.counts("node-1")
+	require.Equal(t, 2, started)
+	require.Equal(t, 2, finished)
+}
@@ -97,6 +97,7 @@ func DialNodes(
 	virtualStorages []*config.VirtualStorage,
 	registry *protoregistry.Registry,
 	errorTracker tracker.ErrorTracker,
+	loadTracker tracker.LoadTracker,
 	handshaker client.Handshaker,
 	sidechannelRegistry *sidechannel.Registry,
 	log log.Logger,
@@ -105,7 +106,7 @@ func DialNodes(
 	for _, virtualStorage := range virtualStorages {
 		set[virtualStorage.Name] = make(map[string]Node, len(virtualStorage.Nodes))
 		for _, node := range virtualStorage.Nodes {
-			conn, err := nodes.Dial(ctx, node, registry, errorTracker, handshaker, sidechannelRegistry, log)
+			conn, err := nodes.Dial(ctx, node, registry, errorTracker, loadTracker, handshaker, sidechannelRegistry, log)
 			if err != nil {
 				return nil, fmt.Errorf("dial %q/%q: %w", virtualStorage.Name, node.Storage, err)
 			}
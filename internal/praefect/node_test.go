@@ -73,7 +73,7 @@ func TestDialNodes(t *testing.T) {
 				Storage: "invalid",
 				Address: "unix:non-existent-socket",
 			}),
-		}}, nil, nil, nil, nil, testhelper.SharedLogger(t),
+		}}, nil, nil, nil, nil, nil, testhelper.SharedLogger(t),
 	)
 	require.NoError(t, err)
 	defer nodeSet.Close()
@@ -9,6 +9,7 @@ import (
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 	"gitlab.com/gitlab-org/labkit/correlation"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -23,7 +24,8 @@ type HealthClients map[string]map[string]grpc_health_v1.HealthClient
 //  1. Runs health checks on configured physical storages by performing a gRPC call
 //     to the health checking endpoint. If an error tracker is configured, it also considers
 //     its view of the node's health.
-//  2. Stores its health check results in the `node_status` table.
+//  2. Stores its health check results in the `node_status` table. Storages drained for maintenance
+//     are not considered locally healthy even if they pass the health check.
 //  3. Checks if the clusters consensus of healthy nodes has changed by querying the `node_status`
 //     table for results of the other Praefect instances. If so, it sends to the Updated channel
 //     to signal a change in the cluster status.
@@ -52,6 +54,9 @@ type HealthManager struct {
 	databaseTimeout func(context.Context) (context.Context, func())
 	firstUpdate     bool
 	updated         chan struct{}
+	// draining contains the storages drained for maintenance by virtual storage as of the latest
+	// successful query. Draining storages are not reported as healthy.
+	draining map[string]map[string]struct{}
 
 	locallyHealthy atomic.Value
 }
@@ -126,13 +131,43 @@ func (hm *HealthManager) HealthyNodes() map[string][]string {
 	return hm.locallyHealthy.Load().(map[string][]string)
 }
 
+// updateDrainingStorages refreshes the set of storages drained for maintenance. The previous set is kept if
+// the draining storages can't be retrieved so a database failure doesn't undo the drains.
+func (hm *HealthManager) updateDrainingStorages(ctx context.Context) {
+	ctx, cancel := hm.databaseTimeout(ctx)
+	defer cancel()
+
+	storages, err := datastore.NewDrainingStorageStore(hm.db).GetDrainingStorages(ctx)
+	if err != nil {
+		hm.log.WithError(err).Error("failed getting draining storages")
+		return
+	}
+
+	draining := make(map[string]map[string]struct{}, len(storages))
+	for _, storage := range storages {
+		if draining[storage.VirtualStorage] == nil {
+			draining[storage.VirtualStorage] = map[string]struct{}{}
+		}
+
+		draining[storage.VirtualStorage][storage.Storage] = struct{}{}
+	}
+
+	hm.draining = draining
+}
+
 func (hm *HealthManager) updateHealthChecks(ctx context.Context, virtualStorages, physicalStorages []string, healthy []bool) error {
+	hm.updateDrainingStorages(ctx)
+
 	locallyHealthy := map[string][]string{}
 	for i := range virtualStorages {
 		if !healthy[i] {
 			continue
 		}
 
+		if _, ok := hm.draining[virtualStorages[i]][physicalStorages[i]]; ok {
+			continue
+		}
+
 		locallyHealthy[virtualStorages[i]] = append(locallyHealthy[virtualStorages[i]], physicalStorages[i])
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
@@ -602,6 +603,35 @@ func TestHealthManager_databaseTimeout(t *testing.T) {
 	require.EqualError(t, <-blockedErr, "update checks: context canceled")
 }
 
+func TestHealthManager_drainingStorages(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	hm := NewHealthManager(testhelper.SharedLogger(t), db, "praefect", nil)
+	hm.handleError = func(err error) error { return err }
+
+	virtualStorages := []string{"virtual-storage", "virtual-storage"}
+	physicalStorages := []string{"gitaly-1", "gitaly-2"}
+	healthy := []bool{true, true}
+
+	require.NoError(t, hm.updateHealthChecks(ctx, virtualStorages, physicalStorages, healthy))
+	require.Equal(t, map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2"}}, hm.HealthyNodes())
+
+	drainer := datastore.NewDrainingStorageStore(db)
+	_, _, err := drainer.DrainStorage(ctx, "virtual-storage", "gitaly-1")
+	require.NoError(t, err)
+
+	require.NoError(t, hm.updateHealthChecks(ctx, virtualStorages, physicalStorages, healthy))
+	require.Equal(t, map[string][]string{"virtual-storage": {"gitaly-2"}}, hm.HealthyNodes())
+
+	require.NoError(t, drainer.UndrainStorage(ctx, "virtual-storage", "gitaly-1"))
+
+	require.NoError(t, hm.updateHealthChecks(ctx, virtualStorages, physicalStorages, healthy))
+	require.Equal(t, map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2"}}, hm.HealthyNodes())
+}
+
 func predateHealthChecks(tb testing.TB, db testdb.DB, amount time.Duration) {
 	tb.Helper()
 
@@ -129,6 +129,7 @@ func Dial(
 	node *config.Node,
 	registry *protoregistry.Registry,
 	errorTracker tracker.ErrorTracker,
+	loadTracker tracker.LoadTracker,
 	handshaker client.Handshaker,
 	sidechannelRegistry *sidechannel.Registry,
 	log log.Logger,
@@ -147,6 +148,11 @@ func Dial(
 		sidechannel.NewUnaryProxy(sidechannelRegistry, log),
 	}
 
+	if loadTracker != nil {
+		streamInterceptors = append(streamInterceptors, middleware.StreamLoadTracker(loadTracker, node.Storage))
+		unaryInterceptors = append(unaryInterceptors, middleware.UnaryLoadTracker(loadTracker, node.Storage))
+	}
+
 	b := backoff.DefaultConfig
 	b.MaxDelay = dialMaxBackoff
 
@@ -189,7 +195,7 @@ func NewManager(
 
 		ns := make([]*nodeStatus, 0, len(virtualStorage.Nodes))
 		for _, node := range virtualStorage.Nodes {
-			conn, err := Dial(ctx, node, registry, errorTracker, handshaker, sidechannelRegistry, log)
+			conn, err := Dial(ctx, node, registry, errorTracker, nil, handshaker, sidechannelRegistry, log)
 			if err != nil {
 				return nil, err
 			}
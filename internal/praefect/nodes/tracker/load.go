@@ -0,0 +1,132 @@
+package tracker
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// latencySmoothingFactor is the weight of a new latency sample in the exponentially weighted moving
+	// average of a node's latency.
+	latencySmoothingFactor = 0.2
+	// latencyHalfLife is the time after which the latency of a node which hasn't served any requests has
+	// decayed to half of its value. The decay ensures that a node which was slow at some point in time gets
+	// probed again eventually instead of being avoided forever.
+	latencyHalfLife = 10 * time.Second
+)
+
+// Load describes the load of a node as observed by Praefect.
+type Load struct {
+	// InFlight is the number of requests currently in flight to the node.
+	InFlight int
+	// Latency is the exponentially weighted moving average of the time it took the node to respond with the
+	// first message of a request.
+	Latency time.Duration
+}
+
+// LoadTracker tracks the load of the nodes requests are proxied to.
+type LoadTracker interface {
+	// RequestStarted records the start of a request to the node. The returned function must be called
+	// exactly once as soon as the request has finished.
+	RequestStarted(nodeStorage string) func()
+	// ObserveLatency records the time it took the node to respond with the first message of a request.
+	// The duration of the whole request is not a measure of the node's load, as it mostly depends on the
+	// amount of data the request transfers.
+	ObserveLatency(nodeStorage string, latency time.Duration)
+	// Load returns the current load of the node.
+	Load(nodeStorage string) Load
+}
+
+type nodeLoad struct {
+	inFlight    int
+	latency     float64
+	lastUpdated time.Time
+}
+
+type loadTracker struct {
+	m     sync.Mutex
+	now   func() time.Time
+	loads map[string]*nodeLoad
+}
+
+// NewLoads creates a new LoadTracker.
+func NewLoads() LoadTracker {
+	return newLoads(time.Now)
+}
+
+func newLoads(now func() time.Time) *loadTracker {
+	return &loadTracker{
+		now:   now,
+		loads: make(map[string]*nodeLoad),
+	}
+}
+
+// RequestStarted records the start of a request to the node.
+func (l *loadTracker) RequestStarted(node string) func() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.loadLocked(node).inFlight++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.m.Lock()
+			defer l.m.Unlock()
+
+			l.loadLocked(node).inFlight--
+		})
+	}
+}
+
+// ObserveLatency records the time it took the node to respond with the first message of a request.
+func (l *loadTracker) ObserveLatency(node string, latency time.Duration) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	now := l.now()
+	load := l.loadLocked(node)
+
+	sample := float64(latency)
+	if load.lastUpdated.IsZero() {
+		load.latency = sample
+	} else {
+		load.latency = latencySmoothingFactor*sample + (1-latencySmoothingFactor)*l.decayedLatency(load, now)
+	}
+	load.lastUpdated = now
+}
+
+// Load returns the current load of the node.
+func (l *loadTracker) Load(node string) Load {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	load, ok := l.loads[node]
+	if !ok {
+		return Load{}
+	}
+
+	return Load{
+		InFlight: load.inFlight,
+		Latency:  time.Duration(l.decayedLatency(load, l.now())),
+	}
+}
+
+func (l *loadTracker) decayedLatency(load *nodeLoad, now time.Time) float64 {
+	elapsed := now.Sub(load.lastUpdated)
+	if elapsed <= 0 {
+		return load.latency
+	}
+
+	return load.latency * math.Exp2(-float64(elapsed)/float64(latencyHalfLife))
+}
+
+func (l *loadTracker) loadLocked(node string) *nodeLoad {
+	load, ok := l.loads[node]
+	if !ok {
+		load = &nodeLoad{}
+		l.loads[node] = load
+	}
+	return load
+}
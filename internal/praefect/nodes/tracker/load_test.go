@@ -0,0 +1,49 @@
+package tracker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoads(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	loads := newLoads(func() time.Time { return now })
+
+	require.Equal(t, Load{}, loads.Load("node-1"))
+
+	// Requests are counted as in flight until they have finished.
+	first := loads.RequestStarted("node-1")
+	second := loads.RequestStarted("node-1")
+	require.Equal(t, Load{InFlight: 2}, loads.Load("node-1"))
+	first()
+	require.Equal(t, Load{InFlight: 1}, loads.Load("node-1"))
+
+	// Calling the done function multiple times only records the request once.
+	first()
+	require.Equal(t, Load{InFlight: 1}, loads.Load("node-1"))
+	second()
+	require.Equal(t, Load{}, loads.Load("node-1"))
+
+	// The first sample initializes the latency.
+	loads.ObserveLatency("node-1", 100*time.Millisecond)
+	require.Equal(t, Load{Latency: 100 * time.Millisecond}, loads.Load("node-1"))
+
+	// Subsequent samples are averaged.
+	loads.ObserveLatency("node-1", 0)
+	require.Equal(t, Load{Latency: 80 * time.Millisecond}, loads.Load("node-1"))
+
+	// Other nodes are tracked separately.
+	require.Equal(t, Load{}, loads.Load("node-2"))
+
+	// The latency decays with time when no latencies are observed.
+	now = now.Add(latencyHalfLife)
+	require.Equal(t, Load{Latency: 40 * time.Millisecond}, loads.Load("node-1"))
+
+	// New samples are averaged with the decayed latency.
+	loads.ObserveLatency("node-1", 240*time.Millisecond)
+	require.Equal(t, Load{Latency: 80 * time.Millisecond}, loads.Load("node-1"))
+}
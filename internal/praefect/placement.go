@@ -0,0 +1,128 @@
+package praefect
+
+import (
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/storageusage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+)
+
+// ErrStoragesFull is returned when a repository can't be created as every suitable storage has reached the
+// configured fill threshold.
+var ErrStoragesFull = structerr.NewResourceExhausted("no storage has free capacity for new repositories")
+
+// StorageUsageGetter returns the disk usage of the storages.
+type StorageUsageGetter interface {
+	// StorageUsage returns the latest known usage of the virtual storage's storages by storage name.
+	// Storages whose usage is not known are omitted.
+	StorageUsage(virtualStorage string) map[string]storageusage.Usage
+}
+
+// placementUsage returns the usage of the virtual storage's storages to place new repositories by. It returns
+// nil if storage usage aware placement is disabled.
+func (r *PerRepositoryRouter) placementUsage(virtualStorage string) map[string]storageusage.Usage {
+	if r.storageUsage == nil {
+		return nil
+	}
+
+	return r.storageUsage.StorageUsage(virtualStorage)
+}
+
+// withoutFullStorages filters out the nodes whose storages are full. Nodes with unknown usage are kept.
+func withoutFullStorages(nodes []RouterNode, usage map[string]storageusage.Usage) []RouterNode {
+	if len(usage) == 0 {
+		return nodes
+	}
+
+	filtered := make([]RouterNode, 0, len(nodes))
+	for _, node := range nodes {
+		if usage[node.Storage].Full {
+			continue
+		}
+
+		filtered = append(filtered, node)
+	}
+
+	return filtered
+}
+
+// placementWeights returns the weight of each node when placing a repository. A node's weight is its free
+// capacity in MiB. Nodes with unknown usage are weighted with the average weight of the nodes with known usage
+// so they are neither starved nor preferred. It returns false if the usage of none of the nodes is known.
+func placementWeights(nodes []RouterNode, usage map[string]storageusage.Usage) ([]int, bool) {
+	weights := make([]int, len(nodes))
+
+	var knownNodes, knownWeight int
+	for i, node := range nodes {
+		storageUsage, ok := usage[node.Storage]
+		if !ok {
+			continue
+		}
+
+		// One is added so storages with less than a MiB of free capacity still have a chance of being picked.
+		weights[i] = int(storageUsage.AvailableBytes>>20) + 1
+		knownNodes++
+		knownWeight += weights[i]
+	}
+
+	if knownNodes == 0 {
+		return nil, false
+	}
+
+	for i, node := range nodes {
+		if _, ok := usage[node.Storage]; !ok {
+			weights[i] = knownWeight/knownNodes + 1
+		}
+	}
+
+	return weights, true
+}
+
+// pickWeighted picks a node at random with the probability of each node being picked proportional to its
+// weight.
+func (r *PerRepositoryRouter) pickWeighted(nodes []RouterNode, weights []int) int {
+	var total int
+	for _, weight := range weights {
+		total += weight
+	}
+
+	target := r.rand.Intn(total)
+	for i, weight := range weights {
+		if target < weight {
+			return i
+		}
+
+		target -= weight
+	}
+
+	return len(nodes) - 1
+}
+
+// pickPlacementPrimary picks the primary of a new repository. If the usage of the nodes is known, nodes with
+// more free capacity are more likely to be picked. Otherwise the primary is picked at random.
+func (r *PerRepositoryRouter) pickPlacementPrimary(nodes []RouterNode, usage map[string]storageusage.Usage) (RouterNode, error) {
+	weights, ok := placementWeights(nodes, usage)
+	if !ok {
+		return r.pickRandom(nodes)
+	}
+
+	return nodes[r.pickWeighted(nodes, weights)], nil
+}
+
+// shufflePlacementSecondaries shuffles the secondary candidates of a new repository. If the usage of the nodes
+// is known, nodes with more free capacity are more likely to be shuffled to the front. Otherwise the candidates
+// are shuffled uniformly.
+func (r *PerRepositoryRouter) shufflePlacementSecondaries(nodes []RouterNode, usage map[string]storageusage.Usage) {
+	weights, ok := placementWeights(nodes, usage)
+	if !ok {
+		r.rand.Shuffle(len(nodes), func(i, j int) {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		})
+
+		return
+	}
+
+	for i := 0; i < len(nodes)-1; i++ {
+		picked := i + r.pickWeighted(nodes[i:], weights[i:])
+		nodes[i], nodes[picked] = nodes[picked], nodes[i]
+		weights[i], weights[picked] = weights[picked], weights[i]
+	}
+}
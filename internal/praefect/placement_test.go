@@ -0,0 +1,121 @@
+package praefect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/storageusage"
+	"google.golang.org/grpc"
+)
+
+type staticStorageUsage map[string]map[string]storageusage.Usage
+
+func (s staticStorageUsage) StorageUsage(virtualStorage string) map[string]storageusage.Usage {
+	return s[virtualStorage]
+}
+
+func TestPlacementWeights(t *testing.T) {
+	t.Parallel()
+
+	nodes := []RouterNode{{Storage: "storage-1"}, {Storage: "storage-2"}, {Storage: "storage-3"}}
+
+	_, ok := placementWeights(nodes, nil)
+	require.False(t, ok)
+
+	weights, ok := placementWeights(nodes, map[string]storageusage.Usage{
+		"storage-1": {AvailableBytes: 9 << 20},
+		"storage-2": {AvailableBytes: 512},
+	})
+	require.True(t, ok)
+	require.Equal(t, []int{10, 1, 6}, weights)
+}
+
+func TestPerRepositoryRouter_assignRepositoryToNodes_storageUsage(t *testing.T) {
+	t.Parallel()
+
+	conns := Connections{
+		"virtual-storage": {
+			"storage-1": &grpc.ClientConn{},
+			"storage-2": &grpc.ClientConn{},
+			"storage-3": &grpc.ClientConn{},
+			"storage-4": &grpc.ClientConn{},
+		},
+	}
+
+	node := func(storage string) RouterNode {
+		return RouterNode{Storage: storage, Connection: conns["virtual-storage"][storage]}
+	}
+
+	newRouter := func(replicationFactor int, usage map[string]storageusage.Usage, intn func(int) int) *PerRepositoryRouter {
+		return NewPerRepositoryRouter(
+			conns,
+			nil,
+			StaticHealthChecker{"virtual-storage": {"storage-1", "storage-2", "storage-3", "storage-4"}},
+			mockRandom{intnFunc: intn, shuffleFunc: func(int, func(int, int)) {}},
+			nil,
+			nil,
+			nil,
+			map[string]int{"virtual-storage": replicationFactor},
+			nil,
+			nil,
+			staticStorageUsage{"virtual-storage": usage},
+		)
+	}
+
+	t.Run("full storages are not assigned", func(t *testing.T) {
+		t.Parallel()
+
+		assigned, err := newRouter(3, map[string]storageusage.Usage{
+			"storage-1": {UsedBytes: 99, AvailableBytes: 1, Full: true},
+			"storage-2": {UsedBytes: 1 << 20, AvailableBytes: 1 << 20},
+			"storage-3": {UsedBytes: 99, AvailableBytes: 1, Full: true},
+		}, func(int) int { return 0 }).assignRepositoryToNodes("virtual-storage", nil)
+		require.NoError(t, err)
+		require.Equal(t, assignedNodes{
+			primary:     node("storage-2"),
+			secondaries: []RouterNode{node("storage-4")},
+		}, assigned)
+	})
+
+	t.Run("storages with more free capacity are preferred", func(t *testing.T) {
+		t.Parallel()
+
+		usage := map[string]storageusage.Usage{
+			"storage-1": {AvailableBytes: 2 << 20, Full: true},
+			"storage-2": {AvailableBytes: 3 << 20},
+			"storage-3": {AvailableBytes: 1 << 20},
+			"storage-4": {AvailableBytes: 1 << 20},
+		}
+
+		for _, tc := range []struct {
+			random          int
+			expectedPrimary string
+		}{
+			{random: 0, expectedPrimary: "storage-2"},
+			{random: 3, expectedPrimary: "storage-2"},
+			{random: 4, expectedPrimary: "storage-3"},
+			{random: 5, expectedPrimary: "storage-3"},
+			{random: 6, expectedPrimary: "storage-4"},
+			{random: 7, expectedPrimary: "storage-4"},
+		} {
+			assigned, err := newRouter(1, usage, func(n int) int {
+				require.Equal(t, 8, n)
+				return tc.random
+			}).assignRepositoryToNodes("virtual-storage", nil)
+			require.NoError(t, err)
+			require.Equal(t, assignedNodes{primary: node(tc.expectedPrimary)}, assigned)
+		}
+	})
+
+	t.Run("all storages full", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newRouter(1, map[string]storageusage.Usage{
+			"storage-1": {Full: true},
+			"storage-2": {Full: true},
+			"storage-3": {Full: true},
+			"storage-4": {Full: true},
+		}, nil).assignRepositoryToNodes("virtual-storage", nil)
+		require.Equal(t, ErrStoragesFull, err)
+	})
+}
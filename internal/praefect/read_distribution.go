@@ -0,0 +1,78 @@
+package praefect
+
+import (
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
+)
+
+// minimumLatency is the latency assumed for nodes whose latency is not known yet or has decayed to almost
+// nothing. Without it, such nodes would be considered to be free of load regardless of their in-flight requests.
+const minimumLatency = time.Millisecond
+
+// ReadDistributor picks the node a read is routed to from the healthy and up-to-date replicas of a repository.
+type ReadDistributor interface {
+	// Pick returns the node to route the read to. It returns ErrNoSuitableNode if there are no candidates.
+	Pick(candidates []RouterNode) (RouterNode, error)
+}
+
+// NewReadDistributors returns the read distributors of the virtual storages as configured. Virtual storages which
+// distribute reads at random don't have a read distributor. The load tracker must be the one the nodes have been
+// dialed with.
+func NewReadDistributors(conf config.Config, rand Random, loads tracker.LoadTracker) map[string]ReadDistributor {
+	distributors := make(map[string]ReadDistributor)
+	for virtualStorage, readDistribution := range conf.ReadDistributions() {
+		if readDistribution == config.ReadDistributionLeastLoaded {
+			distributors[virtualStorage] = NewLeastLoadedDistributor(rand, loads)
+		}
+	}
+
+	return distributors
+}
+
+type leastLoadedDistributor struct {
+	rand  Random
+	loads tracker.LoadTracker
+}
+
+// NewLeastLoadedDistributor returns a ReadDistributor that prefers the least loaded node. It uses the power of two
+// choices: two candidates are picked at random and the read is routed to the one with less load. The load of a node
+// is the product of its average latency and the number of requests in flight to it. Compared to always picking the
+// least loaded node, this avoids herding all reads onto a single node whose load information is stale.
+func NewLeastLoadedDistributor(rand Random, loads tracker.LoadTracker) ReadDistributor {
+	return leastLoadedDistributor{rand: rand, loads: loads}
+}
+
+// Pick returns the less loaded of two randomly chosen candidates.
+func (d leastLoadedDistributor) Pick(candidates []RouterNode) (RouterNode, error) {
+	switch len(candidates) {
+	case 0:
+		return RouterNode{}, ErrNoSuitableNode
+	case 1:
+		return candidates[0], nil
+	}
+
+	first := d.rand.Intn(len(candidates))
+	second := d.rand.Intn(len(candidates) - 1)
+	if second >= first {
+		second++
+	}
+
+	if d.cost(candidates[second]) < d.cost(candidates[first]) {
+		return candidates[second], nil
+	}
+
+	return candidates[first], nil
+}
+
+func (d leastLoadedDistributor) cost(node RouterNode) float64 {
+	load := d.loads.Load(node.Storage)
+
+	latency := load.Latency
+	if latency < minimumLatency {
+		latency = minimumLatency
+	}
+
+	return float64(latency) * float64(load.InFlight+1)
+}
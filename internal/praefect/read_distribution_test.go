@@ -0,0 +1,197 @@
+package praefect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"google.golang.org/grpc"
+)
+
+type staticLoads map[string]tracker.Load
+
+func (l staticLoads) RequestStarted(string) func() { return func() {} }
+
+func (l staticLoads) ObserveLatency(string, time.Duration) {}
+
+func (l staticLoads) Load(node string) tracker.Load { return l[node] }
+
+// sequenceRandom returns the given values in order from Intn.
+func sequenceRandom(t *testing.T, values ...int) Random {
+	return mockRandom{
+		intnFunc: func(n int) int {
+			require.NotEmpty(t, values, "unexpected call to Intn")
+			value := values[0]
+			values = values[1:]
+			require.Less(t, value, n)
+			return value
+		},
+	}
+}
+
+func TestLeastLoadedDistributor(t *testing.T) {
+	t.Parallel()
+
+	candidates := []RouterNode{{Storage: "node-1"}, {Storage: "node-2"}, {Storage: "node-3"}}
+
+	for _, tc := range []struct {
+		desc       string
+		candidates []RouterNode
+		random     []int
+		loads      staticLoads
+		expected   RouterNode
+		error      error
+	}{
+		{
+			desc:  "no candidates",
+			error: ErrNoSuitableNode,
+		},
+		{
+			desc:       "single candidate",
+			candidates: candidates[:1],
+			expected:   candidates[0],
+		},
+		{
+			desc:       "without load information the first choice is picked",
+			candidates: candidates,
+			random:     []int{1, 1},
+			expected:   candidates[1],
+		},
+		{
+			desc:       "second choice with lower latency is picked",
+			candidates: candidates,
+			// The second choice skips over the first one, so it is node-3.
+			random: []int{1, 1},
+			loads: staticLoads{
+				"node-2": {Latency: 100 * time.Millisecond},
+				"node-3": {Latency: 10 * time.Millisecond},
+			},
+			expected: candidates[2],
+		},
+		{
+			desc:       "second choice with fewer requests in flight is picked",
+			candidates: candidates,
+			random:     []int{2, 0},
+			loads: staticLoads{
+				"node-1": {Latency: 10 * time.Millisecond, InFlight: 1},
+				"node-3": {Latency: 10 * time.Millisecond, InFlight: 5},
+			},
+			expected: candidates[0],
+		},
+		{
+			desc:       "latency and requests in flight are combined",
+			candidates: candidates,
+			random:     []int{0, 1},
+			loads: staticLoads{
+				"node-1": {Latency: 10 * time.Millisecond, InFlight: 3},
+				"node-3": {Latency: 50 * time.Millisecond},
+			},
+			expected: candidates[0],
+		},
+		{
+			desc:       "nodes without known latency are not considered free",
+			candidates: candidates,
+			random:     []int{0, 0},
+			loads: staticLoads{
+				"node-1": {Latency: 2 * time.Millisecond},
+				"node-2": {InFlight: 10},
+			},
+			expected: candidates[0],
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			node, err := NewLeastLoadedDistributor(sequenceRandom(t, tc.random...), tc.loads).Pick(tc.candidates)
+			require.Equal(t, tc.error, err)
+			require.Equal(t, tc.expected, node)
+		})
+	}
+}
+
+func TestNewReadDistributors(t *testing.T) {
+	t.Parallel()
+
+	distributors := NewReadDistributors(config.Config{
+		VirtualStorages: []*config.VirtualStorage{
+			{Name: "default"},
+			{Name: "random", ReadDistribution: config.ReadDistributionRandom},
+			{Name: "least-loaded", ReadDistribution: config.ReadDistributionLeastLoaded},
+		},
+	}, mockRandom{}, staticLoads{})
+
+	require.Equal(t, map[string]ReadDistributor{
+		"least-loaded": NewLeastLoadedDistributor(mockRandom{}, staticLoads{}),
+	}, distributors)
+}
+
+type pickFirstDistributor struct{}
+
+func (pickFirstDistributor) Pick(candidates []RouterNode) (RouterNode, error) {
+	return candidates[0], nil
+}
+
+func TestPerRepositoryRouter_RouteRepositoryAccessor_readDistributor(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	conns := Connections{
+		"with-distributor": {
+			"node-1": &grpc.ClientConn{},
+			"node-2": &grpc.ClientConn{},
+		},
+		"without-distributor": {
+			"node-1": &grpc.ClientConn{},
+			"node-2": &grpc.ClientConn{},
+		},
+	}
+
+	router := NewPerRepositoryRouter(
+		conns,
+		nil,
+		StaticHealthChecker{
+			"with-distributor":    {"node-1", "node-2"},
+			"without-distributor": {"node-1", "node-2"},
+		},
+		mockRandom{
+			intnFunc: func(n int) int {
+				require.Equal(t, 2, n)
+				return 1
+			},
+		},
+		datastore.MockRepositoryStore{
+			GetConsistentStoragesFunc: func(context.Context, string, string) (string, *datastructure.Set[string], error) {
+				return "replica-path", datastructure.SetFromValues("node-1", "node-2"), nil
+			},
+		},
+		nil,
+		nil,
+		nil,
+		map[string]ReadDistributor{"with-distributor": pickFirstDistributor{}},
+		nil,
+		nil,
+	)
+
+	route, err := router.RouteRepositoryAccessor(ctx, "with-distributor", "relative-path", false)
+	require.NoError(t, err)
+	require.Equal(t, RepositoryAccessorRoute{
+		ReplicaPath: "replica-path",
+		Node:        RouterNode{Storage: "node-1", Connection: conns["with-distributor"]["node-1"]},
+	}, route)
+
+	route, err = router.RouteRepositoryAccessor(ctx, "without-distributor", "relative-path", false)
+	require.NoError(t, err)
+	require.Equal(t, RepositoryAccessorRoute{
+		ReplicaPath: "replica-path",
+		Node:        RouterNode{Storage: "node-2", Connection: conns["without-distributor"]["node-2"]},
+	}, route)
+}
@@ -0,0 +1,63 @@
+package praefect
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/metadata"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"google.golang.org/grpc"
+	grpc_metadata "google.golang.org/grpc/metadata"
+)
+
+// repositoryGenerationKey is the key of the repository's generation in the trailer of successful mutator RPCs.
+// Clients that pass the generation back in the metadata of later accessor RPCs are guaranteed to read their own
+// writes, as the accessors are then only routed to replicas on that generation or a later one.
+const repositoryGenerationKey = "gitaly-repository-generation"
+
+// setGenerationTrailer sets the repository's generation in the trailer of the RPC.
+func setGenerationTrailer(ctx context.Context, generation int) error {
+	return grpc.SetTrailer(ctx, grpc_metadata.Pairs(repositoryGenerationKey, strconv.Itoa(generation)))
+}
+
+// minimumGeneration returns the generation the client requires the replica serving the accessor to be on. It
+// returns false if the client did not send a generation.
+func minimumGeneration(ctx context.Context) (int64, bool, error) {
+	value := metadata.GetValue(ctx, repositoryGenerationKey)
+	if value == "" {
+		return 0, false, nil
+	}
+
+	generation, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || generation < 0 {
+		return 0, false, structerr.NewInvalidArgument("invalid repository generation %q", value)
+	}
+
+	return generation, true, nil
+}
+
+// storagesAtGeneration returns the replica path of the repository and the storages whose replicas are up to date
+// and on at least the given generation. The repository store is queried directly, as cached consistent storages may
+// lag behind the write that produced the generation. If no replica is on the given generation, only the primary is
+// returned as it's the authoritative replica of the repository.
+func (r *PerRepositoryRouter) storagesAtGeneration(ctx context.Context, virtualStorage, relativePath string, generation int64) (string, *datastructure.Set[string], error) {
+	repository, err := r.rs.GetRepositoryMetadataByPath(ctx, virtualStorage, relativePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("get repository metadata: %w", err)
+	}
+
+	if repository.Generation < generation {
+		return repository.ReplicaPath, datastructure.SetFromValues(repository.Primary), nil
+	}
+
+	storages := datastructure.NewSet[string]()
+	for _, replica := range repository.Replicas {
+		if replica.Generation == repository.Generation {
+			storages.Add(replica.Storage)
+		}
+	}
+
+	return repository.ReplicaPath, storages, nil
+}
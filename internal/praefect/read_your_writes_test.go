@@ -0,0 +1,201 @@
+package praefect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPerRepositoryRouter_RouteRepositoryAccessor_minimumGeneration(t *testing.T) {
+	t.Parallel()
+
+	conns := Connections{
+		"virtual-storage": {
+			"primary":   &grpc.ClientConn{},
+			"storage-a": &grpc.ClientConn{},
+			"storage-b": &grpc.ClientConn{},
+		},
+	}
+
+	router := NewPerRepositoryRouter(
+		conns,
+		nil,
+		StaticHealthChecker{"virtual-storage": {"primary", "storage-a", "storage-b"}},
+		mockRandom{intnFunc: func(int) int { return 0 }},
+		datastore.MockRepositoryStore{
+			// The cached consistent storages still consider storage-a up to date.
+			GetConsistentStoragesFunc: func(context.Context, string, string) (string, *datastructure.Set[string], error) {
+				return "replica-path", datastructure.SetFromValues("storage-a", "storage-b"), nil
+			},
+		},
+		nil,
+		datastore.MockRepositoryStore{
+			GetRepositoryMetadataByPathFunc: func(context.Context, string, string) (datastore.RepositoryMetadata, error) {
+				return datastore.RepositoryMetadata{
+					ReplicaPath: "replica-path",
+					Primary:     "primary",
+					Generation:  2,
+					Replicas: []datastore.Replica{
+						{Storage: "primary", Generation: 2},
+						{Storage: "storage-a", Generation: 1},
+						{Storage: "storage-b", Generation: 2},
+					},
+				}, nil
+			},
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	for _, tc := range []struct {
+		desc            string
+		generation      string
+		expectedStorage string
+		expectedErr     error
+	}{
+		{
+			desc:            "no generation",
+			expectedStorage: "storage-a",
+		},
+		{
+			desc:            "replicas on the generation",
+			generation:      "2",
+			expectedStorage: "primary",
+		},
+		{
+			desc:            "replicas past the generation",
+			generation:      "1",
+			expectedStorage: "primary",
+		},
+		{
+			desc:            "generation not recorded",
+			generation:      "3",
+			expectedStorage: "primary",
+		},
+		{
+			desc:        "invalid generation",
+			generation:  "invalid",
+			expectedErr: structerr.NewInvalidArgument(`invalid repository generation "invalid"`),
+		},
+		{
+			desc:        "negative generation",
+			generation:  "-1",
+			expectedErr: structerr.NewInvalidArgument(`invalid repository generation "-1"`),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+			if tc.generation != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(repositoryGenerationKey, tc.generation))
+			}
+
+			route, err := router.RouteRepositoryAccessor(ctx, "virtual-storage", "relative-path", false)
+			if tc.expectedErr != nil {
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, RepositoryAccessorRoute{
+				ReplicaPath: "replica-path",
+				Node:        RouterNode{Storage: tc.expectedStorage, Connection: conns["virtual-storage"][tc.expectedStorage]},
+			}, route)
+		})
+	}
+
+	t.Run("storage-b is picked when the primary is unhealthy", func(t *testing.T) {
+		t.Parallel()
+
+		router := *router
+		router.hc = StaticHealthChecker{"virtual-storage": {"storage-a", "storage-b"}}
+
+		ctx := metadata.NewIncomingContext(testhelper.Context(t), metadata.Pairs(repositoryGenerationKey, "2"))
+		route, err := router.RouteRepositoryAccessor(ctx, "virtual-storage", "relative-path", false)
+		require.NoError(t, err)
+		require.Equal(t, "storage-b", route.Node.Storage)
+	})
+}
+
+type trailerRecordingStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerRecordingStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestCoordinator_returnGeneration(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc             string
+		electionStrategy config.ElectionStrategy
+		getGeneration    func(context.Context, int64, string) (int, error)
+		expectedTrailer  metadata.MD
+	}{
+		{
+			desc:             "generation returned",
+			electionStrategy: config.ElectionStrategyPerRepository,
+			getGeneration: func(_ context.Context, repositoryID int64, storage string) (int, error) {
+				require.Equal(t, int64(1), repositoryID)
+				require.Equal(t, "primary", storage)
+				return 5, nil
+			},
+			expectedTrailer: metadata.Pairs(repositoryGenerationKey, "5"),
+		},
+		{
+			desc:             "unknown generation",
+			electionStrategy: config.ElectionStrategyPerRepository,
+			getGeneration: func(context.Context, int64, string) (int, error) {
+				return datastore.GenerationUnknown, nil
+			},
+		},
+		{
+			desc:             "failure to get generation",
+			electionStrategy: config.ElectionStrategyPerRepository,
+			getGeneration: func(context.Context, int64, string) (int, error) {
+				return 0, errors.New("database failure")
+			},
+		},
+		{
+			desc:             "repository specific primaries disabled",
+			electionStrategy: config.ElectionStrategySQL,
+			getGeneration: func(context.Context, int64, string) (int, error) {
+				return 5, nil
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			coordinator := &Coordinator{
+				conf: config.Config{Failover: config.Failover{ElectionStrategy: tc.electionStrategy}},
+				rs:   datastore.MockRepositoryStore{GetGenerationFunc: tc.getGeneration},
+			}
+
+			stream := &trailerRecordingStream{}
+			ctx := testhelper.Context(t)
+			coordinator.returnGeneration(grpc.NewContextWithServerTransportStream(ctx, stream), ctx, 1, "primary")
+			require.Equal(t, tc.expectedTrailer, stream.trailer)
+		})
+	}
+}
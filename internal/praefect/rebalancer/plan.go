@@ -0,0 +1,263 @@
+package rebalancer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// Strategy is the target distribution the rebalancer moves replicas towards.
+type Strategy string
+
+const (
+	// StrategyDrain moves every replica off the drained storages.
+	StrategyDrain Strategy = "drain"
+	// StrategyEqualizeRepositories moves replicas until every storage hosts roughly the same number
+	// of repositories.
+	StrategyEqualizeRepositories Strategy = "equalize_repositories"
+	// StrategyEqualizeBytes moves replicas until every storage uses roughly the same amount of disk space.
+	StrategyEqualizeBytes Strategy = "equalize_bytes"
+)
+
+// ErrPlanNotFound is returned when a virtual storage has no rebalancing plan.
+var ErrPlanNotFound = errors.New("rebalancing plan not found")
+
+// Plan is the rebalancing plan of a virtual storage.
+type Plan struct {
+	// VirtualStorage is the virtual storage the plan applies to.
+	VirtualStorage string
+	// Strategy is the target distribution of the replicas.
+	Strategy Strategy
+	// DrainedStorages are the storages replicas are moved off of if the strategy is StrategyDrain.
+	DrainedStorages []string
+	// CreatedAt is the time the plan was set.
+	CreatedAt time.Time
+}
+
+// SetPlan sets the rebalancing plan of the virtual storage, replacing the existing plan if there is one. Moves
+// which are already in progress are finished regardless of the new plan.
+func SetPlan(ctx context.Context, db glsql.Querier, plan Plan) error {
+	if _, err := db.ExecContext(ctx, `
+INSERT INTO rebalancing_plans (virtual_storage, strategy, drained_storages)
+VALUES ($1, $2, $3)
+ON CONFLICT (virtual_storage) DO UPDATE SET
+	strategy = excluded.strategy,
+	drained_storages = excluded.drained_storages,
+	created_at = NOW()
+	`, plan.VirtualStorage, string(plan.Strategy), plan.DrainedStorages); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// CancelPlan removes the rebalancing plan of the virtual storage. Moves which are already in progress are finished.
+// ErrPlanNotFound is returned if the virtual storage doesn't have a plan.
+func CancelPlan(ctx context.Context, db glsql.Querier, virtualStorage string) error {
+	result, err := db.ExecContext(ctx, `DELETE FROM rebalancing_plans WHERE virtual_storage = $1`, virtualStorage)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrPlanNotFound
+	}
+
+	return nil
+}
+
+func getPlans(ctx context.Context, db glsql.Querier) ([]Plan, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT virtual_storage, strategy, drained_storages, created_at
+FROM rebalancing_plans
+ORDER BY virtual_storage
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []Plan
+	for rows.Next() {
+		var plan Plan
+		var drainedStorages glsql.StringArray
+		if err := rows.Scan(&plan.VirtualStorage, &plan.Strategy, &drainedStorages, &plan.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		plan.DrainedStorages = drainedStorages.Slice()
+		plans = append(plans, plan)
+	}
+
+	return plans, rows.Err()
+}
+
+// StorageProgress describes the rebalancing progress of a single storage.
+type StorageProgress struct {
+	// Storage is the name of the storage.
+	Storage string
+	// Repositories is the number of repositories assigned to the storage. Repositories without explicit
+	// assignments are counted on every storage.
+	Repositories int
+	// MovingIn is the number of in-progress moves that target the storage.
+	MovingIn int
+	// MovingOut is the number of in-progress moves that move a replica off the storage.
+	MovingOut int
+}
+
+// Progress describes the rebalancing progress of a virtual storage.
+type Progress struct {
+	// Plan is the current plan of the virtual storage. It is nil if the virtual storage has no plan.
+	Plan *Plan
+	// Storages contains the progress of each storage in the order they were passed in.
+	Storages []StorageProgress
+}
+
+// Completed returns whether the plan has been completed. Only drains complete. Equalization continues for as
+// long as the plan is in place.
+func (p Progress) Completed() bool {
+	if p.Plan == nil || p.Plan.Strategy != StrategyDrain {
+		return false
+	}
+
+	drained := make(map[string]bool, len(p.Plan.DrainedStorages))
+	for _, storage := range p.Plan.DrainedStorages {
+		drained[storage] = true
+	}
+
+	for _, storage := range p.Storages {
+		if storage.MovingIn > 0 || storage.MovingOut > 0 {
+			return false
+		}
+
+		if drained[storage.Storage] && storage.Repositories > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetProgress returns the rebalancing progress of the virtual storage's configured storages.
+func GetProgress(ctx context.Context, db glsql.Querier, virtualStorage string, storages []string) (Progress, error) {
+	var progress Progress
+
+	var plan Plan
+	var drainedStorages glsql.StringArray
+	if err := db.QueryRowContext(ctx, `
+SELECT virtual_storage, strategy, drained_storages, created_at
+FROM rebalancing_plans
+WHERE virtual_storage = $1
+	`, virtualStorage).Scan(&plan.VirtualStorage, &plan.Strategy, &drainedStorages, &plan.CreatedAt); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return Progress{}, fmt.Errorf("query plan: %w", err)
+		}
+	} else {
+		plan.DrainedStorages = drainedStorages.Slice()
+		progress.Plan = &plan
+	}
+
+	repositories, err := repositoryCounts(ctx, db, virtualStorage, storages)
+	if err != nil {
+		return Progress{}, fmt.Errorf("repository counts: %w", err)
+	}
+
+	movingIn, movingOut, err := moveCounts(ctx, db, virtualStorage)
+	if err != nil {
+		return Progress{}, fmt.Errorf("move counts: %w", err)
+	}
+
+	for _, storage := range storages {
+		progress.Storages = append(progress.Storages, StorageProgress{
+			Storage:      storage,
+			Repositories: repositories[storage],
+			MovingIn:     movingIn[storage],
+			MovingOut:    movingOut[storage],
+		})
+	}
+
+	return progress, nil
+}
+
+// repositoryCounts returns the number of repositories assigned to each of the storages. Repositories without
+// assignments on the configured storages are considered to be assigned to every storage.
+func repositoryCounts(ctx context.Context, db glsql.Querier, virtualStorage string, storages []string) (map[string]int, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT storage, COUNT(*)
+FROM (
+	SELECT storage
+	FROM repositories
+	JOIN repository_assignments USING (repository_id)
+	WHERE repositories.virtual_storage = $1
+	AND storage = ANY($2::text[])
+		UNION ALL
+	SELECT storage
+	FROM repositories
+	CROSS JOIN unnest($2::text[]) AS storage
+	WHERE virtual_storage = $1
+	AND NOT EXISTS (
+		SELECT FROM repository_assignments
+		WHERE repository_id = repositories.repository_id
+		AND storage = ANY($2::text[])
+	)
+) AS assignments
+GROUP BY storage
+	`, virtualStorage, storages)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(storages))
+	for rows.Next() {
+		var storage string
+		var count int
+		if err := rows.Scan(&storage, &count); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		counts[storage] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// moveCounts returns the number of in-progress moves by their target and source storages.
+func moveCounts(ctx context.Context, db glsql.Querier, virtualStorage string) (map[string]int, map[string]int, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT source_storage, target_storage
+FROM rebalancing_moves
+JOIN repositories USING (repository_id)
+WHERE virtual_storage = $1
+	`, virtualStorage)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	movingIn := map[string]int{}
+	movingOut := map[string]int{}
+	for rows.Next() {
+		var source string
+		var target sql.NullString
+		if err := rows.Scan(&source, &target); err != nil {
+			return nil, nil, fmt.Errorf("scan: %w", err)
+		}
+
+		movingOut[source]++
+		if target.Valid {
+			movingIn[target.String]++
+		}
+	}
+
+	return movingIn, movingOut, rows.Err()
+}
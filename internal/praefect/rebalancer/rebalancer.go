@@ -0,0 +1,623 @@
+package rebalancer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/advisorylock"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// UsageGetter returns the disk space used by the storages.
+type UsageGetter interface {
+	// UsedBytes returns the bytes used by each storage of the virtual storage. Storages whose usage could
+	// not be determined are omitted.
+	UsedBytes(ctx context.Context, virtualStorage string) (map[string]int64, error)
+}
+
+// move is an internal type for formatting log messages.
+type move struct {
+	RepositoryID   int64   `json:"repository_id"`
+	VirtualStorage string  `json:"virtual_storage"`
+	RelativePath   string  `json:"relative_path"`
+	SourceStorage  string  `json:"source_storage"`
+	TargetStorage  *string `json:"target_storage,omitempty"`
+}
+
+// Rebalancer moves replicas between the storages of a virtual storage according to the virtual storage's
+// rebalancing plan.
+//
+// Moves are performed by changing the repository's host assignments. A move first assigns the target storage to
+// the repository. The reconciler then replicates the repository to the target storage. Once the target storage
+// has an up to date replica, the source storage is unassigned and the reconciler deletes its replica. If the
+// source storage was the repository's primary, a new primary is elected from the assigned storages as the
+// unassigned storage is no longer a valid primary. Replicas are thus never removed before a replacement
+// exists.
+type Rebalancer struct {
+	log                log.Logger
+	db                 *sql.DB
+	hc                 praefect.HealthChecker
+	storages           map[string][]string
+	usage              UsageGetter
+	maxConcurrentMoves int
+	movesTotal         *prometheus.CounterVec
+	// handleError is called with a possible error from rebalance.
+	// If it returns an error, Run stops and returns with the error.
+	handleError func(error) error
+}
+
+// NewRebalancer returns a new Rebalancer. maxConcurrentMoves limits the number of moves in progress per virtual
+// storage. usage is only needed for equalizing the used disk space and may be nil otherwise.
+func NewRebalancer(logger log.Logger, db *sql.DB, hc praefect.HealthChecker, storages map[string][]string, usage UsageGetter, maxConcurrentMoves int) *Rebalancer {
+	logger = logger.WithField("component", "rebalancer")
+
+	return &Rebalancer{
+		log:                logger,
+		db:                 db,
+		hc:                 hc,
+		storages:           storages,
+		usage:              usage,
+		maxConcurrentMoves: maxConcurrentMoves,
+		movesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitaly_praefect_rebalancing_moves_total",
+			Help: "Counter of replica moves performed by the rebalancer.",
+		}, []string{"virtual_storage", "state"}),
+		handleError: func(err error) error {
+			logger.WithError(err).Error("rebalancing failed")
+			return nil
+		},
+	}
+}
+
+// Describe describes the metrics of the rebalancer.
+func (r *Rebalancer) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(r, ch)
+}
+
+// Collect collects the metrics of the rebalancer.
+func (r *Rebalancer) Collect(ch chan<- prometheus.Metric) {
+	r.movesTotal.Collect(ch)
+}
+
+// Run rebalances on each tick the Ticker emits. Run returns
+// when the context is canceled, returning the error from the context.
+func (r *Rebalancer) Run(ctx context.Context, ticker helper.Ticker) error {
+	r.log.Info("rebalancer started")
+	defer r.log.Info("rebalancer stopped")
+
+	defer ticker.Stop()
+
+	for {
+		ticker.Reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+			if err := r.rebalance(ctx); err != nil {
+				if err := r.handleError(err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// rebalance completes the moves whose target storages have caught up and schedules new moves according to the
+// plans of the virtual storages. Only one Praefect rebalances at a time.
+func (r *Rebalancer) rebalance(ctx context.Context) (returnedErr error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer func() {
+		if returnedErr != nil {
+			if err := tx.Rollback(); err != nil {
+				r.log.WithError(err).Error("failed rolling back transaction")
+			}
+		}
+	}()
+
+	var acquired bool
+	if err := tx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, advisorylock.Rebalance).Scan(&acquired); err != nil {
+		return fmt.Errorf("acquire lock: %w", err)
+	}
+
+	if !acquired {
+		r.log.Debug("rebalancing skipped as another Praefect is rebalancing")
+		return tx.Commit()
+	}
+
+	completed, err := r.completeMoves(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("complete moves: %w", err)
+	}
+
+	plans, err := getPlans(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("get plans: %w", err)
+	}
+
+	var scheduled []move
+	for _, plan := range plans {
+		moves, err := r.schedule(ctx, tx, plan)
+		if err != nil {
+			return fmt.Errorf("schedule moves of %q: %w", plan.VirtualStorage, err)
+		}
+
+		scheduled = append(scheduled, moves...)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	for _, m := range completed {
+		r.movesTotal.WithLabelValues(m.VirtualStorage, "completed").Inc()
+	}
+
+	for _, m := range scheduled {
+		r.movesTotal.WithLabelValues(m.VirtualStorage, "scheduled").Inc()
+	}
+
+	if len(completed) > 0 {
+		r.log.WithField("completed_moves", completed).Info("rebalancing moves completed")
+	}
+
+	if len(scheduled) > 0 {
+		r.log.WithField("scheduled_moves", scheduled).Info("rebalancing moves scheduled")
+	}
+
+	return nil
+}
+
+// completeMoves unassigns the source storages of the moves whose target has an up to date replica of the
+// repository. Moves without a target storage are completed once any other assigned storage has an up to date
+// replica.
+func (r *Rebalancer) completeMoves(ctx context.Context, tx glsql.Querier) ([]move, error) {
+	rows, err := tx.QueryContext(ctx, `
+WITH completed_moves AS (
+	DELETE FROM rebalancing_moves
+	USING repositories
+	WHERE rebalancing_moves.repository_id = repositories.repository_id
+	AND EXISTS (
+		SELECT FROM storage_repositories
+		JOIN repository_assignments USING (repository_id, storage)
+		WHERE storage_repositories.repository_id = rebalancing_moves.repository_id
+		AND storage_repositories.generation = repositories.generation
+		AND storage_repositories.storage != rebalancing_moves.source_storage
+		AND COALESCE(storage_repositories.storage = rebalancing_moves.target_storage, true)
+	)
+	RETURNING
+		rebalancing_moves.repository_id,
+		repositories.virtual_storage,
+		repositories.relative_path,
+		rebalancing_moves.source_storage,
+		rebalancing_moves.target_storage
+),
+
+unassigned_sources AS (
+	DELETE FROM repository_assignments
+	USING completed_moves
+	WHERE repository_assignments.repository_id = completed_moves.repository_id
+	AND repository_assignments.storage = completed_moves.source_storage
+)
+
+SELECT repository_id, virtual_storage, relative_path, source_storage, target_storage
+FROM completed_moves
+ORDER BY repository_id, source_storage
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var moves []move
+	for rows.Next() {
+		var m move
+		if err := rows.Scan(&m.RepositoryID, &m.VirtualStorage, &m.RelativePath, &m.SourceStorage, &m.TargetStorage); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		moves = append(moves, m)
+	}
+
+	return moves, rows.Err()
+}
+
+// planState contains the state of a virtual storage needed to schedule moves.
+type planState struct {
+	virtualStorage string
+	storages       []string
+	healthy        map[string]bool
+	// repositories contains the number of repositories on each storage as it will be once the in-progress
+	// moves have completed.
+	repositories map[string]int
+	// budget is the number of moves that can still be scheduled.
+	budget int
+}
+
+// schedule schedules new moves according to the plan.
+func (r *Rebalancer) schedule(ctx context.Context, tx glsql.Querier, plan Plan) ([]move, error) {
+	storages, ok := r.storages[plan.VirtualStorage]
+	if !ok {
+		r.log.WithField("virtual_storage", plan.VirtualStorage).Warn("rebalancing plan of an unconfigured virtual storage skipped")
+		return nil, nil
+	}
+
+	repositories, err := repositoryCounts(ctx, tx, plan.VirtualStorage, storages)
+	if err != nil {
+		return nil, fmt.Errorf("repository counts: %w", err)
+	}
+
+	_, movingOut, err := moveCounts(ctx, tx, plan.VirtualStorage)
+	if err != nil {
+		return nil, fmt.Errorf("move counts: %w", err)
+	}
+
+	inProgress := 0
+	for storage, count := range movingOut {
+		repositories[storage] -= count
+		inProgress += count
+	}
+
+	state := planState{
+		virtualStorage: plan.VirtualStorage,
+		storages:       storages,
+		healthy:        map[string]bool{},
+		repositories:   repositories,
+		budget:         r.maxConcurrentMoves - inProgress,
+	}
+
+	if state.budget <= 0 {
+		return nil, nil
+	}
+
+	for _, storage := range r.hc.HealthyNodes()[plan.VirtualStorage] {
+		state.healthy[storage] = true
+	}
+
+	switch plan.Strategy {
+	case StrategyDrain:
+		return r.scheduleDrain(ctx, tx, state, plan.DrainedStorages)
+	case StrategyEqualizeRepositories:
+		return r.scheduleEqualizeRepositories(ctx, tx, state)
+	case StrategyEqualizeBytes:
+		if inProgress > 0 {
+			// The used bytes only reflect the moves once they have been completed. Moves are
+			// scheduled only once the previous ones have completed to avoid overshooting.
+			return nil, nil
+		}
+
+		return r.scheduleEqualizeBytes(ctx, tx, state)
+	default:
+		r.log.WithField("virtual_storage", plan.VirtualStorage).WithField("strategy", plan.Strategy).Warn("rebalancing plan with unknown strategy skipped")
+		return nil, nil
+	}
+}
+
+// scheduleDrain schedules moves of the replicas on the drained storages to the least used healthy storages.
+// If a repository is already assigned to every other storage, the drained storage is unassigned without a
+// replacement.
+func (r *Rebalancer) scheduleDrain(ctx context.Context, tx glsql.Querier, state planState, drainedStorages []string) ([]move, error) {
+	drained := make(map[string]bool, len(drainedStorages))
+	for _, storage := range drainedStorages {
+		drained[storage] = true
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+SELECT repository_id, relative_path, ARRAY(
+	SELECT storage
+	FROM repository_assignments
+	WHERE repository_id = repositories.repository_id
+	AND storage = ANY($3::text[])
+	ORDER BY storage
+)
+FROM repositories
+WHERE virtual_storage = $1
+AND NOT EXISTS (
+	SELECT FROM rebalancing_moves
+	WHERE repository_id = repositories.repository_id
+)
+AND (
+	EXISTS (
+		SELECT FROM repository_assignments
+		WHERE repository_id = repositories.repository_id
+		AND storage = ANY($2::text[])
+	) OR NOT EXISTS (
+		-- Repositories without assignments are considered to be assigned to every storage.
+		SELECT FROM repository_assignments
+		WHERE repository_id = repositories.repository_id
+		AND storage = ANY($3::text[])
+	)
+)
+ORDER BY repository_id
+LIMIT $4
+	`, state.virtualStorage, drainedStorages, state.storages, state.budget)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	type repository struct {
+		id           int64
+		relativePath string
+		assigned     []string
+	}
+
+	var repositories []repository
+	for rows.Next() {
+		var repo repository
+		var assigned glsql.StringArray
+		if err := rows.Scan(&repo.id, &repo.relativePath, &assigned); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		repo.assigned = assigned.Slice()
+		repositories = append(repositories, repo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+	rows.Close()
+
+	var moves []move
+	for _, repo := range repositories {
+		if len(repo.assigned) == 0 {
+			// The repository is implicitly assigned to every storage. The assignments are made
+			// explicit so the drained storages can be unassigned.
+			if _, err := tx.ExecContext(ctx, `
+INSERT INTO repository_assignments (virtual_storage, relative_path, storage, repository_id)
+SELECT $1, $2, unnest($3::text[]), $4
+ON CONFLICT DO NOTHING
+			`, state.virtualStorage, repo.relativePath, state.storages, repo.id); err != nil {
+				return nil, fmt.Errorf("assign all storages: %w", err)
+			}
+
+			repo.assigned = append(repo.assigned, state.storages...)
+		}
+
+		assigned := make(map[string]bool, len(repo.assigned))
+		remaining := 0
+		for _, storage := range repo.assigned {
+			assigned[storage] = true
+			if !drained[storage] {
+				remaining++
+			}
+		}
+
+		for _, source := range repo.assigned {
+			if !drained[source] || state.budget == 0 {
+				continue
+			}
+
+			target := state.leastUsedTarget(func(storage string) bool {
+				return !drained[storage] && !assigned[storage]
+			})
+
+			if target == "" && remaining == 0 {
+				r.log.WithFields(log.Fields{
+					"virtual_storage": state.virtualStorage,
+					"relative_path":   repo.relativePath,
+					"storage":         source,
+				}).Warn("replica can't be drained as there is no storage to move it to")
+				continue
+			}
+
+			m, err := scheduleMove(ctx, tx, state.virtualStorage, repo.id, repo.relativePath, source, target)
+			if err != nil {
+				return nil, err
+			}
+
+			state.repositories[source]--
+			state.budget--
+			if target != "" {
+				assigned[target] = true
+				remaining++
+				state.repositories[target]++
+			}
+
+			moves = append(moves, m)
+		}
+	}
+
+	return moves, nil
+}
+
+// scheduleEqualizeRepositories schedules moves from the storages hosting the most repositories to the healthy
+// storages hosting the fewest until the difference between them is at most one repository.
+func (r *Rebalancer) scheduleEqualizeRepositories(ctx context.Context, tx glsql.Querier, state planState) ([]move, error) {
+	var moves []move
+	for state.budget > 0 {
+		source := state.mostUsedSource()
+		target := state.leastUsedTarget(func(string) bool { return true })
+		if source == "" || target == "" || state.repositories[source]-state.repositories[target] <= 1 {
+			break
+		}
+
+		m, ok, err := scheduleMoveOfAnyRepository(ctx, tx, state.virtualStorage, source, target)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			// Every repository on the source storage is already on the target storage.
+			break
+		}
+
+		state.repositories[source]--
+		state.repositories[target]++
+		state.budget--
+		moves = append(moves, m)
+	}
+
+	return moves, nil
+}
+
+// scheduleEqualizeBytes schedules moves from the storage using the most disk space to the healthy storage using
+// the least if they differ by more than a tenth. The number of moves is estimated from the average size of the
+// replicas on the source storage so that about half of the difference is moved.
+func (r *Rebalancer) scheduleEqualizeBytes(ctx context.Context, tx glsql.Querier, state planState) ([]move, error) {
+	if r.usage == nil {
+		return nil, errors.New("disk usage is not available")
+	}
+
+	usedBytes, err := r.usage.UsedBytes(ctx, state.virtualStorage)
+	if err != nil {
+		return nil, fmt.Errorf("used bytes: %w", err)
+	}
+
+	var source, target string
+	for _, storage := range state.storages {
+		used, ok := usedBytes[storage]
+		if !ok {
+			continue
+		}
+
+		if source == "" || used > usedBytes[source] {
+			source = storage
+		}
+
+		if state.healthy[storage] && (target == "" || used < usedBytes[target]) {
+			target = storage
+		}
+	}
+
+	if source == "" || target == "" || source == target || state.repositories[source] <= 0 {
+		return nil, nil
+	}
+
+	difference := usedBytes[source] - usedBytes[target]
+	if difference <= usedBytes[source]/10 {
+		return nil, nil
+	}
+
+	averageSize := usedBytes[source] / int64(state.repositories[source])
+	count := state.budget
+	if averageSize > 0 && difference/2/averageSize < int64(count) {
+		count = int(difference / 2 / averageSize)
+	}
+
+	var moves []move
+	for i := 0; i < count; i++ {
+		m, ok, err := scheduleMoveOfAnyRepository(ctx, tx, state.virtualStorage, source, target)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			break
+		}
+
+		moves = append(moves, m)
+	}
+
+	return moves, nil
+}
+
+// mostUsedSource returns the storage hosting the most repositories.
+func (s planState) mostUsedSource() string {
+	var source string
+	for _, storage := range s.storages {
+		if source == "" || s.repositories[storage] > s.repositories[source] {
+			source = storage
+		}
+	}
+
+	return source
+}
+
+// leastUsedTarget returns the healthy storage hosting the fewest repositories of those accepted by the filter.
+// It returns an empty string if there is no such storage.
+func (s planState) leastUsedTarget(accept func(string) bool) string {
+	var target string
+	for _, storage := range s.storages {
+		if !s.healthy[storage] || !accept(storage) {
+			continue
+		}
+
+		if target == "" || s.repositories[storage] < s.repositories[target] {
+			target = storage
+		}
+	}
+
+	return target
+}
+
+// scheduleMoveOfAnyRepository schedules a move of a random repository from the source storage to the target
+// storage. Only repositories which are explicitly assigned to the source storage, not assigned to the target
+// storage and not being moved already are considered. It returns false if there is no such repository.
+func scheduleMoveOfAnyRepository(ctx context.Context, tx glsql.Querier, virtualStorage, source, target string) (move, bool, error) {
+	var repositoryID int64
+	var relativePath string
+	if err := tx.QueryRowContext(ctx, `
+SELECT repository_id, relative_path
+FROM repositories
+JOIN repository_assignments USING (repository_id)
+WHERE repositories.virtual_storage = $1
+AND repository_assignments.storage = $2
+AND NOT EXISTS (
+	SELECT FROM repository_assignments AS target_assignments
+	WHERE target_assignments.repository_id = repositories.repository_id
+	AND target_assignments.storage = $3
+)
+AND NOT EXISTS (
+	SELECT FROM rebalancing_moves
+	WHERE rebalancing_moves.repository_id = repositories.repository_id
+)
+ORDER BY random()
+LIMIT 1
+	`, virtualStorage, source, target).Scan(&repositoryID, &relativePath); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return move{}, false, nil
+		}
+
+		return move{}, false, fmt.Errorf("query: %w", err)
+	}
+
+	m, err := scheduleMove(ctx, tx, virtualStorage, repositoryID, relativePath, source, target)
+	if err != nil {
+		return move{}, false, err
+	}
+
+	return m, true, nil
+}
+
+// scheduleMove records the move and assigns the target storage to the repository. The source storage stays
+// assigned until the move is completed. An empty target unassigns the source storage without a replacement.
+func scheduleMove(ctx context.Context, tx glsql.Querier, virtualStorage string, repositoryID int64, relativePath, source, target string) (move, error) {
+	m := move{
+		RepositoryID:   repositoryID,
+		VirtualStorage: virtualStorage,
+		RelativePath:   relativePath,
+		SourceStorage:  source,
+	}
+
+	if target != "" {
+		m.TargetStorage = &target
+
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO repository_assignments (virtual_storage, relative_path, storage, repository_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+		`, virtualStorage, relativePath, target, repositoryID); err != nil {
+			return move{}, fmt.Errorf("assign target: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO rebalancing_moves (repository_id, source_storage, target_storage)
+VALUES ($1, $2, $3)
+	`, repositoryID, source, m.TargetStorage); err != nil {
+		return move{}, fmt.Errorf("record move: %w", err)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,326 @@
+package rebalancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+type staticUsage map[string]int64
+
+func (u staticUsage) UsedBytes(context.Context, string) (map[string]int64, error) {
+	return u, nil
+}
+
+func TestProgress_Completed(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc      string
+		progress  Progress
+		completed bool
+	}{
+		{
+			desc: "no plan",
+		},
+		{
+			desc: "equalization never completes",
+			progress: Progress{
+				Plan:     &Plan{Strategy: StrategyEqualizeRepositories},
+				Storages: []StorageProgress{{Storage: "storage-1", Repositories: 1}},
+			},
+		},
+		{
+			desc: "drained storage has repositories",
+			progress: Progress{
+				Plan: &Plan{Strategy: StrategyDrain, DrainedStorages: []string{"storage-1"}},
+				Storages: []StorageProgress{
+					{Storage: "storage-1", Repositories: 1},
+					{Storage: "storage-2", Repositories: 1},
+				},
+			},
+		},
+		{
+			desc: "moves in progress",
+			progress: Progress{
+				Plan: &Plan{Strategy: StrategyDrain, DrainedStorages: []string{"storage-1"}},
+				Storages: []StorageProgress{
+					{Storage: "storage-1", MovingOut: 1},
+					{Storage: "storage-2", Repositories: 1, MovingIn: 1},
+				},
+			},
+		},
+		{
+			desc: "drain completed",
+			progress: Progress{
+				Plan: &Plan{Strategy: StrategyDrain, DrainedStorages: []string{"storage-1"}},
+				Storages: []StorageProgress{
+					{Storage: "storage-1"},
+					{Storage: "storage-2", Repositories: 1},
+				},
+			},
+			completed: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.completed, tc.progress.Completed())
+		})
+	}
+}
+
+func TestRebalancer(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	const virtualStorage = "virtual-storage"
+	configuredStorages := map[string][]string{virtualStorage: {"storage-1", "storage-2", "storage-3"}}
+
+	// createRepositories creates repositories with the given relative paths and assigns them to the storages.
+	createRepositories := func(t *testing.T, storages []string, relativePaths ...string) {
+		t.Helper()
+
+		rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+		for _, relativePath := range relativePaths {
+			id, err := rs.ReserveRepositoryID(ctx, virtualStorage, relativePath)
+			require.NoError(t, err)
+			require.NoError(t, rs.CreateRepository(ctx, id, virtualStorage, relativePath, relativePath, storages[0], storages[1:], nil, true, true))
+		}
+	}
+
+	getAssignments := func(t *testing.T) map[string][]string {
+		t.Helper()
+
+		rows, err := db.QueryContext(ctx, `
+SELECT relative_path, array_agg(storage ORDER BY storage)
+FROM repository_assignments
+GROUP BY relative_path
+		`)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		assignments := map[string][]string{}
+		for rows.Next() {
+			var relativePath string
+			var storages glsql.StringArray
+			require.NoError(t, rows.Scan(&relativePath, &storages))
+			assignments[relativePath] = storages.Slice()
+		}
+		require.NoError(t, rows.Err())
+
+		return assignments
+	}
+
+	getProgress := func(t *testing.T) Progress {
+		t.Helper()
+
+		progress, err := GetProgress(ctx, db, virtualStorage, configuredStorages[virtualStorage])
+		require.NoError(t, err)
+
+		return progress
+	}
+
+	newRebalancer := func(t *testing.T, usage UsageGetter, maxConcurrentMoves int) *Rebalancer {
+		t.Helper()
+
+		r := NewRebalancer(testhelper.SharedLogger(t), db.DB, praefect.StaticHealthChecker(configuredStorages), configuredStorages, usage, maxConcurrentMoves)
+		r.handleError = func(err error) error { return err }
+		return r
+	}
+
+	t.Run("drain", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, []string{"storage-1", "storage-2"}, "repository-1", "repository-2")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage:  virtualStorage,
+			Strategy:        StrategyDrain,
+			DrainedStorages: []string{"storage-1"},
+		}))
+
+		r := newRebalancer(t, nil, 10)
+		require.NoError(t, r.rebalance(ctx))
+
+		// The targets are assigned while the sources stay assigned until the targets have caught up.
+		require.Equal(t, map[string][]string{
+			"repository-1": {"storage-1", "storage-2", "storage-3"},
+			"repository-2": {"storage-1", "storage-2", "storage-3"},
+		}, getAssignments(t))
+
+		progress := getProgress(t)
+		require.False(t, progress.Completed())
+		require.Equal(t, []StorageProgress{
+			{Storage: "storage-1", Repositories: 2, MovingOut: 2},
+			{Storage: "storage-2", Repositories: 2},
+			{Storage: "storage-3", Repositories: 2, MovingIn: 2},
+		}, progress.Storages)
+
+		// Nothing is completed or scheduled until the targets have caught up.
+		require.NoError(t, r.rebalance(ctx))
+		require.Len(t, getAssignments(t)["repository-1"], 3)
+
+		rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+		for _, relativePath := range []string{"repository-1", "repository-2"} {
+			id, err := rs.GetRepositoryID(ctx, virtualStorage, relativePath)
+			require.NoError(t, err)
+			require.NoError(t, rs.SetGeneration(ctx, id, "storage-3", relativePath, 0))
+		}
+
+		require.NoError(t, r.rebalance(ctx))
+		require.Equal(t, map[string][]string{
+			"repository-1": {"storage-2", "storage-3"},
+			"repository-2": {"storage-2", "storage-3"},
+		}, getAssignments(t))
+		require.True(t, getProgress(t).Completed())
+	})
+
+	t.Run("drain without replacement", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, configuredStorages[virtualStorage], "repository-1")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage:  virtualStorage,
+			Strategy:        StrategyDrain,
+			DrainedStorages: []string{"storage-1"},
+		}))
+
+		r := newRebalancer(t, nil, 10)
+		require.NoError(t, r.rebalance(ctx))
+
+		// The other storages already have up to date replicas so the move completes on the next run.
+		require.Len(t, getAssignments(t)["repository-1"], 3)
+		require.NoError(t, r.rebalance(ctx))
+		require.Equal(t, map[string][]string{
+			"repository-1": {"storage-2", "storage-3"},
+		}, getAssignments(t))
+		require.True(t, getProgress(t).Completed())
+	})
+
+	t.Run("drain of repositories without assignments", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+		require.NoError(t, rs.CreateRepository(ctx, 1, virtualStorage, "repository-1", "repository-1", "storage-1", []string{"storage-2", "storage-3"}, nil, true, false))
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage:  virtualStorage,
+			Strategy:        StrategyDrain,
+			DrainedStorages: []string{"storage-1"},
+		}))
+
+		r := newRebalancer(t, nil, 10)
+		require.NoError(t, r.rebalance(ctx))
+		require.NoError(t, r.rebalance(ctx))
+		require.Equal(t, map[string][]string{
+			"repository-1": {"storage-2", "storage-3"},
+		}, getAssignments(t))
+	})
+
+	t.Run("equalize repositories", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, []string{"storage-1"}, "repository-1", "repository-2", "repository-3", "repository-4")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage: virtualStorage,
+			Strategy:       StrategyEqualizeRepositories,
+		}))
+
+		r := newRebalancer(t, nil, 10)
+		require.NoError(t, r.rebalance(ctx))
+
+		progress := getProgress(t)
+		require.False(t, progress.Completed())
+		require.Equal(t, []StorageProgress{
+			{Storage: "storage-1", Repositories: 4, MovingOut: 2},
+			{Storage: "storage-2", Repositories: 1, MovingIn: 1},
+			{Storage: "storage-3", Repositories: 1, MovingIn: 1},
+		}, progress.Storages)
+
+		// In-progress moves are accounted for so no further moves are scheduled.
+		require.NoError(t, r.rebalance(ctx))
+		require.Equal(t, 2, getProgress(t).Storages[0].MovingOut)
+	})
+
+	t.Run("concurrent moves are limited", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, []string{"storage-1"}, "repository-1", "repository-2", "repository-3", "repository-4")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage: virtualStorage,
+			Strategy:       StrategyEqualizeRepositories,
+		}))
+
+		r := newRebalancer(t, nil, 1)
+		require.NoError(t, r.rebalance(ctx))
+		require.NoError(t, r.rebalance(ctx))
+		require.Equal(t, 1, getProgress(t).Storages[0].MovingOut)
+	})
+
+	t.Run("equalize bytes", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, []string{"storage-1"}, "repository-1", "repository-2", "repository-3", "repository-4")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage: virtualStorage,
+			Strategy:       StrategyEqualizeBytes,
+		}))
+
+		// The average replica on storage-1 uses 250 bytes so two moves are needed to move half of the
+		// difference to storage-3.
+		r := newRebalancer(t, staticUsage{"storage-1": 1000, "storage-2": 600, "storage-3": 0}, 10)
+		require.NoError(t, r.rebalance(ctx))
+		require.Equal(t, []StorageProgress{
+			{Storage: "storage-1", Repositories: 4, MovingOut: 2},
+			{Storage: "storage-2"},
+			{Storage: "storage-3", Repositories: 2, MovingIn: 2},
+		}, getProgress(t).Storages)
+	})
+
+	t.Run("equalize bytes within tolerance", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, []string{"storage-1"}, "repository-1", "repository-2")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage: virtualStorage,
+			Strategy:       StrategyEqualizeBytes,
+		}))
+
+		r := newRebalancer(t, staticUsage{"storage-1": 1000, "storage-2": 950, "storage-3": 910}, 10)
+		require.NoError(t, r.rebalance(ctx))
+		require.Zero(t, getProgress(t).Storages[0].MovingOut)
+	})
+
+	t.Run("canceled plan", func(t *testing.T) {
+		db.TruncateAll(t)
+
+		createRepositories(t, []string{"storage-1"}, "repository-1", "repository-2")
+		require.NoError(t, SetPlan(ctx, db, Plan{
+			VirtualStorage: virtualStorage,
+			Strategy:       StrategyEqualizeRepositories,
+		}))
+		require.NoError(t, CancelPlan(ctx, db, virtualStorage))
+		require.Equal(t, ErrPlanNotFound, CancelPlan(ctx, db, virtualStorage))
+
+		require.NoError(t, newRebalancer(t, nil, 10).rebalance(ctx))
+
+		progress, err := GetProgress(ctx, db, virtualStorage, configuredStorages[virtualStorage])
+		require.NoError(t, err)
+		require.Equal(t, Progress{
+			Storages: []StorageProgress{
+				{Storage: "storage-1", Repositories: 2},
+				{Storage: "storage-2"},
+				{Storage: "storage-3"},
+			},
+		}, progress)
+	})
+}
@@ -0,0 +1,46 @@
+package rebalancer
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+type diskStatistics struct {
+	conns praefect.Connections
+}
+
+// NewDiskStatistics returns a UsageGetter that asks the Gitaly nodes for their disk statistics.
+func NewDiskStatistics(conns praefect.Connections) UsageGetter {
+	return diskStatistics{conns: conns}
+}
+
+// UsedBytes returns the used bytes reported by each storage of the virtual storage. Storages which can't be
+// reached or which can't determine their usage are omitted.
+func (d diskStatistics) UsedBytes(ctx context.Context, virtualStorage string) (map[string]int64, error) {
+	conns, ok := d.conns[virtualStorage]
+	if !ok {
+		return nil, fmt.Errorf("virtual storage %q not found", virtualStorage)
+	}
+
+	usedBytes := make(map[string]int64, len(conns))
+	for storage, conn := range conns {
+		resp, err := gitalypb.NewServerServiceClient(conn).DiskStatistics(ctx, &gitalypb.DiskStatisticsRequest{})
+		if err != nil {
+			continue
+		}
+
+		for _, status := range resp.GetStorageStatuses() {
+			// Both being zero means the node was unable to determine the statistics.
+			if status.GetStorageName() != storage || (status.GetUsed() == 0 && status.GetAvailable() == 0) {
+				continue
+			}
+
+			usedBytes[storage] = status.GetUsed()
+		}
+	}
+
+	return usedBytes, nil
+}
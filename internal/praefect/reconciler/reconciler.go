@@ -8,6 +8,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/advisorylock"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 )
@@ -248,11 +249,15 @@ update_jobs AS (
 ),
 
 reconciliation_jobs AS (
-	INSERT INTO replication_queue (lock_id, job, meta)
+	INSERT INTO replication_queue (lock_id, job, meta, priority)
 	SELECT
 		(virtual_storage || '|' || target_node_storage || '|' || relative_path),
 		to_jsonb(reconciliation_jobs),
-		jsonb_build_object('correlation_id', encode(random()::text::bytea, 'base64'))
+		jsonb_build_object(
+			'correlation_id', encode(random()::text::bytea, 'base64'),
+			'origin', CASE WHEN rebalancing THEN $4::text ELSE $5::text END
+		),
+		CASE WHEN rebalancing THEN $6::smallint ELSE $7::smallint END
 	FROM (
 		SELECT
 			COALESCE(repository_id, 0) AS repository_id,
@@ -272,6 +277,18 @@ reconciliation_jobs AS (
 			'delete_replica' AS change
 		FROM delete_jobs
 	) AS reconciliation_jobs
+	-- jobs replicating to or deleting from a storage a rebalancing move is in progress for are scheduled
+	-- with the rebalancing's lower priority so they don't delay the repair of outdated replicas
+	CROSS JOIN LATERAL (
+		SELECT EXISTS (
+			SELECT FROM rebalancing_moves
+			WHERE rebalancing_moves.repository_id = reconciliation_jobs.repository_id
+			AND (
+				(reconciliation_jobs.change = 'update' AND rebalancing_moves.target_storage = reconciliation_jobs.target_node_storage)
+				OR (reconciliation_jobs.change = 'delete_replica' AND rebalancing_moves.source_storage = reconciliation_jobs.target_node_storage)
+			)
+		) AS rebalancing
+	) AS origin
 	-- only perform inserts if we managed to acquire the lock as otherwise
 	-- we'd schedule duplicate jobs
 	WHERE ( SELECT acquired FROM reconciliation_lock )
@@ -294,7 +311,10 @@ SELECT
 	job->>'source_node_storage',
 	job->>'target_node_storage'
 FROM reconciliation_jobs
-`, advisorylock.Reconcile, virtualStorages, storages)
+`, advisorylock.Reconcile, virtualStorages, storages,
+		string(datastore.OriginRebalance), string(datastore.OriginReconciler),
+		datastore.PriorityRebalance, datastore.PriorityReconciler,
+	)
 	if err != nil {
 		return fmt.Errorf("query: %w", err)
 	}
@@ -139,6 +139,10 @@ type Router interface {
 	// RouteRepositoryAccessor returns the node that should serve the repository accessor
 	// request. If forcePrimary is set to `true`, it returns the primary node.
 	RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error)
+	// RouteRepositoryAccessorHedge returns a node other than the route's node that can serve the
+	// repository accessor request. It's used to send a hedged request when the route's node is slow
+	// to respond.
+	RouteRepositoryAccessorHedge(ctx context.Context, virtualStorage, relativePath string, route RepositoryAccessorRoute) (RouterNode, error)
 	// RouteRepositoryMutator returns a route to primary and secondary nodes that should handle the
 	// repository mutator request. Additionally, it returns nodes which do not participate in the
 	// transaction, but to which the change should be replicated. RouteRepositoryMutator should only
@@ -53,6 +53,12 @@ func (r *nodeManagerRouter) RouteRepositoryAccessor(ctx context.Context, virtual
 	return RepositoryAccessorRoute{ReplicaPath: relativePath, Node: toRouterNode(node)}, nil
 }
 
+// RouteRepositoryAccessorHedge is not supported by the node manager router as hedging requires repository specific
+// primaries.
+func (r *nodeManagerRouter) RouteRepositoryAccessorHedge(ctx context.Context, virtualStorage, relativePath string, route RepositoryAccessorRoute) (RouterNode, error) {
+	return RouterNode{}, errors.New("hedging is not supported with the node manager router")
+}
+
 func (r *nodeManagerRouter) RouteStorageAccessor(ctx context.Context, virtualStorage string) (RouterNode, error) {
 	shard, err := r.mgr.GetShard(ctx, virtualStorage)
 	if err != nil {
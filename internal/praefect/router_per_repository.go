@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
@@ -52,6 +53,9 @@ type PerRepositoryRouter struct {
 	csg                       datastore.ConsistentStoragesGetter
 	rs                        datastore.RepositoryStore
 	defaultReplicationFactors map[string]int
+	readDistributors          map[string]ReadDistributor
+	storageZones              map[string]map[string]string
+	storageUsage              StorageUsageGetter
 }
 
 // NewPerRepositoryRouter returns a new PerRepositoryRouter using the passed configuration.
@@ -64,6 +68,9 @@ func NewPerRepositoryRouter(
 	ag AssignmentGetter,
 	rs datastore.RepositoryStore,
 	defaultReplicationFactors map[string]int,
+	readDistributors map[string]ReadDistributor,
+	storageZones map[string]map[string]string,
+	storageUsage StorageUsageGetter,
 ) *PerRepositoryRouter {
 	return &PerRepositoryRouter{
 		conns:                     conns,
@@ -74,6 +81,9 @@ func NewPerRepositoryRouter(
 		ag:                        ag,
 		rs:                        rs,
 		defaultReplicationFactors: defaultReplicationFactors,
+		readDistributors:          readDistributors,
+		storageZones:              storageZones,
+		storageUsage:              storageUsage,
 	}
 }
 
@@ -111,6 +121,16 @@ func (r *PerRepositoryRouter) pickRandom(nodes []RouterNode) (RouterNode, error)
 	return nodes[r.rand.Intn(len(nodes))], nil
 }
 
+// pickReadNode picks the node to route a read to using the virtual storage's read distributor. Reads are
+// distributed at random if the virtual storage has no read distributor.
+func (r *PerRepositoryRouter) pickReadNode(virtualStorage string, nodes []RouterNode) (RouterNode, error) {
+	if distributor, ok := r.readDistributors[virtualStorage]; ok {
+		return distributor.Pick(nodes)
+	}
+
+	return r.pickRandom(nodes)
+}
+
 // RouteStorageAccessor routes requests for storage-scoped accessor RPCs. The
 // only storage scoped accessor RPC is RemoteService/FindRemoteRepository,
 // which in turn executes a command without a repository. This can be done by
@@ -121,7 +141,7 @@ func (r *PerRepositoryRouter) RouteStorageAccessor(ctx context.Context, virtualS
 		return RouterNode{}, err
 	}
 
-	return r.pickRandom(healthyNodes)
+	return r.pickRandom(r.preferClientZone(ctx, virtualStorage, healthyNodes))
 }
 
 // RouteStorageMutator is not implemented here. The only storage scoped mutator RPC is related to namespace operations.
@@ -165,9 +185,39 @@ func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtu
 		return RepositoryAccessorRoute{}, nodes.ErrPrimaryNotHealthy
 	}
 
-	replicaPath, consistentStorages, err := r.csg.GetConsistentStorages(ctx, virtualStorage, relativePath)
+	replicaPath, healthyConsistentNodes, err := r.healthyConsistentNodes(ctx, virtualStorage, relativePath, healthyNodes)
+	if err != nil {
+		return RepositoryAccessorRoute{}, err
+	}
+
+	node, err := r.pickReadNode(virtualStorage, r.preferClientZone(ctx, virtualStorage, healthyConsistentNodes))
+	if err != nil {
+		return RepositoryAccessorRoute{}, err
+	}
+
+	return RepositoryAccessorRoute{
+		ReplicaPath: replicaPath,
+		Node:        node,
+	}, nil
+}
+
+// healthyConsistentNodes returns the replica path of the repository and the healthy nodes that are safe to read the
+// repository from. If the client sent a minimum repository generation, only the nodes on that generation are returned.
+func (r *PerRepositoryRouter) healthyConsistentNodes(ctx context.Context, virtualStorage, relativePath string, healthyNodes []RouterNode) (string, []RouterNode, error) {
+	minGeneration, ok, err := minimumGeneration(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var replicaPath string
+	var consistentStorages *datastructure.Set[string]
+	if ok {
+		replicaPath, consistentStorages, err = r.storagesAtGeneration(ctx, virtualStorage, relativePath, minGeneration)
+	} else {
+		replicaPath, consistentStorages, err = r.csg.GetConsistentStorages(ctx, virtualStorage, relativePath)
+	}
 	if err != nil {
-		return RepositoryAccessorRoute{}, fmt.Errorf("consistent storages: %w", err)
+		return "", nil, fmt.Errorf("consistent storages: %w", err)
 	}
 
 	healthyConsistentNodes := make([]RouterNode, 0, len(healthyNodes))
@@ -179,15 +229,32 @@ func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtu
 		healthyConsistentNodes = append(healthyConsistentNodes, node)
 	}
 
-	node, err := r.pickRandom(healthyConsistentNodes)
+	return replicaPath, healthyConsistentNodes, nil
+}
+
+// RouteRepositoryAccessorHedge returns a healthy and consistent node other than the route's node to send a hedged
+// request to.
+func (r *PerRepositoryRouter) RouteRepositoryAccessorHedge(ctx context.Context, virtualStorage, relativePath string, route RepositoryAccessorRoute) (RouterNode, error) {
+	healthyNodes, err := r.healthyNodes(virtualStorage)
 	if err != nil {
-		return RepositoryAccessorRoute{}, err
+		return RouterNode{}, err
 	}
 
-	return RepositoryAccessorRoute{
-		ReplicaPath: replicaPath,
-		Node:        node,
-	}, nil
+	_, healthyConsistentNodes, err := r.healthyConsistentNodes(ctx, virtualStorage, relativePath, healthyNodes)
+	if err != nil {
+		return RouterNode{}, err
+	}
+
+	candidates := make([]RouterNode, 0, len(healthyConsistentNodes))
+	for _, node := range healthyConsistentNodes {
+		if node.Storage == route.Node.Storage {
+			continue
+		}
+
+		candidates = append(candidates, node)
+	}
+
+	return r.pickRandom(r.preferClientZone(ctx, virtualStorage, candidates))
 }
 
 func (r *PerRepositoryRouter) resolveAdditionalReplicaPath(ctx context.Context, virtualStorage, additionalRelativePath string) (string, error) {
@@ -301,8 +368,10 @@ type assignedNodes struct {
 }
 
 // assignRepositoryToNodes picks a random healthy node to act as the primary node and selects the
-// secondary nodes if assignments are enabled. Healthy secondaries take part in the transaction,
-// unhealthy secondaries are set as replication targets.
+// secondary nodes if assignments are enabled. Secondaries are spread across the storages' zones.
+// Healthy secondaries take part in the transaction, unhealthy secondaries are set as replication
+// targets. If the storages' usage is tracked, full storages are not assigned and storages with more
+// free capacity are more likely to be picked.
 func (r *PerRepositoryRouter) assignRepositoryToNodes(
 	virtualStorage string,
 	additionalRepoMetadata *datastore.RepositoryMetadata,
@@ -314,6 +383,14 @@ func (r *PerRepositoryRouter) assignRepositoryToNodes(
 
 	replicationFactor := r.defaultReplicationFactors[virtualStorage]
 
+	// Repositories created alongside an additional repository must be placed on its storages, so
+	// the storages' usage only matters when the storages are picked freely.
+	usage := r.placementUsage(virtualStorage)
+	primaryCandidates := withoutFullStorages(healthyNodes, usage)
+	if additionalRepoMetadata == nil && len(primaryCandidates) == 0 {
+		return assignedNodes{}, ErrStoragesFull
+	}
+
 	switch {
 	case additionalRepoMetadata != nil:
 		// RPCs that create repositories can have an additional repository. This repository
@@ -374,7 +451,7 @@ func (r *PerRepositoryRouter) assignRepositoryToNodes(
 	case replicationFactor == 1:
 		// If we have a replication factor of 1 then picking the primary node is already
 		// sufficient.
-		primary, err := r.pickRandom(healthyNodes)
+		primary, err := r.pickPlacementPrimary(primaryCandidates, usage)
 		if err != nil {
 			return assignedNodes{}, err
 		}
@@ -386,14 +463,14 @@ func (r *PerRepositoryRouter) assignRepositoryToNodes(
 		// Otherwise, we need to figure out what the actual replication factor is supposed
 		// to be and assign secondaries as required to satisfy it.
 
-		primary, err := r.pickRandom(healthyNodes)
+		primary, err := r.pickPlacementPrimary(primaryCandidates, usage)
 		if err != nil {
 			return assignedNodes{}, err
 		}
 
 		var secondaryNodes []RouterNode
 		for storage, conn := range r.conns[virtualStorage] {
-			if storage == primary.Storage {
+			if storage == primary.Storage || usage[storage].Full {
 				continue
 			}
 
@@ -408,11 +485,11 @@ func (r *PerRepositoryRouter) assignRepositoryToNodes(
 		// if we have a positive replication factor, we pick a random set of secondaries.
 		if replicationFactor > 0 {
 			// Select random secondaries according to the default replication factor.
-			r.rand.Shuffle(len(secondaryNodes), func(i, j int) {
-				secondaryNodes[i], secondaryNodes[j] = secondaryNodes[j], secondaryNodes[i]
-			})
+			r.shufflePlacementSecondaries(secondaryNodes, usage)
 
-			secondaryNodes = secondaryNodes[:replicationFactor-1]
+			// The secondaries are spread across zones so losing a single zone doesn't lose
+			// every replica of the repository.
+			secondaryNodes = r.spreadAcrossZones(virtualStorage, primary.Storage, secondaryNodes, replicationFactor-1)
 		}
 
 		// We now split up secondaries into two sets: those which are known to be healthy
@@ -93,6 +93,9 @@ func TestPerRepositoryRouter_RouteStorageAccessor(t *testing.T) {
 				nil,
 				datastore.MockRepositoryStore{},
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			node, err := router.RouteStorageAccessor(ctx, tc.virtualStorage)
@@ -236,6 +239,9 @@ func TestPerRepositoryRouter_RouteRepositoryAccessor(t *testing.T) {
 				nil,
 				rs,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			route, err := router.RouteRepositoryAccessor(ctx, tc.virtualStorage, relativePath, tc.forcePrimary)
@@ -410,9 +416,12 @@ func TestPerRepositoryRouter_RouteRepositoryMutator(t *testing.T) {
 				tc.healthyNodes,
 				nil,
 				rs,
-				datastore.NewAssignmentStore(tx, configuredNodes),
+				datastore.NewAssignmentStore(tx, configuredNodes, nil),
 				rs,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			requestAdditionalRelativePath := additionalRelativePath
@@ -638,7 +647,7 @@ func TestPerRepositoryRouter_RouteRepositoryMaintenance(t *testing.T) {
 
 			router := NewPerRepositoryRouter(conns, nil, StaticHealthChecker{
 				virtualStorage: tc.healthyStorages,
-			}, nil, nil, nil, rs, nil)
+			}, nil, nil, nil, rs, nil, nil, nil, nil)
 
 			route, err := router.RouteRepositoryMaintenance(ctx, tc.virtualStorage, relativePath)
 			require.Equal(t, tc.expectedErr, err)
@@ -1030,6 +1039,9 @@ func TestPerRepositoryRouterRouteRepositoryCreation(t *testing.T) {
 				nil,
 				rs,
 				map[string]int{"virtual-storage-1": tc.replicationFactor},
+				nil,
+				nil,
+				nil,
 			).RouteRepositoryCreation(ctx, tc.virtualStorage, tc.relativePath, tc.additionalRelativePath)
 
 			require.Equal(t, tc.expectedPrimaryCandidates, primaryCandidates)
@@ -189,7 +189,7 @@ func NewGRPCServer(
 	warnDupeAddrs(deps.Logger, deps.Config)
 
 	srv := grpc.NewServer(grpcOpts...)
-	registerServices(srv, deps.TxMgr, deps.Config, deps.RepositoryStore, deps.AssignmentStore, service.Connections(deps.Conns), deps.PrimaryGetter, deps.Checks)
+	registerServices(srv, deps.TxMgr, deps.Config, deps.RepositoryStore, deps.AssignmentStore, service.Connections(deps.Conns), deps.PrimaryGetter, deps.StorageDrainer, deps.StorageUsage, deps.Checks)
 
 	if deps.Config.Failover.ElectionStrategy == config.ElectionStrategyPerRepository {
 		proxy.RegisterStreamHandlers(srv, "gitaly.RepositoryService", map[string]grpc.StreamHandler{
@@ -224,11 +224,13 @@ func registerServices(
 	assignmentStore AssignmentStore,
 	conns service.Connections,
 	primaryGetter info.PrimaryGetter,
+	storageDrainer info.StorageDrainer,
+	storageUsage info.StorageUsageGetter,
 	checks []service.CheckFunc,
 ) {
 	// ServerServiceServer is necessary for the ServerInfo RPC
 	gitalypb.RegisterServerServiceServer(srv, server.NewServer(conf, conns, checks))
-	gitalypb.RegisterPraefectInfoServiceServer(srv, info.NewServer(conf, rs, assignmentStore, conns, primaryGetter))
+	gitalypb.RegisterPraefectInfoServiceServer(srv, info.NewServer(conf, rs, assignmentStore, conns, primaryGetter, storageDrainer, storageUsage))
 	gitalypb.RegisterRefTransactionServer(srv, transaction.NewServer(tm))
 	healthpb.RegisterHealthServer(srv, health.NewServer())
 
@@ -96,6 +96,7 @@ func TestNewBackchannelServerFactory(t *testing.T) {
 		Name:  "default",
 		Nodes: []*config.Node{{Storage: "gitaly-1", Address: "tcp://" + ln.Addr().String()}},
 	}}, nil, nil,
+		nil,
 		backchannel.NewClientHandshaker(
 			logger,
 			NewBackchannelServerFactory(
@@ -157,7 +158,7 @@ func TestGitalyServerInfo(t *testing.T) {
 			},
 		}
 
-		nodeSet, err := DialNodes(ctx, conf.VirtualStorages, nil, nil, nil, nil, testhelper.SharedLogger(t))
+		nodeSet, err := DialNodes(ctx, conf.VirtualStorages, nil, nil, nil, nil, nil, testhelper.SharedLogger(t))
 		require.NoError(t, err)
 		t.Cleanup(nodeSet.Close)
 
@@ -223,7 +224,7 @@ func TestGitalyServerInfo(t *testing.T) {
 			},
 		}
 
-		nodeSet, err := DialNodes(ctx, conf.VirtualStorages, nil, nil, nil, nil, testhelper.SharedLogger(t))
+		nodeSet, err := DialNodes(ctx, conf.VirtualStorages, nil, nil, nil, nil, nil, testhelper.SharedLogger(t))
 		require.NoError(t, err)
 		t.Cleanup(nodeSet.Close)
 
@@ -260,7 +261,7 @@ func TestGitalyServerInfoBadNode(t *testing.T) {
 	}
 	ctx := testhelper.Context(t)
 
-	nodes, err := DialNodes(ctx, conf.VirtualStorages, nil, nil, nil, nil, testhelper.SharedLogger(t))
+	nodes, err := DialNodes(ctx, conf.VirtualStorages, nil, nil, nil, nil, nil, testhelper.SharedLogger(t))
 	require.NoError(t, err)
 	defer nodes.Close()
 
@@ -292,7 +293,7 @@ func TestDiskStatistics(t *testing.T) {
 	}
 	ctx := testhelper.Context(t)
 
-	nodes, err := DialNodes(ctx, praefectCfg.VirtualStorages, nil, nil, nil, nil, testhelper.SharedLogger(t))
+	nodes, err := DialNodes(ctx, praefectCfg.VirtualStorages, nil, nil, nil, nil, nil, testhelper.SharedLogger(t))
 	require.NoError(t, err)
 	defer nodes.Close()
 
@@ -617,7 +618,7 @@ func TestRenameRepository(t *testing.T) {
 	)
 
 	ctx := testhelper.Context(t)
-	nodeSet, err := DialNodes(ctx, praefectCfg.VirtualStorages, nil, nil, clientHandshaker, nil, testhelper.SharedLogger(t))
+	nodeSet, err := DialNodes(ctx, praefectCfg.VirtualStorages, nil, nil, nil, clientHandshaker, nil, testhelper.SharedLogger(t))
 	require.NoError(t, err)
 	defer nodeSet.Close()
 
@@ -630,9 +631,12 @@ func TestRenameRepository(t *testing.T) {
 			StaticHealthChecker(praefectCfg.StorageNames()),
 			NewLockedRandom(rand.New(rand.NewSource(0))),
 			rs,
-			datastore.NewAssignmentStore(db, praefectCfg.StorageNames()),
+			datastore.NewAssignmentStore(db, praefectCfg.StorageNames(), nil),
 			rs,
 			nil,
+			nil,
+			nil,
+			nil,
 		),
 		WithTxMgr: txManager,
 	})
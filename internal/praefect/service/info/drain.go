@@ -0,0 +1,43 @@
+package info
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"golang.org/x/exp/slices"
+)
+
+// SetStorageDraining drains a physical storage for maintenance or restores a drained physical storage.
+func (s *Server) SetStorageDraining(ctx context.Context, req *gitalypb.SetStorageDrainingRequest) (*gitalypb.SetStorageDrainingResponse, error) {
+	storages, ok := s.conf.StorageNames()[req.GetVirtualStorage()]
+	if !ok {
+		return nil, structerr.NewInvalidArgument("unknown virtual storage: %q", req.GetVirtualStorage())
+	}
+
+	if !slices.Contains(storages, req.GetStorage()) {
+		return nil, structerr.NewInvalidArgument("unknown storage: %q", req.GetStorage())
+	}
+
+	if s.storageDrainer == nil {
+		return nil, structerr.NewFailedPrecondition("draining storages requires the per_repository election strategy")
+	}
+
+	if !req.GetDraining() {
+		if err := s.storageDrainer.UndrainStorage(ctx, req.GetVirtualStorage(), req.GetStorage()); err != nil {
+			return nil, structerr.NewInternal("undrain storage: %w", err)
+		}
+
+		return &gitalypb.SetStorageDrainingResponse{}, nil
+	}
+
+	reelected, remaining, err := s.storageDrainer.DrainStorage(ctx, req.GetVirtualStorage(), req.GetStorage())
+	if err != nil {
+		return nil, structerr.NewInternal("drain storage: %w", err)
+	}
+
+	return &gitalypb.SetStorageDrainingResponse{
+		ReelectedPrimaries: reelected,
+		RemainingPrimaries: remaining,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/storageusage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
@@ -32,6 +33,26 @@ type PrimaryGetter interface {
 	GetPrimary(ctx context.Context, virtualStorage string, repositoryID int64) (string, error)
 }
 
+// StorageDrainer drains physical storages for maintenance.
+type StorageDrainer interface {
+	// DrainStorage marks the storage as draining and elects new primaries for the repositories the storage
+	// is the primary of. It returns the number of re-elected primaries and the number of repositories that
+	// still have the storage as their primary.
+	DrainStorage(ctx context.Context, virtualStorage, storage string) (int64, int64, error)
+	// UndrainStorage restores a drained storage.
+	UndrainStorage(ctx context.Context, virtualStorage, storage string) error
+}
+
+// StorageUsageGetter returns the disk usage of the physical storages.
+//
+// This duplicates the praefect.StorageUsageGetter type as it is not possible to import anything from
+// `praefect` to `info` packages due to cyclic dependencies.
+type StorageUsageGetter interface {
+	// StorageUsage returns the latest known usage of the virtual storage's storages by storage name.
+	// Storages whose usage is not known are omitted.
+	StorageUsage(virtualStorage string) map[string]storageusage.Usage
+}
+
 // Server is a InfoService server
 type Server struct {
 	gitalypb.UnimplementedPraefectInfoServiceServer
@@ -40,6 +61,8 @@ type Server struct {
 	assignmentStore AssignmentStore
 	conns           service.Connections
 	primaryGetter   PrimaryGetter
+	storageDrainer  StorageDrainer
+	storageUsage    StorageUsageGetter
 }
 
 // NewServer creates a new instance of a grpc InfoServiceServer
@@ -49,6 +72,8 @@ func NewServer(
 	assignmentStore AssignmentStore,
 	conns service.Connections,
 	primaryGetter PrimaryGetter,
+	storageDrainer StorageDrainer,
+	storageUsage StorageUsageGetter,
 ) gitalypb.PraefectInfoServiceServer {
 	return &Server{
 		conf:            conf,
@@ -56,6 +81,8 @@ func NewServer(
 		assignmentStore: assignmentStore,
 		conns:           conns,
 		primaryGetter:   primaryGetter,
+		storageDrainer:  storageDrainer,
+		storageUsage:    storageUsage,
 	}
 }
 
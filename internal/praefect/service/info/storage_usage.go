@@ -0,0 +1,52 @@
+package info
+
+import (
+	"context"
+	"sort"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// GetStorageUsage returns the disk usage of the physical storages as last polled by Praefect.
+func (s *Server) GetStorageUsage(ctx context.Context, req *gitalypb.GetStorageUsageRequest) (*gitalypb.GetStorageUsageResponse, error) {
+	virtualStorages := make([]string, 0, len(s.conf.VirtualStorages))
+	for _, virtualStorage := range s.conf.VirtualStorages {
+		if req.GetVirtualStorage() != "" && virtualStorage.Name != req.GetVirtualStorage() {
+			continue
+		}
+
+		virtualStorages = append(virtualStorages, virtualStorage.Name)
+	}
+
+	if len(virtualStorages) == 0 {
+		return nil, structerr.NewInvalidArgument("unknown virtual storage: %q", req.GetVirtualStorage())
+	}
+
+	if s.storageUsage == nil {
+		return nil, structerr.NewFailedPrecondition("storage usage is not tracked")
+	}
+
+	var storages []*gitalypb.GetStorageUsageResponse_StorageUsage
+	for _, virtualStorage := range virtualStorages {
+		usage := s.storageUsage.StorageUsage(virtualStorage)
+
+		names := make([]string, 0, len(usage))
+		for storage := range usage {
+			names = append(names, storage)
+		}
+		sort.Strings(names)
+
+		for _, storage := range names {
+			storages = append(storages, &gitalypb.GetStorageUsageResponse_StorageUsage{
+				VirtualStorage: virtualStorage,
+				Storage:        storage,
+				UsedBytes:      usage[storage].UsedBytes,
+				AvailableBytes: usage[storage].AvailableBytes,
+				Full:           usage[storage].Full,
+			})
+		}
+	}
+
+	return &gitalypb.GetStorageUsageResponse{Storages: storages}, nil
+}
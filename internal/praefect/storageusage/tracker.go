@@ -0,0 +1,165 @@
+package storageusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+)
+
+// pollTimeout is the time a storage has to report its disk statistics.
+const pollTimeout = 10 * time.Second
+
+// Usage is the disk usage of a physical storage.
+type Usage struct {
+	// UsedBytes is the number of bytes used on the storage's file system.
+	UsedBytes int64
+	// AvailableBytes is the number of bytes available on the storage's file system.
+	AvailableBytes int64
+	// Full is set if the storage's fill percentage has reached the fill threshold. New repositories
+	// are not placed on full storages.
+	Full bool
+}
+
+// FillPercentage returns the percentage of the storage's file system that is in use.
+func (u Usage) FillPercentage() float64 {
+	total := u.UsedBytes + u.AvailableBytes
+	if total == 0 {
+		return 0
+	}
+
+	return float64(u.UsedBytes) / float64(total) * 100
+}
+
+// Tracker periodically polls the disk statistics of the physical storages and keeps the latest
+// usage of each storage in memory. The polling frequency is controlled by the Ticker passed in
+// to the Run method.
+type Tracker struct {
+	log   log.Logger
+	conns map[string]map[string]*grpc.ClientConn
+	// fillThreshold is the fill percentage at which a storage is considered full.
+	fillThreshold float64
+
+	m sync.RWMutex
+	// usage contains the latest usage reported by each storage by virtual storage. Storages that
+	// have never reported their usage are absent.
+	usage map[string]map[string]Usage
+}
+
+// NewTracker returns a new Tracker that polls the storages behind the connections. Storages whose
+// fill percentage reaches fillThreshold are reported as full.
+func NewTracker(logger log.Logger, conns map[string]map[string]*grpc.ClientConn, fillThreshold float64) *Tracker {
+	usage := make(map[string]map[string]Usage, len(conns))
+	for virtualStorage := range conns {
+		usage[virtualStorage] = map[string]Usage{}
+	}
+
+	return &Tracker{
+		log:           logger.WithField("component", "storage_usage_tracker"),
+		conns:         conns,
+		fillThreshold: fillThreshold,
+		usage:         usage,
+	}
+}
+
+// Run polls the storages right away and then on every tick until the context is canceled.
+func (t *Tracker) Run(ctx context.Context, ticker helper.Ticker) error {
+	t.log.Info("storage usage tracker started")
+	defer t.log.Info("storage usage tracker stopped")
+
+	defer ticker.Stop()
+
+	t.Poll(ctx)
+
+	for {
+		ticker.Reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+			t.Poll(ctx)
+		}
+	}
+}
+
+// Poll fetches the disk statistics of every storage. A storage that fails to report its usage keeps
+// its previously known usage so a full storage doesn't start receiving repositories when it is
+// temporarily unreachable.
+func (t *Tracker) Poll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, pollTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for virtualStorage, conns := range t.conns {
+		for storage, conn := range conns {
+			wg.Add(1)
+			go func(virtualStorage, storage string, conn *grpc.ClientConn) {
+				defer wg.Done()
+
+				usage, ok, err := t.fetchUsage(ctx, storage, conn)
+				if err != nil {
+					t.log.WithError(err).WithFields(log.Fields{
+						"virtual_storage": virtualStorage,
+						"storage":         storage,
+					}).Warn("failed to fetch disk statistics")
+					return
+				}
+
+				if !ok {
+					return
+				}
+
+				t.m.Lock()
+				defer t.m.Unlock()
+				t.usage[virtualStorage][storage] = usage
+			}(virtualStorage, storage, conn)
+		}
+	}
+
+	wg.Wait()
+}
+
+// fetchUsage returns the usage reported by the storage. It returns false if the storage was unable to
+// determine its usage.
+func (t *Tracker) fetchUsage(ctx context.Context, storage string, conn *grpc.ClientConn) (Usage, bool, error) {
+	resp, err := gitalypb.NewServerServiceClient(conn).DiskStatistics(ctx, &gitalypb.DiskStatisticsRequest{})
+	if err != nil {
+		return Usage{}, false, err
+	}
+
+	for _, status := range resp.GetStorageStatuses() {
+		// Both being zero means the node was unable to determine the statistics.
+		if status.GetStorageName() != storage || (status.GetUsed() == 0 && status.GetAvailable() == 0) {
+			continue
+		}
+
+		usage := Usage{
+			UsedBytes:      status.GetUsed(),
+			AvailableBytes: status.GetAvailable(),
+		}
+		usage.Full = usage.FillPercentage() >= t.fillThreshold
+
+		return usage, true, nil
+	}
+
+	return Usage{}, false, nil
+}
+
+// StorageUsage returns the latest known usage of the virtual storage's storages by storage name.
+// Storages whose usage is not known are omitted.
+func (t *Tracker) StorageUsage(virtualStorage string) map[string]Usage {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	usage := make(map[string]Usage, len(t.usage[virtualStorage]))
+	for storage, storageUsage := range t.usage[virtualStorage] {
+		usage[storage] = storageUsage
+	}
+
+	return usage
+}
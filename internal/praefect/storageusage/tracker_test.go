@@ -0,0 +1,118 @@
+package storageusage
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type mockServerService struct {
+	gitalypb.UnimplementedServerServiceServer
+
+	m    sync.Mutex
+	resp *gitalypb.DiskStatisticsResponse
+	err  error
+}
+
+func (m *mockServerService) DiskStatistics(context.Context, *gitalypb.DiskStatisticsRequest) (*gitalypb.DiskStatisticsResponse, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.resp, m.err
+}
+
+func (m *mockServerService) set(resp *gitalypb.DiskStatisticsResponse, err error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.resp, m.err = resp, err
+}
+
+func startServerService(t *testing.T, srv gitalypb.ServerServiceServer) *grpc.ClientConn {
+	t.Helper()
+
+	grpcSrv := grpc.NewServer()
+	gitalypb.RegisterServerServiceServer(grpcSrv, srv)
+	t.Cleanup(grpcSrv.Stop)
+
+	socketPath := testhelper.GetTemporaryGitalySocketFileName(t)
+	lis, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	go testhelper.MustServe(t, grpcSrv, lis)
+
+	cc, err := grpc.Dial("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { testhelper.MustClose(t, cc) })
+
+	return cc
+}
+
+func TestUsage_FillPercentage(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, float64(0), Usage{}.FillPercentage())
+	require.Equal(t, float64(25), Usage{UsedBytes: 1, AvailableBytes: 3}.FillPercentage())
+	require.Equal(t, float64(100), Usage{UsedBytes: 4}.FillPercentage())
+}
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	storage1 := &mockServerService{resp: &gitalypb.DiskStatisticsResponse{
+		StorageStatuses: []*gitalypb.DiskStatisticsResponse_StorageStatus{
+			{StorageName: "storage-1", Used: 10, Available: 90},
+		},
+	}}
+	storage2 := &mockServerService{resp: &gitalypb.DiskStatisticsResponse{
+		StorageStatuses: []*gitalypb.DiskStatisticsResponse_StorageStatus{
+			{StorageName: "unrelated-storage", Used: 10, Available: 90},
+			{StorageName: "storage-2", Used: 95, Available: 5},
+		},
+	}}
+	// The node was unable to determine the statistics.
+	storage3 := &mockServerService{resp: &gitalypb.DiskStatisticsResponse{
+		StorageStatuses: []*gitalypb.DiskStatisticsResponse_StorageStatus{
+			{StorageName: "storage-3"},
+		},
+	}}
+
+	tracker := NewTracker(testhelper.SharedLogger(t), map[string]map[string]*grpc.ClientConn{
+		"virtual-storage": {
+			"storage-1": startServerService(t, storage1),
+			"storage-2": startServerService(t, storage2),
+			"storage-3": startServerService(t, storage3),
+		},
+	}, 95)
+
+	require.Empty(t, tracker.StorageUsage("virtual-storage"))
+
+	tracker.Poll(ctx)
+
+	expectedUsage := map[string]Usage{
+		"storage-1": {UsedBytes: 10, AvailableBytes: 90},
+		"storage-2": {UsedBytes: 95, AvailableBytes: 5, Full: true},
+	}
+	require.Equal(t, expectedUsage, tracker.StorageUsage("virtual-storage"))
+	require.Empty(t, tracker.StorageUsage("unknown-virtual-storage"))
+
+	// A storage failing to report its usage keeps its previously known usage.
+	storage2.set(nil, errors.New("unavailable"))
+	storage1.set(&gitalypb.DiskStatisticsResponse{
+		StorageStatuses: []*gitalypb.DiskStatisticsResponse_StorageStatus{
+			{StorageName: "storage-1", Used: 50, Available: 50},
+		},
+	}, nil)
+
+	tracker.Poll(ctx)
+
+	expectedUsage["storage-1"] = Usage{UsedBytes: 50, AvailableBytes: 50}
+	require.Equal(t, expectedUsage, tracker.StorageUsage("virtual-storage"))
+}
@@ -16,6 +16,7 @@ type mockRepositoryService struct {
 	gitalypb.UnimplementedRepositoryServiceServer
 	RepositoryExistsFunc    func(context.Context, *gitalypb.RepositoryExistsRequest) (*gitalypb.RepositoryExistsResponse, error)
 	ReplicateRepositoryFunc func(context.Context, *gitalypb.ReplicateRepositoryRequest) (*gitalypb.ReplicateRepositoryResponse, error)
+	CalculateChecksumFunc   func(context.Context, *gitalypb.CalculateChecksumRequest) (*gitalypb.CalculateChecksumResponse, error)
 }
 
 func (m *mockRepositoryService) RepositoryExists(ctx context.Context, r *gitalypb.RepositoryExistsRequest) (*gitalypb.RepositoryExistsResponse, error) {
@@ -25,3 +26,7 @@ func (m *mockRepositoryService) RepositoryExists(ctx context.Context, r *gitalyp
 func (m *mockRepositoryService) ReplicateRepository(ctx context.Context, r *gitalypb.ReplicateRepositoryRequest) (*gitalypb.ReplicateRepositoryResponse, error) {
 	return m.ReplicateRepositoryFunc(ctx, r)
 }
+
+func (m *mockRepositoryService) CalculateChecksum(ctx context.Context, r *gitalypb.CalculateChecksumRequest) (*gitalypb.CalculateChecksumResponse, error) {
+	return m.CalculateChecksumFunc(ctx, r)
+}
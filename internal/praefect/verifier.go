@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
@@ -20,6 +21,11 @@ import (
 // the replica's metadata record is removed and the removal logged. The repository's record
 // is still left in place even if all of the replicas are lost to ensure the data loss doesn't
 // go unnoticed.
+//
+// If checksum verification is enabled, the verifier additionally compares the checksums of the
+// verified repositories' replicas on the latest generation. Replicas whose checksum differs from
+// the checksum the majority of the replicas agree on are marked outdated and repaired from a
+// replica with the majority's checksum. The decisions are recorded in the consistency audit log.
 type MetadataVerifier struct {
 	log                  log.Logger
 	db                   glsql.Querier
@@ -34,10 +40,20 @@ type MetadataVerifier struct {
 	// allows the worker to proceed. The invalid replicas will be found again after the configured
 	// verificationInterval has passed.
 	performDeletions bool
-
-	dequeuedJobsTotal        *prometheus.CounterVec
-	completedJobsTotal       *prometheus.CounterVec
-	staleLeasesReleasedTotal prometheus.Counter
+	// If verifyChecksums is set, the worker additionally compares the checksums of the replicas on
+	// the latest generation of the verified repositories and repairs the replicas that differ from
+	// the majority.
+	verifyChecksums bool
+	// consistencyRecheckDelay is the time to wait before calculating the checksums of divergent replicas again
+	// before they are marked outdated.
+	consistencyRecheckDelay time.Duration
+	audit                   *datastore.ConsistencyAudit
+	queue                   datastore.ReplicationEventQueue
+
+	dequeuedJobsTotal         *prometheus.CounterVec
+	completedJobsTotal        *prometheus.CounterVec
+	staleLeasesReleasedTotal  prometheus.Counter
+	consistencyDecisionsTotal *prometheus.CounterVec
 }
 
 const (
@@ -54,16 +70,21 @@ func NewMetadataVerifier(
 	healthChecker HealthChecker,
 	verificationInterval time.Duration,
 	performDeletions bool,
+	verifyChecksums bool,
 ) *MetadataVerifier {
 	v := &MetadataVerifier{
-		log:                  log,
-		db:                   db,
-		conns:                conns,
-		batchSize:            25,
-		leaseDuration:        30 * time.Second,
-		healthChecker:        healthChecker,
-		verificationInterval: verificationInterval,
-		performDeletions:     performDeletions,
+		log:                     log,
+		db:                      db,
+		conns:                   conns,
+		batchSize:               25,
+		leaseDuration:           30 * time.Second,
+		healthChecker:           healthChecker,
+		verificationInterval:    verificationInterval,
+		performDeletions:        performDeletions,
+		verifyChecksums:         verifyChecksums,
+		consistencyRecheckDelay: 5 * time.Second,
+		audit:                   datastore.NewConsistencyAudit(db),
+		queue:                   datastore.NewPostgresReplicationEventQueue(db),
 		dequeuedJobsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "gitaly_praefect_verification_jobs_dequeued_total",
@@ -84,6 +105,13 @@ func NewMetadataVerifier(
 				Help: "Number of stale verification leases released.",
 			},
 		),
+		consistencyDecisionsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_praefect_consistency_decisions_total",
+				Help: "Number of replicas found inconsistent by the checksum verification and the decision made about them.",
+			},
+			[]string{"virtual_storage", "decision"},
+		),
 	}
 
 	// pre-warm the metrics so all labels are exported prior to their first observation
@@ -248,6 +276,10 @@ func (v *MetadataVerifier) run(ctx context.Context) error {
 		return fmt.Errorf("update metadata: %w", err)
 	}
 
+	if v.verifyChecksums {
+		v.verifyConsistency(ctx, results)
+	}
+
 	for _, r := range results {
 		result := resultError
 		if r.error == nil {
@@ -443,4 +475,5 @@ func (v *MetadataVerifier) Collect(ch chan<- prometheus.Metric) {
 	v.dequeuedJobsTotal.Collect(ch)
 	v.completedJobsTotal.Collect(ch)
 	v.staleLeasesReleasedTotal.Collect(ch)
+	v.consistencyDecisionsTotal.Collect(ch)
 }
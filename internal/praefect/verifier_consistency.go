@@ -0,0 +1,391 @@
+package praefect
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// consistencyCheck contains the replicas on the latest generation of a repository.
+type consistencyCheck struct {
+	repositoryID   int64
+	virtualStorage string
+	relativePath   string
+	replicaPath    string
+	generation     int
+	// primary is the repository's primary storage. It's empty if the repository has no primary.
+	primary  string
+	storages []string
+}
+
+// consistencyVerdict is the outcome of comparing the checksums of a repository's replicas.
+type consistencyVerdict struct {
+	// majorityChecksum is the checksum more than half of the replicas agree on. It's empty if
+	// there is no majority.
+	majorityChecksum string
+	// sourceStorage is a storage with the majority's checksum. It's empty if there is no majority.
+	sourceStorage string
+	// divergentStorages are the storages whose checksum differs from the majority's. If there is
+	// no majority, every storage is divergent.
+	divergentStorages []string
+}
+
+// decideConsistency compares the checksums of the replicas keyed by their storage. It returns false if all
+// of the checksums are equal.
+func decideConsistency(checksums map[string]string) (consistencyVerdict, bool) {
+	storages := make([]string, 0, len(checksums))
+	counts := map[string]int{}
+	for storage, checksum := range checksums {
+		storages = append(storages, storage)
+		counts[checksum]++
+	}
+
+	if len(counts) <= 1 {
+		return consistencyVerdict{}, false
+	}
+
+	sort.Strings(storages)
+
+	var verdict consistencyVerdict
+	for checksum, count := range counts {
+		if 2*count > len(checksums) {
+			verdict.majorityChecksum = checksum
+		}
+	}
+
+	for _, storage := range storages {
+		if verdict.majorityChecksum != "" && checksums[storage] == verdict.majorityChecksum {
+			if verdict.sourceStorage == "" {
+				verdict.sourceStorage = storage
+			}
+
+			continue
+		}
+
+		verdict.divergentStorages = append(verdict.divergentStorages, storage)
+	}
+
+	return verdict, true
+}
+
+// verifyConsistency compares the checksums of the replicas of the repositories whose replicas were found
+// to exist. Failures are logged as they only affect the single repository.
+func (v *MetadataVerifier) verifyConsistency(ctx context.Context, results []verificationResult) {
+	verified := map[int64]bool{}
+	for _, result := range results {
+		if result.error != nil || !result.exists || verified[result.job.repositoryID] {
+			continue
+		}
+
+		verified[result.job.repositoryID] = true
+		if err := v.verifyRepositoryConsistency(ctx, result.job.repositoryID); err != nil {
+			v.log.WithFields(log.Fields{
+				"repository_id":   result.job.repositoryID,
+				"virtual_storage": result.job.virtualStorage,
+				"relative_path":   result.job.relativePath,
+				"error":           err,
+			}).Error("failed to verify replica consistency")
+		}
+	}
+}
+
+func (v *MetadataVerifier) verifyRepositoryConsistency(ctx context.Context, repositoryID int64) error {
+	check, err := v.getConsistencyCheck(ctx, repositoryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The repository has been deleted or has no replicas on its latest generation.
+			return nil
+		}
+
+		return fmt.Errorf("get replicas: %w", err)
+	}
+
+	checksums := v.calculateChecksums(ctx, check)
+	if len(checksums) < 2 {
+		return nil
+	}
+
+	verdict, inconsistent := decideConsistency(checksums)
+	if !inconsistent {
+		return nil
+	}
+
+	decisions := map[string]datastore.ConsistencyDecision{}
+	if verdict.majorityChecksum == "" {
+		for _, storage := range verdict.divergentStorages {
+			decisions[storage] = datastore.ConsistencyDecisionNoMajority
+		}
+	} else {
+		// The checksums are calculated without blocking writes. A write in progress may have been applied
+		// on some of the replicas only, so the checksums are calculated again before any replica is marked.
+		checksums, verdict.divergentStorages, err = v.recheckConsistency(ctx, check, verdict)
+		if err != nil {
+			return fmt.Errorf("recheck: %w", err)
+		}
+
+		var outdated []string
+		for _, storage := range verdict.divergentStorages {
+			if storage == check.primary {
+				// Marking the primary outdated would fail a write in progress on it and cause a
+				// failover. The primary is only marked if it still differs on the next verification.
+				deferred, err := v.audit.IsDeferred(ctx, check.repositoryID, storage, check.generation, checksums[storage])
+				if err != nil {
+					return fmt.Errorf("check deferred decision: %w", err)
+				}
+
+				if !deferred {
+					decisions[storage] = datastore.ConsistencyDecisionPrimaryDeferred
+					continue
+				}
+			}
+
+			outdated = append(outdated, storage)
+		}
+
+		// The divergent replicas are only marked outdated if the repository hasn't been written to since
+		// the checksums were calculated. Otherwise the checksums may differ only due to the write.
+		marked, err := v.markOutdated(ctx, check, outdated)
+		if err != nil {
+			return fmt.Errorf("mark outdated: %w", err)
+		}
+
+		for _, storage := range marked {
+			decisions[storage] = datastore.ConsistencyDecisionRepair
+
+			if _, err := v.queue.Enqueue(ctx, datastore.ReplicationEvent{
+				Job: datastore.ReplicationJob{
+					RepositoryID:      check.repositoryID,
+					Change:            datastore.UpdateRepo,
+					VirtualStorage:    check.virtualStorage,
+					RelativePath:      check.relativePath,
+					ReplicaPath:       check.replicaPath,
+					SourceNodeStorage: verdict.sourceStorage,
+					TargetNodeStorage: storage,
+				},
+				Meta: datastore.Params{datastore.OriginKey: string(datastore.OriginRepair)},
+			}); err != nil {
+				// The reconciler schedules the repair as the replica has been marked outdated.
+				return fmt.Errorf("enqueue repair: %w", err)
+			}
+		}
+	}
+
+	if len(decisions) == 0 {
+		return nil
+	}
+
+	decidedStorages := make([]string, 0, len(decisions))
+	for storage := range decisions {
+		decidedStorages = append(decidedStorages, storage)
+	}
+	sort.Strings(decidedStorages)
+
+	entries := make([]datastore.ConsistencyAuditEntry, 0, len(decidedStorages))
+	for _, storage := range decidedStorages {
+		entry := datastore.ConsistencyAuditEntry{
+			RepositoryID:     check.repositoryID,
+			VirtualStorage:   check.virtualStorage,
+			RelativePath:     check.relativePath,
+			Storage:          storage,
+			Generation:       check.generation,
+			Checksum:         checksums[storage],
+			MajorityChecksum: verdict.majorityChecksum,
+			Decision:         decisions[storage],
+		}
+
+		if entry.Decision == datastore.ConsistencyDecisionRepair {
+			entry.SourceStorage = verdict.sourceStorage
+		}
+
+		entries = append(entries, entry)
+		v.consistencyDecisionsTotal.WithLabelValues(check.virtualStorage, string(entry.Decision)).Inc()
+	}
+
+	if err := v.audit.Record(ctx, entries); err != nil {
+		return fmt.Errorf("record decisions: %w", err)
+	}
+
+	v.log.WithFields(log.Fields{
+		"repository_id":   check.repositoryID,
+		"virtual_storage": check.virtualStorage,
+		"relative_path":   check.relativePath,
+		"generation":      check.generation,
+		"checksums":       checksums,
+		"decisions":       decisions,
+		"source_storage":  verdict.sourceStorage,
+	}).Warn("replicas on the same generation have different checksums")
+
+	return nil
+}
+
+// recheckConsistency calculates the checksums of the replicas again after the recheck delay. It returns the
+// storages that still differ from the same majority checksum along with the checksums of the second calculation.
+// No storage is returned if the repository has been written to in the meanwhile.
+func (v *MetadataVerifier) recheckConsistency(ctx context.Context, check consistencyCheck, verdict consistencyVerdict) (map[string]string, []string, error) {
+	timer := time.NewTimer(v.consistencyRecheckDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case <-timer.C:
+	}
+
+	recheck, err := v.getConsistencyCheck(ctx, check.repositoryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, nil
+		}
+
+		return nil, nil, fmt.Errorf("get replicas: %w", err)
+	}
+
+	if recheck.generation != check.generation {
+		return nil, nil, nil
+	}
+
+	checksums := v.calculateChecksums(ctx, check)
+
+	var divergent []string
+	for _, storage := range verdict.divergentStorages {
+		checksum, ok := checksums[storage]
+		if !ok || checksum == verdict.majorityChecksum {
+			continue
+		}
+
+		divergent = append(divergent, storage)
+	}
+
+	// The source must still have the majority's checksum for the repair to be correct.
+	if checksums[verdict.sourceStorage] != verdict.majorityChecksum {
+		return checksums, nil, nil
+	}
+
+	return checksums, divergent, nil
+}
+
+// getConsistencyCheck returns the healthy replicas on the latest generation of the repository.
+func (v *MetadataVerifier) getConsistencyCheck(ctx context.Context, repositoryID int64) (consistencyCheck, error) {
+	check := consistencyCheck{repositoryID: repositoryID}
+
+	var primary sql.NullString
+	var storages glsql.StringArray
+	if err := v.db.QueryRowContext(ctx, `
+SELECT virtual_storage, relative_path, replica_path, repositories.generation, "primary", array_agg(storage ORDER BY storage)
+FROM repositories
+JOIN storage_repositories USING (repository_id)
+WHERE repository_id = $1
+AND storage_repositories.generation = repositories.generation
+GROUP BY repository_id
+	`, repositoryID).Scan(&check.virtualStorage, &check.relativePath, &check.replicaPath, &check.generation, &primary, &storages); err != nil {
+		return consistencyCheck{}, err
+	}
+	check.primary = primary.String
+
+	healthy := map[string]bool{}
+	for _, storage := range v.healthChecker.HealthyNodes()[check.virtualStorage] {
+		healthy[storage] = true
+	}
+
+	for _, storage := range storages.Slice() {
+		if healthy[storage] {
+			check.storages = append(check.storages, storage)
+		}
+	}
+
+	return check, nil
+}
+
+// calculateChecksums calculates the checksums of the replicas in parallel. Replicas whose checksum can't be
+// calculated are omitted from the result.
+func (v *MetadataVerifier) calculateChecksums(ctx context.Context, check consistencyCheck) map[string]string {
+	var mutex sync.Mutex
+	checksums := make(map[string]string, len(check.storages))
+
+	var wg sync.WaitGroup
+	for _, storage := range check.storages {
+		storage := storage
+
+		conn, ok := v.conns[check.virtualStorage][storage]
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := gitalypb.NewRepositoryServiceClient(conn).CalculateChecksum(ctx, &gitalypb.CalculateChecksumRequest{
+				Repository: &gitalypb.Repository{
+					StorageName:  storage,
+					RelativePath: check.replicaPath,
+				},
+			})
+			if err != nil {
+				v.log.WithFields(log.Fields{
+					"repository_id":   check.repositoryID,
+					"virtual_storage": check.virtualStorage,
+					"storage":         storage,
+					"error":           err,
+				}).Error("failed to calculate replica checksum")
+				return
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			checksums[storage] = resp.GetChecksum()
+		}()
+	}
+
+	wg.Wait()
+
+	return checksums
+}
+
+// markOutdated marks the replicas on the given storages as outdated and unverified if the repository is still on
+// the checked generation. As the replicas' content is not known, their generation is set to unknown. It returns
+// the storages of the replicas that were marked.
+func (v *MetadataVerifier) markOutdated(ctx context.Context, check consistencyCheck, storages []string) ([]string, error) {
+	rows, err := v.db.QueryContext(ctx, `
+UPDATE storage_repositories
+SET generation = $4, verified_at = NULL
+FROM repositories
+WHERE repositories.repository_id = $1
+AND repositories.generation = $2
+AND storage_repositories.repository_id = repositories.repository_id
+AND storage_repositories.generation = $2
+AND storage_repositories.storage = ANY($3::text[])
+RETURNING storage_repositories.storage
+	`, check.repositoryID, check.generation, storages, datastore.GenerationUnknown)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var marked []string
+	for rows.Next() {
+		var storage string
+		if err := rows.Scan(&storage); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		marked = append(marked, storage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	sort.Strings(marked)
+
+	return marked, nil
+}
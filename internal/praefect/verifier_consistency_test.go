@@ -0,0 +1,379 @@
+package praefect
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/client"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+)
+
+func TestDecideConsistency(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc            string
+		checksums       map[string]string
+		expectedVerdict consistencyVerdict
+		inconsistent    bool
+	}{
+		{
+			desc:      "consistent",
+			checksums: map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "a"},
+		},
+		{
+			desc:      "majority",
+			checksums: map[string]string{"storage-1": "b", "storage-2": "a", "storage-3": "a"},
+			expectedVerdict: consistencyVerdict{
+				majorityChecksum:  "a",
+				sourceStorage:     "storage-2",
+				divergentStorages: []string{"storage-1"},
+			},
+			inconsistent: true,
+		},
+		{
+			desc:      "tie",
+			checksums: map[string]string{"storage-1": "a", "storage-2": "b"},
+			expectedVerdict: consistencyVerdict{
+				divergentStorages: []string{"storage-1", "storage-2"},
+			},
+			inconsistent: true,
+		},
+		{
+			desc:      "all different",
+			checksums: map[string]string{"storage-1": "a", "storage-2": "b", "storage-3": "c"},
+			expectedVerdict: consistencyVerdict{
+				divergentStorages: []string{"storage-1", "storage-2", "storage-3"},
+			},
+			inconsistent: true,
+		},
+		{
+			desc:      "plurality is not a majority",
+			checksums: map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "b", "storage-4": "c"},
+			expectedVerdict: consistencyVerdict{
+				divergentStorages: []string{"storage-1", "storage-2", "storage-3", "storage-4"},
+			},
+			inconsistent: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			verdict, inconsistent := decideConsistency(tc.checksums)
+			require.Equal(t, tc.inconsistent, inconsistent)
+			require.Equal(t, tc.expectedVerdict, verdict)
+		})
+	}
+}
+
+func TestMetadataVerifier_verifyRepositoryConsistency(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	const virtualStorage = "virtual-storage"
+	storages := []string{"storage-1", "storage-2", "storage-3"}
+
+	// checksums are the checksums the mock Gitalys return for the replicas. They are set by each test case
+	// before the verification. If recheckChecksums is set, its checksums are returned once a replica's
+	// checksum has been calculated already.
+	var checksumsMutex sync.Mutex
+	checksums := map[string]string{}
+	recheckChecksums := map[string]string{}
+	calculated := map[string]bool{}
+	// onFirstCalculation is called when the first checksum of a test case is calculated if it's set.
+	var onFirstCalculation func()
+
+	conns := Connections{virtualStorage: {}}
+	for _, storage := range storages {
+		storage := storage
+
+		addr, cleanup := newMockDownstream(t, "", func(srv *grpc.Server) {
+			gitalypb.RegisterRepositoryServiceServer(srv, &mockRepositoryService{
+				CalculateChecksumFunc: func(context.Context, *gitalypb.CalculateChecksumRequest) (*gitalypb.CalculateChecksumResponse, error) {
+					checksumsMutex.Lock()
+					defer checksumsMutex.Unlock()
+
+					checksum := checksums[storage]
+					if recheckChecksum, ok := recheckChecksums[storage]; ok && calculated[storage] {
+						checksum = recheckChecksum
+					}
+					if len(calculated) == 0 && onFirstCalculation != nil {
+						onFirstCalculation()
+					}
+					calculated[storage] = true
+
+					return &gitalypb.CalculateChecksumResponse{Checksum: checksum}, nil
+				},
+			})
+		})
+		t.Cleanup(cleanup)
+
+		conn, err := client.Dial(ctx, addr)
+		require.NoError(t, err)
+		t.Cleanup(func() { testhelper.MustClose(t, conn) })
+
+		conns[virtualStorage][storage] = conn
+	}
+
+	type generations map[string]int
+
+	for _, tc := range []struct {
+		desc                string
+		checksums           map[string]string
+		recheckChecksums    map[string]string
+		verifications       int
+		writeOnRecheck      bool
+		healthyStorages     []string
+		expectedGenerations generations
+		expectedJobs        []datastore.ReplicationJob
+		expectedAudit       []datastore.ConsistencyAuditEntry
+	}{
+		{
+			desc:                "consistent replicas",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "a"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": 1},
+		},
+		{
+			desc:                "divergent replica is repaired",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "b"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": datastore.GenerationUnknown},
+			expectedJobs: []datastore.ReplicationJob{
+				{
+					RepositoryID:      1,
+					Change:            datastore.UpdateRepo,
+					VirtualStorage:    virtualStorage,
+					RelativePath:      "relative-path",
+					ReplicaPath:       "replica-path",
+					SourceNodeStorage: "storage-1",
+					TargetNodeStorage: "storage-3",
+				},
+			},
+			expectedAudit: []datastore.ConsistencyAuditEntry{
+				{
+					RepositoryID:     1,
+					VirtualStorage:   virtualStorage,
+					RelativePath:     "relative-path",
+					Storage:          "storage-3",
+					Generation:       1,
+					Checksum:         "b",
+					MajorityChecksum: "a",
+					SourceStorage:    "storage-1",
+					Decision:         datastore.ConsistencyDecisionRepair,
+				},
+			},
+		},
+		{
+			desc:                "divergent primary is deferred",
+			checksums:           map[string]string{"storage-1": "b", "storage-2": "a", "storage-3": "a"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": 1},
+			expectedAudit: []datastore.ConsistencyAuditEntry{
+				{
+					RepositoryID:     1,
+					VirtualStorage:   virtualStorage,
+					RelativePath:     "relative-path",
+					Storage:          "storage-1",
+					Generation:       1,
+					Checksum:         "b",
+					MajorityChecksum: "a",
+					Decision:         datastore.ConsistencyDecisionPrimaryDeferred,
+				},
+			},
+		},
+		{
+			desc:                "divergent primary is repaired on the next verification",
+			checksums:           map[string]string{"storage-1": "b", "storage-2": "a", "storage-3": "a"},
+			verifications:       2,
+			expectedGenerations: generations{"storage-1": datastore.GenerationUnknown, "storage-2": 1, "storage-3": 1},
+			expectedJobs: []datastore.ReplicationJob{
+				{
+					RepositoryID:      1,
+					Change:            datastore.UpdateRepo,
+					VirtualStorage:    virtualStorage,
+					RelativePath:      "relative-path",
+					ReplicaPath:       "replica-path",
+					SourceNodeStorage: "storage-2",
+					TargetNodeStorage: "storage-1",
+				},
+			},
+			expectedAudit: []datastore.ConsistencyAuditEntry{
+				{
+					RepositoryID:     1,
+					VirtualStorage:   virtualStorage,
+					RelativePath:     "relative-path",
+					Storage:          "storage-1",
+					Generation:       1,
+					Checksum:         "b",
+					MajorityChecksum: "a",
+					SourceStorage:    "storage-2",
+					Decision:         datastore.ConsistencyDecisionRepair,
+				},
+				{
+					RepositoryID:     1,
+					VirtualStorage:   virtualStorage,
+					RelativePath:     "relative-path",
+					Storage:          "storage-1",
+					Generation:       1,
+					Checksum:         "b",
+					MajorityChecksum: "a",
+					Decision:         datastore.ConsistencyDecisionPrimaryDeferred,
+				},
+			},
+		},
+		{
+			desc:                "replica converging on recheck is left in place",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "b"},
+			recheckChecksums:    map[string]string{"storage-3": "a"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": 1},
+		},
+		{
+			desc:                "replicas are left in place if written to before the recheck",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "b"},
+			writeOnRecheck:      true,
+			expectedGenerations: generations{"storage-1": 2, "storage-2": 2, "storage-3": 2},
+		},
+		{
+			desc:                "replicas without majority are left in place",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "b", "storage-3": "c"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": 1},
+			expectedAudit: []datastore.ConsistencyAuditEntry{
+				{
+					RepositoryID:   1,
+					VirtualStorage: virtualStorage,
+					RelativePath:   "relative-path",
+					Storage:        "storage-3",
+					Generation:     1,
+					Checksum:       "c",
+					Decision:       datastore.ConsistencyDecisionNoMajority,
+				},
+				{
+					RepositoryID:   1,
+					VirtualStorage: virtualStorage,
+					RelativePath:   "relative-path",
+					Storage:        "storage-2",
+					Generation:     1,
+					Checksum:       "b",
+					Decision:       datastore.ConsistencyDecisionNoMajority,
+				},
+				{
+					RepositoryID:   1,
+					VirtualStorage: virtualStorage,
+					RelativePath:   "relative-path",
+					Storage:        "storage-1",
+					Generation:     1,
+					Checksum:       "a",
+					Decision:       datastore.ConsistencyDecisionNoMajority,
+				},
+			},
+		},
+		{
+			desc:                "unhealthy replicas are not compared",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "a", "storage-3": "b"},
+			healthyStorages:     []string{"storage-1", "storage-2"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": 1},
+		},
+		{
+			desc:                "a single healthy replica is not compared",
+			checksums:           map[string]string{"storage-1": "a", "storage-2": "b", "storage-3": "b"},
+			healthyStorages:     []string{"storage-1"},
+			expectedGenerations: generations{"storage-1": 1, "storage-2": 1, "storage-3": 1},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			db.TruncateAll(t)
+
+			checksumsMutex.Lock()
+			checksums = tc.checksums
+			recheckChecksums = tc.recheckChecksums
+			calculated = map[string]bool{}
+			onFirstCalculation = nil
+			checksumsMutex.Unlock()
+
+			rs := datastore.NewPostgresRepositoryStore(db, map[string][]string{virtualStorage: storages})
+			require.NoError(t, rs.CreateRepository(ctx, 1, virtualStorage, "relative-path", "replica-path", "storage-1", []string{"storage-2", "storage-3"}, nil, true, false))
+			require.NoError(t, rs.IncrementGeneration(ctx, 1, "storage-1", []string{"storage-2", "storage-3"}))
+
+			healthyStorages := storages
+			if tc.healthyStorages != nil {
+				healthyStorages = tc.healthyStorages
+			}
+
+			verifier := NewMetadataVerifier(
+				testhelper.SharedLogger(t),
+				db,
+				conns,
+				StaticHealthChecker{virtualStorage: healthyStorages},
+				0,
+				true,
+				true,
+			)
+			verifier.consistencyRecheckDelay = 0
+
+			if tc.writeOnRecheck {
+				// The recheck observes the repository on a newer generation as a write has been
+				// finished while the checksums were calculated.
+				checksumsMutex.Lock()
+				onFirstCalculation = func() {
+					// The function is called from the mock's goroutine.
+					assert.NoError(t, rs.IncrementGeneration(ctx, 1, "storage-1", []string{"storage-2", "storage-3"}))
+				}
+				checksumsMutex.Unlock()
+			}
+
+			verifications := tc.verifications
+			if verifications == 0 {
+				verifications = 1
+			}
+
+			for i := 0; i < verifications; i++ {
+				require.NoError(t, verifier.verifyRepositoryConsistency(ctx, 1))
+			}
+
+			rows, err := db.QueryContext(ctx, `SELECT storage, generation FROM storage_repositories`)
+			require.NoError(t, err)
+			defer rows.Close()
+
+			actualGenerations := generations{}
+			for rows.Next() {
+				var storage string
+				var generation int
+				require.NoError(t, rows.Scan(&storage, &generation))
+				actualGenerations[storage] = generation
+			}
+			require.NoError(t, rows.Err())
+			require.Equal(t, tc.expectedGenerations, actualGenerations)
+
+			jobRows, err := db.QueryContext(ctx, `SELECT job FROM replication_queue ORDER BY id`)
+			require.NoError(t, err)
+			defer jobRows.Close()
+
+			var actualJobs []datastore.ReplicationJob
+			for jobRows.Next() {
+				var job datastore.ReplicationJob
+				require.NoError(t, jobRows.Scan(&job))
+				actualJobs = append(actualJobs, job)
+			}
+			require.NoError(t, jobRows.Err())
+			require.Equal(t, tc.expectedJobs, actualJobs)
+
+			actualAudit, err := datastore.NewConsistencyAudit(db).List(ctx, virtualStorage, "relative-path", 10)
+			require.NoError(t, err)
+			for i := range actualAudit {
+				require.False(t, actualAudit[i].CreatedAt.IsZero())
+				actualAudit[i].CreatedAt = time.Time{}
+			}
+			require.Equal(t, tc.expectedAudit, actualAudit)
+		})
+	}
+}
@@ -486,6 +486,7 @@ func TestVerifier(t *testing.T) {
 							deps.GetBackupSink(),
 							deps.GetBackupLocator(),
 							deps.GetRepositoryCounter(),
+							deps.GetPartitionManager(),
 						)})
 					}
 				}
@@ -507,6 +508,7 @@ func TestVerifier(t *testing.T) {
 				conf.VirtualStorages,
 				protoregistry.GitalyProtoPreregistered,
 				nil,
+				nil,
 				backchannel.NewClientHandshaker(
 					logger,
 					NewBackchannelServerFactory(
@@ -538,9 +540,12 @@ func TestVerifier(t *testing.T) {
 					StaticHealthChecker(conf.StorageNames()),
 					NewLockedRandom(rand.New(rand.NewSource(0))),
 					rs,
-					datastore.NewAssignmentStore(db, conf.StorageNames()),
+					datastore.NewAssignmentStore(db, conf.StorageNames(), nil),
 					rs,
 					conf.DefaultReplicationFactors(),
+					nil,
+					nil,
+					nil,
 				),
 				WithRepoStore: rs,
 				WithTxMgr:     txManager,
@@ -644,7 +649,7 @@ func TestVerifier(t *testing.T) {
 				healthyStorages = tc.healthyStorages
 			}
 
-			verifier := NewMetadataVerifier(logger, db, conns, healthyStorages, 24*7*time.Hour, !tc.dontPerformDeletions)
+			verifier := NewMetadataVerifier(logger, db, conns, healthyStorages, 24*7*time.Hour, !tc.dontPerformDeletions, false)
 			if tc.batchSize > 0 {
 				verifier.batchSize = tc.batchSize
 			}
@@ -809,7 +814,7 @@ func TestVerifier_runExpiredLeaseReleaser(t *testing.T) {
 	logger := testhelper.NewLogger(t)
 	hook := testhelper.AddLoggerHook(logger)
 
-	verifier := NewMetadataVerifier(logger, tx, nil, nil, 0, true)
+	verifier := NewMetadataVerifier(logger, tx, nil, nil, 0, true, false)
 	// set batch size lower than the number of locked leases to ensure the batching works
 	verifier.batchSize = 2
 
@@ -0,0 +1,65 @@
+package praefect
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/metadata"
+)
+
+// clientZoneHeader is the metadata header clients use to tell Praefect which zone they are located in. Reads are
+// routed to replicas in the same zone if there are any suitable ones.
+const clientZoneHeader = "gitaly-client-zone"
+
+// storageZone returns the zone of the storage. Storages without a configured zone are all considered to be in the
+// same unnamed zone.
+func (r *PerRepositoryRouter) storageZone(virtualStorage, storage string) string {
+	return r.storageZones[virtualStorage][storage]
+}
+
+// preferClientZone returns the nodes that are located in the zone of the client. If the client didn't send its
+// zone or if there are no nodes in its zone, all nodes are returned.
+func (r *PerRepositoryRouter) preferClientZone(ctx context.Context, virtualStorage string, nodes []RouterNode) []RouterNode {
+	zone := metadata.GetValue(ctx, clientZoneHeader)
+	if zone == "" {
+		return nodes
+	}
+
+	var zoneNodes []RouterNode
+	for _, node := range nodes {
+		if r.storageZone(virtualStorage, node.Storage) == zone {
+			zoneNodes = append(zoneNodes, node)
+		}
+	}
+
+	if len(zoneNodes) == 0 {
+		return nodes
+	}
+
+	return zoneNodes
+}
+
+// spreadAcrossZones picks count secondaries from the candidates so that the replicas of a repository are spread as
+// evenly as possible across the zones. The primary's zone is considered to already hold a replica. Candidates are
+// considered in order, so they should be shuffled beforehand for the secondaries to be picked at random within a
+// zone. If there are fewer candidates than requested, all candidates are returned.
+func (r *PerRepositoryRouter) spreadAcrossZones(virtualStorage, primary string, candidates []RouterNode, count int) []RouterNode {
+	replicasByZone := map[string]int{r.storageZone(virtualStorage, primary): 1}
+
+	remaining := append([]RouterNode(nil), candidates...)
+	picked := make([]RouterNode, 0, count)
+	for len(picked) < count && len(remaining) > 0 {
+		best := 0
+		for i := 1; i < len(remaining); i++ {
+			if replicasByZone[r.storageZone(virtualStorage, remaining[i].Storage)] <
+				replicasByZone[r.storageZone(virtualStorage, remaining[best].Storage)] {
+				best = i
+			}
+		}
+
+		picked = append(picked, remaining[best])
+		replicasByZone[r.storageZone(virtualStorage, remaining[best].Storage)]++
+		remaining = append(remaining[:best], remaining[best+1:]...)
+	}
+
+	return picked
+}
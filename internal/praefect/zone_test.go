@@ -0,0 +1,216 @@
+package praefect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPerRepositoryRouter_spreadAcrossZones(t *testing.T) {
+	t.Parallel()
+
+	router := &PerRepositoryRouter{
+		storageZones: map[string]map[string]string{
+			"virtual-storage": {
+				"primary":     "zone-a",
+				"storage-a-1": "zone-a",
+				"storage-a-2": "zone-a",
+				"storage-b-1": "zone-b",
+				"storage-b-2": "zone-b",
+				"storage-c-1": "zone-c",
+			},
+		},
+	}
+
+	nodes := func(storages ...string) []RouterNode {
+		var nodes []RouterNode
+		for _, storage := range storages {
+			nodes = append(nodes, RouterNode{Storage: storage})
+		}
+		return nodes
+	}
+
+	for _, tc := range []struct {
+		desc       string
+		primary    string
+		candidates []RouterNode
+		count      int
+		expected   []RouterNode
+	}{
+		{
+			desc:       "zones without replicas are preferred",
+			primary:    "primary",
+			candidates: nodes("storage-a-1", "storage-b-1", "storage-c-1"),
+			count:      2,
+			expected:   nodes("storage-b-1", "storage-c-1"),
+		},
+		{
+			desc:       "replicas are spread evenly across zones",
+			primary:    "primary",
+			candidates: nodes("storage-a-1", "storage-a-2", "storage-b-1", "storage-b-2", "storage-c-1"),
+			count:      4,
+			expected:   nodes("storage-b-1", "storage-c-1", "storage-a-1", "storage-b-2"),
+		},
+		{
+			desc:       "candidate order is kept within a zone",
+			primary:    "storage-c-1",
+			candidates: nodes("storage-b-2", "storage-a-2", "storage-b-1", "storage-a-1"),
+			count:      2,
+			expected:   nodes("storage-b-2", "storage-a-2"),
+		},
+		{
+			desc:       "all candidates are returned if there are too few",
+			primary:    "primary",
+			candidates: nodes("storage-a-1", "storage-b-1"),
+			count:      3,
+			expected:   nodes("storage-b-1", "storage-a-1"),
+		},
+		{
+			desc:       "storages without zones are picked in order",
+			primary:    "unzoned-1",
+			candidates: nodes("unzoned-2", "unzoned-3", "unzoned-4"),
+			count:      2,
+			expected:   nodes("unzoned-2", "unzoned-3"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, router.spreadAcrossZones("virtual-storage", tc.primary, tc.candidates, tc.count))
+		})
+	}
+}
+
+func TestPerRepositoryRouter_assignRepositoryToNodes_zones(t *testing.T) {
+	t.Parallel()
+
+	conns := Connections{
+		"virtual-storage": {
+			"primary":     &grpc.ClientConn{},
+			"storage-a-1": &grpc.ClientConn{},
+			"storage-b-1": &grpc.ClientConn{},
+			"storage-c-1": &grpc.ClientConn{},
+		},
+	}
+
+	router := NewPerRepositoryRouter(
+		conns,
+		nil,
+		StaticHealthChecker{"virtual-storage": {"primary", "storage-a-1", "storage-b-1", "storage-c-1"}},
+		mockRandom{
+			intnFunc:    func(int) int { return 0 },
+			shuffleFunc: func(int, func(int, int)) {},
+		},
+		nil,
+		nil,
+		nil,
+		map[string]int{"virtual-storage": 3},
+		nil,
+		map[string]map[string]string{
+			"virtual-storage": {
+				"primary":     "zone-a",
+				"storage-a-1": "zone-a",
+				"storage-b-1": "zone-b",
+				"storage-c-1": "zone-c",
+			},
+		},
+		nil,
+	)
+
+	assigned, err := router.assignRepositoryToNodes("virtual-storage", nil)
+	require.NoError(t, err)
+	require.Equal(t, RouterNode{Storage: "primary", Connection: conns["virtual-storage"]["primary"]}, assigned.primary)
+	require.ElementsMatch(t, []RouterNode{
+		{Storage: "storage-b-1", Connection: conns["virtual-storage"]["storage-b-1"]},
+		{Storage: "storage-c-1", Connection: conns["virtual-storage"]["storage-c-1"]},
+	}, assigned.secondaries)
+	require.Empty(t, assigned.replicationTargets)
+}
+
+func TestPerRepositoryRouter_RouteRepositoryAccessor_clientZone(t *testing.T) {
+	t.Parallel()
+
+	conns := Connections{
+		"virtual-storage": {
+			"storage-a": &grpc.ClientConn{},
+			"storage-b": &grpc.ClientConn{},
+			"storage-c": &grpc.ClientConn{},
+		},
+	}
+
+	router := NewPerRepositoryRouter(
+		conns,
+		nil,
+		StaticHealthChecker{"virtual-storage": {"storage-a", "storage-b", "storage-c"}},
+		mockRandom{intnFunc: func(int) int { return 0 }},
+		datastore.MockRepositoryStore{
+			GetConsistentStoragesFunc: func(context.Context, string, string) (string, *datastructure.Set[string], error) {
+				return "replica-path", datastructure.SetFromValues("storage-a", "storage-b"), nil
+			},
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+		map[string]map[string]string{
+			"virtual-storage": {
+				"storage-a": "zone-a",
+				"storage-b": "zone-b",
+				"storage-c": "zone-c",
+			},
+		},
+		nil,
+	)
+
+	for _, tc := range []struct {
+		desc            string
+		zone            string
+		expectedStorage string
+	}{
+		{
+			desc:            "no client zone",
+			expectedStorage: "storage-a",
+		},
+		{
+			desc:            "replica in client zone",
+			zone:            "zone-b",
+			expectedStorage: "storage-b",
+		},
+		{
+			desc:            "outdated replica in client zone",
+			zone:            "zone-c",
+			expectedStorage: "storage-a",
+		},
+		{
+			desc:            "unknown client zone",
+			zone:            "zone-d",
+			expectedStorage: "storage-a",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+			if tc.zone != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientZoneHeader, tc.zone))
+			}
+
+			route, err := router.RouteRepositoryAccessor(ctx, "virtual-storage", "relative-path", false)
+			require.NoError(t, err)
+			require.Equal(t, RepositoryAccessorRoute{
+				ReplicaPath: "replica-path",
+				Node:        RouterNode{Storage: tc.expectedStorage, Connection: conns["virtual-storage"][tc.expectedStorage]},
+			}, route)
+		})
+	}
+}
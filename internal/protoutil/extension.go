@@ -31,6 +31,12 @@ func IsInterceptedMethod(s *descriptorpb.ServiceDescriptorProto, m *descriptorpb
 	return isServiceIntercepted, nil
 }
 
+// IsImmutableResultMethod returns whether the result of the RPC method is fully determined by its request in
+// case all of its revisions are full object IDs.
+func IsImmutableResultMethod(m *descriptorpb.MethodDescriptorProto) (bool, error) {
+	return getBoolExtension(m.GetOptions(), gitalypb.E_ImmutableResult)
+}
+
 // GetRepositoryExtension gets the repository extension from a field descriptor
 func GetRepositoryExtension(m *descriptorpb.FieldDescriptorProto) (bool, error) {
 	return getBoolExtension(m.GetOptions(), gitalypb.E_Repository)
@@ -0,0 +1,260 @@
+package signature
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// externalSignerCommandPrefix is the prefix of signing keys that delegate to an external signing program.
+	externalSignerCommandPrefix = "exec:"
+	// externalSignerSocketPrefix is the prefix of signing keys that delegate to a signing agent listening on
+	// a Unix socket.
+	externalSignerSocketPrefix = "unix:"
+	// externalSignerTimeout is the time an external signer has to answer a request.
+	externalSignerTimeout = 30 * time.Second
+)
+
+// The following environment variables pass the repository a commit is signed in along to gitaly-gpg so that
+// it can be forwarded to external signers.
+const (
+	// EnvSigningStorageName is the storage of the repository.
+	EnvSigningStorageName = "GITALY_SIGNING_STORAGE_NAME"
+	// EnvSigningRelativePath is the relative path of the repository.
+	EnvSigningRelativePath = "GITALY_SIGNING_RELATIVE_PATH"
+	// EnvSigningGlRepository is the GitLab identifier of the repository.
+	EnvSigningGlRepository = "GITALY_SIGNING_GL_REPOSITORY"
+	// EnvSigningGlProjectPath is the GitLab project path of the repository including its namespace.
+	EnvSigningGlProjectPath = "GITALY_SIGNING_GL_PROJECT_PATH"
+)
+
+// SigningContext describes what is being signed. It is passed along to external signers so that they can
+// decide which key or identity to sign with.
+type SigningContext struct {
+	// CommitterName is the name of the committer of the signed commit.
+	CommitterName string `json:"committer_name"`
+	// CommitterEmail is the email of the committer of the signed commit.
+	CommitterEmail string `json:"committer_email"`
+	// StorageName is the storage of the repository the commit is created in.
+	StorageName string `json:"storage_name"`
+	// RelativePath is the relative path of the repository the commit is created in.
+	RelativePath string `json:"relative_path"`
+	// GlRepository is the GitLab identifier of the repository the commit is created in.
+	GlRepository string `json:"gl_repository"`
+	// GlProjectPath is the GitLab project path including the namespace of the repository the commit is
+	// created in.
+	GlProjectPath string `json:"gl_project_path"`
+}
+
+// Env returns the environment variables that pass the repository context along to gitaly-gpg. The committer
+// is not included as Git already passes it along via GIT_COMMITTER_NAME and GIT_COMMITTER_EMAIL.
+func (c SigningContext) Env() []string {
+	return []string{
+		EnvSigningStorageName + "=" + c.StorageName,
+		EnvSigningRelativePath + "=" + c.RelativePath,
+		EnvSigningGlRepository + "=" + c.GlRepository,
+		EnvSigningGlProjectPath + "=" + c.GlProjectPath,
+	}
+}
+
+// SigningContextFromEnv reads the signing context from the environment gitaly-gpg has been spawned with.
+func SigningContextFromEnv(env []string) SigningContext {
+	var signingContext SigningContext
+	for _, variable := range env {
+		key, value, _ := strings.Cut(variable, "=")
+
+		switch key {
+		case "GIT_COMMITTER_NAME":
+			signingContext.CommitterName = value
+		case "GIT_COMMITTER_EMAIL":
+			signingContext.CommitterEmail = value
+		case EnvSigningStorageName:
+			signingContext.StorageName = value
+		case EnvSigningRelativePath:
+			signingContext.RelativePath = value
+		case EnvSigningGlRepository:
+			signingContext.GlRepository = value
+		case EnvSigningGlProjectPath:
+			signingContext.GlProjectPath = value
+		}
+	}
+
+	return signingContext
+}
+
+// externalSignerRequest is the request sent to external signers as a single JSON document.
+type externalSignerRequest struct {
+	// Operation is either "sign" or "verify".
+	Operation string `json:"operation"`
+	// Content is the content to sign or whose signature to verify.
+	Content []byte `json:"content"`
+	// Signature is the signature to verify.
+	Signature []byte `json:"signature,omitempty"`
+	// Context describes what is being signed.
+	Context SigningContext `json:"context"`
+}
+
+// externalSignerResponse is the response external signers answer with as a single JSON document.
+type externalSignerResponse struct {
+	// Signature is the armored signature of the content.
+	Signature []byte `json:"signature,omitempty"`
+	// Verified is set if the signature has been created by the signer.
+	Verified bool `json:"verified,omitempty"`
+	// Error is set if the request failed.
+	Error string `json:"error,omitempty"`
+}
+
+// ExternalSigningKey is a struct that implements SigningKey interface by delegating to an external signer.
+// The signer is either a program that is spawned for each request or an agent listening on a Unix socket
+// that is connected to for each request. In both cases, the request is written as JSON and the signer
+// answers with a JSON response.
+type ExternalSigningKey struct {
+	// Command is the path to the signing program. The request is written to its standard input and the
+	// response is read from its standard output.
+	Command string
+	// SocketPath is the path to the Unix socket of the signing agent.
+	SocketPath string
+	// Context describes what is being signed.
+	Context SigningContext
+}
+
+// parseExternalSigningKey parses a signing key that refers to an external signer. It returns false if
+// the key doesn't refer to an external signer.
+func parseExternalSigningKey(key string) (*ExternalSigningKey, bool) {
+	if command, ok := strings.CutPrefix(key, externalSignerCommandPrefix); ok {
+		return &ExternalSigningKey{Command: command}, true
+	}
+
+	if socketPath, ok := strings.CutPrefix(key, externalSignerSocketPrefix); ok {
+		return &ExternalSigningKey{SocketPath: socketPath}, true
+	}
+
+	return nil, false
+}
+
+// CreateSignature asks the external signer to sign the content
+func (sk *ExternalSigningKey) CreateSignature(contentToSign []byte) ([]byte, error) {
+	response, err := sk.request(context.Background(), externalSignerRequest{
+		Operation: "sign",
+		Content:   contentToSign,
+		Context:   sk.Context,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign commit: %w", err)
+	}
+
+	if len(response.Signature) == 0 {
+		return nil, errors.New("sign commit: external signer returned no signature")
+	}
+
+	return response.Signature, nil
+}
+
+// Verify asks the external signer whether the signature has been created by it
+func (sk *ExternalSigningKey) Verify(signature, signedText []byte) error {
+	return sk.VerifyContext(context.Background(), signature, signedText)
+}
+
+// VerifyContext asks the external signer whether the signature has been created by it. The request is
+// aborted once the context is done.
+func (sk *ExternalSigningKey) VerifyContext(ctx context.Context, signature, signedText []byte) error {
+	response, err := sk.request(ctx, externalSignerRequest{
+		Operation: "verify",
+		Content:   signedText,
+		Signature: signature,
+		Context:   sk.Context,
+	})
+	if err != nil {
+		return fmt.Errorf("verify signature: %w", err)
+	}
+
+	if !response.Verified {
+		return errors.New("signature has not been created by the external signer")
+	}
+
+	return nil
+}
+
+func (sk *ExternalSigningKey) request(ctx context.Context, request externalSignerRequest) (externalSignerResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, externalSignerTimeout)
+	defer cancel()
+
+	encodedRequest, err := json.Marshal(request)
+	if err != nil {
+		return externalSignerResponse{}, fmt.Errorf("encode request: %w", err)
+	}
+
+	var encodedResponse []byte
+	if sk.SocketPath != "" {
+		encodedResponse, err = sk.requestAgent(ctx, encodedRequest)
+	} else {
+		encodedResponse, err = sk.requestCommand(ctx, encodedRequest)
+	}
+	if err != nil {
+		return externalSignerResponse{}, err
+	}
+
+	var response externalSignerResponse
+	if err := json.Unmarshal(encodedResponse, &response); err != nil {
+		return externalSignerResponse{}, fmt.Errorf("decode response: %w", err)
+	}
+
+	if response.Error != "" {
+		return externalSignerResponse{}, fmt.Errorf("external signer: %s", response.Error)
+	}
+
+	return response, nil
+}
+
+func (sk *ExternalSigningKey) requestCommand(ctx context.Context, request []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, sk.Command)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("run signing program: %w, stderr: %q", err, stderr.String())
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func (sk *ExternalSigningKey) requestAgent(ctx context.Context, request []byte) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", sk.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("connect to signing agent: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, fmt.Errorf("set deadline: %w", err)
+		}
+	}
+
+	if _, err := conn.Write(request); err != nil {
+		return nil, fmt.Errorf("write request: %w", err)
+	}
+
+	// Closing the write side signals the end of the request to the agent.
+	if err := conn.(*net.UnixConn).CloseWrite(); err != nil {
+		return nil, fmt.Errorf("close request: %w", err)
+	}
+
+	var response bytes.Buffer
+	if _, err := response.ReadFrom(conn); err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	return response.Bytes(), nil
+}
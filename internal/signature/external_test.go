@@ -0,0 +1,184 @@
+package signature
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestSigningContextFromEnv(t *testing.T) {
+	t.Parallel()
+
+	signingContext := SigningContext{
+		StorageName:   "default",
+		RelativePath:  "@hashed/aa/bb/repo.git",
+		GlRepository:  "project-1",
+		GlProjectPath: "group/subgroup/project",
+	}
+
+	env := append([]string{
+		"GIT_COMMITTER_NAME=Jane Doe",
+		"GIT_COMMITTER_EMAIL=jane@example.com",
+		"UNRELATED=value",
+	}, signingContext.Env()...)
+
+	signingContext.CommitterName = "Jane Doe"
+	signingContext.CommitterEmail = "jane@example.com"
+
+	require.Equal(t, signingContext, SigningContextFromEnv(env))
+}
+
+func TestExternalSigningKey_agent(t *testing.T) {
+	t.Parallel()
+
+	expectedSignature, err := os.ReadFile("testdata/signing_key.ssh.sig")
+	require.NoError(t, err)
+
+	sshKey, err := parseSigningKey("testdata/signing_key.ssh")
+	require.NoError(t, err)
+
+	socketPath := filepath.Join(testhelper.TempDir(t), "agent.sock")
+	agent := StartSigningAgent(t, socketPath, sshKey)
+
+	signingKeys, err := ParseSigningKeys("unix:" + socketPath)
+	require.NoError(t, err)
+
+	signingContext := SigningContext{
+		CommitterName:  "Jane Doe",
+		CommitterEmail: "jane@example.com",
+		GlProjectPath:  "group/project",
+	}
+	signingKeys = signingKeys.WithContext(signingContext)
+
+	signature, err := signingKeys.CreateSignature(commit)
+	require.NoError(t, err)
+	require.Equal(t, expectedSignature, signature)
+
+	require.NoError(t, signingKeys.Verify(signature, commit))
+	require.EqualError(t, signingKeys.Verify(signature, append([]byte("tampered"), commit...)),
+		"signature has not been created by the external signer")
+
+	require.Equal(t, []SigningContext{signingContext, signingContext, signingContext}, agent.Contexts())
+}
+
+func TestExternalSigningKey_agentUnavailable(t *testing.T) {
+	t.Parallel()
+
+	socketPath := filepath.Join(testhelper.TempDir(t), "agent.sock")
+
+	signingKeys, err := ParseSigningKeys("unix:" + socketPath)
+	require.NoError(t, err)
+
+	_, err = signingKeys.CreateSignature(commit)
+	require.ErrorContains(t, err, "sign commit: connect to signing agent")
+}
+
+func TestExternalSigningKey_verifyCanceled(t *testing.T) {
+	t.Parallel()
+
+	sshKey, err := parseSigningKey("testdata/signing_key.ssh")
+	require.NoError(t, err)
+
+	socketPath := filepath.Join(testhelper.TempDir(t), "agent.sock")
+	agent := StartSigningAgent(t, socketPath, sshKey)
+
+	signingKeys, err := ParseSigningKeys("unix:"+socketPath, "unix:"+socketPath)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(testhelper.Context(t))
+	cancel()
+
+	signature, err := os.ReadFile("testdata/signing_key.ssh.sig")
+	require.NoError(t, err)
+
+	// The rotated key is not asked once the context is done.
+	require.Equal(t, context.Canceled, signingKeys.VerifyContext(ctx, signature, commit))
+	require.Empty(t, agent.Contexts())
+}
+
+func TestExternalSigningKey_command(t *testing.T) {
+	t.Parallel()
+
+	tempDir := testhelper.TempDir(t)
+	requestPath := filepath.Join(tempDir, "request")
+
+	writeSigningProgram := func(t *testing.T, script string) string {
+		return testhelper.WriteExecutable(t, filepath.Join(testhelper.TempDir(t), "signer"), []byte(script))
+	}
+
+	for _, tc := range []struct {
+		desc              string
+		script            string
+		expectedSignature []byte
+		expectedErr       string
+	}{
+		{
+			desc: "successful signing",
+			script: fmt.Sprintf(`#!/bin/sh
+cat >%q
+printf '{"signature":"c2lnbmF0dXJl"}'
+`, requestPath),
+			expectedSignature: []byte("signature"),
+		},
+		{
+			desc: "signer returns error",
+			script: `#!/bin/sh
+printf '{"error":"key not available"}'
+`,
+			expectedErr: "sign commit: external signer: key not available",
+		},
+		{
+			desc: "signer returns no signature",
+			script: `#!/bin/sh
+printf '{}'
+`,
+			expectedErr: "sign commit: external signer returned no signature",
+		},
+		{
+			desc: "signer fails",
+			script: `#!/bin/sh
+echo "no hsm" >&2
+exit 1
+`,
+			expectedErr: "sign commit: run signing program: exit status 1, stderr: \"no hsm\\n\"",
+		},
+		{
+			desc: "signer returns garbage",
+			script: `#!/bin/sh
+echo "garbage"
+`,
+			expectedErr: "sign commit: decode response: invalid character 'g' looking for beginning of value",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			signingKeys, err := ParseSigningKeys("exec:" + writeSigningProgram(t, tc.script))
+			require.NoError(t, err)
+
+			signingContext := SigningContext{CommitterName: "Jane Doe", StorageName: "default"}
+
+			signature, err := signingKeys.WithContext(signingContext).CreateSignature(commit)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSignature, signature)
+
+			var request externalSignerRequest
+			require.NoError(t, json.Unmarshal(testhelper.MustReadFile(t, requestPath), &request))
+			require.Equal(t, externalSignerRequest{
+				Operation: "sign",
+				Content:   commit,
+				Context:   signingContext,
+			}, request)
+		})
+	}
+}
@@ -2,10 +2,15 @@ package signature
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	pgpErrors "github.com/ProtonMail/go-crypto/openpgp/errors"
 	"github.com/ProtonMail/go-crypto/openpgp/packet"
 )
 
@@ -49,3 +54,106 @@ func (sk *GpgSigningKey) Verify(signature, signedText []byte) error {
 
 	return err
 }
+
+// parseGpgKeyring parses an armored or binary keyring of public keys.
+func parseGpgKeyring(keyring []byte) (openpgp.EntityList, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(keyring), []byte("-----BEGIN")) {
+		return openpgp.ReadArmoredKeyRing(bytes.NewReader(keyring))
+	}
+
+	return openpgp.ReadKeyRing(bytes.NewReader(keyring))
+}
+
+// verifyGpg verifies an armored OpenPGP signature against the trusted GPG keyring.
+func (t *TrustedKeys) verifyGpg(signature, signedText []byte) Verdict {
+	verdict := Verdict{Type: TypeGPG, Status: StatusUnverified}
+
+	block, err := armor.Decode(bytes.NewReader(signature))
+	if err != nil {
+		return verdict
+	}
+
+	body, err := io.ReadAll(block.Body)
+	if err != nil {
+		return verdict
+	}
+
+	p, err := packet.Read(bytes.NewReader(body))
+	if err != nil {
+		return verdict
+	}
+
+	sig, ok := p.(*packet.Signature)
+	if !ok {
+		return verdict
+	}
+
+	verdict.KeyFingerprint = gpgIssuerFingerprint(sig)
+
+	// The validity of the key is judged at the time the signature was created so that keys expiring or
+	// being superseded later on don't invalidate existing signatures. Keys revoked because they have been
+	// compromised are considered revoked regardless of the time.
+	_, signer, err := openpgp.VerifyDetachedSignature(
+		t.gpgKeyring,
+		bytes.NewReader(signedText),
+		bytes.NewReader(body),
+		&packet.Config{Time: func() time.Time { return sig.CreationTime }},
+	)
+	switch {
+	case err == nil:
+		verdict.Status = StatusVerified
+	case errors.Is(err, pgpErrors.ErrUnknownIssuer):
+		verdict.Status = StatusUnknownKey
+		return verdict
+	case errors.Is(err, pgpErrors.ErrKeyRevoked):
+		verdict.Status = StatusRevoked
+	case errors.Is(err, pgpErrors.ErrKeyExpired), errors.Is(err, pgpErrors.ErrSignatureExpired):
+		verdict.Status = StatusExpired
+	default:
+		return verdict
+	}
+
+	// The signature has been checked against the signer's key if the signer is known, so its identity
+	// can be reported even if the key has expired or has been revoked.
+	if signer != nil {
+		verdict.KeyFingerprint = gpgKeyFingerprint(signer, sig)
+		if identity := signer.PrimaryIdentity(); identity != nil {
+			verdict.Signer = identity.Name
+		}
+	}
+
+	return verdict
+}
+
+// gpgIssuerFingerprint returns the fingerprint of the key that issued the signature as recorded in the
+// signature. Older signatures only record the issuer's key ID, which is returned instead.
+func gpgIssuerFingerprint(sig *packet.Signature) string {
+	if len(sig.IssuerFingerprint) > 0 {
+		return fmt.Sprintf("%X", sig.IssuerFingerprint)
+	}
+
+	if sig.IssuerKeyId != nil {
+		return fmt.Sprintf("%016X", *sig.IssuerKeyId)
+	}
+
+	return ""
+}
+
+// gpgKeyFingerprint returns the fingerprint of the signer's key or subkey that issued the signature.
+func gpgKeyFingerprint(signer *openpgp.Entity, sig *packet.Signature) string {
+	if sig.IssuerKeyId == nil {
+		return gpgIssuerFingerprint(sig)
+	}
+
+	if signer.PrimaryKey.KeyId == *sig.IssuerKeyId {
+		return fmt.Sprintf("%X", signer.PrimaryKey.Fingerprint)
+	}
+
+	for _, subkey := range signer.Subkeys {
+		if subkey.PublicKey.KeyId == *sig.IssuerKeyId {
+			return fmt.Sprintf("%X", subkey.PublicKey.Fingerprint)
+		}
+	}
+
+	return gpgIssuerFingerprint(sig)
+}
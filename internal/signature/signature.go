@@ -2,11 +2,12 @@ package signature
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 )
 
-// SigningKey is the common interface of SSH and GPG signing keys
+// SigningKey is the common interface of SSH, GPG and external signing keys
 type SigningKey interface {
 	CreateSignature([]byte) ([]byte, error)
 	Verify([]byte, []byte) error
@@ -26,6 +27,8 @@ type SigningKeys struct {
 // Multiple signing keys are necessary to provide proper key rotation.
 // The latest signing key is specified first and used for creating a signature. The
 // previous signing keys go after and are used to verify a signature.
+// Instead of a path, a key may refer to an external signer with "exec:<path to program>"
+// or "unix:<path to agent socket>".
 func ParseSigningKeys(primaryPath string, secondaryPaths ...string) (*SigningKeys, error) {
 	primaryKey, err := parseSigningKey(primaryPath)
 	if err != nil {
@@ -48,6 +51,10 @@ func ParseSigningKeys(primaryPath string, secondaryPaths ...string) (*SigningKey
 }
 
 func parseSigningKey(path string) (SigningKey, error) {
+	if externalKey, ok := parseExternalSigningKey(path); ok {
+		return externalKey, nil
+	}
+
 	key, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("open file: %w", err)
@@ -60,6 +67,31 @@ func parseSigningKey(path string) (SigningKey, error) {
 	return parseGpgSigningKey(key)
 }
 
+// WithContext returns the signing keys with the signing context that is passed along to
+// external signers. Other keys are not affected by the context.
+func (s *SigningKeys) WithContext(signingContext SigningContext) *SigningKeys {
+	withContext := func(signingKey SigningKey) SigningKey {
+		externalKey, ok := signingKey.(*ExternalSigningKey)
+		if !ok {
+			return signingKey
+		}
+
+		externalKeyWithContext := *externalKey
+		externalKeyWithContext.Context = signingContext
+		return &externalKeyWithContext
+	}
+
+	secondaryKeys := make([]SigningKey, 0, len(s.secondaryKeys))
+	for _, signingKey := range s.secondaryKeys {
+		secondaryKeys = append(secondaryKeys, withContext(signingKey))
+	}
+
+	return &SigningKeys{
+		primaryKey:    withContext(s.primaryKey),
+		secondaryKeys: secondaryKeys,
+	}
+}
+
 // CreateSignature uses the primary key to create a signature
 func (s *SigningKeys) CreateSignature(contentToSign []byte) ([]byte, error) {
 	return s.primaryKey.CreateSignature(contentToSign)
@@ -69,12 +101,27 @@ func (s *SigningKeys) CreateSignature(contentToSign []byte) ([]byte, error) {
 // verification was successful. Otherwise, the last error is returned.
 // Note: when Golang 1.19 is no longer supported, can be refactored using errors.Join
 func (s *SigningKeys) Verify(signature, signedText []byte) error {
+	return s.VerifyContext(context.Background(), signature, signedText)
+}
+
+// VerifyContext is like Verify, but aborts the requests to external signers
+// once the context is done. The context's error is returned in that case.
+func (s *SigningKeys) VerifyContext(ctx context.Context, signature, signedText []byte) error {
 	var err error
 	for _, signingKey := range append([]SigningKey{s.primaryKey}, s.secondaryKeys...) {
-		err = signingKey.Verify(signature, signedText)
+		if externalKey, ok := signingKey.(*ExternalSigningKey); ok {
+			err = externalKey.VerifyContext(ctx, signature, signedText)
+		} else {
+			err = signingKey.Verify(signature, signedText)
+		}
+
 		if err == nil {
 			return nil
 		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 	}
 	return err
 }
@@ -1,12 +1,18 @@
 package signature
 
 import (
+	"bytes"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/pem"
 	"fmt"
+	"hash"
+	"strings"
+	"time"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/exp/slices"
 )
 
 // SSHSigningKey is a struct that implements SigningKey interface for SSH keys
@@ -105,22 +111,46 @@ func (sk *SSHSigningKey) CreateSignature(contentToSign []byte) ([]byte, error) {
 
 // Verify method verifies whether a signature has been created by this signing key
 func (sk *SSHSigningKey) Verify(signatureText, signedText []byte) error {
+	sshSig, signature, err := parseSSHSignature(signatureText)
+	if err != nil {
+		return err
+	}
+
+	return verifySSHSignature(sk.PrivateKey.PublicKey(), sshSig, signature, signedText)
+}
+
+// parseSSHSignature parses an armored SSH signature.
+func parseSSHSignature(signatureText []byte) (*sshSignature, *ssh.Signature, error) {
 	block, rest := pem.Decode(signatureText)
 	if block == nil || len(rest) > 0 || block.Type != sshSignatureType {
-		return fmt.Errorf("invalid signature text")
+		return nil, nil, fmt.Errorf("invalid signature text")
 	}
 
 	sshSig := &sshSignature{}
 	if err := ssh.Unmarshal(block.Bytes, sshSig); err != nil {
-		return fmt.Errorf("parse signature text: %w", err)
+		return nil, nil, fmt.Errorf("parse signature text: %w", err)
 	}
 
 	signature := &ssh.Signature{}
 	if err := ssh.Unmarshal(sshSig.Signature, signature); err != nil {
-		return fmt.Errorf("parse signature: %w", err)
+		return nil, nil, fmt.Errorf("parse signature: %w", err)
+	}
+
+	return sshSig, signature, nil
+}
+
+// verifySSHSignature verifies that the signature of the signed text has been created with the public key.
+func verifySSHSignature(publicKey ssh.PublicKey, sshSig *sshSignature, signature *ssh.Signature, signedText []byte) error {
+	var h hash.Hash
+	switch sshSig.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case hashAlgorithm:
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported hash algorithm %q", sshSig.HashAlgorithm)
 	}
 
-	h := sha512.New()
 	if _, err := h.Write(signedText); err != nil {
 		return fmt.Errorf("failed to create sha for verifying content: %w", err)
 	}
@@ -132,5 +162,172 @@ func (sk *SSHSigningKey) Verify(signatureText, signedText []byte) error {
 		Hash:          h.Sum(nil),
 	}
 
-	return sk.PrivateKey.PublicKey().Verify(ssh.Marshal(signedData), signature)
+	return publicKey.Verify(ssh.Marshal(signedData), signature)
+}
+
+// allowedSigner is an entry of an allowed signers file. See the ALLOWED SIGNERS section of ssh-keygen(1).
+type allowedSigner struct {
+	principals  string
+	publicKey   []byte
+	namespaces  []string
+	validAfter  time.Time
+	validBefore time.Time
+}
+
+// parseAllowedSigners parses the entries of an allowed signers file. Certificate authority entries are
+// not supported and are skipped.
+func parseAllowedSigners(allowedSigners []byte) ([]allowedSigner, error) {
+	var signers []allowedSigner
+	for i, line := range bytes.Split(allowedSigners, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		principals, rest := cutPrincipals(line)
+
+		publicKey, _, options, _, err := ssh.ParseAuthorizedKey(rest)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		signer := allowedSigner{
+			principals: principals,
+			publicKey:  publicKey.Marshal(),
+		}
+
+		isCertificateAuthority := false
+		for _, option := range options {
+			name, value, _ := strings.Cut(option, "=")
+			value = strings.Trim(value, `"`)
+
+			switch strings.ToLower(name) {
+			case "cert-authority":
+				isCertificateAuthority = true
+			case "namespaces":
+				signer.namespaces = strings.Split(value, ",")
+			case "valid-after":
+				if signer.validAfter, err = parseAllowedSignerTime(value); err != nil {
+					return nil, fmt.Errorf("line %d: valid-after: %w", i+1, err)
+				}
+			case "valid-before":
+				if signer.validBefore, err = parseAllowedSignerTime(value); err != nil {
+					return nil, fmt.Errorf("line %d: valid-before: %w", i+1, err)
+				}
+			}
+		}
+
+		if isCertificateAuthority {
+			continue
+		}
+
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
+// cutPrincipals splits the principals off the beginning of an allowed signers line. The principals may be
+// quoted.
+func cutPrincipals(line []byte) (string, []byte) {
+	if line[0] == '"' {
+		if end := bytes.IndexByte(line[1:], '"'); end >= 0 {
+			return string(line[1 : end+1]), bytes.TrimSpace(line[end+2:])
+		}
+	}
+
+	principals, rest, _ := bytes.Cut(line, []byte(" "))
+	return string(principals), bytes.TrimSpace(rest)
+}
+
+// parseAllowedSignerTime parses a timestamp in the YYYYMMDD[HHMM[SS]][Z] format. Timestamps without the Z
+// suffix are in the local time zone.
+func parseAllowedSignerTime(value string) (time.Time, error) {
+	location := time.Local
+	if strings.HasSuffix(value, "Z") {
+		location = time.UTC
+		value = strings.TrimSuffix(value, "Z")
+	}
+
+	for _, layout := range []string{"20060102", "200601021504", "20060102150405"} {
+		if len(value) != len(layout) {
+			continue
+		}
+
+		return time.ParseInLocation(layout, value, location)
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
+
+// parseRevokedKeys parses the public keys of a revocation list in the authorized_keys format.
+func parseRevokedKeys(revokedKeys []byte) ([][]byte, error) {
+	var keys [][]byte
+	for i, line := range bytes.Split(revokedKeys, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		keys = append(keys, publicKey.Marshal())
+	}
+
+	return keys, nil
+}
+
+// verifySSH verifies an armored SSH signature against the trusted allowed signers.
+func (t *TrustedKeys) verifySSH(signatureText, signedText []byte, signedAt time.Time) Verdict {
+	verdict := Verdict{Type: TypeSSH, Status: StatusUnverified}
+
+	sshSig, signature, err := parseSSHSignature(signatureText)
+	if err != nil {
+		return verdict
+	}
+
+	publicKey, err := ssh.ParsePublicKey(sshSig.PublicKey)
+	if err != nil {
+		return verdict
+	}
+
+	verdict.KeyFingerprint = ssh.FingerprintSHA256(publicKey)
+
+	if sshSig.Namespace != namespace || verifySSHSignature(publicKey, sshSig, signature, signedText) != nil {
+		return verdict
+	}
+
+	for _, revokedKey := range t.sshRevokedKeys {
+		if bytes.Equal(revokedKey, sshSig.PublicKey) {
+			verdict.Status = StatusRevoked
+			return verdict
+		}
+	}
+
+	verdict.Status = StatusUnknownKey
+	for _, signer := range t.sshAllowedSigners {
+		if !bytes.Equal(signer.publicKey, sshSig.PublicKey) {
+			continue
+		}
+
+		if len(signer.namespaces) > 0 && !slices.Contains(signer.namespaces, namespace) {
+			continue
+		}
+
+		verdict.Signer = signer.principals
+
+		if (!signer.validAfter.IsZero() && signedAt.Before(signer.validAfter)) ||
+			(!signer.validBefore.IsZero() && !signedAt.Before(signer.validBefore)) {
+			verdict.Status = StatusExpired
+			continue
+		}
+
+		verdict.Status = StatusVerified
+		break
+	}
+
+	return verdict
 }
@@ -2,7 +2,12 @@ package signature
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -40,3 +45,89 @@ func ExtractSignature(tb testing.TB, ctx context.Context, objectData []byte) (st
 
 	return gpgsig, dataWithoutGpgSig
 }
+
+// SigningAgent is a stub signing agent for testing purposes. It signs and
+// verifies with its signing key and records the context of each request.
+type SigningAgent struct {
+	signingKey SigningKey
+
+	m        sync.Mutex
+	contexts []SigningContext
+}
+
+// StartSigningAgent starts a signing agent listening on the socket path. The
+// agent is stopped when the test finishes.
+func StartSigningAgent(tb testing.TB, socketPath string, signingKey SigningKey) *SigningAgent {
+	tb.Helper()
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		tb.Fatalf("listen on signing agent socket: %v", err)
+	}
+
+	agent := &SigningAgent{signingKey: signingKey}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			agent.serve(conn)
+		}
+	}()
+
+	tb.Cleanup(func() {
+		listener.Close()
+		wg.Wait()
+	})
+
+	return agent
+}
+
+func (a *SigningAgent) serve(conn net.Conn) {
+	defer conn.Close()
+
+	var request externalSignerRequest
+	var response externalSignerResponse
+
+	encodedRequest, err := io.ReadAll(conn)
+	if err == nil {
+		err = json.Unmarshal(encodedRequest, &request)
+	}
+
+	if err == nil {
+		a.m.Lock()
+		a.contexts = append(a.contexts, request.Context)
+		a.m.Unlock()
+
+		switch request.Operation {
+		case "sign":
+			response.Signature, err = a.signingKey.CreateSignature(request.Content)
+		case "verify":
+			response.Verified = a.signingKey.Verify(request.Signature, request.Content) == nil
+		default:
+			err = errors.New("unknown operation")
+		}
+	}
+
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	encodedResponse, _ := json.Marshal(response)
+	_, _ = conn.Write(encodedResponse)
+}
+
+// Contexts returns the signing contexts of the requests the agent has received.
+func (a *SigningAgent) Contexts() []SigningContext {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	return append([]SigningContext(nil), a.contexts...)
+}
@@ -0,0 +1,138 @@
+package signature
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+// Type is the type of a signature.
+type Type int
+
+const (
+	// TypeUnknown is a signature of an unrecognized format.
+	TypeUnknown Type = iota
+	// TypeGPG is an OpenPGP signature.
+	TypeGPG
+	// TypeSSH is an SSH signature.
+	TypeSSH
+	// TypeX509 is a CMS signature made with an X.509 certificate.
+	TypeX509
+)
+
+// Status is the outcome of verifying a signature.
+type Status int
+
+const (
+	// StatusUnverified means the signature doesn't match the signed content or couldn't be parsed.
+	StatusUnverified Status = iota
+	// StatusVerified means the signature was made by a trusted key and matches the signed content.
+	StatusVerified
+	// StatusUnknownKey means the signature was made by a key that isn't trusted.
+	StatusUnknownKey
+	// StatusExpired means the signature was made by a trusted key that has expired.
+	StatusExpired
+	// StatusRevoked means the signature was made by a trusted key that has been revoked.
+	StatusRevoked
+)
+
+// Verdict is the outcome of verifying a signature against the trusted keys.
+type Verdict struct {
+	// Type is the type of the signature.
+	Type Type
+	// Status is the outcome of the verification.
+	Status Status
+	// KeyFingerprint is the fingerprint of the key that made the signature. It is empty if the signature
+	// couldn't be parsed.
+	KeyFingerprint string
+	// Signer is the identity the trusted key belongs to. It is empty unless the key is trusted.
+	Signer string
+}
+
+// TrustedKeys are the keys signatures are verified against.
+type TrustedKeys struct {
+	gpgKeyring        openpgp.EntityList
+	sshAllowedSigners []allowedSigner
+	sshRevokedKeys    [][]byte
+	x509Roots         *x509.CertPool
+	// x509RevocationLists are the revocation lists of the trusted certificate authorities.
+	x509RevocationLists []*x509.RevocationList
+}
+
+// ParseTrustedKeys parses the keys signatures are trusted from. gpgKeyring is an armored or binary OpenPGP
+// keyring. sshAllowedSigners is in the format of Git's `gpg.ssh.allowedSignersFile` and sshRevokedKeys lists
+// revoked public keys in the format of an `authorized_keys` file. x509CACertificates are the PEM-encoded
+// certificates of the trusted certificate authorities, optionally followed by PEM-encoded revocation lists
+// issued by them. Any of them may be empty.
+func ParseTrustedKeys(gpgKeyring, sshAllowedSigners, sshRevokedKeys, x509CACertificates []byte) (*TrustedKeys, error) {
+	var trustedKeys TrustedKeys
+
+	if len(bytes.TrimSpace(gpgKeyring)) > 0 {
+		keyring, err := parseGpgKeyring(gpgKeyring)
+		if err != nil {
+			return nil, fmt.Errorf("parse gpg keyring: %w", err)
+		}
+
+		trustedKeys.gpgKeyring = keyring
+	}
+
+	allowedSigners, err := parseAllowedSigners(sshAllowedSigners)
+	if err != nil {
+		return nil, fmt.Errorf("parse ssh allowed signers: %w", err)
+	}
+	trustedKeys.sshAllowedSigners = allowedSigners
+
+	revokedKeys, err := parseRevokedKeys(sshRevokedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("parse ssh revoked keys: %w", err)
+	}
+	trustedKeys.sshRevokedKeys = revokedKeys
+
+	if len(bytes.TrimSpace(x509CACertificates)) > 0 {
+		roots, revocationLists, err := parseX509TrustedCertificates(x509CACertificates)
+		if err != nil {
+			return nil, fmt.Errorf("parse x509 ca certificates: %w", err)
+		}
+
+		trustedKeys.x509Roots = roots
+		trustedKeys.x509RevocationLists = revocationLists
+	}
+
+	return &trustedKeys, nil
+}
+
+// DetectType detects the type of the signature from its armor.
+func DetectType(signature []byte) Type {
+	switch {
+	case bytes.HasPrefix(signature, []byte("-----BEGIN PGP SIGNATURE-----")),
+		bytes.HasPrefix(signature, []byte("-----BEGIN PGP MESSAGE-----")):
+		return TypeGPG
+	case bytes.HasPrefix(signature, []byte("-----BEGIN SSH SIGNATURE-----")):
+		return TypeSSH
+	case bytes.HasPrefix(signature, []byte("-----BEGIN SIGNED MESSAGE-----")):
+		return TypeX509
+	default:
+		return TypeUnknown
+	}
+}
+
+// Verify verifies the signature of the signed text against the trusted keys. Keys are judged by whether they
+// were valid at the time the signature was created. signedAt is the time the object was signed according to
+// its committer or tagger date. It is used for SSH signatures, which is what Git does as SSH signatures carry
+// no timestamp of their own, and for X.509 signatures that don't record their signing time. OpenPGP signatures
+// always record their creation time.
+func (t *TrustedKeys) Verify(signature, signedText []byte, signedAt time.Time) Verdict {
+	switch signatureType := DetectType(signature); signatureType {
+	case TypeGPG:
+		return t.verifyGpg(signature, signedText)
+	case TypeSSH:
+		return t.verifySSH(signature, signedText, signedAt)
+	case TypeX509:
+		return t.verifyX509(signature, signedText, signedAt)
+	default:
+		return Verdict{Type: signatureType, Status: StatusUnverified}
+	}
+}
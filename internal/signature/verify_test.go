@@ -0,0 +1,732 @@
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestDetectType(t *testing.T) {
+	t.Parallel()
+
+	gpgSignature, err := os.ReadFile("testdata/signing_key.gpg.sig")
+	require.NoError(t, err)
+
+	sshSignature, err := os.ReadFile("testdata/signing_key.ssh.sig")
+	require.NoError(t, err)
+
+	require.Equal(t, TypeGPG, DetectType(gpgSignature))
+	require.Equal(t, TypeSSH, DetectType(sshSignature))
+	require.Equal(t, TypeX509, DetectType([]byte("-----BEGIN SIGNED MESSAGE-----\n")))
+	require.Equal(t, TypeUnknown, DetectType([]byte("garbage")))
+}
+
+func TestParseTrustedKeys(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc              string
+		gpgKeyring        []byte
+		sshAllowedSigners []byte
+		sshRevokedKeys    []byte
+		x509CAs           []byte
+		expectedErr       string
+	}{
+		{
+			desc: "no keys",
+		},
+		{
+			desc:        "invalid gpg keyring",
+			gpgKeyring:  []byte("garbage"),
+			expectedErr: "parse gpg keyring",
+		},
+		{
+			desc:              "invalid allowed signers",
+			sshAllowedSigners: []byte("user@example.com ssh-ed25519 garbage"),
+			expectedErr:       "parse ssh allowed signers: line 1",
+		},
+		{
+			desc:              "invalid validity period",
+			sshAllowedSigners: []byte(fmt.Sprintf("user@example.com valid-before=\"2023\" %s", newSSHSigner(t).authorizedKey)),
+			expectedErr:       "parse ssh allowed signers: line 1: valid-before: invalid timestamp \"2023\"",
+		},
+		{
+			desc:           "invalid revoked keys",
+			sshRevokedKeys: []byte("\n\nssh-ed25519 garbage"),
+			expectedErr:    "parse ssh revoked keys: line 3",
+		},
+		{
+			desc:        "invalid x509 certificates",
+			x509CAs:     []byte("garbage"),
+			expectedErr: "parse x509 ca certificates: no certificates found",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			trustedKeys, err := ParseTrustedKeys(tc.gpgKeyring, tc.sshAllowedSigners, tc.sshRevokedKeys, tc.x509CAs)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, trustedKeys)
+		})
+	}
+}
+
+func TestTrustedKeys_Verify_gpg(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	// Keys are judged at the time the signature was created, so all keys were created two hours ago.
+	createdConfig := &packet.Config{Time: func() time.Time { return now.Add(-2 * time.Hour) }}
+	signedConfig := &packet.Config{Time: func() time.Time { return now.Add(-time.Hour) }}
+
+	trusted := newGpgEntity(t, "Trusted", &packet.Config{})
+	revoked := newGpgEntity(t, "Revoked", createdConfig)
+	superseded := newGpgEntity(t, "Superseded", createdConfig)
+	// The expired key expires 90 minutes after it has been created.
+	expired := newGpgEntity(t, "Expired", &packet.Config{
+		Time:            createdConfig.Time,
+		KeyLifetimeSecs: 90 * 60,
+	})
+	untrusted := newGpgEntity(t, "Untrusted", &packet.Config{})
+
+	trustedSignature := createGpgSignature(t, trusted, commit, &packet.Config{})
+	revokedSignature := createGpgSignature(t, revoked, commit, signedConfig)
+	supersededSignature := createGpgSignature(t, superseded, commit, signedConfig)
+	expiredSignature := createGpgSignatureAt(t, expired, commit, now)
+	signedBeforeExpirySignature := createGpgSignature(t, expired, commit, signedConfig)
+	untrustedSignature := createGpgSignature(t, untrusted, commit, &packet.Config{})
+
+	require.NoError(t, revoked.RevokeKey(packet.KeyCompromised, "", &packet.Config{}))
+	require.NoError(t, superseded.RevokeKey(packet.KeySuperseded, "", &packet.Config{}))
+
+	var keyring bytes.Buffer
+	for _, entity := range []*openpgp.Entity{trusted, revoked, superseded, expired} {
+		require.NoError(t, entity.Serialize(&keyring))
+	}
+
+	trustedKeys, err := ParseTrustedKeys(keyring.Bytes(), nil, nil, nil)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc            string
+		signature       []byte
+		signedText      []byte
+		expectedVerdict Verdict
+	}{
+		{
+			desc:       "verified",
+			signature:  trustedSignature,
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusVerified,
+				KeyFingerprint: gpgFingerprint(trusted),
+				Signer:         "Trusted <trusted@example.com>",
+			},
+		},
+		{
+			desc:       "tampered content",
+			signature:  trustedSignature,
+			signedText: append([]byte("tampered"), commit...),
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusUnverified,
+				KeyFingerprint: gpgFingerprint(trusted),
+			},
+		},
+		{
+			desc:       "unknown key",
+			signature:  untrustedSignature,
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusUnknownKey,
+				KeyFingerprint: gpgFingerprint(untrusted),
+			},
+		},
+		{
+			desc:       "revoked key",
+			signature:  revokedSignature,
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusRevoked,
+				KeyFingerprint: gpgFingerprint(revoked),
+				Signer:         "Revoked <revoked@example.com>",
+			},
+		},
+		{
+			desc:       "key superseded after signing",
+			signature:  supersededSignature,
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusVerified,
+				KeyFingerprint: gpgFingerprint(superseded),
+				Signer:         "Superseded <superseded@example.com>",
+			},
+		},
+		{
+			desc:       "key expired after signing",
+			signature:  signedBeforeExpirySignature,
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusVerified,
+				KeyFingerprint: gpgFingerprint(expired),
+				Signer:         "Expired <expired@example.com>",
+			},
+		},
+		{
+			desc:       "expired key",
+			signature:  expiredSignature,
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:           TypeGPG,
+				Status:         StatusExpired,
+				KeyFingerprint: gpgFingerprint(expired),
+				Signer:         "Expired <expired@example.com>",
+			},
+		},
+		{
+			desc:       "malformed signature",
+			signature:  []byte("-----BEGIN PGP SIGNATURE-----\n\ngarbage\n-----END PGP SIGNATURE-----\n"),
+			signedText: commit,
+			expectedVerdict: Verdict{
+				Type:   TypeGPG,
+				Status: StatusUnverified,
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expectedVerdict, trustedKeys.Verify(tc.signature, tc.signedText, now))
+		})
+	}
+}
+
+func TestTrustedKeys_Verify_gpgWithoutKeyring(t *testing.T) {
+	t.Parallel()
+
+	entity := newGpgEntity(t, "Signer", &packet.Config{})
+
+	trustedKeys, err := ParseTrustedKeys(nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, Verdict{
+		Type:           TypeGPG,
+		Status:         StatusUnknownKey,
+		KeyFingerprint: gpgFingerprint(entity),
+	}, trustedKeys.Verify(createGpgSignature(t, entity, commit, &packet.Config{}), commit, time.Now()))
+}
+
+func TestTrustedKeys_Verify_ssh(t *testing.T) {
+	t.Parallel()
+
+	signedAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	trusted := newSSHSigner(t)
+	otherNamespace := newSSHSigner(t)
+	expired := newSSHSigner(t)
+	notYetValid := newSSHSigner(t)
+	revoked := newSSHSigner(t)
+	untrusted := newSSHSigner(t)
+
+	allowedSigners := strings.Join([]string{
+		"# Comments and empty lines are ignored.",
+		"",
+		fmt.Sprintf("trusted@example.com,alias@example.com namespaces=\"git\" %s", trusted.authorizedKey),
+		fmt.Sprintf("other@example.com namespaces=\"file\" %s", otherNamespace.authorizedKey),
+		fmt.Sprintf("expired@example.com valid-before=\"20230101Z\" %s", expired.authorizedKey),
+		fmt.Sprintf("\"not yet valid@example.com\" valid-after=\"202401011200Z\" %s", notYetValid.authorizedKey),
+		fmt.Sprintf("revoked@example.com %s", revoked.authorizedKey),
+		fmt.Sprintf("authority@example.com cert-authority %s", untrusted.authorizedKey),
+	}, "\n")
+
+	trustedKeys, err := ParseTrustedKeys(nil, []byte(allowedSigners), []byte(revoked.authorizedKey), nil)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc           string
+		signer         sshSigner
+		signedText     []byte
+		signingTime    time.Time
+		signedAt       time.Time
+		expectedStatus Status
+		expectedSigner string
+	}{
+		{
+			desc:           "verified",
+			signer:         trusted,
+			signedText:     commit,
+			expectedStatus: StatusVerified,
+			expectedSigner: "trusted@example.com,alias@example.com",
+		},
+		{
+			desc:           "tampered content",
+			signer:         trusted,
+			signedText:     append([]byte("tampered"), commit...),
+			expectedStatus: StatusUnverified,
+		},
+		{
+			desc:           "key not allowed for namespace",
+			signer:         otherNamespace,
+			signedText:     commit,
+			expectedStatus: StatusUnknownKey,
+		},
+		{
+			desc:           "key expired",
+			signer:         expired,
+			signedText:     commit,
+			expectedStatus: StatusExpired,
+			expectedSigner: "expired@example.com",
+		},
+		{
+			desc:           "key not yet valid",
+			signer:         notYetValid,
+			signedText:     commit,
+			expectedStatus: StatusExpired,
+			expectedSigner: "not yet valid@example.com",
+		},
+		{
+			desc:           "key revoked",
+			signer:         revoked,
+			signedText:     commit,
+			expectedStatus: StatusRevoked,
+		},
+		{
+			desc:           "certificate authorities are not trusted",
+			signer:         untrusted,
+			signedText:     commit,
+			expectedStatus: StatusUnknownKey,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			signature, err := tc.signer.CreateSignature(commit)
+			require.NoError(t, err)
+
+			require.Equal(t, Verdict{
+				Type:           TypeSSH,
+				Status:         tc.expectedStatus,
+				KeyFingerprint: ssh.FingerprintSHA256(tc.signer.PrivateKey.PublicKey()),
+				Signer:         tc.expectedSigner,
+			}, trustedKeys.Verify(signature, tc.signedText, signedAt))
+		})
+	}
+}
+
+func TestTrustedKeys_Verify_x509(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	trustedCA := newX509Certificate(t, x509CertificateOptions{isCA: true, notAfter: now.Add(time.Hour)})
+	untrustedCA := newX509Certificate(t, x509CertificateOptions{isCA: true, notAfter: now.Add(time.Hour)})
+
+	trusted := newX509Certificate(t, x509CertificateOptions{
+		parent:   &trustedCA,
+		email:    "trusted@example.com",
+		notAfter: now.Add(time.Hour),
+	})
+	expired := newX509Certificate(t, x509CertificateOptions{
+		parent:   &trustedCA,
+		email:    "expired@example.com",
+		notAfter: now.Add(-time.Hour),
+	})
+	revoked := newX509Certificate(t, x509CertificateOptions{
+		parent:   &trustedCA,
+		email:    "revoked@example.com",
+		notAfter: now.Add(time.Hour),
+	})
+	untrusted := newX509Certificate(t, x509CertificateOptions{
+		parent:   &untrustedCA,
+		email:    "untrusted@example.com",
+		notAfter: now.Add(time.Hour),
+	})
+
+	trustedKeys, err := ParseTrustedKeys(nil, nil, nil, append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: trustedCA.certificate.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: x509CRLType, Bytes: createX509RevocationList(t, trustedCA, revoked)})...,
+	))
+	require.NoError(t, err)
+
+	// A revocation list that isn't signed by the certificate's issuer must not revoke the certificate.
+	forgedRevocationKeys, err := ParseTrustedKeys(nil, nil, nil, append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: trustedCA.certificate.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: x509CRLType, Bytes: createX509RevocationList(t, untrustedCA, revoked)})...,
+	))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc           string
+		trustedKeys    *TrustedKeys
+		certificate    x509Certificate
+		signedText     []byte
+		signingTime    time.Time
+		signedAt       time.Time
+		expectedStatus Status
+		expectedSigner string
+	}{
+		{
+			desc:           "verified",
+			trustedKeys:    trustedKeys,
+			certificate:    trusted,
+			signedText:     commit,
+			expectedStatus: StatusVerified,
+			expectedSigner: "trusted@example.com",
+		},
+		{
+			desc:           "tampered content",
+			trustedKeys:    trustedKeys,
+			certificate:    trusted,
+			signedText:     append([]byte("tampered"), commit...),
+			expectedStatus: StatusUnverified,
+		},
+		{
+			desc:           "expired certificate",
+			trustedKeys:    trustedKeys,
+			certificate:    expired,
+			signedText:     commit,
+			expectedStatus: StatusExpired,
+			expectedSigner: "expired@example.com",
+		},
+		{
+			desc:           "signed before the certificate expired",
+			trustedKeys:    trustedKeys,
+			certificate:    expired,
+			signedText:     commit,
+			signingTime:    now.Add(-2 * time.Hour),
+			expectedStatus: StatusVerified,
+			expectedSigner: "expired@example.com",
+		},
+		{
+			desc:           "committed before the certificate expired",
+			trustedKeys:    trustedKeys,
+			certificate:    expired,
+			signedText:     commit,
+			signedAt:       now.Add(-2 * time.Hour),
+			expectedStatus: StatusVerified,
+			expectedSigner: "expired@example.com",
+		},
+		{
+			desc:           "signing time takes precedence over the commit time",
+			trustedKeys:    trustedKeys,
+			certificate:    expired,
+			signedText:     commit,
+			signingTime:    now,
+			signedAt:       now.Add(-2 * time.Hour),
+			expectedStatus: StatusExpired,
+			expectedSigner: "expired@example.com",
+		},
+		{
+			desc:           "revoked certificate",
+			trustedKeys:    trustedKeys,
+			certificate:    revoked,
+			signedText:     commit,
+			expectedStatus: StatusRevoked,
+			expectedSigner: "revoked@example.com",
+		},
+		{
+			desc:           "revocation list of another authority",
+			trustedKeys:    forgedRevocationKeys,
+			certificate:    revoked,
+			signedText:     commit,
+			expectedStatus: StatusVerified,
+			expectedSigner: "revoked@example.com",
+		},
+		{
+			desc:           "untrusted certificate authority",
+			trustedKeys:    trustedKeys,
+			certificate:    untrusted,
+			signedText:     commit,
+			expectedStatus: StatusUnknownKey,
+		},
+		{
+			desc:           "no trusted certificate authorities",
+			trustedKeys:    &TrustedKeys{},
+			certificate:    trusted,
+			signedText:     commit,
+			expectedStatus: StatusUnknownKey,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			signature := createX509Signature(t, tc.certificate, commit, tc.signingTime)
+
+			signedAt := tc.signedAt
+			if signedAt.IsZero() {
+				signedAt = now
+			}
+
+			require.Equal(t, Verdict{
+				Type:           TypeX509,
+				Status:         tc.expectedStatus,
+				KeyFingerprint: fmt.Sprintf("%X", sha256.Sum256(tc.certificate.certificate.Raw)),
+				Signer:         tc.expectedSigner,
+			}, tc.trustedKeys.Verify(signature, tc.signedText, signedAt))
+		})
+	}
+}
+
+func newGpgEntity(tb testing.TB, name string, config *packet.Config) *openpgp.Entity {
+	tb.Helper()
+
+	entity, err := openpgp.NewEntity(name, "", strings.ToLower(name)+"@example.com", config)
+	require.NoError(tb, err)
+
+	return entity
+}
+
+func createGpgSignature(tb testing.TB, entity *openpgp.Entity, signedText []byte, config *packet.Config) []byte {
+	tb.Helper()
+
+	var signature bytes.Buffer
+	require.NoError(tb, openpgp.ArmoredDetachSignText(&signature, entity, bytes.NewReader(signedText), config))
+
+	return signature.Bytes()
+}
+
+// createGpgSignatureAt creates a detached signature with the entity's primary key that claims to have been created
+// at the given time. Contrary to createGpgSignature, the signature is created even if the key isn't valid at that
+// time.
+func createGpgSignatureAt(tb testing.TB, entity *openpgp.Entity, signedText []byte, signedAt time.Time) []byte {
+	tb.Helper()
+
+	sig := &packet.Signature{
+		SigType:      packet.SigTypeBinary,
+		PubKeyAlgo:   entity.PrivateKey.PubKeyAlgo,
+		Hash:         crypto.SHA256,
+		CreationTime: signedAt,
+		IssuerKeyId:  &entity.PrivateKey.KeyId,
+	}
+
+	hash := sig.Hash.New()
+	hash.Write(signedText)
+	require.NoError(tb, sig.Sign(hash, entity.PrivateKey, &packet.Config{}))
+
+	var signature bytes.Buffer
+	writer, err := armor.Encode(&signature, "PGP SIGNATURE", nil)
+	require.NoError(tb, err)
+	require.NoError(tb, sig.Serialize(writer))
+	require.NoError(tb, writer.Close())
+
+	return signature.Bytes()
+}
+
+func gpgFingerprint(entity *openpgp.Entity) string {
+	return fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)
+}
+
+type sshSigner struct {
+	SSHSigningKey
+	authorizedKey string
+}
+
+func newSSHSigner(tb testing.TB) sshSigner {
+	tb.Helper()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(tb, err)
+
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	require.NoError(tb, err)
+
+	return sshSigner{
+		SSHSigningKey: SSHSigningKey{PrivateKey: signer},
+		authorizedKey: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))),
+	}
+}
+
+type x509Certificate struct {
+	certificate *x509.Certificate
+	privateKey  crypto.Signer
+}
+
+type x509CertificateOptions struct {
+	parent   *x509Certificate
+	isCA     bool
+	email    string
+	notAfter time.Time
+}
+
+func newX509Certificate(tb testing.TB, opts x509CertificateOptions) x509Certificate {
+	tb.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(tb, err)
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(tb, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("certificate %d", serialNumber)},
+		NotBefore:             opts.notAfter.Add(-24 * time.Hour),
+		NotAfter:              opts.notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  opts.isCA,
+	}
+	if opts.isCA {
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.EmailAddresses = []string{opts.email}
+	}
+
+	parent, signer := template, crypto.Signer(privateKey)
+	if opts.parent != nil {
+		parent, signer = opts.parent.certificate, opts.parent.privateKey
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, parent, privateKey.Public(), signer)
+	require.NoError(tb, err)
+
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.NoError(tb, err)
+
+	return x509Certificate{certificate: certificate, privateKey: privateKey}
+}
+
+// createX509RevocationList creates a DER-encoded revocation list issued by the certificate authority that revokes
+// the certificate.
+func createX509RevocationList(tb testing.TB, ca, revoked x509Certificate) []byte {
+	tb.Helper()
+
+	revocationList, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Hour),
+		NextUpdate: time.Now().Add(time.Hour),
+		//nolint:staticcheck // RevokedCertificateEntries is only available as of Go 1.21.
+		RevokedCertificates: []pkix.RevokedCertificate{
+			{SerialNumber: revoked.certificate.SerialNumber, RevocationTime: time.Now()},
+		},
+	}, ca.certificate, ca.privateKey)
+	require.NoError(tb, err)
+
+	return revocationList
+}
+
+// createX509Signature creates a detached CMS signature with signed attributes the way gpgsm does. The signing
+// time is only recorded if it is set.
+func createX509Signature(tb testing.TB, certificate x509Certificate, signedText []byte, signingTime time.Time) []byte {
+	tb.Helper()
+
+	digest := sha256.Sum256(signedText)
+	messageDigest, err := asn1.Marshal(digest[:])
+	require.NoError(tb, err)
+
+	attributes := []cmsAttribute{
+		{
+			Type: oidMessageDigest,
+			Values: asn1.RawValue{
+				Tag:        asn1.TagSet,
+				IsCompound: true,
+				Bytes:      messageDigest,
+			},
+		},
+	}
+
+	if !signingTime.IsZero() {
+		encodedSigningTime, err := asn1.Marshal(signingTime.UTC())
+		require.NoError(tb, err)
+
+		attributes = append(attributes, cmsAttribute{
+			Type: oidSigningTime,
+			Values: asn1.RawValue{
+				Tag:        asn1.TagSet,
+				IsCompound: true,
+				Bytes:      encodedSigningTime,
+			},
+		})
+	}
+
+	signedAttributes, err := asn1.MarshalWithParams(attributes, "set")
+	require.NoError(tb, err)
+
+	signedAttributesDigest := sha256.Sum256(signedAttributes)
+	signature, err := certificate.privateKey.Sign(rand.Reader, signedAttributesDigest[:], crypto.SHA256)
+	require.NoError(tb, err)
+
+	signerIdentifier, err := asn1.Marshal(cmsIssuerAndSerialNumber{
+		Issuer:       asn1.RawValue{FullBytes: certificate.certificate.RawIssuer},
+		SerialNumber: certificate.certificate.SerialNumber,
+	})
+	require.NoError(tb, err)
+
+	signedData, err := asn1.Marshal(cmsSignedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: oidSHA256}},
+		EncapContentInfo: cmsEncapsulatedContentInfo{ContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}},
+		Certificates: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        0,
+			IsCompound: true,
+			Bytes:      certificate.certificate.Raw,
+		},
+		SignerInfos: []cmsSignerInfo{
+			{
+				Version:          1,
+				SignerIdentifier: asn1.RawValue{FullBytes: signerIdentifier},
+				DigestAlgorithm:  pkix.AlgorithmIdentifier{Algorithm: oidSHA256},
+				// The signed attributes are embedded with an implicit context-specific tag.
+				SignedAttributes: asn1.RawValue{FullBytes: append([]byte{0xa0}, signedAttributes[1:]...)},
+				SignatureAlgorithm: pkix.AlgorithmIdentifier{
+					Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2},
+				},
+				Signature: signature,
+			},
+		},
+	})
+	require.NoError(tb, err)
+
+	contentInfo, err := asn1.Marshal(cmsContentInfo{
+		ContentType: oidSignedData,
+		Content: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        0,
+			IsCompound: true,
+			Bytes:      signedData,
+		},
+	})
+	require.NoError(tb, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: x509SignatureType, Bytes: contentInfo})
+}
@@ -0,0 +1,388 @@
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// x509SignatureType is the PEM block type of CMS signatures as created by Git's X.509 signing programs.
+const x509SignatureType = "SIGNED MESSAGE"
+
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+)
+
+// The following types are the parts of the CMS SignedData structure that are needed to verify detached
+// signatures as defined in RFC 5652.
+
+type cmsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type cmsSignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo cmsEncapsulatedContentInfo
+	Certificates     asn1.RawValue   `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue   `asn1:"optional,tag:1"`
+	SignerInfos      []cmsSignerInfo `asn1:"set"`
+}
+
+type cmsEncapsulatedContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type cmsSignerInfo struct {
+	Version            int
+	SignerIdentifier   asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttributes   asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttributes asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type cmsIssuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type cmsAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue `asn1:"set"`
+}
+
+// x509CRLType is the PEM block type of certificate revocation lists.
+const x509CRLType = "X509 CRL"
+
+// parseX509TrustedCertificates parses the PEM-encoded certificates of the trusted certificate authorities and the
+// revocation lists issued by them. Blocks of other types are skipped.
+func parseX509TrustedCertificates(data []byte) (*x509.CertPool, []*x509.RevocationList, error) {
+	roots := x509.NewCertPool()
+	var revocationLists []*x509.RevocationList
+
+	var certificates int
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parse certificate: %w", err)
+			}
+
+			roots.AddCert(certificate)
+			certificates++
+		case x509CRLType:
+			revocationList, err := x509.ParseRevocationList(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parse revocation list: %w", err)
+			}
+
+			revocationLists = append(revocationLists, revocationList)
+		}
+	}
+
+	if certificates == 0 {
+		return nil, nil, errors.New("no certificates found")
+	}
+
+	return roots, revocationLists, nil
+}
+
+// parseX509Signature parses an armored CMS signature and returns its signer's information and the
+// certificates embedded in it.
+func parseX509Signature(signatureText []byte) (cmsSignerInfo, []*x509.Certificate, error) {
+	block, _ := pem.Decode(signatureText)
+	if block == nil || block.Type != x509SignatureType {
+		return cmsSignerInfo{}, nil, errors.New("invalid signature text")
+	}
+
+	var contentInfo cmsContentInfo
+	if _, err := asn1.Unmarshal(block.Bytes, &contentInfo); err != nil {
+		return cmsSignerInfo{}, nil, fmt.Errorf("parse content info: %w", err)
+	}
+
+	if !contentInfo.ContentType.Equal(oidSignedData) {
+		return cmsSignerInfo{}, nil, fmt.Errorf("unexpected content type %v", contentInfo.ContentType)
+	}
+
+	var signedData cmsSignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return cmsSignerInfo{}, nil, fmt.Errorf("parse signed data: %w", err)
+	}
+
+	if len(signedData.SignerInfos) != 1 {
+		return cmsSignerInfo{}, nil, fmt.Errorf("expected a single signer, got %d", len(signedData.SignerInfos))
+	}
+
+	certificates, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+	if err != nil {
+		return cmsSignerInfo{}, nil, fmt.Errorf("parse certificates: %w", err)
+	}
+
+	return signedData.SignerInfos[0], certificates, nil
+}
+
+// signerCertificate returns the certificate identified by the signer's issuer and serial number.
+func (si cmsSignerInfo) signerCertificate(certificates []*x509.Certificate) (*x509.Certificate, error) {
+	var issuerAndSerial cmsIssuerAndSerialNumber
+	if _, err := asn1.Unmarshal(si.SignerIdentifier.FullBytes, &issuerAndSerial); err != nil {
+		return nil, fmt.Errorf("parse signer identifier: %w", err)
+	}
+
+	for _, certificate := range certificates {
+		if bytes.Equal(certificate.RawIssuer, issuerAndSerial.Issuer.FullBytes) &&
+			certificate.SerialNumber.Cmp(issuerAndSerial.SerialNumber) == 0 {
+			return certificate, nil
+		}
+	}
+
+	return nil, errors.New("signer certificate not found")
+}
+
+// checkSignature checks that the signer's signature over the signed text has been created with the
+// certificate's key.
+func (si cmsSignerInfo) checkSignature(certificate *x509.Certificate, signedText []byte) error {
+	hash, err := cmsDigestHash(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	digest := hash.New()
+	digest.Write(signedText)
+
+	signedBytes := signedText
+	if len(si.SignedAttributes.FullBytes) > 0 {
+		messageDigest, err := si.messageDigest()
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(messageDigest, digest.Sum(nil)) {
+			return errors.New("message digest mismatch")
+		}
+
+		// The signature is computed over the DER encoding of the signed attributes as a SET rather than
+		// with the implicit tag they are embedded with.
+		signedBytes = append([]byte{0x31}, si.SignedAttributes.FullBytes[1:]...)
+	}
+
+	algorithm, err := cmsSignatureAlgorithm(certificate.PublicKey, hash)
+	if err != nil {
+		return err
+	}
+
+	return certificate.CheckSignature(algorithm, signedBytes, si.Signature)
+}
+
+// signingTime returns the time the signature was created at as recorded in the signed attributes. It returns
+// false if the signing time has not been recorded.
+func (si cmsSignerInfo) signingTime() (time.Time, bool) {
+	if len(si.SignedAttributes.FullBytes) == 0 {
+		return time.Time{}, false
+	}
+
+	var attributes []cmsAttribute
+	if _, err := asn1.UnmarshalWithParams(si.SignedAttributes.FullBytes, &attributes, "set,tag:0"); err != nil {
+		return time.Time{}, false
+	}
+
+	for _, attribute := range attributes {
+		if !attribute.Type.Equal(oidSigningTime) {
+			continue
+		}
+
+		var signingTime time.Time
+		if _, err := asn1.Unmarshal(attribute.Values.Bytes, &signingTime); err != nil {
+			return time.Time{}, false
+		}
+
+		return signingTime, true
+	}
+
+	return time.Time{}, false
+}
+
+// messageDigest returns the digest of the signed text recorded in the signed attributes.
+func (si cmsSignerInfo) messageDigest() ([]byte, error) {
+	var attributes []cmsAttribute
+	if _, err := asn1.UnmarshalWithParams(si.SignedAttributes.FullBytes, &attributes, "set,tag:0"); err != nil {
+		return nil, fmt.Errorf("parse signed attributes: %w", err)
+	}
+
+	for _, attribute := range attributes {
+		if !attribute.Type.Equal(oidMessageDigest) {
+			continue
+		}
+
+		var messageDigest []byte
+		if _, err := asn1.Unmarshal(attribute.Values.Bytes, &messageDigest); err != nil {
+			return nil, fmt.Errorf("parse message digest: %w", err)
+		}
+
+		return messageDigest, nil
+	}
+
+	return nil, errors.New("message digest attribute not found")
+}
+
+func cmsDigestHash(algorithm asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case algorithm.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case algorithm.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case algorithm.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported digest algorithm %v", algorithm)
+	}
+}
+
+// cmsSignatureAlgorithm returns the signature algorithm for the key type and digest. The signature algorithm
+// of the signer information commonly only names the key type, so it is derived from the public key instead.
+func cmsSignatureAlgorithm(publicKey crypto.PublicKey, hash crypto.Hash) (x509.SignatureAlgorithm, error) {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return x509.SHA256WithRSA, nil
+		case crypto.SHA384:
+			return x509.SHA384WithRSA, nil
+		case crypto.SHA512:
+			return x509.SHA512WithRSA, nil
+		}
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return x509.ECDSAWithSHA256, nil
+		case crypto.SHA384:
+			return x509.ECDSAWithSHA384, nil
+		case crypto.SHA512:
+			return x509.ECDSAWithSHA512, nil
+		}
+	case ed25519.PublicKey:
+		return x509.PureEd25519, nil
+	}
+
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported key type %T with digest %v", publicKey, hash)
+}
+
+// verifyX509 verifies an armored CMS signature against the trusted certificate authorities. The validity of the
+// certificates is judged at the time the signature was created. This is the signing time recorded in the signature
+// if there is one, and signedAt otherwise. Certificates listed in a revocation list issued by their authority are
+// considered revoked regardless of the time.
+func (t *TrustedKeys) verifyX509(signatureText, signedText []byte, signedAt time.Time) Verdict {
+	verdict := Verdict{Type: TypeX509, Status: StatusUnverified}
+
+	signerInfo, certificates, err := parseX509Signature(signatureText)
+	if err != nil {
+		return verdict
+	}
+
+	certificate, err := signerInfo.signerCertificate(certificates)
+	if err != nil {
+		return verdict
+	}
+
+	verdict.KeyFingerprint = fmt.Sprintf("%X", sha256.Sum256(certificate.Raw))
+
+	if err := signerInfo.checkSignature(certificate, signedText); err != nil {
+		return verdict
+	}
+
+	if t.x509Roots == nil {
+		verdict.Status = StatusUnknownKey
+		return verdict
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range certificates {
+		if intermediate != certificate {
+			intermediates.AddCert(intermediate)
+		}
+	}
+
+	if signingTime, ok := signerInfo.signingTime(); ok {
+		signedAt = signingTime
+	}
+
+	chains, err := certificate.Verify(x509.VerifyOptions{
+		Roots:         t.x509Roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		var invalidErr x509.CertificateInvalidError
+		switch {
+		case errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired:
+			verdict.Status = StatusExpired
+		case errors.As(err, new(x509.UnknownAuthorityError)):
+			verdict.Status = StatusUnknownKey
+			return verdict
+		default:
+			return verdict
+		}
+	} else if t.x509Revoked(chains[0]) {
+		verdict.Status = StatusRevoked
+	} else {
+		verdict.Status = StatusVerified
+	}
+
+	verdict.Signer = certificate.Subject.String()
+	if len(certificate.EmailAddresses) > 0 {
+		verdict.Signer = certificate.EmailAddresses[0]
+	}
+
+	return verdict
+}
+
+// x509Revoked determines whether any of the certificates of the chain has been revoked by its issuer. Only
+// revocation lists that have been signed by the issuer are taken into account.
+func (t *TrustedKeys) x509Revoked(chain []*x509.Certificate) bool {
+	for i := 0; i+1 < len(chain); i++ {
+		certificate, issuer := chain[i], chain[i+1]
+
+		for _, revocationList := range t.x509RevocationLists {
+			if !bytes.Equal(revocationList.RawIssuer, issuer.RawSubject) ||
+				revocationList.CheckSignatureFrom(issuer) != nil {
+				continue
+			}
+
+			//nolint:staticcheck // RevokedCertificateEntries is only available as of Go 1.21.
+			for _, revoked := range revocationList.RevokedCertificates {
+				if revoked.SerialNumber.Cmp(certificate.SerialNumber) == 0 {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -126,6 +126,15 @@ func (gc *GitalyCfgBuilder) Build(tb testing.TB) config.Cfg {
 
 	cfg.PackObjectsCache.Enabled = gc.packObjectsCacheEnabled
 
+	// Retain the history of the write-ahead log so that tests can read past states of repositories.
+	if cfg.Transactions.HistoryRetention == 0 {
+		cfg.Transactions.HistoryRetention = 1000
+	}
+
+	if cfg.Transactions.Database == "" {
+		cfg.Transactions.Database = testhelper.WALDatabase()
+	}
+
 	// The tests don't require GitLab API to be accessible, but as it is required to pass
 	// validation, so the artificial values are set to pass.
 	if cfg.Gitlab.URL == "" {
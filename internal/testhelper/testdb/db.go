@@ -100,6 +100,10 @@ func (db DB) TruncateAll(tb testing.TB) {
 		"virtual_storages",
 		"repository_assignments",
 		"storage_cleanups",
+		"rebalancing_moves",
+		"rebalancing_plans",
+		"consistency_audit",
+		"draining_storages",
 	)
 }
 
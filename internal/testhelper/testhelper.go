@@ -39,6 +39,12 @@ func IsWALEnabled() bool {
 	return ok
 }
 
+// WALDatabase returns the database the write-ahead log is stored in during this testing run. The
+// default database is used if it is not set.
+func WALDatabase() string {
+	return os.Getenv("GITALY_TEST_WAL_DATABASE")
+}
+
 // SkipWithWAL skips the test if write-ahead logging is enabled in this testing run. A reason
 // should be provided either as a description or a link to an issue to explain why the test is
 // skipped.
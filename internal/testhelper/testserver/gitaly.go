@@ -349,13 +349,17 @@ func (gsd *gitalyServerDeps) createDependencies(tb testing.TB, cfg config.Cfg) *
 
 	var partitionManager *storagemgr.PartitionManager
 	if testhelper.IsWALEnabled() {
-		var err error
+		openDatabase, err := storagemgr.NewDatabaseOpener(cfg.Transactions.Database)
+		require.NoError(tb, err)
+
 		partitionManager, err = storagemgr.NewPartitionManager(
 			cfg.Storages,
 			gsd.gitCmdFactory,
 			gsd.housekeepingManager,
 			localrepo.NewFactory(gsd.locator, gsd.gitCmdFactory, gsd.catfileCache),
 			gsd.logger,
+			storagemgr.WithHistoryRetention(cfg.Transactions.HistoryRetention),
+			storagemgr.WithDatabaseOpener(openDatabase),
 		)
 		require.NoError(tb, err)
 		tb.Cleanup(partitionManager.Close)
@@ -391,7 +391,80 @@ func (x GetCommitSignaturesResponse_Signer) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetCommitSignaturesResponse_Signer.Descriptor instead.
 func (GetCommitSignaturesResponse_Signer) EnumDescriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{46, 0}
+	return file_commit_proto_rawDescGZIP(), []int{49, 0}
+}
+
+// Status is the outcome of the verification.
+type VerifyCommitSignaturesResponse_Verdict_Status int32
+
+const (
+	// STATUS_UNSPECIFIED is the default value and is never returned.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_UNSPECIFIED VerifyCommitSignaturesResponse_Verdict_Status = 0
+	// STATUS_UNSIGNED indicates that the object has no signature.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_UNSIGNED VerifyCommitSignaturesResponse_Verdict_Status = 1
+	// STATUS_VERIFIED indicates that the signature matches the object and has been made by a
+	// trusted key.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_VERIFIED VerifyCommitSignaturesResponse_Verdict_Status = 2
+	// STATUS_UNVERIFIED indicates that the signature doesn't match the object or couldn't be
+	// parsed.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_UNVERIFIED VerifyCommitSignaturesResponse_Verdict_Status = 3
+	// STATUS_UNKNOWN_KEY indicates that the signature has been made by a key that isn't trusted.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_UNKNOWN_KEY VerifyCommitSignaturesResponse_Verdict_Status = 4
+	// STATUS_EXPIRED indicates that the signature has been made by a trusted key that had
+	// expired or wasn't valid yet.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_EXPIRED VerifyCommitSignaturesResponse_Verdict_Status = 5
+	// STATUS_REVOKED indicates that the signature has been made by a trusted key that has been
+	// revoked.
+	VerifyCommitSignaturesResponse_Verdict_STATUS_REVOKED VerifyCommitSignaturesResponse_Verdict_Status = 6
+)
+
+// Enum value maps for VerifyCommitSignaturesResponse_Verdict_Status.
+var (
+	VerifyCommitSignaturesResponse_Verdict_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_UNSIGNED",
+		2: "STATUS_VERIFIED",
+		3: "STATUS_UNVERIFIED",
+		4: "STATUS_UNKNOWN_KEY",
+		5: "STATUS_EXPIRED",
+		6: "STATUS_REVOKED",
+	}
+	VerifyCommitSignaturesResponse_Verdict_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_UNSIGNED":    1,
+		"STATUS_VERIFIED":    2,
+		"STATUS_UNVERIFIED":  3,
+		"STATUS_UNKNOWN_KEY": 4,
+		"STATUS_EXPIRED":     5,
+		"STATUS_REVOKED":     6,
+	}
+)
+
+func (x VerifyCommitSignaturesResponse_Verdict_Status) Enum() *VerifyCommitSignaturesResponse_Verdict_Status {
+	p := new(VerifyCommitSignaturesResponse_Verdict_Status)
+	*p = x
+	return p
+}
+
+func (x VerifyCommitSignaturesResponse_Verdict_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerifyCommitSignaturesResponse_Verdict_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_commit_proto_enumTypes[7].Descriptor()
+}
+
+func (VerifyCommitSignaturesResponse_Verdict_Status) Type() protoreflect.EnumType {
+	return &file_commit_proto_enumTypes[7]
+}
+
+func (x VerifyCommitSignaturesResponse_Verdict_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerifyCommitSignaturesResponse_Verdict_Status.Descriptor instead.
+func (VerifyCommitSignaturesResponse_Verdict_Status) EnumDescriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{51, 0, 0}
 }
 
 // ListCommitsRequest is a request for the ListCommits RPC.
@@ -2930,6 +3003,251 @@ func (*RawBlameError_PathNotFound) isRawBlameError_Error() {}
 
 func (*RawBlameError_OutOfRange) isRawBlameError_Error() {}
 
+// BlameRequest is a request for the Blame RPC.
+type BlameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository is the repository where to perform the blame.
+	Repository *Repository `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Revision is the committish at which to start the blame.
+	Revision []byte `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Path is the path of the blob that should be blamed.
+	Path []byte `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// IgnoreRevisionsPath is the path of a blob in Revision that lists revisions to be ignored when blaming, for
+	// example ".git-blame-ignore-revs". The blob uses the same format as the file passed to the
+	// `--ignore-revs-file` option of git-blame(1): one full object ID per line, with comments starting with "#".
+	// Optional - if no path is provided, no revisions will be ignored.
+	IgnoreRevisionsPath []byte `protobuf:"bytes,4,opt,name=ignore_revisions_path,json=ignoreRevisionsPath,proto3" json:"ignore_revisions_path,omitempty"`
+	// PaginationParams allows to paginate the line ranges. The limit is the maximum number of line ranges to
+	// return and the page token is the cursor returned by a previous call. The whole file will be blamed if no
+	// pagination parameters are provided.
+	PaginationParams *PaginationParameter `protobuf:"bytes,5,opt,name=pagination_params,json=paginationParams,proto3" json:"pagination_params,omitempty"`
+}
+
+func (x *BlameRequest) Reset() {
+	*x = BlameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlameRequest) ProtoMessage() {}
+
+func (x *BlameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlameRequest.ProtoReflect.Descriptor instead.
+func (*BlameRequest) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BlameRequest) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *BlameRequest) GetRevision() []byte {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *BlameRequest) GetPath() []byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *BlameRequest) GetIgnoreRevisionsPath() []byte {
+	if x != nil {
+		return x.IgnoreRevisionsPath
+	}
+	return nil
+}
+
+func (x *BlameRequest) GetPaginationParams() *PaginationParameter {
+	if x != nil {
+		return x.PaginationParams
+	}
+	return nil
+}
+
+// BlameResponse is a response for the Blame RPC. The line ranges are returned in order of their line numbers
+// and will be chunked into multiple messages.
+type BlameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commits are the commits the line ranges of this message refer to that haven't been returned by any
+	// previous message of the same call.
+	Commits []*BlameResponse_Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	// Ranges are the blamed line ranges.
+	Ranges []*BlameResponse_Range `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// PaginationCursor is set on the last message in case there are more line ranges to be blamed. Its next
+	// cursor should be passed as page token to retrieve the next page.
+	PaginationCursor *PaginationCursor `protobuf:"bytes,3,opt,name=pagination_cursor,json=paginationCursor,proto3" json:"pagination_cursor,omitempty"`
+}
+
+func (x *BlameResponse) Reset() {
+	*x = BlameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlameResponse) ProtoMessage() {}
+
+func (x *BlameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlameResponse.ProtoReflect.Descriptor instead.
+func (*BlameResponse) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BlameResponse) GetCommits() []*BlameResponse_Commit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *BlameResponse) GetRanges() []*BlameResponse_Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *BlameResponse) GetPaginationCursor() *PaginationCursor {
+	if x != nil {
+		return x.PaginationCursor
+	}
+	return nil
+}
+
+// BlameError is used as error detail when the Blame RPC fails in a specific way.
+type BlameError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Error:
+	//
+	//	*BlameError_PathNotFound
+	//	*BlameError_IgnoreRevisionsPathNotFound
+	Error isBlameError_Error `protobuf_oneof:"error"`
+}
+
+func (x *BlameError) Reset() {
+	*x = BlameError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlameError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlameError) ProtoMessage() {}
+
+func (x *BlameError) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlameError.ProtoReflect.Descriptor instead.
+func (*BlameError) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *BlameError) GetError() isBlameError_Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (x *BlameError) GetPathNotFound() *PathNotFoundError {
+	if x, ok := x.GetError().(*BlameError_PathNotFound); ok {
+		return x.PathNotFound
+	}
+	return nil
+}
+
+func (x *BlameError) GetIgnoreRevisionsPathNotFound() *PathNotFoundError {
+	if x, ok := x.GetError().(*BlameError_IgnoreRevisionsPathNotFound); ok {
+		return x.IgnoreRevisionsPathNotFound
+	}
+	return nil
+}
+
+type isBlameError_Error interface {
+	isBlameError_Error()
+}
+
+type BlameError_PathNotFound struct {
+	// PathNotFound is returned when the blamed path cannot be found in the revision.
+	PathNotFound *PathNotFoundError `protobuf:"bytes,1,opt,name=path_not_found,json=pathNotFound,proto3,oneof"`
+}
+
+type BlameError_IgnoreRevisionsPathNotFound struct {
+	// IgnoreRevisionsPathNotFound is returned when the path of the ignored revisions cannot be found in the
+	// revision.
+	IgnoreRevisionsPathNotFound *PathNotFoundError `protobuf:"bytes,2,opt,name=ignore_revisions_path_not_found,json=ignoreRevisionsPathNotFound,proto3,oneof"`
+}
+
+func (*BlameError_PathNotFound) isBlameError_Error() {}
+
+func (*BlameError_IgnoreRevisionsPathNotFound) isBlameError_Error() {}
+
 // LastCommitForPathRequest is a request for the LastCommitForPath RPC.
 type LastCommitForPathRequest struct {
 	state         protoimpl.MessageState
@@ -2954,7 +3272,7 @@ type LastCommitForPathRequest struct {
 func (x *LastCommitForPathRequest) Reset() {
 	*x = LastCommitForPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[35]
+		mi := &file_commit_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2967,7 +3285,7 @@ func (x *LastCommitForPathRequest) String() string {
 func (*LastCommitForPathRequest) ProtoMessage() {}
 
 func (x *LastCommitForPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[35]
+	mi := &file_commit_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,7 +3298,7 @@ func (x *LastCommitForPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LastCommitForPathRequest.ProtoReflect.Descriptor instead.
 func (*LastCommitForPathRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{35}
+	return file_commit_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *LastCommitForPathRequest) GetRepository() *Repository {
@@ -3031,7 +3349,7 @@ type LastCommitForPathResponse struct {
 func (x *LastCommitForPathResponse) Reset() {
 	*x = LastCommitForPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[36]
+		mi := &file_commit_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3044,7 +3362,7 @@ func (x *LastCommitForPathResponse) String() string {
 func (*LastCommitForPathResponse) ProtoMessage() {}
 
 func (x *LastCommitForPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[36]
+	mi := &file_commit_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3057,7 +3375,7 @@ func (x *LastCommitForPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LastCommitForPathResponse.ProtoReflect.Descriptor instead.
 func (*LastCommitForPathResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{36}
+	return file_commit_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *LastCommitForPathResponse) GetCommit() *GitCommit {
@@ -3094,7 +3412,7 @@ type ListLastCommitsForTreeRequest struct {
 func (x *ListLastCommitsForTreeRequest) Reset() {
 	*x = ListLastCommitsForTreeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[37]
+		mi := &file_commit_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3107,7 +3425,7 @@ func (x *ListLastCommitsForTreeRequest) String() string {
 func (*ListLastCommitsForTreeRequest) ProtoMessage() {}
 
 func (x *ListLastCommitsForTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[37]
+	mi := &file_commit_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3120,7 +3438,7 @@ func (x *ListLastCommitsForTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLastCommitsForTreeRequest.ProtoReflect.Descriptor instead.
 func (*ListLastCommitsForTreeRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{37}
+	return file_commit_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListLastCommitsForTreeRequest) GetRepository() *Repository {
@@ -3186,7 +3504,7 @@ type ListLastCommitsForTreeResponse struct {
 func (x *ListLastCommitsForTreeResponse) Reset() {
 	*x = ListLastCommitsForTreeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[38]
+		mi := &file_commit_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3199,7 +3517,7 @@ func (x *ListLastCommitsForTreeResponse) String() string {
 func (*ListLastCommitsForTreeResponse) ProtoMessage() {}
 
 func (x *ListLastCommitsForTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[38]
+	mi := &file_commit_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3212,7 +3530,7 @@ func (x *ListLastCommitsForTreeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLastCommitsForTreeResponse.ProtoReflect.Descriptor instead.
 func (*ListLastCommitsForTreeResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{38}
+	return file_commit_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListLastCommitsForTreeResponse) GetCommits() []*ListLastCommitsForTreeResponse_CommitForTree {
@@ -3247,7 +3565,7 @@ type CommitsByMessageRequest struct {
 func (x *CommitsByMessageRequest) Reset() {
 	*x = CommitsByMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[39]
+		mi := &file_commit_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3260,7 +3578,7 @@ func (x *CommitsByMessageRequest) String() string {
 func (*CommitsByMessageRequest) ProtoMessage() {}
 
 func (x *CommitsByMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[39]
+	mi := &file_commit_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3273,7 +3591,7 @@ func (x *CommitsByMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitsByMessageRequest.ProtoReflect.Descriptor instead.
 func (*CommitsByMessageRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{39}
+	return file_commit_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CommitsByMessageRequest) GetRepository() *Repository {
@@ -3338,7 +3656,7 @@ type CommitsByMessageResponse struct {
 func (x *CommitsByMessageResponse) Reset() {
 	*x = CommitsByMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[40]
+		mi := &file_commit_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3351,7 +3669,7 @@ func (x *CommitsByMessageResponse) String() string {
 func (*CommitsByMessageResponse) ProtoMessage() {}
 
 func (x *CommitsByMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[40]
+	mi := &file_commit_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3364,7 +3682,7 @@ func (x *CommitsByMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitsByMessageResponse.ProtoReflect.Descriptor instead.
 func (*CommitsByMessageResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{40}
+	return file_commit_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CommitsByMessageResponse) GetCommits() []*GitCommit {
@@ -3389,7 +3707,7 @@ type FilterShasWithSignaturesRequest struct {
 func (x *FilterShasWithSignaturesRequest) Reset() {
 	*x = FilterShasWithSignaturesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[41]
+		mi := &file_commit_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3402,7 +3720,7 @@ func (x *FilterShasWithSignaturesRequest) String() string {
 func (*FilterShasWithSignaturesRequest) ProtoMessage() {}
 
 func (x *FilterShasWithSignaturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[41]
+	mi := &file_commit_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,7 +3733,7 @@ func (x *FilterShasWithSignaturesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterShasWithSignaturesRequest.ProtoReflect.Descriptor instead.
 func (*FilterShasWithSignaturesRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{41}
+	return file_commit_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *FilterShasWithSignaturesRequest) GetRepository() *Repository {
@@ -3445,7 +3763,7 @@ type FilterShasWithSignaturesResponse struct {
 func (x *FilterShasWithSignaturesResponse) Reset() {
 	*x = FilterShasWithSignaturesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[42]
+		mi := &file_commit_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3458,7 +3776,7 @@ func (x *FilterShasWithSignaturesResponse) String() string {
 func (*FilterShasWithSignaturesResponse) ProtoMessage() {}
 
 func (x *FilterShasWithSignaturesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[42]
+	mi := &file_commit_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3471,7 +3789,7 @@ func (x *FilterShasWithSignaturesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterShasWithSignaturesResponse.ProtoReflect.Descriptor instead.
 func (*FilterShasWithSignaturesResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{42}
+	return file_commit_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FilterShasWithSignaturesResponse) GetShas() [][]byte {
@@ -3496,7 +3814,7 @@ type ExtractCommitSignatureRequest struct {
 func (x *ExtractCommitSignatureRequest) Reset() {
 	*x = ExtractCommitSignatureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[43]
+		mi := &file_commit_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3509,7 +3827,7 @@ func (x *ExtractCommitSignatureRequest) String() string {
 func (*ExtractCommitSignatureRequest) ProtoMessage() {}
 
 func (x *ExtractCommitSignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[43]
+	mi := &file_commit_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,7 +3840,7 @@ func (x *ExtractCommitSignatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractCommitSignatureRequest.ProtoReflect.Descriptor instead.
 func (*ExtractCommitSignatureRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{43}
+	return file_commit_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ExtractCommitSignatureRequest) GetRepository() *Repository {
@@ -3555,7 +3873,7 @@ type ExtractCommitSignatureResponse struct {
 func (x *ExtractCommitSignatureResponse) Reset() {
 	*x = ExtractCommitSignatureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[44]
+		mi := &file_commit_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3568,7 +3886,7 @@ func (x *ExtractCommitSignatureResponse) String() string {
 func (*ExtractCommitSignatureResponse) ProtoMessage() {}
 
 func (x *ExtractCommitSignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[44]
+	mi := &file_commit_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3581,7 +3899,7 @@ func (x *ExtractCommitSignatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractCommitSignatureResponse.ProtoReflect.Descriptor instead.
 func (*ExtractCommitSignatureResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{44}
+	return file_commit_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ExtractCommitSignatureResponse) GetSignature() []byte {
@@ -3613,7 +3931,7 @@ type GetCommitSignaturesRequest struct {
 func (x *GetCommitSignaturesRequest) Reset() {
 	*x = GetCommitSignaturesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[45]
+		mi := &file_commit_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3626,7 +3944,7 @@ func (x *GetCommitSignaturesRequest) String() string {
 func (*GetCommitSignaturesRequest) ProtoMessage() {}
 
 func (x *GetCommitSignaturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[45]
+	mi := &file_commit_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3639,7 +3957,7 @@ func (x *GetCommitSignaturesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommitSignaturesRequest.ProtoReflect.Descriptor instead.
 func (*GetCommitSignaturesRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{45}
+	return file_commit_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetCommitSignaturesRequest) GetRepository() *Repository {
@@ -3675,7 +3993,7 @@ type GetCommitSignaturesResponse struct {
 func (x *GetCommitSignaturesResponse) Reset() {
 	*x = GetCommitSignaturesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[46]
+		mi := &file_commit_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3688,7 +4006,7 @@ func (x *GetCommitSignaturesResponse) String() string {
 func (*GetCommitSignaturesResponse) ProtoMessage() {}
 
 func (x *GetCommitSignaturesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[46]
+	mi := &file_commit_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3701,7 +4019,7 @@ func (x *GetCommitSignaturesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommitSignaturesResponse.ProtoReflect.Descriptor instead.
 func (*GetCommitSignaturesResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{46}
+	return file_commit_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetCommitSignaturesResponse) GetCommitId() string {
@@ -3732,6 +4050,123 @@ func (x *GetCommitSignaturesResponse) GetSigner() GetCommitSignaturesResponse_Si
 	return GetCommitSignaturesResponse_SIGNER_UNSPECIFIED
 }
 
+// VerifyCommitSignaturesRequest is a request for the VerifyCommitSignatures RPC.
+type VerifyCommitSignaturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repository is the repository the objects are verified in.
+	Repository *Repository `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// object_ids are the full object IDs of the commits and annotated tags to verify.
+	ObjectIds []string `protobuf:"bytes,2,rep,name=object_ids,json=objectIds,proto3" json:"object_ids,omitempty"`
+	// trusted_keys are the keys to verify signatures against. Keys that are not set fall back to
+	// the keys configured for the repository's storage.
+	TrustedKeys *VerifyCommitSignaturesRequest_TrustedKeys `protobuf:"bytes,3,opt,name=trusted_keys,json=trustedKeys,proto3" json:"trusted_keys,omitempty"`
+}
+
+func (x *VerifyCommitSignaturesRequest) Reset() {
+	*x = VerifyCommitSignaturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCommitSignaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCommitSignaturesRequest) ProtoMessage() {}
+
+func (x *VerifyCommitSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCommitSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCommitSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VerifyCommitSignaturesRequest) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *VerifyCommitSignaturesRequest) GetObjectIds() []string {
+	if x != nil {
+		return x.ObjectIds
+	}
+	return nil
+}
+
+func (x *VerifyCommitSignaturesRequest) GetTrustedKeys() *VerifyCommitSignaturesRequest_TrustedKeys {
+	if x != nil {
+		return x.TrustedKeys
+	}
+	return nil
+}
+
+// VerifyCommitSignaturesResponse is a response for the VerifyCommitSignatures RPC.
+type VerifyCommitSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// verdicts are the verdicts of the verified objects in the order they were requested.
+	Verdicts []*VerifyCommitSignaturesResponse_Verdict `protobuf:"bytes,1,rep,name=verdicts,proto3" json:"verdicts,omitempty"`
+}
+
+func (x *VerifyCommitSignaturesResponse) Reset() {
+	*x = VerifyCommitSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCommitSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCommitSignaturesResponse) ProtoMessage() {}
+
+func (x *VerifyCommitSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCommitSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*VerifyCommitSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VerifyCommitSignaturesResponse) GetVerdicts() []*VerifyCommitSignaturesResponse_Verdict {
+	if x != nil {
+		return x.Verdicts
+	}
+	return nil
+}
+
 // This comment is left unintentionally blank.
 type GetCommitMessagesRequest struct {
 	state         protoimpl.MessageState
@@ -3747,7 +4182,7 @@ type GetCommitMessagesRequest struct {
 func (x *GetCommitMessagesRequest) Reset() {
 	*x = GetCommitMessagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[47]
+		mi := &file_commit_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3760,7 +4195,7 @@ func (x *GetCommitMessagesRequest) String() string {
 func (*GetCommitMessagesRequest) ProtoMessage() {}
 
 func (x *GetCommitMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[47]
+	mi := &file_commit_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3773,7 +4208,7 @@ func (x *GetCommitMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommitMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetCommitMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{47}
+	return file_commit_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetCommitMessagesRequest) GetRepository() *Repository {
@@ -3805,7 +4240,7 @@ type GetCommitMessagesResponse struct {
 func (x *GetCommitMessagesResponse) Reset() {
 	*x = GetCommitMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[48]
+		mi := &file_commit_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3818,7 +4253,7 @@ func (x *GetCommitMessagesResponse) String() string {
 func (*GetCommitMessagesResponse) ProtoMessage() {}
 
 func (x *GetCommitMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[48]
+	mi := &file_commit_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +4266,7 @@ func (x *GetCommitMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommitMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetCommitMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{48}
+	return file_commit_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetCommitMessagesResponse) GetCommitId() string {
@@ -3867,7 +4302,7 @@ type CheckObjectsExistRequest struct {
 func (x *CheckObjectsExistRequest) Reset() {
 	*x = CheckObjectsExistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[49]
+		mi := &file_commit_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3880,7 +4315,7 @@ func (x *CheckObjectsExistRequest) String() string {
 func (*CheckObjectsExistRequest) ProtoMessage() {}
 
 func (x *CheckObjectsExistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[49]
+	mi := &file_commit_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3893,7 +4328,7 @@ func (x *CheckObjectsExistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckObjectsExistRequest.ProtoReflect.Descriptor instead.
 func (*CheckObjectsExistRequest) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{49}
+	return file_commit_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *CheckObjectsExistRequest) GetRepository() *Repository {
@@ -3923,7 +4358,7 @@ type CheckObjectsExistResponse struct {
 func (x *CheckObjectsExistResponse) Reset() {
 	*x = CheckObjectsExistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[50]
+		mi := &file_commit_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3936,7 +4371,7 @@ func (x *CheckObjectsExistResponse) String() string {
 func (*CheckObjectsExistResponse) ProtoMessage() {}
 
 func (x *CheckObjectsExistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[50]
+	mi := &file_commit_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,7 +4384,7 @@ func (x *CheckObjectsExistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckObjectsExistResponse.ProtoReflect.Descriptor instead.
 func (*CheckObjectsExistResponse) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{50}
+	return file_commit_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *CheckObjectsExistResponse) GetRevisions() []*CheckObjectsExistResponse_RevisionExistence {
@@ -3974,7 +4409,7 @@ type ListCommitsByRefNameResponse_CommitForRef struct {
 func (x *ListCommitsByRefNameResponse_CommitForRef) Reset() {
 	*x = ListCommitsByRefNameResponse_CommitForRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[51]
+		mi := &file_commit_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3987,7 +4422,7 @@ func (x *ListCommitsByRefNameResponse_CommitForRef) String() string {
 func (*ListCommitsByRefNameResponse_CommitForRef) ProtoMessage() {}
 
 func (x *ListCommitsByRefNameResponse_CommitForRef) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[51]
+	mi := &file_commit_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4039,7 +4474,7 @@ type CommitLanguagesResponse_Language struct {
 func (x *CommitLanguagesResponse_Language) Reset() {
 	*x = CommitLanguagesResponse_Language{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[52]
+		mi := &file_commit_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4052,7 +4487,7 @@ func (x *CommitLanguagesResponse_Language) String() string {
 func (*CommitLanguagesResponse_Language) ProtoMessage() {}
 
 func (x *CommitLanguagesResponse_Language) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[52]
+	mi := &file_commit_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4110,7 +4545,7 @@ type RawBlameError_OutOfRangeError struct {
 func (x *RawBlameError_OutOfRangeError) Reset() {
 	*x = RawBlameError_OutOfRangeError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[53]
+		mi := &file_commit_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4123,7 +4558,314 @@ func (x *RawBlameError_OutOfRangeError) String() string {
 func (*RawBlameError_OutOfRangeError) ProtoMessage() {}
 
 func (x *RawBlameError_OutOfRangeError) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[53]
+	mi := &file_commit_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawBlameError_OutOfRangeError.ProtoReflect.Descriptor instead.
+func (*RawBlameError_OutOfRangeError) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *RawBlameError_OutOfRangeError) GetActualLines() uint64 {
+	if x != nil {
+		return x.ActualLines
+	}
+	return 0
+}
+
+// Commit is a commit that line ranges have been blamed to.
+type BlameResponse_Commit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the object ID of the commit.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Author is the author of the commit.
+	Author *CommitAuthor `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// Committer is the committer of the commit.
+	Committer *CommitAuthor `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
+	// Summary is the first line of the commit message.
+	Summary []byte `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// PreviousCommitId is the object ID of the parent commit the blamed lines have been changed in relation
+	// to. It is unset if the commit is a root commit.
+	PreviousCommitId string `protobuf:"bytes,5,opt,name=previous_commit_id,json=previousCommitId,proto3" json:"previous_commit_id,omitempty"`
+	// PreviousPath is the path of the blamed file in the previous commit.
+	PreviousPath []byte `protobuf:"bytes,6,opt,name=previous_path,json=previousPath,proto3" json:"previous_path,omitempty"`
+	// Boundary is set if the commit is the boundary of the blame, that is it is a root commit.
+	Boundary bool `protobuf:"varint,7,opt,name=boundary,proto3" json:"boundary,omitempty"`
+}
+
+func (x *BlameResponse_Commit) Reset() {
+	*x = BlameResponse_Commit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlameResponse_Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlameResponse_Commit) ProtoMessage() {}
+
+func (x *BlameResponse_Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlameResponse_Commit.ProtoReflect.Descriptor instead.
+func (*BlameResponse_Commit) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *BlameResponse_Commit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlameResponse_Commit) GetAuthor() *CommitAuthor {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *BlameResponse_Commit) GetCommitter() *CommitAuthor {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+func (x *BlameResponse_Commit) GetSummary() []byte {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *BlameResponse_Commit) GetPreviousCommitId() string {
+	if x != nil {
+		return x.PreviousCommitId
+	}
+	return ""
+}
+
+func (x *BlameResponse_Commit) GetPreviousPath() []byte {
+	if x != nil {
+		return x.PreviousPath
+	}
+	return nil
+}
+
+func (x *BlameResponse_Commit) GetBoundary() bool {
+	if x != nil {
+		return x.Boundary
+	}
+	return false
+}
+
+// Range is a range of consecutive lines that have been blamed to the same commit.
+type BlameResponse_Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommitId is the object ID of the commit the lines have been blamed to.
+	CommitId string `protobuf:"bytes,1,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	// StartLine is the 1-based number of the first line of the range in the blamed file.
+	StartLine uint64 `protobuf:"varint,2,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	// LineCount is the number of lines in the range.
+	LineCount uint64 `protobuf:"varint,3,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	// OriginalStartLine is the 1-based number of the first line of the range in the commit the lines have
+	// been blamed to.
+	OriginalStartLine uint64 `protobuf:"varint,4,opt,name=original_start_line,json=originalStartLine,proto3" json:"original_start_line,omitempty"`
+	// OriginalPath is the path of the file in the commit the lines have been blamed to.
+	OriginalPath []byte `protobuf:"bytes,5,opt,name=original_path,json=originalPath,proto3" json:"original_path,omitempty"`
+}
+
+func (x *BlameResponse_Range) Reset() {
+	*x = BlameResponse_Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlameResponse_Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlameResponse_Range) ProtoMessage() {}
+
+func (x *BlameResponse_Range) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlameResponse_Range.ProtoReflect.Descriptor instead.
+func (*BlameResponse_Range) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *BlameResponse_Range) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *BlameResponse_Range) GetStartLine() uint64 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *BlameResponse_Range) GetLineCount() uint64 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *BlameResponse_Range) GetOriginalStartLine() uint64 {
+	if x != nil {
+		return x.OriginalStartLine
+	}
+	return 0
+}
+
+func (x *BlameResponse_Range) GetOriginalPath() []byte {
+	if x != nil {
+		return x.OriginalPath
+	}
+	return nil
+}
+
+// This comment is left unintentionally blank.
+type ListLastCommitsForTreeResponse_CommitForTree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// This comment is left unintentionally blank.
+	Commit *GitCommit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// This comment is left unintentionally blank.
+	PathBytes []byte `protobuf:"bytes,4,opt,name=path_bytes,json=pathBytes,proto3" json:"path_bytes,omitempty"`
+}
+
+func (x *ListLastCommitsForTreeResponse_CommitForTree) Reset() {
+	*x = ListLastCommitsForTreeResponse_CommitForTree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLastCommitsForTreeResponse_CommitForTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLastCommitsForTreeResponse_CommitForTree) ProtoMessage() {}
+
+func (x *ListLastCommitsForTreeResponse_CommitForTree) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLastCommitsForTreeResponse_CommitForTree.ProtoReflect.Descriptor instead.
+func (*ListLastCommitsForTreeResponse_CommitForTree) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *ListLastCommitsForTreeResponse_CommitForTree) GetCommit() *GitCommit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *ListLastCommitsForTreeResponse_CommitForTree) GetPathBytes() []byte {
+	if x != nil {
+		return x.PathBytes
+	}
+	return nil
+}
+
+// TrustedKeys are the keys signatures are verified against. Each of them is optional.
+type VerifyCommitSignaturesRequest_TrustedKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gpg_keyring is an armored or binary OpenPGP keyring of the trusted public keys.
+	GpgKeyring []byte `protobuf:"bytes,1,opt,name=gpg_keyring,json=gpgKeyring,proto3" json:"gpg_keyring,omitempty"`
+	// ssh_allowed_signers are the trusted SSH keys in the format of Git's
+	// `gpg.ssh.allowedSignersFile`. Namespace restrictions and validity periods are honored.
+	// Certificate authorities are not supported.
+	SshAllowedSigners []byte `protobuf:"bytes,2,opt,name=ssh_allowed_signers,json=sshAllowedSigners,proto3" json:"ssh_allowed_signers,omitempty"`
+	// ssh_revoked_keys are the revoked SSH public keys in the `authorized_keys` format.
+	SshRevokedKeys []byte `protobuf:"bytes,3,opt,name=ssh_revoked_keys,json=sshRevokedKeys,proto3" json:"ssh_revoked_keys,omitempty"`
+	// x509_ca_certificates are the PEM-encoded certificates of the trusted certificate authorities. They
+	// may be followed by PEM-encoded certificate revocation lists issued by the authorities. Certificates
+	// listed in a revocation list are reported as revoked.
+	X509CaCertificates []byte `protobuf:"bytes,4,opt,name=x509_ca_certificates,json=x509CaCertificates,proto3" json:"x509_ca_certificates,omitempty"`
+}
+
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) Reset() {
+	*x = VerifyCommitSignaturesRequest_TrustedKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCommitSignaturesRequest_TrustedKeys) ProtoMessage() {}
+
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4134,47 +4876,78 @@ func (x *RawBlameError_OutOfRangeError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RawBlameError_OutOfRangeError.ProtoReflect.Descriptor instead.
-func (*RawBlameError_OutOfRangeError) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{34, 0}
+// Deprecated: Use VerifyCommitSignaturesRequest_TrustedKeys.ProtoReflect.Descriptor instead.
+func (*VerifyCommitSignaturesRequest_TrustedKeys) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) GetGpgKeyring() []byte {
+	if x != nil {
+		return x.GpgKeyring
+	}
+	return nil
+}
+
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) GetSshAllowedSigners() []byte {
+	if x != nil {
+		return x.SshAllowedSigners
+	}
+	return nil
+}
+
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) GetSshRevokedKeys() []byte {
+	if x != nil {
+		return x.SshRevokedKeys
+	}
+	return nil
 }
 
-func (x *RawBlameError_OutOfRangeError) GetActualLines() uint64 {
+func (x *VerifyCommitSignaturesRequest_TrustedKeys) GetX509CaCertificates() []byte {
 	if x != nil {
-		return x.ActualLines
+		return x.X509CaCertificates
 	}
-	return 0
+	return nil
 }
 
-// This comment is left unintentionally blank.
-type ListLastCommitsForTreeResponse_CommitForTree struct {
+// Verdict is the outcome of verifying the signature of a single object.
+type VerifyCommitSignaturesResponse_Verdict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// This comment is left unintentionally blank.
-	Commit *GitCommit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
-	// This comment is left unintentionally blank.
-	PathBytes []byte `protobuf:"bytes,4,opt,name=path_bytes,json=pathBytes,proto3" json:"path_bytes,omitempty"`
-}
-
-func (x *ListLastCommitsForTreeResponse_CommitForTree) Reset() {
-	*x = ListLastCommitsForTreeResponse_CommitForTree{}
+	// object_id is the ID of the commit or tag the verdict is for.
+	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// signature_type is the type of the object's signature.
+	SignatureType SignatureType `protobuf:"varint,2,opt,name=signature_type,json=signatureType,proto3,enum=gitaly.SignatureType" json:"signature_type,omitempty"`
+	// status is the outcome of the verification.
+	Status VerifyCommitSignaturesResponse_Verdict_Status `protobuf:"varint,3,opt,name=status,proto3,enum=gitaly.VerifyCommitSignaturesResponse_Verdict_Status" json:"status,omitempty"`
+	// key_fingerprint is the fingerprint of the key that made the signature. It is the OpenPGP
+	// fingerprint for GPG keys, the SHA256 fingerprint as printed by ssh-keygen for SSH keys and
+	// the hex-encoded SHA256 digest of the certificate for X.509 signatures.
+	KeyFingerprint string `protobuf:"bytes,4,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"`
+	// signer is the identity the trusted key belongs to. It is the key's primary user ID for GPG
+	// keys, the principals for SSH keys and the certificate's email address or subject for X.509
+	// signatures. It is only set if the key is trusted.
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *VerifyCommitSignaturesResponse_Verdict) Reset() {
+	*x = VerifyCommitSignaturesResponse_Verdict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[54]
+		mi := &file_commit_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLastCommitsForTreeResponse_CommitForTree) String() string {
+func (x *VerifyCommitSignaturesResponse_Verdict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLastCommitsForTreeResponse_CommitForTree) ProtoMessage() {}
+func (*VerifyCommitSignaturesResponse_Verdict) ProtoMessage() {}
 
-func (x *ListLastCommitsForTreeResponse_CommitForTree) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[54]
+func (x *VerifyCommitSignaturesResponse_Verdict) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4185,23 +4958,44 @@ func (x *ListLastCommitsForTreeResponse_CommitForTree) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLastCommitsForTreeResponse_CommitForTree.ProtoReflect.Descriptor instead.
-func (*ListLastCommitsForTreeResponse_CommitForTree) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{38, 0}
+// Deprecated: Use VerifyCommitSignaturesResponse_Verdict.ProtoReflect.Descriptor instead.
+func (*VerifyCommitSignaturesResponse_Verdict) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{51, 0}
 }
 
-func (x *ListLastCommitsForTreeResponse_CommitForTree) GetCommit() *GitCommit {
+func (x *VerifyCommitSignaturesResponse_Verdict) GetObjectId() string {
 	if x != nil {
-		return x.Commit
+		return x.ObjectId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListLastCommitsForTreeResponse_CommitForTree) GetPathBytes() []byte {
+func (x *VerifyCommitSignaturesResponse_Verdict) GetSignatureType() SignatureType {
 	if x != nil {
-		return x.PathBytes
+		return x.SignatureType
 	}
-	return nil
+	return SignatureType_NONE
+}
+
+func (x *VerifyCommitSignaturesResponse_Verdict) GetStatus() VerifyCommitSignaturesResponse_Verdict_Status {
+	if x != nil {
+		return x.Status
+	}
+	return VerifyCommitSignaturesResponse_Verdict_STATUS_UNSPECIFIED
+}
+
+func (x *VerifyCommitSignaturesResponse_Verdict) GetKeyFingerprint() string {
+	if x != nil {
+		return x.KeyFingerprint
+	}
+	return ""
+}
+
+func (x *VerifyCommitSignaturesResponse_Verdict) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
 }
 
 // This comment is left unintentionally blank.
@@ -4219,7 +5013,7 @@ type CheckObjectsExistResponse_RevisionExistence struct {
 func (x *CheckObjectsExistResponse_RevisionExistence) Reset() {
 	*x = CheckObjectsExistResponse_RevisionExistence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commit_proto_msgTypes[55]
+		mi := &file_commit_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4232,7 +5026,7 @@ func (x *CheckObjectsExistResponse_RevisionExistence) String() string {
 func (*CheckObjectsExistResponse_RevisionExistence) ProtoMessage() {}
 
 func (x *CheckObjectsExistResponse_RevisionExistence) ProtoReflect() protoreflect.Message {
-	mi := &file_commit_proto_msgTypes[55]
+	mi := &file_commit_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4245,7 +5039,7 @@ func (x *CheckObjectsExistResponse_RevisionExistence) ProtoReflect() protoreflec
 
 // Deprecated: Use CheckObjectsExistResponse_RevisionExistence.ProtoReflect.Descriptor instead.
 func (*CheckObjectsExistResponse_RevisionExistence) Descriptor() ([]byte, []int) {
-	return file_commit_proto_rawDescGZIP(), []int{50, 0}
+	return file_commit_proto_rawDescGZIP(), []int{55, 0}
 }
 
 func (x *CheckObjectsExistResponse_RevisionExistence) GetName() []byte {
@@ -4600,326 +5394,465 @@ var file_commit_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x69, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x6e,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x74,
 	0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f,
 	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
 	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
 	0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd5,
-	0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x73, 0x1a, 0x72, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x42, 0x6c,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x52, 0x61,
-	0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0xdc, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x6f, 0x74,
-	0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
-	0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x4e,
-	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x5f, 0x6f,
-	0x66, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x1a, 0x34, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f,
-	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74,
-	0x75, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0xed, 0x01, 0x0a, 0x18, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xa8, 0xc6, 0x2c, 0x01, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd5, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x09, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x72, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
+	0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x97, 0x01, 0x0a,
+	0x0f, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xa8, 0xc6,
+	0x2c, 0x01, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdc,
+	0x01, 0x0a, 0x0d, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x41, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x79, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x4f, 0x75, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x34,
+	0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xfc, 0x01,
+	0x0a, 0x0c, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
 	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f,
 	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x70, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x69,
-	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x46, 0x0a, 0x19, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
-	0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
-	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xa4, 0x02, 0x0a, 0x1d, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72,
-	0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x10, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18,
-	0x01, 0x52, 0x0f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x70,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xa8, 0xc6, 0x2c, 0x01,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x32,
+	0x0a, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x48, 0x0a, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x10, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x83, 0x05, 0x0a,
+	0x0d, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x52, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x1a, 0x83, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x1a, 0xb7, 0x01, 0x0a, 0x05, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x61, 0x0a, 0x1f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x1b, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x61, 0x74, 0x68, 0x4e,
+	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xed, 0x01, 0x0a, 0x18, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
+	0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x70,
 	0x65, 0x63, 0x12, 0x3c, 0x0a, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x69, 0x74,
 	0x61, 0x6c, 0x79, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xd7, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72,
-	0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x73, 0x1a, 0x65, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72,
-	0x54, 0x72, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x69,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4a, 0x04,
-	0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x85, 0x02, 0x0a, 0x17, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04,
-	0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x1f, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
-	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x68, 0x61, 0x73, 0x22, 0x36, 0x0a, 0x20,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
-	0x73, 0x68, 0x61, 0x73, 0x22, 0x76, 0x0a, 0x1d, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98,
-	0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1e,
-	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x22, 0x75, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72,
+	0x22, 0x46, 0x0a, 0x19, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xa8, 0xc6, 0x2c, 0x01, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x10, 0x6c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x65, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22,
+	0x85, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72,
 	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
 	0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x49, 0x64, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74,
-	0x12, 0x42, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x12, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52,
-	0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x49, 0x47, 0x4e, 0x45,
-	0x52, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x02, 0x22, 0x73, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04,
-	0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x73, 0x22,
-	0x52, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x72, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x79, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x32, 0xf1, 0x10, 0x0a, 0x0d, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x59, 0x0a,
-	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
-	0x1d, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06,
-	0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x41, 0x6e,
-	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x41,
-	0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x4a, 0x0a, 0x09, 0x54, 0x72, 0x65, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x54, 0x72,
-	0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08,
-	0x02, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06,
-	0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x6c, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44,
-	0x69, 0x76, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
-	0x24, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69,
-	0x76, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97,
-	0x28, 0x02, 0x08, 0x02, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12,
-	0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x46,
-	0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x59, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64,
-	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08,
-	0x02, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x0e, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x22, 0x6f, 0x0a, 0x1f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c,
+	0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x68, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x68, 0x61,
+	0x73, 0x22, 0x36, 0x0a, 0x20, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x68, 0x61, 0x73, 0x22, 0x76, 0x0a, 0x1d, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x64, 0x22, 0x5f, 0x0a, 0x1e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x65,
+	0x78, 0x74, 0x22, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x65, 0x78, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x06, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
+	0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x02, 0x22,
+	0x8b, 0x03, 0x0a, 0x1d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73,
+	0x1a, 0xba, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x70, 0x67, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x73, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11,
+	0x73, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x73, 0x68,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x78,
+	0x35, 0x30, 0x39, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x78, 0x35, 0x30, 0x39, 0x43,
+	0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x87, 0x04,
+	0x0a, 0x1e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x52, 0x08, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x98, 0x03, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x64,
+	0x69, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x65, 0x79,
+	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x56, 0x45, 0x52,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x04, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x06, 0x22, 0x73, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c,
+	0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x72, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x32, 0xb4, 0x12, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28,
+	0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x73, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x73, 0x41, 0x6e, 0x63, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97,
+	0x28, 0x02, 0x08, 0x02, 0x12, 0x4a, 0x0a, 0x09, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01,
+	0x12, 0x51, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
 	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28,
-	0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x02, 0x08, 0x02, 0x12, 0x6c, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x65,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08,
-	0x02, 0x12, 0x47, 0x0a, 0x08, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
-	0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x61,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x71, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46,
-	0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x02, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06,
+	0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa,
+	0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa,
+	0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x59, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01,
+	0x12, 0x50, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02,
+	0x30, 0x01, 0x12, 0x5e, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x80, 0x98,
+	0x28, 0x01, 0x12, 0x4b, 0x0a, 0x08, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0a, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x80, 0x98, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x42, 0x0a, 0x05, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x79, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x80, 0x98, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x79, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa,
+	0x97, 0x28, 0x02, 0x08, 0x02, 0x12, 0x75, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x12,
+	0x25, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
 	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46,
-	0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12,
-	0x5f, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0a,
+	0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x80, 0x98, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x10,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x5f, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x69,
+	0x64, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x6b,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x52,
+	0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x52, 0x65, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x42, 0x79, 0x52, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x18, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02,
+	0x08, 0x02, 0x28, 0x01, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01,
-	0x12, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42,
-	0x79, 0x4f, 0x69, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x69, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x69, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30,
-	0x01, 0x12, 0x6b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
-	0x42, 0x79, 0x52, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x79,
-	0x52, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x73, 0x42, 0x79, 0x52, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x79,
-	0x0a, 0x18, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa,
-	0x97, 0x28, 0x02, 0x08, 0x02, 0x28, 0x01, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x12, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x71, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08,
 	0x02, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
@@ -4952,8 +5885,8 @@ func file_commit_proto_rawDescGZIP() []byte {
 	return file_commit_proto_rawDescData
 }
 
-var file_commit_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_commit_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_commit_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_commit_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_commit_proto_goTypes = []interface{}{
 	(ListCommitsRequest_Order)(0),                        // 0: gitaly.ListCommitsRequest.Order
 	(TreeEntryResponse_ObjectType)(0),                    // 1: gitaly.TreeEntryResponse.ObjectType
@@ -4962,189 +5895,219 @@ var file_commit_proto_goTypes = []interface{}{
 	(FindAllCommitsRequest_Order)(0),                     // 4: gitaly.FindAllCommitsRequest.Order
 	(FindCommitsRequest_Order)(0),                        // 5: gitaly.FindCommitsRequest.Order
 	(GetCommitSignaturesResponse_Signer)(0),              // 6: gitaly.GetCommitSignaturesResponse.Signer
-	(*ListCommitsRequest)(nil),                           // 7: gitaly.ListCommitsRequest
-	(*ListCommitsResponse)(nil),                          // 8: gitaly.ListCommitsResponse
-	(*ListAllCommitsRequest)(nil),                        // 9: gitaly.ListAllCommitsRequest
-	(*ListAllCommitsResponse)(nil),                       // 10: gitaly.ListAllCommitsResponse
-	(*CommitStatsRequest)(nil),                           // 11: gitaly.CommitStatsRequest
-	(*CommitStatsResponse)(nil),                          // 12: gitaly.CommitStatsResponse
-	(*CommitIsAncestorRequest)(nil),                      // 13: gitaly.CommitIsAncestorRequest
-	(*CommitIsAncestorResponse)(nil),                     // 14: gitaly.CommitIsAncestorResponse
-	(*TreeEntryRequest)(nil),                             // 15: gitaly.TreeEntryRequest
-	(*TreeEntryResponse)(nil),                            // 16: gitaly.TreeEntryResponse
-	(*CountCommitsRequest)(nil),                          // 17: gitaly.CountCommitsRequest
-	(*CountCommitsResponse)(nil),                         // 18: gitaly.CountCommitsResponse
-	(*CountDivergingCommitsRequest)(nil),                 // 19: gitaly.CountDivergingCommitsRequest
-	(*CountDivergingCommitsResponse)(nil),                // 20: gitaly.CountDivergingCommitsResponse
-	(*TreeEntry)(nil),                                    // 21: gitaly.TreeEntry
-	(*GetTreeEntriesRequest)(nil),                        // 22: gitaly.GetTreeEntriesRequest
-	(*GetTreeEntriesResponse)(nil),                       // 23: gitaly.GetTreeEntriesResponse
-	(*GetTreeEntriesError)(nil),                          // 24: gitaly.GetTreeEntriesError
-	(*ListFilesRequest)(nil),                             // 25: gitaly.ListFilesRequest
-	(*ListFilesResponse)(nil),                            // 26: gitaly.ListFilesResponse
-	(*FindCommitRequest)(nil),                            // 27: gitaly.FindCommitRequest
-	(*FindCommitResponse)(nil),                           // 28: gitaly.FindCommitResponse
-	(*ListCommitsByOidRequest)(nil),                      // 29: gitaly.ListCommitsByOidRequest
-	(*ListCommitsByOidResponse)(nil),                     // 30: gitaly.ListCommitsByOidResponse
-	(*ListCommitsByRefNameRequest)(nil),                  // 31: gitaly.ListCommitsByRefNameRequest
-	(*ListCommitsByRefNameResponse)(nil),                 // 32: gitaly.ListCommitsByRefNameResponse
-	(*FindAllCommitsRequest)(nil),                        // 33: gitaly.FindAllCommitsRequest
-	(*FindAllCommitsResponse)(nil),                       // 34: gitaly.FindAllCommitsResponse
-	(*FindCommitsRequest)(nil),                           // 35: gitaly.FindCommitsRequest
-	(*FindCommitsResponse)(nil),                          // 36: gitaly.FindCommitsResponse
-	(*CommitLanguagesRequest)(nil),                       // 37: gitaly.CommitLanguagesRequest
-	(*CommitLanguagesResponse)(nil),                      // 38: gitaly.CommitLanguagesResponse
-	(*RawBlameRequest)(nil),                              // 39: gitaly.RawBlameRequest
-	(*RawBlameResponse)(nil),                             // 40: gitaly.RawBlameResponse
-	(*RawBlameError)(nil),                                // 41: gitaly.RawBlameError
-	(*LastCommitForPathRequest)(nil),                     // 42: gitaly.LastCommitForPathRequest
-	(*LastCommitForPathResponse)(nil),                    // 43: gitaly.LastCommitForPathResponse
-	(*ListLastCommitsForTreeRequest)(nil),                // 44: gitaly.ListLastCommitsForTreeRequest
-	(*ListLastCommitsForTreeResponse)(nil),               // 45: gitaly.ListLastCommitsForTreeResponse
-	(*CommitsByMessageRequest)(nil),                      // 46: gitaly.CommitsByMessageRequest
-	(*CommitsByMessageResponse)(nil),                     // 47: gitaly.CommitsByMessageResponse
-	(*FilterShasWithSignaturesRequest)(nil),              // 48: gitaly.FilterShasWithSignaturesRequest
-	(*FilterShasWithSignaturesResponse)(nil),             // 49: gitaly.FilterShasWithSignaturesResponse
-	(*ExtractCommitSignatureRequest)(nil),                // 50: gitaly.ExtractCommitSignatureRequest
-	(*ExtractCommitSignatureResponse)(nil),               // 51: gitaly.ExtractCommitSignatureResponse
-	(*GetCommitSignaturesRequest)(nil),                   // 52: gitaly.GetCommitSignaturesRequest
-	(*GetCommitSignaturesResponse)(nil),                  // 53: gitaly.GetCommitSignaturesResponse
-	(*GetCommitMessagesRequest)(nil),                     // 54: gitaly.GetCommitMessagesRequest
-	(*GetCommitMessagesResponse)(nil),                    // 55: gitaly.GetCommitMessagesResponse
-	(*CheckObjectsExistRequest)(nil),                     // 56: gitaly.CheckObjectsExistRequest
-	(*CheckObjectsExistResponse)(nil),                    // 57: gitaly.CheckObjectsExistResponse
-	(*ListCommitsByRefNameResponse_CommitForRef)(nil),    // 58: gitaly.ListCommitsByRefNameResponse.CommitForRef
-	(*CommitLanguagesResponse_Language)(nil),             // 59: gitaly.CommitLanguagesResponse.Language
-	(*RawBlameError_OutOfRangeError)(nil),                // 60: gitaly.RawBlameError.OutOfRangeError
-	(*ListLastCommitsForTreeResponse_CommitForTree)(nil), // 61: gitaly.ListLastCommitsForTreeResponse.CommitForTree
-	(*CheckObjectsExistResponse_RevisionExistence)(nil),  // 62: gitaly.CheckObjectsExistResponse.RevisionExistence
-	(*Repository)(nil),                                   // 63: gitaly.Repository
-	(*PaginationParameter)(nil),                          // 64: gitaly.PaginationParameter
-	(*timestamppb.Timestamp)(nil),                        // 65: google.protobuf.Timestamp
-	(*GitCommit)(nil),                                    // 66: gitaly.GitCommit
-	(*GlobalOptions)(nil),                                // 67: gitaly.GlobalOptions
-	(*PaginationCursor)(nil),                             // 68: gitaly.PaginationCursor
-	(*ResolveRevisionError)(nil),                         // 69: gitaly.ResolveRevisionError
-	(*PathError)(nil),                                    // 70: gitaly.PathError
-	(*PathNotFoundError)(nil),                            // 71: gitaly.PathNotFoundError
+	(VerifyCommitSignaturesResponse_Verdict_Status)(0),   // 7: gitaly.VerifyCommitSignaturesResponse.Verdict.Status
+	(*ListCommitsRequest)(nil),                           // 8: gitaly.ListCommitsRequest
+	(*ListCommitsResponse)(nil),                          // 9: gitaly.ListCommitsResponse
+	(*ListAllCommitsRequest)(nil),                        // 10: gitaly.ListAllCommitsRequest
+	(*ListAllCommitsResponse)(nil),                       // 11: gitaly.ListAllCommitsResponse
+	(*CommitStatsRequest)(nil),                           // 12: gitaly.CommitStatsRequest
+	(*CommitStatsResponse)(nil),                          // 13: gitaly.CommitStatsResponse
+	(*CommitIsAncestorRequest)(nil),                      // 14: gitaly.CommitIsAncestorRequest
+	(*CommitIsAncestorResponse)(nil),                     // 15: gitaly.CommitIsAncestorResponse
+	(*TreeEntryRequest)(nil),                             // 16: gitaly.TreeEntryRequest
+	(*TreeEntryResponse)(nil),                            // 17: gitaly.TreeEntryResponse
+	(*CountCommitsRequest)(nil),                          // 18: gitaly.CountCommitsRequest
+	(*CountCommitsResponse)(nil),                         // 19: gitaly.CountCommitsResponse
+	(*CountDivergingCommitsRequest)(nil),                 // 20: gitaly.CountDivergingCommitsRequest
+	(*CountDivergingCommitsResponse)(nil),                // 21: gitaly.CountDivergingCommitsResponse
+	(*TreeEntry)(nil),                                    // 22: gitaly.TreeEntry
+	(*GetTreeEntriesRequest)(nil),                        // 23: gitaly.GetTreeEntriesRequest
+	(*GetTreeEntriesResponse)(nil),                       // 24: gitaly.GetTreeEntriesResponse
+	(*GetTreeEntriesError)(nil),                          // 25: gitaly.GetTreeEntriesError
+	(*ListFilesRequest)(nil),                             // 26: gitaly.ListFilesRequest
+	(*ListFilesResponse)(nil),                            // 27: gitaly.ListFilesResponse
+	(*FindCommitRequest)(nil),                            // 28: gitaly.FindCommitRequest
+	(*FindCommitResponse)(nil),                           // 29: gitaly.FindCommitResponse
+	(*ListCommitsByOidRequest)(nil),                      // 30: gitaly.ListCommitsByOidRequest
+	(*ListCommitsByOidResponse)(nil),                     // 31: gitaly.ListCommitsByOidResponse
+	(*ListCommitsByRefNameRequest)(nil),                  // 32: gitaly.ListCommitsByRefNameRequest
+	(*ListCommitsByRefNameResponse)(nil),                 // 33: gitaly.ListCommitsByRefNameResponse
+	(*FindAllCommitsRequest)(nil),                        // 34: gitaly.FindAllCommitsRequest
+	(*FindAllCommitsResponse)(nil),                       // 35: gitaly.FindAllCommitsResponse
+	(*FindCommitsRequest)(nil),                           // 36: gitaly.FindCommitsRequest
+	(*FindCommitsResponse)(nil),                          // 37: gitaly.FindCommitsResponse
+	(*CommitLanguagesRequest)(nil),                       // 38: gitaly.CommitLanguagesRequest
+	(*CommitLanguagesResponse)(nil),                      // 39: gitaly.CommitLanguagesResponse
+	(*RawBlameRequest)(nil),                              // 40: gitaly.RawBlameRequest
+	(*RawBlameResponse)(nil),                             // 41: gitaly.RawBlameResponse
+	(*RawBlameError)(nil),                                // 42: gitaly.RawBlameError
+	(*BlameRequest)(nil),                                 // 43: gitaly.BlameRequest
+	(*BlameResponse)(nil),                                // 44: gitaly.BlameResponse
+	(*BlameError)(nil),                                   // 45: gitaly.BlameError
+	(*LastCommitForPathRequest)(nil),                     // 46: gitaly.LastCommitForPathRequest
+	(*LastCommitForPathResponse)(nil),                    // 47: gitaly.LastCommitForPathResponse
+	(*ListLastCommitsForTreeRequest)(nil),                // 48: gitaly.ListLastCommitsForTreeRequest
+	(*ListLastCommitsForTreeResponse)(nil),               // 49: gitaly.ListLastCommitsForTreeResponse
+	(*CommitsByMessageRequest)(nil),                      // 50: gitaly.CommitsByMessageRequest
+	(*CommitsByMessageResponse)(nil),                     // 51: gitaly.CommitsByMessageResponse
+	(*FilterShasWithSignaturesRequest)(nil),              // 52: gitaly.FilterShasWithSignaturesRequest
+	(*FilterShasWithSignaturesResponse)(nil),             // 53: gitaly.FilterShasWithSignaturesResponse
+	(*ExtractCommitSignatureRequest)(nil),                // 54: gitaly.ExtractCommitSignatureRequest
+	(*ExtractCommitSignatureResponse)(nil),               // 55: gitaly.ExtractCommitSignatureResponse
+	(*GetCommitSignaturesRequest)(nil),                   // 56: gitaly.GetCommitSignaturesRequest
+	(*GetCommitSignaturesResponse)(nil),                  // 57: gitaly.GetCommitSignaturesResponse
+	(*VerifyCommitSignaturesRequest)(nil),                // 58: gitaly.VerifyCommitSignaturesRequest
+	(*VerifyCommitSignaturesResponse)(nil),               // 59: gitaly.VerifyCommitSignaturesResponse
+	(*GetCommitMessagesRequest)(nil),                     // 60: gitaly.GetCommitMessagesRequest
+	(*GetCommitMessagesResponse)(nil),                    // 61: gitaly.GetCommitMessagesResponse
+	(*CheckObjectsExistRequest)(nil),                     // 62: gitaly.CheckObjectsExistRequest
+	(*CheckObjectsExistResponse)(nil),                    // 63: gitaly.CheckObjectsExistResponse
+	(*ListCommitsByRefNameResponse_CommitForRef)(nil),    // 64: gitaly.ListCommitsByRefNameResponse.CommitForRef
+	(*CommitLanguagesResponse_Language)(nil),             // 65: gitaly.CommitLanguagesResponse.Language
+	(*RawBlameError_OutOfRangeError)(nil),                // 66: gitaly.RawBlameError.OutOfRangeError
+	(*BlameResponse_Commit)(nil),                         // 67: gitaly.BlameResponse.Commit
+	(*BlameResponse_Range)(nil),                          // 68: gitaly.BlameResponse.Range
+	(*ListLastCommitsForTreeResponse_CommitForTree)(nil), // 69: gitaly.ListLastCommitsForTreeResponse.CommitForTree
+	(*VerifyCommitSignaturesRequest_TrustedKeys)(nil),    // 70: gitaly.VerifyCommitSignaturesRequest.TrustedKeys
+	(*VerifyCommitSignaturesResponse_Verdict)(nil),       // 71: gitaly.VerifyCommitSignaturesResponse.Verdict
+	(*CheckObjectsExistResponse_RevisionExistence)(nil),  // 72: gitaly.CheckObjectsExistResponse.RevisionExistence
+	(*Repository)(nil),                                   // 73: gitaly.Repository
+	(*PaginationParameter)(nil),                          // 74: gitaly.PaginationParameter
+	(*timestamppb.Timestamp)(nil),                        // 75: google.protobuf.Timestamp
+	(*GitCommit)(nil),                                    // 76: gitaly.GitCommit
+	(*GlobalOptions)(nil),                                // 77: gitaly.GlobalOptions
+	(*PaginationCursor)(nil),                             // 78: gitaly.PaginationCursor
+	(*ResolveRevisionError)(nil),                         // 79: gitaly.ResolveRevisionError
+	(*PathError)(nil),                                    // 80: gitaly.PathError
+	(*PathNotFoundError)(nil),                            // 81: gitaly.PathNotFoundError
+	(*CommitAuthor)(nil),                                 // 82: gitaly.CommitAuthor
+	(SignatureType)(0),                                   // 83: gitaly.SignatureType
 }
 var file_commit_proto_depIdxs = []int32{
-	63, // 0: gitaly.ListCommitsRequest.repository:type_name -> gitaly.Repository
-	64, // 1: gitaly.ListCommitsRequest.pagination_params:type_name -> gitaly.PaginationParameter
-	0,  // 2: gitaly.ListCommitsRequest.order:type_name -> gitaly.ListCommitsRequest.Order
-	65, // 3: gitaly.ListCommitsRequest.after:type_name -> google.protobuf.Timestamp
-	65, // 4: gitaly.ListCommitsRequest.before:type_name -> google.protobuf.Timestamp
-	66, // 5: gitaly.ListCommitsResponse.commits:type_name -> gitaly.GitCommit
-	63, // 6: gitaly.ListAllCommitsRequest.repository:type_name -> gitaly.Repository
-	64, // 7: gitaly.ListAllCommitsRequest.pagination_params:type_name -> gitaly.PaginationParameter
-	66, // 8: gitaly.ListAllCommitsResponse.commits:type_name -> gitaly.GitCommit
-	63, // 9: gitaly.CommitStatsRequest.repository:type_name -> gitaly.Repository
-	63, // 10: gitaly.CommitIsAncestorRequest.repository:type_name -> gitaly.Repository
-	63, // 11: gitaly.TreeEntryRequest.repository:type_name -> gitaly.Repository
-	1,  // 12: gitaly.TreeEntryResponse.type:type_name -> gitaly.TreeEntryResponse.ObjectType
-	63, // 13: gitaly.CountCommitsRequest.repository:type_name -> gitaly.Repository
-	65, // 14: gitaly.CountCommitsRequest.after:type_name -> google.protobuf.Timestamp
-	65, // 15: gitaly.CountCommitsRequest.before:type_name -> google.protobuf.Timestamp
-	67, // 16: gitaly.CountCommitsRequest.global_options:type_name -> gitaly.GlobalOptions
-	63, // 17: gitaly.CountDivergingCommitsRequest.repository:type_name -> gitaly.Repository
-	2,  // 18: gitaly.TreeEntry.type:type_name -> gitaly.TreeEntry.EntryType
-	63, // 19: gitaly.GetTreeEntriesRequest.repository:type_name -> gitaly.Repository
-	3,  // 20: gitaly.GetTreeEntriesRequest.sort:type_name -> gitaly.GetTreeEntriesRequest.SortBy
-	64, // 21: gitaly.GetTreeEntriesRequest.pagination_params:type_name -> gitaly.PaginationParameter
-	21, // 22: gitaly.GetTreeEntriesResponse.entries:type_name -> gitaly.TreeEntry
-	68, // 23: gitaly.GetTreeEntriesResponse.pagination_cursor:type_name -> gitaly.PaginationCursor
-	69, // 24: gitaly.GetTreeEntriesError.resolve_tree:type_name -> gitaly.ResolveRevisionError
-	70, // 25: gitaly.GetTreeEntriesError.path:type_name -> gitaly.PathError
-	63, // 26: gitaly.ListFilesRequest.repository:type_name -> gitaly.Repository
-	63, // 27: gitaly.FindCommitRequest.repository:type_name -> gitaly.Repository
-	66, // 28: gitaly.FindCommitResponse.commit:type_name -> gitaly.GitCommit
-	63, // 29: gitaly.ListCommitsByOidRequest.repository:type_name -> gitaly.Repository
-	66, // 30: gitaly.ListCommitsByOidResponse.commits:type_name -> gitaly.GitCommit
-	63, // 31: gitaly.ListCommitsByRefNameRequest.repository:type_name -> gitaly.Repository
-	58, // 32: gitaly.ListCommitsByRefNameResponse.commit_refs:type_name -> gitaly.ListCommitsByRefNameResponse.CommitForRef
-	63, // 33: gitaly.FindAllCommitsRequest.repository:type_name -> gitaly.Repository
-	4,  // 34: gitaly.FindAllCommitsRequest.order:type_name -> gitaly.FindAllCommitsRequest.Order
-	66, // 35: gitaly.FindAllCommitsResponse.commits:type_name -> gitaly.GitCommit
-	63, // 36: gitaly.FindCommitsRequest.repository:type_name -> gitaly.Repository
-	65, // 37: gitaly.FindCommitsRequest.after:type_name -> google.protobuf.Timestamp
-	65, // 38: gitaly.FindCommitsRequest.before:type_name -> google.protobuf.Timestamp
-	5,  // 39: gitaly.FindCommitsRequest.order:type_name -> gitaly.FindCommitsRequest.Order
-	67, // 40: gitaly.FindCommitsRequest.global_options:type_name -> gitaly.GlobalOptions
-	66, // 41: gitaly.FindCommitsResponse.commits:type_name -> gitaly.GitCommit
-	63, // 42: gitaly.CommitLanguagesRequest.repository:type_name -> gitaly.Repository
-	59, // 43: gitaly.CommitLanguagesResponse.languages:type_name -> gitaly.CommitLanguagesResponse.Language
-	63, // 44: gitaly.RawBlameRequest.repository:type_name -> gitaly.Repository
-	71, // 45: gitaly.RawBlameError.path_not_found:type_name -> gitaly.PathNotFoundError
-	60, // 46: gitaly.RawBlameError.out_of_range:type_name -> gitaly.RawBlameError.OutOfRangeError
-	63, // 47: gitaly.LastCommitForPathRequest.repository:type_name -> gitaly.Repository
-	67, // 48: gitaly.LastCommitForPathRequest.global_options:type_name -> gitaly.GlobalOptions
-	66, // 49: gitaly.LastCommitForPathResponse.commit:type_name -> gitaly.GitCommit
-	63, // 50: gitaly.ListLastCommitsForTreeRequest.repository:type_name -> gitaly.Repository
-	67, // 51: gitaly.ListLastCommitsForTreeRequest.global_options:type_name -> gitaly.GlobalOptions
-	61, // 52: gitaly.ListLastCommitsForTreeResponse.commits:type_name -> gitaly.ListLastCommitsForTreeResponse.CommitForTree
-	63, // 53: gitaly.CommitsByMessageRequest.repository:type_name -> gitaly.Repository
-	67, // 54: gitaly.CommitsByMessageRequest.global_options:type_name -> gitaly.GlobalOptions
-	66, // 55: gitaly.CommitsByMessageResponse.commits:type_name -> gitaly.GitCommit
-	63, // 56: gitaly.FilterShasWithSignaturesRequest.repository:type_name -> gitaly.Repository
-	63, // 57: gitaly.ExtractCommitSignatureRequest.repository:type_name -> gitaly.Repository
-	63, // 58: gitaly.GetCommitSignaturesRequest.repository:type_name -> gitaly.Repository
-	6,  // 59: gitaly.GetCommitSignaturesResponse.signer:type_name -> gitaly.GetCommitSignaturesResponse.Signer
-	63, // 60: gitaly.GetCommitMessagesRequest.repository:type_name -> gitaly.Repository
-	63, // 61: gitaly.CheckObjectsExistRequest.repository:type_name -> gitaly.Repository
-	62, // 62: gitaly.CheckObjectsExistResponse.revisions:type_name -> gitaly.CheckObjectsExistResponse.RevisionExistence
-	66, // 63: gitaly.ListCommitsByRefNameResponse.CommitForRef.commit:type_name -> gitaly.GitCommit
-	66, // 64: gitaly.ListLastCommitsForTreeResponse.CommitForTree.commit:type_name -> gitaly.GitCommit
-	7,  // 65: gitaly.CommitService.ListCommits:input_type -> gitaly.ListCommitsRequest
-	9,  // 66: gitaly.CommitService.ListAllCommits:input_type -> gitaly.ListAllCommitsRequest
-	13, // 67: gitaly.CommitService.CommitIsAncestor:input_type -> gitaly.CommitIsAncestorRequest
-	15, // 68: gitaly.CommitService.TreeEntry:input_type -> gitaly.TreeEntryRequest
-	17, // 69: gitaly.CommitService.CountCommits:input_type -> gitaly.CountCommitsRequest
-	19, // 70: gitaly.CommitService.CountDivergingCommits:input_type -> gitaly.CountDivergingCommitsRequest
-	22, // 71: gitaly.CommitService.GetTreeEntries:input_type -> gitaly.GetTreeEntriesRequest
-	25, // 72: gitaly.CommitService.ListFiles:input_type -> gitaly.ListFilesRequest
-	27, // 73: gitaly.CommitService.FindCommit:input_type -> gitaly.FindCommitRequest
-	11, // 74: gitaly.CommitService.CommitStats:input_type -> gitaly.CommitStatsRequest
-	33, // 75: gitaly.CommitService.FindAllCommits:input_type -> gitaly.FindAllCommitsRequest
-	35, // 76: gitaly.CommitService.FindCommits:input_type -> gitaly.FindCommitsRequest
-	37, // 77: gitaly.CommitService.CommitLanguages:input_type -> gitaly.CommitLanguagesRequest
-	39, // 78: gitaly.CommitService.RawBlame:input_type -> gitaly.RawBlameRequest
-	42, // 79: gitaly.CommitService.LastCommitForPath:input_type -> gitaly.LastCommitForPathRequest
-	44, // 80: gitaly.CommitService.ListLastCommitsForTree:input_type -> gitaly.ListLastCommitsForTreeRequest
-	46, // 81: gitaly.CommitService.CommitsByMessage:input_type -> gitaly.CommitsByMessageRequest
-	29, // 82: gitaly.CommitService.ListCommitsByOid:input_type -> gitaly.ListCommitsByOidRequest
-	31, // 83: gitaly.CommitService.ListCommitsByRefName:input_type -> gitaly.ListCommitsByRefNameRequest
-	48, // 84: gitaly.CommitService.FilterShasWithSignatures:input_type -> gitaly.FilterShasWithSignaturesRequest
-	52, // 85: gitaly.CommitService.GetCommitSignatures:input_type -> gitaly.GetCommitSignaturesRequest
-	54, // 86: gitaly.CommitService.GetCommitMessages:input_type -> gitaly.GetCommitMessagesRequest
-	56, // 87: gitaly.CommitService.CheckObjectsExist:input_type -> gitaly.CheckObjectsExistRequest
-	8,  // 88: gitaly.CommitService.ListCommits:output_type -> gitaly.ListCommitsResponse
-	10, // 89: gitaly.CommitService.ListAllCommits:output_type -> gitaly.ListAllCommitsResponse
-	14, // 90: gitaly.CommitService.CommitIsAncestor:output_type -> gitaly.CommitIsAncestorResponse
-	16, // 91: gitaly.CommitService.TreeEntry:output_type -> gitaly.TreeEntryResponse
-	18, // 92: gitaly.CommitService.CountCommits:output_type -> gitaly.CountCommitsResponse
-	20, // 93: gitaly.CommitService.CountDivergingCommits:output_type -> gitaly.CountDivergingCommitsResponse
-	23, // 94: gitaly.CommitService.GetTreeEntries:output_type -> gitaly.GetTreeEntriesResponse
-	26, // 95: gitaly.CommitService.ListFiles:output_type -> gitaly.ListFilesResponse
-	28, // 96: gitaly.CommitService.FindCommit:output_type -> gitaly.FindCommitResponse
-	12, // 97: gitaly.CommitService.CommitStats:output_type -> gitaly.CommitStatsResponse
-	34, // 98: gitaly.CommitService.FindAllCommits:output_type -> gitaly.FindAllCommitsResponse
-	36, // 99: gitaly.CommitService.FindCommits:output_type -> gitaly.FindCommitsResponse
-	38, // 100: gitaly.CommitService.CommitLanguages:output_type -> gitaly.CommitLanguagesResponse
-	40, // 101: gitaly.CommitService.RawBlame:output_type -> gitaly.RawBlameResponse
-	43, // 102: gitaly.CommitService.LastCommitForPath:output_type -> gitaly.LastCommitForPathResponse
-	45, // 103: gitaly.CommitService.ListLastCommitsForTree:output_type -> gitaly.ListLastCommitsForTreeResponse
-	47, // 104: gitaly.CommitService.CommitsByMessage:output_type -> gitaly.CommitsByMessageResponse
-	30, // 105: gitaly.CommitService.ListCommitsByOid:output_type -> gitaly.ListCommitsByOidResponse
-	32, // 106: gitaly.CommitService.ListCommitsByRefName:output_type -> gitaly.ListCommitsByRefNameResponse
-	49, // 107: gitaly.CommitService.FilterShasWithSignatures:output_type -> gitaly.FilterShasWithSignaturesResponse
-	53, // 108: gitaly.CommitService.GetCommitSignatures:output_type -> gitaly.GetCommitSignaturesResponse
-	55, // 109: gitaly.CommitService.GetCommitMessages:output_type -> gitaly.GetCommitMessagesResponse
-	57, // 110: gitaly.CommitService.CheckObjectsExist:output_type -> gitaly.CheckObjectsExistResponse
-	88, // [88:111] is the sub-list for method output_type
-	65, // [65:88] is the sub-list for method input_type
-	65, // [65:65] is the sub-list for extension type_name
-	65, // [65:65] is the sub-list for extension extendee
-	0,  // [0:65] is the sub-list for field type_name
+	73,  // 0: gitaly.ListCommitsRequest.repository:type_name -> gitaly.Repository
+	74,  // 1: gitaly.ListCommitsRequest.pagination_params:type_name -> gitaly.PaginationParameter
+	0,   // 2: gitaly.ListCommitsRequest.order:type_name -> gitaly.ListCommitsRequest.Order
+	75,  // 3: gitaly.ListCommitsRequest.after:type_name -> google.protobuf.Timestamp
+	75,  // 4: gitaly.ListCommitsRequest.before:type_name -> google.protobuf.Timestamp
+	76,  // 5: gitaly.ListCommitsResponse.commits:type_name -> gitaly.GitCommit
+	73,  // 6: gitaly.ListAllCommitsRequest.repository:type_name -> gitaly.Repository
+	74,  // 7: gitaly.ListAllCommitsRequest.pagination_params:type_name -> gitaly.PaginationParameter
+	76,  // 8: gitaly.ListAllCommitsResponse.commits:type_name -> gitaly.GitCommit
+	73,  // 9: gitaly.CommitStatsRequest.repository:type_name -> gitaly.Repository
+	73,  // 10: gitaly.CommitIsAncestorRequest.repository:type_name -> gitaly.Repository
+	73,  // 11: gitaly.TreeEntryRequest.repository:type_name -> gitaly.Repository
+	1,   // 12: gitaly.TreeEntryResponse.type:type_name -> gitaly.TreeEntryResponse.ObjectType
+	73,  // 13: gitaly.CountCommitsRequest.repository:type_name -> gitaly.Repository
+	75,  // 14: gitaly.CountCommitsRequest.after:type_name -> google.protobuf.Timestamp
+	75,  // 15: gitaly.CountCommitsRequest.before:type_name -> google.protobuf.Timestamp
+	77,  // 16: gitaly.CountCommitsRequest.global_options:type_name -> gitaly.GlobalOptions
+	73,  // 17: gitaly.CountDivergingCommitsRequest.repository:type_name -> gitaly.Repository
+	2,   // 18: gitaly.TreeEntry.type:type_name -> gitaly.TreeEntry.EntryType
+	73,  // 19: gitaly.GetTreeEntriesRequest.repository:type_name -> gitaly.Repository
+	3,   // 20: gitaly.GetTreeEntriesRequest.sort:type_name -> gitaly.GetTreeEntriesRequest.SortBy
+	74,  // 21: gitaly.GetTreeEntriesRequest.pagination_params:type_name -> gitaly.PaginationParameter
+	22,  // 22: gitaly.GetTreeEntriesResponse.entries:type_name -> gitaly.TreeEntry
+	78,  // 23: gitaly.GetTreeEntriesResponse.pagination_cursor:type_name -> gitaly.PaginationCursor
+	79,  // 24: gitaly.GetTreeEntriesError.resolve_tree:type_name -> gitaly.ResolveRevisionError
+	80,  // 25: gitaly.GetTreeEntriesError.path:type_name -> gitaly.PathError
+	73,  // 26: gitaly.ListFilesRequest.repository:type_name -> gitaly.Repository
+	73,  // 27: gitaly.FindCommitRequest.repository:type_name -> gitaly.Repository
+	76,  // 28: gitaly.FindCommitResponse.commit:type_name -> gitaly.GitCommit
+	73,  // 29: gitaly.ListCommitsByOidRequest.repository:type_name -> gitaly.Repository
+	76,  // 30: gitaly.ListCommitsByOidResponse.commits:type_name -> gitaly.GitCommit
+	73,  // 31: gitaly.ListCommitsByRefNameRequest.repository:type_name -> gitaly.Repository
+	64,  // 32: gitaly.ListCommitsByRefNameResponse.commit_refs:type_name -> gitaly.ListCommitsByRefNameResponse.CommitForRef
+	73,  // 33: gitaly.FindAllCommitsRequest.repository:type_name -> gitaly.Repository
+	4,   // 34: gitaly.FindAllCommitsRequest.order:type_name -> gitaly.FindAllCommitsRequest.Order
+	76,  // 35: gitaly.FindAllCommitsResponse.commits:type_name -> gitaly.GitCommit
+	73,  // 36: gitaly.FindCommitsRequest.repository:type_name -> gitaly.Repository
+	75,  // 37: gitaly.FindCommitsRequest.after:type_name -> google.protobuf.Timestamp
+	75,  // 38: gitaly.FindCommitsRequest.before:type_name -> google.protobuf.Timestamp
+	5,   // 39: gitaly.FindCommitsRequest.order:type_name -> gitaly.FindCommitsRequest.Order
+	77,  // 40: gitaly.FindCommitsRequest.global_options:type_name -> gitaly.GlobalOptions
+	76,  // 41: gitaly.FindCommitsResponse.commits:type_name -> gitaly.GitCommit
+	73,  // 42: gitaly.CommitLanguagesRequest.repository:type_name -> gitaly.Repository
+	65,  // 43: gitaly.CommitLanguagesResponse.languages:type_name -> gitaly.CommitLanguagesResponse.Language
+	73,  // 44: gitaly.RawBlameRequest.repository:type_name -> gitaly.Repository
+	81,  // 45: gitaly.RawBlameError.path_not_found:type_name -> gitaly.PathNotFoundError
+	66,  // 46: gitaly.RawBlameError.out_of_range:type_name -> gitaly.RawBlameError.OutOfRangeError
+	73,  // 47: gitaly.BlameRequest.repository:type_name -> gitaly.Repository
+	74,  // 48: gitaly.BlameRequest.pagination_params:type_name -> gitaly.PaginationParameter
+	67,  // 49: gitaly.BlameResponse.commits:type_name -> gitaly.BlameResponse.Commit
+	68,  // 50: gitaly.BlameResponse.ranges:type_name -> gitaly.BlameResponse.Range
+	78,  // 51: gitaly.BlameResponse.pagination_cursor:type_name -> gitaly.PaginationCursor
+	81,  // 52: gitaly.BlameError.path_not_found:type_name -> gitaly.PathNotFoundError
+	81,  // 53: gitaly.BlameError.ignore_revisions_path_not_found:type_name -> gitaly.PathNotFoundError
+	73,  // 54: gitaly.LastCommitForPathRequest.repository:type_name -> gitaly.Repository
+	77,  // 55: gitaly.LastCommitForPathRequest.global_options:type_name -> gitaly.GlobalOptions
+	76,  // 56: gitaly.LastCommitForPathResponse.commit:type_name -> gitaly.GitCommit
+	73,  // 57: gitaly.ListLastCommitsForTreeRequest.repository:type_name -> gitaly.Repository
+	77,  // 58: gitaly.ListLastCommitsForTreeRequest.global_options:type_name -> gitaly.GlobalOptions
+	69,  // 59: gitaly.ListLastCommitsForTreeResponse.commits:type_name -> gitaly.ListLastCommitsForTreeResponse.CommitForTree
+	73,  // 60: gitaly.CommitsByMessageRequest.repository:type_name -> gitaly.Repository
+	77,  // 61: gitaly.CommitsByMessageRequest.global_options:type_name -> gitaly.GlobalOptions
+	76,  // 62: gitaly.CommitsByMessageResponse.commits:type_name -> gitaly.GitCommit
+	73,  // 63: gitaly.FilterShasWithSignaturesRequest.repository:type_name -> gitaly.Repository
+	73,  // 64: gitaly.ExtractCommitSignatureRequest.repository:type_name -> gitaly.Repository
+	73,  // 65: gitaly.GetCommitSignaturesRequest.repository:type_name -> gitaly.Repository
+	6,   // 66: gitaly.GetCommitSignaturesResponse.signer:type_name -> gitaly.GetCommitSignaturesResponse.Signer
+	73,  // 67: gitaly.VerifyCommitSignaturesRequest.repository:type_name -> gitaly.Repository
+	70,  // 68: gitaly.VerifyCommitSignaturesRequest.trusted_keys:type_name -> gitaly.VerifyCommitSignaturesRequest.TrustedKeys
+	71,  // 69: gitaly.VerifyCommitSignaturesResponse.verdicts:type_name -> gitaly.VerifyCommitSignaturesResponse.Verdict
+	73,  // 70: gitaly.GetCommitMessagesRequest.repository:type_name -> gitaly.Repository
+	73,  // 71: gitaly.CheckObjectsExistRequest.repository:type_name -> gitaly.Repository
+	72,  // 72: gitaly.CheckObjectsExistResponse.revisions:type_name -> gitaly.CheckObjectsExistResponse.RevisionExistence
+	76,  // 73: gitaly.ListCommitsByRefNameResponse.CommitForRef.commit:type_name -> gitaly.GitCommit
+	82,  // 74: gitaly.BlameResponse.Commit.author:type_name -> gitaly.CommitAuthor
+	82,  // 75: gitaly.BlameResponse.Commit.committer:type_name -> gitaly.CommitAuthor
+	76,  // 76: gitaly.ListLastCommitsForTreeResponse.CommitForTree.commit:type_name -> gitaly.GitCommit
+	83,  // 77: gitaly.VerifyCommitSignaturesResponse.Verdict.signature_type:type_name -> gitaly.SignatureType
+	7,   // 78: gitaly.VerifyCommitSignaturesResponse.Verdict.status:type_name -> gitaly.VerifyCommitSignaturesResponse.Verdict.Status
+	8,   // 79: gitaly.CommitService.ListCommits:input_type -> gitaly.ListCommitsRequest
+	10,  // 80: gitaly.CommitService.ListAllCommits:input_type -> gitaly.ListAllCommitsRequest
+	14,  // 81: gitaly.CommitService.CommitIsAncestor:input_type -> gitaly.CommitIsAncestorRequest
+	16,  // 82: gitaly.CommitService.TreeEntry:input_type -> gitaly.TreeEntryRequest
+	18,  // 83: gitaly.CommitService.CountCommits:input_type -> gitaly.CountCommitsRequest
+	20,  // 84: gitaly.CommitService.CountDivergingCommits:input_type -> gitaly.CountDivergingCommitsRequest
+	23,  // 85: gitaly.CommitService.GetTreeEntries:input_type -> gitaly.GetTreeEntriesRequest
+	26,  // 86: gitaly.CommitService.ListFiles:input_type -> gitaly.ListFilesRequest
+	28,  // 87: gitaly.CommitService.FindCommit:input_type -> gitaly.FindCommitRequest
+	12,  // 88: gitaly.CommitService.CommitStats:input_type -> gitaly.CommitStatsRequest
+	34,  // 89: gitaly.CommitService.FindAllCommits:input_type -> gitaly.FindAllCommitsRequest
+	36,  // 90: gitaly.CommitService.FindCommits:input_type -> gitaly.FindCommitsRequest
+	38,  // 91: gitaly.CommitService.CommitLanguages:input_type -> gitaly.CommitLanguagesRequest
+	40,  // 92: gitaly.CommitService.RawBlame:input_type -> gitaly.RawBlameRequest
+	43,  // 93: gitaly.CommitService.Blame:input_type -> gitaly.BlameRequest
+	46,  // 94: gitaly.CommitService.LastCommitForPath:input_type -> gitaly.LastCommitForPathRequest
+	48,  // 95: gitaly.CommitService.ListLastCommitsForTree:input_type -> gitaly.ListLastCommitsForTreeRequest
+	50,  // 96: gitaly.CommitService.CommitsByMessage:input_type -> gitaly.CommitsByMessageRequest
+	30,  // 97: gitaly.CommitService.ListCommitsByOid:input_type -> gitaly.ListCommitsByOidRequest
+	32,  // 98: gitaly.CommitService.ListCommitsByRefName:input_type -> gitaly.ListCommitsByRefNameRequest
+	52,  // 99: gitaly.CommitService.FilterShasWithSignatures:input_type -> gitaly.FilterShasWithSignaturesRequest
+	56,  // 100: gitaly.CommitService.GetCommitSignatures:input_type -> gitaly.GetCommitSignaturesRequest
+	58,  // 101: gitaly.CommitService.VerifyCommitSignatures:input_type -> gitaly.VerifyCommitSignaturesRequest
+	60,  // 102: gitaly.CommitService.GetCommitMessages:input_type -> gitaly.GetCommitMessagesRequest
+	62,  // 103: gitaly.CommitService.CheckObjectsExist:input_type -> gitaly.CheckObjectsExistRequest
+	9,   // 104: gitaly.CommitService.ListCommits:output_type -> gitaly.ListCommitsResponse
+	11,  // 105: gitaly.CommitService.ListAllCommits:output_type -> gitaly.ListAllCommitsResponse
+	15,  // 106: gitaly.CommitService.CommitIsAncestor:output_type -> gitaly.CommitIsAncestorResponse
+	17,  // 107: gitaly.CommitService.TreeEntry:output_type -> gitaly.TreeEntryResponse
+	19,  // 108: gitaly.CommitService.CountCommits:output_type -> gitaly.CountCommitsResponse
+	21,  // 109: gitaly.CommitService.CountDivergingCommits:output_type -> gitaly.CountDivergingCommitsResponse
+	24,  // 110: gitaly.CommitService.GetTreeEntries:output_type -> gitaly.GetTreeEntriesResponse
+	27,  // 111: gitaly.CommitService.ListFiles:output_type -> gitaly.ListFilesResponse
+	29,  // 112: gitaly.CommitService.FindCommit:output_type -> gitaly.FindCommitResponse
+	13,  // 113: gitaly.CommitService.CommitStats:output_type -> gitaly.CommitStatsResponse
+	35,  // 114: gitaly.CommitService.FindAllCommits:output_type -> gitaly.FindAllCommitsResponse
+	37,  // 115: gitaly.CommitService.FindCommits:output_type -> gitaly.FindCommitsResponse
+	39,  // 116: gitaly.CommitService.CommitLanguages:output_type -> gitaly.CommitLanguagesResponse
+	41,  // 117: gitaly.CommitService.RawBlame:output_type -> gitaly.RawBlameResponse
+	44,  // 118: gitaly.CommitService.Blame:output_type -> gitaly.BlameResponse
+	47,  // 119: gitaly.CommitService.LastCommitForPath:output_type -> gitaly.LastCommitForPathResponse
+	49,  // 120: gitaly.CommitService.ListLastCommitsForTree:output_type -> gitaly.ListLastCommitsForTreeResponse
+	51,  // 121: gitaly.CommitService.CommitsByMessage:output_type -> gitaly.CommitsByMessageResponse
+	31,  // 122: gitaly.CommitService.ListCommitsByOid:output_type -> gitaly.ListCommitsByOidResponse
+	33,  // 123: gitaly.CommitService.ListCommitsByRefName:output_type -> gitaly.ListCommitsByRefNameResponse
+	53,  // 124: gitaly.CommitService.FilterShasWithSignatures:output_type -> gitaly.FilterShasWithSignaturesResponse
+	57,  // 125: gitaly.CommitService.GetCommitSignatures:output_type -> gitaly.GetCommitSignaturesResponse
+	59,  // 126: gitaly.CommitService.VerifyCommitSignatures:output_type -> gitaly.VerifyCommitSignaturesResponse
+	61,  // 127: gitaly.CommitService.GetCommitMessages:output_type -> gitaly.GetCommitMessagesResponse
+	63,  // 128: gitaly.CommitService.CheckObjectsExist:output_type -> gitaly.CheckObjectsExistResponse
+	104, // [104:129] is the sub-list for method output_type
+	79,  // [79:104] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_commit_proto_init() }
@@ -5577,7 +6540,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastCommitForPathRequest); i {
+			switch v := v.(*BlameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5589,7 +6552,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastCommitForPathResponse); i {
+			switch v := v.(*BlameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5601,7 +6564,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLastCommitsForTreeRequest); i {
+			switch v := v.(*BlameError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5613,7 +6576,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLastCommitsForTreeResponse); i {
+			switch v := v.(*LastCommitForPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5625,7 +6588,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitsByMessageRequest); i {
+			switch v := v.(*LastCommitForPathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5637,7 +6600,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitsByMessageResponse); i {
+			switch v := v.(*ListLastCommitsForTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5649,7 +6612,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterShasWithSignaturesRequest); i {
+			switch v := v.(*ListLastCommitsForTreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5661,7 +6624,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterShasWithSignaturesResponse); i {
+			switch v := v.(*CommitsByMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5673,7 +6636,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractCommitSignatureRequest); i {
+			switch v := v.(*CommitsByMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5685,7 +6648,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractCommitSignatureResponse); i {
+			switch v := v.(*FilterShasWithSignaturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5697,7 +6660,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommitSignaturesRequest); i {
+			switch v := v.(*FilterShasWithSignaturesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5709,7 +6672,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommitSignaturesResponse); i {
+			switch v := v.(*ExtractCommitSignatureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5721,7 +6684,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommitMessagesRequest); i {
+			switch v := v.(*ExtractCommitSignatureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5733,7 +6696,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommitMessagesResponse); i {
+			switch v := v.(*GetCommitSignaturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5745,7 +6708,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckObjectsExistRequest); i {
+			switch v := v.(*GetCommitSignaturesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5757,7 +6720,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckObjectsExistResponse); i {
+			switch v := v.(*VerifyCommitSignaturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5769,7 +6732,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitsByRefNameResponse_CommitForRef); i {
+			switch v := v.(*VerifyCommitSignaturesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5781,7 +6744,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitLanguagesResponse_Language); i {
+			switch v := v.(*GetCommitMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5793,7 +6756,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawBlameError_OutOfRangeError); i {
+			switch v := v.(*GetCommitMessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5805,7 +6768,7 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLastCommitsForTreeResponse_CommitForTree); i {
+			switch v := v.(*CheckObjectsExistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5817,6 +6780,114 @@ func file_commit_proto_init() {
 			}
 		}
 		file_commit_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckObjectsExistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommitsByRefNameResponse_CommitForRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitLanguagesResponse_Language); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawBlameError_OutOfRangeError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameResponse_Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameResponse_Range); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLastCommitsForTreeResponse_CommitForTree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCommitSignaturesRequest_TrustedKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCommitSignaturesResponse_Verdict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckObjectsExistResponse_RevisionExistence); i {
 			case 0:
 				return &v.state
@@ -5837,13 +6908,17 @@ func file_commit_proto_init() {
 		(*RawBlameError_PathNotFound)(nil),
 		(*RawBlameError_OutOfRange)(nil),
 	}
+	file_commit_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*BlameError_PathNotFound)(nil),
+		(*BlameError_IgnoreRevisionsPathNotFound)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_commit_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   56,
+			NumEnums:      8,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
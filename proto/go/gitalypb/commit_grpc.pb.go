@@ -55,6 +55,11 @@ type CommitServiceClient interface {
 	// RawBlame blames lines in a blob to when they have last been changed. Returns the raw output of the git-blame(1)
 	// command.
 	RawBlame(ctx context.Context, in *RawBlameRequest, opts ...grpc.CallOption) (CommitService_RawBlameClient, error)
+	// Blame blames lines in a blob to the commits that have last changed them. Contrary to RawBlame, the blame
+	// is returned as line ranges that refer to the commits that are returned alongside of them. Every commit is
+	// only returned once per call. The line ranges can be paginated so that large files can be blamed
+	// incrementally. The response is fully determined by the request in case the revision is a commit ID.
+	Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (CommitService_BlameClient, error)
 	// LastCommitForPath returns the last commit that has changed a given path.
 	//
 	// The following special cases apply and have grown historically:
@@ -74,6 +79,11 @@ type CommitServiceClient interface {
 	FilterShasWithSignatures(ctx context.Context, opts ...grpc.CallOption) (CommitService_FilterShasWithSignaturesClient, error)
 	// This comment is left unintentionally blank.
 	GetCommitSignatures(ctx context.Context, in *GetCommitSignaturesRequest, opts ...grpc.CallOption) (CommitService_GetCommitSignaturesClient, error)
+	// VerifyCommitSignatures verifies the signatures of commits and annotated tags against trusted
+	// keys and returns a verdict for each of them. GPG, SSH and X.509 signatures are supported. For
+	// each type of key, the keys passed in with the request are used if set, and the keys configured
+	// for the repository's storage otherwise. Objects that don't exist are skipped.
+	VerifyCommitSignatures(ctx context.Context, in *VerifyCommitSignaturesRequest, opts ...grpc.CallOption) (CommitService_VerifyCommitSignaturesClient, error)
 	// This comment is left unintentionally blank.
 	GetCommitMessages(ctx context.Context, in *GetCommitMessagesRequest, opts ...grpc.CallOption) (CommitService_GetCommitMessagesClient, error)
 	// CheckObjectsExist will check for the existence of revisions against a
@@ -401,6 +411,38 @@ func (x *commitServiceRawBlameClient) Recv() (*RawBlameResponse, error) {
 	return m, nil
 }
 
+func (c *commitServiceClient) Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (CommitService_BlameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[8], "/gitaly.CommitService/Blame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commitServiceBlameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommitService_BlameClient interface {
+	Recv() (*BlameResponse, error)
+	grpc.ClientStream
+}
+
+type commitServiceBlameClient struct {
+	grpc.ClientStream
+}
+
+func (x *commitServiceBlameClient) Recv() (*BlameResponse, error) {
+	m := new(BlameResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *commitServiceClient) LastCommitForPath(ctx context.Context, in *LastCommitForPathRequest, opts ...grpc.CallOption) (*LastCommitForPathResponse, error) {
 	out := new(LastCommitForPathResponse)
 	err := c.cc.Invoke(ctx, "/gitaly.CommitService/LastCommitForPath", in, out, opts...)
@@ -411,7 +453,7 @@ func (c *commitServiceClient) LastCommitForPath(ctx context.Context, in *LastCom
 }
 
 func (c *commitServiceClient) ListLastCommitsForTree(ctx context.Context, in *ListLastCommitsForTreeRequest, opts ...grpc.CallOption) (CommitService_ListLastCommitsForTreeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[8], "/gitaly.CommitService/ListLastCommitsForTree", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[9], "/gitaly.CommitService/ListLastCommitsForTree", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -443,7 +485,7 @@ func (x *commitServiceListLastCommitsForTreeClient) Recv() (*ListLastCommitsForT
 }
 
 func (c *commitServiceClient) CommitsByMessage(ctx context.Context, in *CommitsByMessageRequest, opts ...grpc.CallOption) (CommitService_CommitsByMessageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[9], "/gitaly.CommitService/CommitsByMessage", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[10], "/gitaly.CommitService/CommitsByMessage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -475,7 +517,7 @@ func (x *commitServiceCommitsByMessageClient) Recv() (*CommitsByMessageResponse,
 }
 
 func (c *commitServiceClient) ListCommitsByOid(ctx context.Context, in *ListCommitsByOidRequest, opts ...grpc.CallOption) (CommitService_ListCommitsByOidClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[10], "/gitaly.CommitService/ListCommitsByOid", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[11], "/gitaly.CommitService/ListCommitsByOid", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +549,7 @@ func (x *commitServiceListCommitsByOidClient) Recv() (*ListCommitsByOidResponse,
 }
 
 func (c *commitServiceClient) ListCommitsByRefName(ctx context.Context, in *ListCommitsByRefNameRequest, opts ...grpc.CallOption) (CommitService_ListCommitsByRefNameClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[11], "/gitaly.CommitService/ListCommitsByRefName", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[12], "/gitaly.CommitService/ListCommitsByRefName", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -539,7 +581,7 @@ func (x *commitServiceListCommitsByRefNameClient) Recv() (*ListCommitsByRefNameR
 }
 
 func (c *commitServiceClient) FilterShasWithSignatures(ctx context.Context, opts ...grpc.CallOption) (CommitService_FilterShasWithSignaturesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[12], "/gitaly.CommitService/FilterShasWithSignatures", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[13], "/gitaly.CommitService/FilterShasWithSignatures", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -570,7 +612,7 @@ func (x *commitServiceFilterShasWithSignaturesClient) Recv() (*FilterShasWithSig
 }
 
 func (c *commitServiceClient) GetCommitSignatures(ctx context.Context, in *GetCommitSignaturesRequest, opts ...grpc.CallOption) (CommitService_GetCommitSignaturesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[13], "/gitaly.CommitService/GetCommitSignatures", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[14], "/gitaly.CommitService/GetCommitSignatures", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -601,8 +643,40 @@ func (x *commitServiceGetCommitSignaturesClient) Recv() (*GetCommitSignaturesRes
 	return m, nil
 }
 
+func (c *commitServiceClient) VerifyCommitSignatures(ctx context.Context, in *VerifyCommitSignaturesRequest, opts ...grpc.CallOption) (CommitService_VerifyCommitSignaturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[15], "/gitaly.CommitService/VerifyCommitSignatures", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commitServiceVerifyCommitSignaturesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommitService_VerifyCommitSignaturesClient interface {
+	Recv() (*VerifyCommitSignaturesResponse, error)
+	grpc.ClientStream
+}
+
+type commitServiceVerifyCommitSignaturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *commitServiceVerifyCommitSignaturesClient) Recv() (*VerifyCommitSignaturesResponse, error) {
+	m := new(VerifyCommitSignaturesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *commitServiceClient) GetCommitMessages(ctx context.Context, in *GetCommitMessagesRequest, opts ...grpc.CallOption) (CommitService_GetCommitMessagesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[14], "/gitaly.CommitService/GetCommitMessages", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[16], "/gitaly.CommitService/GetCommitMessages", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -634,7 +708,7 @@ func (x *commitServiceGetCommitMessagesClient) Recv() (*GetCommitMessagesRespons
 }
 
 func (c *commitServiceClient) CheckObjectsExist(ctx context.Context, opts ...grpc.CallOption) (CommitService_CheckObjectsExistClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[15], "/gitaly.CommitService/CheckObjectsExist", opts...)
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[17], "/gitaly.CommitService/CheckObjectsExist", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -701,6 +775,11 @@ type CommitServiceServer interface {
 	// RawBlame blames lines in a blob to when they have last been changed. Returns the raw output of the git-blame(1)
 	// command.
 	RawBlame(*RawBlameRequest, CommitService_RawBlameServer) error
+	// Blame blames lines in a blob to the commits that have last changed them. Contrary to RawBlame, the blame
+	// is returned as line ranges that refer to the commits that are returned alongside of them. Every commit is
+	// only returned once per call. The line ranges can be paginated so that large files can be blamed
+	// incrementally. The response is fully determined by the request in case the revision is a commit ID.
+	Blame(*BlameRequest, CommitService_BlameServer) error
 	// LastCommitForPath returns the last commit that has changed a given path.
 	//
 	// The following special cases apply and have grown historically:
@@ -720,6 +799,11 @@ type CommitServiceServer interface {
 	FilterShasWithSignatures(CommitService_FilterShasWithSignaturesServer) error
 	// This comment is left unintentionally blank.
 	GetCommitSignatures(*GetCommitSignaturesRequest, CommitService_GetCommitSignaturesServer) error
+	// VerifyCommitSignatures verifies the signatures of commits and annotated tags against trusted
+	// keys and returns a verdict for each of them. GPG, SSH and X.509 signatures are supported. For
+	// each type of key, the keys passed in with the request are used if set, and the keys configured
+	// for the repository's storage otherwise. Objects that don't exist are skipped.
+	VerifyCommitSignatures(*VerifyCommitSignaturesRequest, CommitService_VerifyCommitSignaturesServer) error
 	// This comment is left unintentionally blank.
 	GetCommitMessages(*GetCommitMessagesRequest, CommitService_GetCommitMessagesServer) error
 	// CheckObjectsExist will check for the existence of revisions against a
@@ -776,6 +860,9 @@ func (UnimplementedCommitServiceServer) CommitLanguages(context.Context, *Commit
 func (UnimplementedCommitServiceServer) RawBlame(*RawBlameRequest, CommitService_RawBlameServer) error {
 	return status.Errorf(codes.Unimplemented, "method RawBlame not implemented")
 }
+func (UnimplementedCommitServiceServer) Blame(*BlameRequest, CommitService_BlameServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blame not implemented")
+}
 func (UnimplementedCommitServiceServer) LastCommitForPath(context.Context, *LastCommitForPathRequest) (*LastCommitForPathResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastCommitForPath not implemented")
 }
@@ -797,6 +884,9 @@ func (UnimplementedCommitServiceServer) FilterShasWithSignatures(CommitService_F
 func (UnimplementedCommitServiceServer) GetCommitSignatures(*GetCommitSignaturesRequest, CommitService_GetCommitSignaturesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetCommitSignatures not implemented")
 }
+func (UnimplementedCommitServiceServer) VerifyCommitSignatures(*VerifyCommitSignaturesRequest, CommitService_VerifyCommitSignaturesServer) error {
+	return status.Errorf(codes.Unimplemented, "method VerifyCommitSignatures not implemented")
+}
 func (UnimplementedCommitServiceServer) GetCommitMessages(*GetCommitMessagesRequest, CommitService_GetCommitMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetCommitMessages not implemented")
 }
@@ -1092,6 +1182,27 @@ func (x *commitServiceRawBlameServer) Send(m *RawBlameResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CommitService_Blame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommitServiceServer).Blame(m, &commitServiceBlameServer{stream})
+}
+
+type CommitService_BlameServer interface {
+	Send(*BlameResponse) error
+	grpc.ServerStream
+}
+
+type commitServiceBlameServer struct {
+	grpc.ServerStream
+}
+
+func (x *commitServiceBlameServer) Send(m *BlameResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CommitService_LastCommitForPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LastCommitForPathRequest)
 	if err := dec(in); err != nil {
@@ -1241,6 +1352,27 @@ func (x *commitServiceGetCommitSignaturesServer) Send(m *GetCommitSignaturesResp
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CommitService_VerifyCommitSignatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VerifyCommitSignaturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommitServiceServer).VerifyCommitSignatures(m, &commitServiceVerifyCommitSignaturesServer{stream})
+}
+
+type CommitService_VerifyCommitSignaturesServer interface {
+	Send(*VerifyCommitSignaturesResponse) error
+	grpc.ServerStream
+}
+
+type commitServiceVerifyCommitSignaturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *commitServiceVerifyCommitSignaturesServer) Send(m *VerifyCommitSignaturesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CommitService_GetCommitMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetCommitMessagesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1365,6 +1497,11 @@ var CommitService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CommitService_RawBlame_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Blame",
+			Handler:       _CommitService_Blame_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListLastCommitsForTree",
 			Handler:       _CommitService_ListLastCommitsForTree_Handler,
@@ -1396,6 +1533,11 @@ var CommitService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CommitService_GetCommitSignatures_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "VerifyCommitSignatures",
+			Handler:       _CommitService_VerifyCommitSignatures_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetCommitMessages",
 			Handler:       _CommitService_GetCommitMessages_Handler,
@@ -51,6 +51,14 @@ type PraefectInfoServiceClient interface {
 	SetReplicationFactor(ctx context.Context, in *SetReplicationFactorRequest, opts ...grpc.CallOption) (*SetReplicationFactorResponse, error)
 	// GetRepositoryMetadata returns the cluster metadata for a repository. Returns NotFound if the repository does not exist.
 	GetRepositoryMetadata(ctx context.Context, in *GetRepositoryMetadataRequest, opts ...grpc.CallOption) (*GetRepositoryMetadataResponse, error)
+	// SetStorageDraining drains a physical storage for maintenance or restores a drained physical storage. Praefect
+	// doesn't route new requests to a draining storage and elects new primaries for the repositories it is the primary
+	// of. Requests already in progress on the storage are allowed to finish. A restored storage serves requests for a
+	// repository again once it has caught up with the repository's replication.
+	SetStorageDraining(ctx context.Context, in *SetStorageDrainingRequest, opts ...grpc.CallOption) (*SetStorageDrainingResponse, error)
+	// GetStorageUsage returns the disk usage of the physical storages as last polled by Praefect. The disk usage is
+	// only tracked if storage usage aware placement of new repositories is enabled.
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
 }
 
 type praefectInfoServiceClient struct {
@@ -148,6 +156,24 @@ func (c *praefectInfoServiceClient) GetRepositoryMetadata(ctx context.Context, i
 	return out, nil
 }
 
+func (c *praefectInfoServiceClient) SetStorageDraining(ctx context.Context, in *SetStorageDrainingRequest, opts ...grpc.CallOption) (*SetStorageDrainingResponse, error) {
+	out := new(SetStorageDrainingResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.PraefectInfoService/SetStorageDraining", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *praefectInfoServiceClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.PraefectInfoService/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PraefectInfoServiceServer is the server API for PraefectInfoService service.
 // All implementations must embed UnimplementedPraefectInfoServiceServer
 // for forward compatibility
@@ -181,6 +207,14 @@ type PraefectInfoServiceServer interface {
 	SetReplicationFactor(context.Context, *SetReplicationFactorRequest) (*SetReplicationFactorResponse, error)
 	// GetRepositoryMetadata returns the cluster metadata for a repository. Returns NotFound if the repository does not exist.
 	GetRepositoryMetadata(context.Context, *GetRepositoryMetadataRequest) (*GetRepositoryMetadataResponse, error)
+	// SetStorageDraining drains a physical storage for maintenance or restores a drained physical storage. Praefect
+	// doesn't route new requests to a draining storage and elects new primaries for the repositories it is the primary
+	// of. Requests already in progress on the storage are allowed to finish. A restored storage serves requests for a
+	// repository again once it has caught up with the repository's replication.
+	SetStorageDraining(context.Context, *SetStorageDrainingRequest) (*SetStorageDrainingResponse, error)
+	// GetStorageUsage returns the disk usage of the physical storages as last polled by Praefect. The disk usage is
+	// only tracked if storage usage aware placement of new repositories is enabled.
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
 	mustEmbedUnimplementedPraefectInfoServiceServer()
 }
 
@@ -209,6 +243,12 @@ func (UnimplementedPraefectInfoServiceServer) SetReplicationFactor(context.Conte
 func (UnimplementedPraefectInfoServiceServer) GetRepositoryMetadata(context.Context, *GetRepositoryMetadataRequest) (*GetRepositoryMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRepositoryMetadata not implemented")
 }
+func (UnimplementedPraefectInfoServiceServer) SetStorageDraining(context.Context, *SetStorageDrainingRequest) (*SetStorageDrainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageDraining not implemented")
+}
+func (UnimplementedPraefectInfoServiceServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
 func (UnimplementedPraefectInfoServiceServer) mustEmbedUnimplementedPraefectInfoServiceServer() {}
 
 // UnsafePraefectInfoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -351,6 +391,42 @@ func _PraefectInfoService_GetRepositoryMetadata_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PraefectInfoService_SetStorageDraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageDrainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PraefectInfoServiceServer).SetStorageDraining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.PraefectInfoService/SetStorageDraining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PraefectInfoServiceServer).SetStorageDraining(ctx, req.(*SetStorageDrainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PraefectInfoService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PraefectInfoServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.PraefectInfoService/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PraefectInfoServiceServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PraefectInfoService_ServiceDesc is the grpc.ServiceDesc for PraefectInfoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +458,14 @@ var PraefectInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRepositoryMetadata",
 			Handler:    _PraefectInfoService_GetRepositoryMetadata_Handler,
 		},
+		{
+			MethodName: "SetStorageDraining",
+			Handler:    _PraefectInfoService_SetStorageDraining_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _PraefectInfoService_GetStorageUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
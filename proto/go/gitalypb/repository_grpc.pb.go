@@ -118,6 +118,16 @@ type RepositoryServiceClient interface {
 	SearchFilesByContent(ctx context.Context, in *SearchFilesByContentRequest, opts ...grpc.CallOption) (RepositoryService_SearchFilesByContentClient, error)
 	// This comment is left unintentionally blank.
 	SearchFilesByName(ctx context.Context, in *SearchFilesByNameRequest, opts ...grpc.CallOption) (RepositoryService_SearchFilesByNameClient, error)
+	// SearchCode searches the contents of the files at a revision. Contrary to SearchFilesByContent, matching files are
+	// ranked and can be paginated. The search uses the repository's code search index in case it has been created via
+	// UpdateCodeSearchIndex, and falls back to git-grep(1) otherwise.
+	SearchCode(ctx context.Context, in *SearchCodeRequest, opts ...grpc.CallOption) (RepositoryService_SearchCodeClient, error)
+	// UpdateCodeSearchIndex creates the trigram index used by SearchCode for the default branch of the repository, or
+	// updates it in case it exists already. Once created, the index is updated incrementally in the background after
+	// pushes and when the repository is optimized. The index is a cache that is local to each replica and is neither
+	// replicated nor part of the repository's snapshots: SearchCode accounts for the files changed since the indexed
+	// commit, so an outdated index only slows down searches but doesn't change their results.
+	UpdateCodeSearchIndex(ctx context.Context, in *UpdateCodeSearchIndexRequest, opts ...grpc.CallOption) (*UpdateCodeSearchIndexResponse, error)
 	// Deprecated: Do not use.
 	// RestoreCustomHooks sets the git hooks for a repository. The hooks are sent
 	// in a tar archive containing a `custom_hooks` directory. This directory is
@@ -192,6 +202,29 @@ type RepositoryServiceClient interface {
 	// The repository is restored synchronously. The source object-storage must
 	// be configured in config.backup.go_cloud_url
 	RestoreRepository(ctx context.Context, in *RestoreRepositoryRequest, opts ...grpc.CallOption) (*RestoreRepositoryResponse, error)
+	// VerifyRepositoryBackup verifies a backup streamed directly from object-storage
+	// can be restored. The backup is restored into a scratch repository which is
+	// checked with git-fsck(1) and whose references are compared with the
+	// references recorded in the backup. The scratch repository is removed
+	// afterwards. The source object-storage must be configured in
+	// config.backup.go_cloud_url
+	VerifyRepositoryBackup(ctx context.Context, in *VerifyRepositoryBackupRequest, opts ...grpc.CallOption) (*VerifyRepositoryBackupResponse, error)
+	// GetHistoricalSnapshot returns the references of a repository as they were at a past point
+	// in its write-ahead log. The point in time can be given either as a log index or as a
+	// timestamp. Only states still retained in the write-ahead log's history can be read. The
+	// RPC requires transactions to be enabled.
+	//
+	// The snapshot only covers the direct references and the default branch. The history doesn't
+	// retain the rest of the repository's state, so custom hooks and repository configuration are
+	// not included. The objects the references point to are not guaranteed to still exist as
+	// housekeeping may have pruned them since.
+	GetHistoricalSnapshot(ctx context.Context, in *GetHistoricalSnapshotRequest, opts ...grpc.CallOption) (RepositoryService_GetHistoricalSnapshotClient, error)
+	// StreamLogEvents streams the changes committed to a repository through its write-ahead log.
+	// The stream starts after the given log index and follows new changes as they are committed
+	// until the client cancels the RPC. The log index of the last received event can be used to
+	// resume the stream. Only changes still retained in the write-ahead log's history can be
+	// streamed. The RPC requires transactions to be enabled.
+	StreamLogEvents(ctx context.Context, in *StreamLogEventsRequest, opts ...grpc.CallOption) (RepositoryService_StreamLogEventsClient, error)
 }
 
 type repositoryServiceClient struct {
@@ -744,9 +777,50 @@ func (x *repositoryServiceSearchFilesByNameClient) Recv() (*SearchFilesByNameRes
 	return m, nil
 }
 
+func (c *repositoryServiceClient) SearchCode(ctx context.Context, in *SearchCodeRequest, opts ...grpc.CallOption) (RepositoryService_SearchCodeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[12], "/gitaly.RepositoryService/SearchCode", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceSearchCodeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_SearchCodeClient interface {
+	Recv() (*SearchCodeResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceSearchCodeClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceSearchCodeClient) Recv() (*SearchCodeResponse, error) {
+	m := new(SearchCodeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryServiceClient) UpdateCodeSearchIndex(ctx context.Context, in *UpdateCodeSearchIndexRequest, opts ...grpc.CallOption) (*UpdateCodeSearchIndexResponse, error) {
+	out := new(UpdateCodeSearchIndexResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/UpdateCodeSearchIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *repositoryServiceClient) RestoreCustomHooks(ctx context.Context, opts ...grpc.CallOption) (RepositoryService_RestoreCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[12], "/gitaly.RepositoryService/RestoreCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[13], "/gitaly.RepositoryService/RestoreCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -780,7 +854,7 @@ func (x *repositoryServiceRestoreCustomHooksClient) CloseAndRecv() (*RestoreCust
 }
 
 func (c *repositoryServiceClient) SetCustomHooks(ctx context.Context, opts ...grpc.CallOption) (RepositoryService_SetCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[13], "/gitaly.RepositoryService/SetCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[14], "/gitaly.RepositoryService/SetCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -815,7 +889,7 @@ func (x *repositoryServiceSetCustomHooksClient) CloseAndRecv() (*SetCustomHooksR
 
 // Deprecated: Do not use.
 func (c *repositoryServiceClient) BackupCustomHooks(ctx context.Context, in *BackupCustomHooksRequest, opts ...grpc.CallOption) (RepositoryService_BackupCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[14], "/gitaly.RepositoryService/BackupCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[15], "/gitaly.RepositoryService/BackupCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -847,7 +921,7 @@ func (x *repositoryServiceBackupCustomHooksClient) Recv() (*BackupCustomHooksRes
 }
 
 func (c *repositoryServiceClient) GetCustomHooks(ctx context.Context, in *GetCustomHooksRequest, opts ...grpc.CallOption) (RepositoryService_GetCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[15], "/gitaly.RepositoryService/GetCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[16], "/gitaly.RepositoryService/GetCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -979,6 +1053,79 @@ func (c *repositoryServiceClient) RestoreRepository(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *repositoryServiceClient) VerifyRepositoryBackup(ctx context.Context, in *VerifyRepositoryBackupRequest, opts ...grpc.CallOption) (*VerifyRepositoryBackupResponse, error) {
+	out := new(VerifyRepositoryBackupResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/VerifyRepositoryBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetHistoricalSnapshot(ctx context.Context, in *GetHistoricalSnapshotRequest, opts ...grpc.CallOption) (RepositoryService_GetHistoricalSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[17], "/gitaly.RepositoryService/GetHistoricalSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceGetHistoricalSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_GetHistoricalSnapshotClient interface {
+	Recv() (*GetHistoricalSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceGetHistoricalSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceGetHistoricalSnapshotClient) Recv() (*GetHistoricalSnapshotResponse, error) {
+	m := new(GetHistoricalSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryServiceClient) StreamLogEvents(ctx context.Context, in *StreamLogEventsRequest, opts ...grpc.CallOption) (RepositoryService_StreamLogEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[18], "/gitaly.RepositoryService/StreamLogEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceStreamLogEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_StreamLogEventsClient interface {
+	Recv() (*StreamLogEventsResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceStreamLogEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceStreamLogEventsClient) Recv() (*StreamLogEventsResponse, error) {
+	m := new(StreamLogEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility
@@ -1079,6 +1226,16 @@ type RepositoryServiceServer interface {
 	SearchFilesByContent(*SearchFilesByContentRequest, RepositoryService_SearchFilesByContentServer) error
 	// This comment is left unintentionally blank.
 	SearchFilesByName(*SearchFilesByNameRequest, RepositoryService_SearchFilesByNameServer) error
+	// SearchCode searches the contents of the files at a revision. Contrary to SearchFilesByContent, matching files are
+	// ranked and can be paginated. The search uses the repository's code search index in case it has been created via
+	// UpdateCodeSearchIndex, and falls back to git-grep(1) otherwise.
+	SearchCode(*SearchCodeRequest, RepositoryService_SearchCodeServer) error
+	// UpdateCodeSearchIndex creates the trigram index used by SearchCode for the default branch of the repository, or
+	// updates it in case it exists already. Once created, the index is updated incrementally in the background after
+	// pushes and when the repository is optimized. The index is a cache that is local to each replica and is neither
+	// replicated nor part of the repository's snapshots: SearchCode accounts for the files changed since the indexed
+	// commit, so an outdated index only slows down searches but doesn't change their results.
+	UpdateCodeSearchIndex(context.Context, *UpdateCodeSearchIndexRequest) (*UpdateCodeSearchIndexResponse, error)
 	// Deprecated: Do not use.
 	// RestoreCustomHooks sets the git hooks for a repository. The hooks are sent
 	// in a tar archive containing a `custom_hooks` directory. This directory is
@@ -1153,6 +1310,29 @@ type RepositoryServiceServer interface {
 	// The repository is restored synchronously. The source object-storage must
 	// be configured in config.backup.go_cloud_url
 	RestoreRepository(context.Context, *RestoreRepositoryRequest) (*RestoreRepositoryResponse, error)
+	// VerifyRepositoryBackup verifies a backup streamed directly from object-storage
+	// can be restored. The backup is restored into a scratch repository which is
+	// checked with git-fsck(1) and whose references are compared with the
+	// references recorded in the backup. The scratch repository is removed
+	// afterwards. The source object-storage must be configured in
+	// config.backup.go_cloud_url
+	VerifyRepositoryBackup(context.Context, *VerifyRepositoryBackupRequest) (*VerifyRepositoryBackupResponse, error)
+	// GetHistoricalSnapshot returns the references of a repository as they were at a past point
+	// in its write-ahead log. The point in time can be given either as a log index or as a
+	// timestamp. Only states still retained in the write-ahead log's history can be read. The
+	// RPC requires transactions to be enabled.
+	//
+	// The snapshot only covers the direct references and the default branch. The history doesn't
+	// retain the rest of the repository's state, so custom hooks and repository configuration are
+	// not included. The objects the references point to are not guaranteed to still exist as
+	// housekeeping may have pruned them since.
+	GetHistoricalSnapshot(*GetHistoricalSnapshotRequest, RepositoryService_GetHistoricalSnapshotServer) error
+	// StreamLogEvents streams the changes committed to a repository through its write-ahead log.
+	// The stream starts after the given log index and follows new changes as they are committed
+	// until the client cancels the RPC. The log index of the last received event can be used to
+	// resume the stream. Only changes still retained in the write-ahead log's history can be
+	// streamed. The RPC requires transactions to be enabled.
+	StreamLogEvents(*StreamLogEventsRequest, RepositoryService_StreamLogEventsServer) error
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -1247,6 +1427,12 @@ func (UnimplementedRepositoryServiceServer) SearchFilesByContent(*SearchFilesByC
 func (UnimplementedRepositoryServiceServer) SearchFilesByName(*SearchFilesByNameRequest, RepositoryService_SearchFilesByNameServer) error {
 	return status.Errorf(codes.Unimplemented, "method SearchFilesByName not implemented")
 }
+func (UnimplementedRepositoryServiceServer) SearchCode(*SearchCodeRequest, RepositoryService_SearchCodeServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchCode not implemented")
+}
+func (UnimplementedRepositoryServiceServer) UpdateCodeSearchIndex(context.Context, *UpdateCodeSearchIndexRequest) (*UpdateCodeSearchIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCodeSearchIndex not implemented")
+}
 func (UnimplementedRepositoryServiceServer) RestoreCustomHooks(RepositoryService_RestoreCustomHooksServer) error {
 	return status.Errorf(codes.Unimplemented, "method RestoreCustomHooks not implemented")
 }
@@ -1292,6 +1478,15 @@ func (UnimplementedRepositoryServiceServer) BackupRepository(context.Context, *B
 func (UnimplementedRepositoryServiceServer) RestoreRepository(context.Context, *RestoreRepositoryRequest) (*RestoreRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestoreRepository not implemented")
 }
+func (UnimplementedRepositoryServiceServer) VerifyRepositoryBackup(context.Context, *VerifyRepositoryBackupRequest) (*VerifyRepositoryBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRepositoryBackup not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetHistoricalSnapshot(*GetHistoricalSnapshotRequest, RepositoryService_GetHistoricalSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetHistoricalSnapshot not implemented")
+}
+func (UnimplementedRepositoryServiceServer) StreamLogEvents(*StreamLogEventsRequest, RepositoryService_StreamLogEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogEvents not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 
 // UnsafeRepositoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1883,6 +2078,45 @@ func (x *repositoryServiceSearchFilesByNameServer) Send(m *SearchFilesByNameResp
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RepositoryService_SearchCode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchCodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).SearchCode(m, &repositoryServiceSearchCodeServer{stream})
+}
+
+type RepositoryService_SearchCodeServer interface {
+	Send(*SearchCodeResponse) error
+	grpc.ServerStream
+}
+
+type repositoryServiceSearchCodeServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceSearchCodeServer) Send(m *SearchCodeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RepositoryService_UpdateCodeSearchIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCodeSearchIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).UpdateCodeSearchIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.RepositoryService/UpdateCodeSearchIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).UpdateCodeSearchIndex(ctx, req.(*UpdateCodeSearchIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_RestoreCustomHooks_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(RepositoryServiceServer).RestoreCustomHooks(&repositoryServiceRestoreCustomHooksServer{stream})
 }
@@ -2175,6 +2409,66 @@ func _RepositoryService_RestoreRepository_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_VerifyRepositoryBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRepositoryBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).VerifyRepositoryBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.RepositoryService/VerifyRepositoryBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).VerifyRepositoryBackup(ctx, req.(*VerifyRepositoryBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetHistoricalSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetHistoricalSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).GetHistoricalSnapshot(m, &repositoryServiceGetHistoricalSnapshotServer{stream})
+}
+
+type RepositoryService_GetHistoricalSnapshotServer interface {
+	Send(*GetHistoricalSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type repositoryServiceGetHistoricalSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceGetHistoricalSnapshotServer) Send(m *GetHistoricalSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RepositoryService_StreamLogEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).StreamLogEvents(m, &repositoryServiceStreamLogEventsServer{stream})
+}
+
+type RepositoryService_StreamLogEventsServer interface {
+	Send(*StreamLogEventsResponse) error
+	grpc.ServerStream
+}
+
+type repositoryServiceStreamLogEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceStreamLogEventsServer) Send(m *StreamLogEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2250,6 +2544,10 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRepositoryFromSnapshot",
 			Handler:    _RepositoryService_CreateRepositoryFromSnapshot_Handler,
 		},
+		{
+			MethodName: "UpdateCodeSearchIndex",
+			Handler:    _RepositoryService_UpdateCodeSearchIndex_Handler,
+		},
 		{
 			MethodName: "GetObjectDirectorySize",
 			Handler:    _RepositoryService_GetObjectDirectorySize_Handler,
@@ -2294,6 +2592,10 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreRepository",
 			Handler:    _RepositoryService_RestoreRepository_Handler,
 		},
+		{
+			MethodName: "VerifyRepositoryBackup",
+			Handler:    _RepositoryService_VerifyRepositoryBackup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2357,6 +2659,11 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RepositoryService_SearchFilesByName_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SearchCode",
+			Handler:       _RepositoryService_SearchCode_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "RestoreCustomHooks",
 			Handler:       _RepositoryService_RestoreCustomHooks_Handler,
@@ -2377,6 +2684,16 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RepositoryService_GetCustomHooks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetHistoricalSnapshot",
+			Handler:       _RepositoryService_GetHistoricalSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLogEvents",
+			Handler:       _RepositoryService_StreamLogEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "repository.proto",
 }